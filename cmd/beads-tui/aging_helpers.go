@@ -0,0 +1,25 @@
+package main
+
+import (
+	"log"
+	"maps"
+	"strconv"
+	"strings"
+
+	"github.com/andy/beads-tui/internal/state"
+)
+
+// agingDaysFromConfig merges the priority_aging_days config ("P2": 30) into
+// state.DefaultAgingDays. Keys that aren't P0-P4 are logged and ignored.
+func agingDaysFromConfig(configured map[string]int) map[int]int {
+	limits := maps.Clone(state.DefaultAgingDays)
+	for key, days := range configured {
+		priority, err := strconv.Atoi(strings.TrimPrefix(strings.ToUpper(strings.TrimSpace(key)), "P"))
+		if err != nil || priority < 0 || priority > 4 {
+			log.Printf("CONFIG: Ignoring priority_aging_days key %q (want P0-P4)", key)
+			continue
+		}
+		limits[priority] = days
+	}
+	return limits
+}
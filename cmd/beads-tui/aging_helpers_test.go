@@ -0,0 +1,23 @@
+package main
+
+import (
+	"reflect"
+	"testing"
+
+	"github.com/andy/beads-tui/internal/state"
+)
+
+func TestAgingDaysFromConfig(t *testing.T) {
+	if got := agingDaysFromConfig(nil); !reflect.DeepEqual(got, state.DefaultAgingDays) {
+		t.Errorf("expected the defaults without config, got %v", got)
+	}
+
+	got := agingDaysFromConfig(map[string]int{"P2": 30, "p4": 365, "P0": 0, "urgent": 1, "P7": 5})
+	want := map[int]int{0: 0, 1: state.DefaultAgingDays[1], 2: 30, 3: state.DefaultAgingDays[3], 4: 365}
+	if !reflect.DeepEqual(got, want) {
+		t.Errorf("agingDaysFromConfig = %v, want %v", got, want)
+	}
+	if state.DefaultAgingDays[2] == 30 {
+		t.Error("the defaults must not be modified")
+	}
+}
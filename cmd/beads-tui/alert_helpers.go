@@ -0,0 +1,37 @@
+package main
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/andy/beads-tui/internal/parser"
+)
+
+// arrivalAlerts compares the issues before and after a refresh and describes
+// the changes that deserve the user's attention even when the TUI is in a
+// background pane: open issues that are new at P0 or were raised to P0, and
+// issues newly assigned to user. The issue named by skip (one just changed
+// from this TUI) is ignored so the user isn't alerted about their own edits.
+func arrivalAlerts(before, after []*parser.Issue, user, skip string) []string {
+	previous := make(map[string]*parser.Issue, len(before))
+	for _, issue := range before {
+		previous[issue.ID] = issue
+	}
+
+	var alerts []string
+	for _, issue := range after {
+		if issue.ID == skip || issue.Status == parser.StatusClosed {
+			continue
+		}
+		old := previous[issue.ID]
+
+		if issue.Priority == 0 && (old == nil || old.Priority != 0 || old.Status == parser.StatusClosed) {
+			alerts = append(alerts, fmt.Sprintf("New P0: %s %s", issue.ID, issue.Title))
+			continue
+		}
+		if user != "" && strings.EqualFold(issue.Assignee, user) && (old == nil || !strings.EqualFold(old.Assignee, user)) {
+			alerts = append(alerts, fmt.Sprintf("Assigned to you: %s %s", issue.ID, issue.Title))
+		}
+	}
+	return alerts
+}
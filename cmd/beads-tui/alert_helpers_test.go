@@ -0,0 +1,41 @@
+package main
+
+import (
+	"reflect"
+	"testing"
+
+	"github.com/andy/beads-tui/internal/parser"
+)
+
+func TestArrivalAlerts(t *testing.T) {
+	before := []*parser.Issue{
+		{ID: "tui-1", Title: "Already urgent", Priority: 0, Status: parser.StatusOpen},
+		{ID: "tui-2", Title: "Raised", Priority: 2, Status: parser.StatusOpen},
+		{ID: "tui-3", Title: "Handed over", Priority: 2, Status: parser.StatusOpen, Assignee: "bob"},
+		{ID: "tui-4", Title: "Mine already", Priority: 2, Status: parser.StatusOpen, Assignee: "alice"},
+		{ID: "tui-5", Title: "My own edit", Priority: 2, Status: parser.StatusOpen},
+	}
+	after := []*parser.Issue{
+		{ID: "tui-1", Title: "Already urgent", Priority: 0, Status: parser.StatusOpen},
+		{ID: "tui-2", Title: "Raised", Priority: 0, Status: parser.StatusOpen},
+		{ID: "tui-3", Title: "Handed over", Priority: 2, Status: parser.StatusOpen, Assignee: "Alice"},
+		{ID: "tui-4", Title: "Mine already", Priority: 2, Status: parser.StatusOpen, Assignee: "alice"},
+		{ID: "tui-5", Title: "My own edit", Priority: 0, Status: parser.StatusOpen},
+		{ID: "tui-6", Title: "Brand new", Priority: 0, Status: parser.StatusOpen},
+		{ID: "tui-7", Title: "Closed on arrival", Priority: 0, Status: parser.StatusClosed},
+	}
+
+	got := arrivalAlerts(before, after, "alice", "tui-5")
+	want := []string{
+		"New P0: tui-2 Raised",
+		"Assigned to you: tui-3 Handed over",
+		"New P0: tui-6 Brand new",
+	}
+	if !reflect.DeepEqual(got, want) {
+		t.Errorf("arrivalAlerts() = %q, want %q", got, want)
+	}
+
+	if got := arrivalAlerts(after, after, "alice", ""); len(got) != 0 {
+		t.Errorf("expected no alerts when nothing changed, got %q", got)
+	}
+}
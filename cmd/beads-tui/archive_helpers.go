@@ -0,0 +1,94 @@
+package main
+
+import (
+	"fmt"
+	"strings"
+	"time"
+)
+
+// archivePageSize is how many closed issues the archive shows per page
+const archivePageSize = 50
+
+// archiveDateLayout is how dates are typed and shown in the archive range
+const archiveDateLayout = "2006-01-02"
+
+// archivePeriods are the ranges Tab cycles through in the archive, in days
+// back from today (0 for every closed issue)
+var archivePeriods = []int{7, 30, 90, 0}
+
+// archiveRange is the span of closing dates the archive lists: closed at or
+// after From and before To, where a zero time leaves that end open
+type archiveRange struct {
+	From, To time.Time
+}
+
+// lastDays returns the range of the last days days up to now (every closed
+// issue for 0)
+func lastDays(days int, now time.Time) archiveRange {
+	if days == 0 {
+		return archiveRange{}
+	}
+	return archiveRange{From: now.AddDate(0, 0, -days)}
+}
+
+// parseArchiveRange reads a custom range typed as "FROM..TO" (dates as
+// YYYY-MM-DD, in local time). Either end may be left out, and both dates
+// are included; a single date is that one day.
+func parseArchiveRange(text string) (archiveRange, error) {
+	text = strings.TrimSpace(text)
+	fromText, toText, isRange := strings.Cut(text, "..")
+	if !isRange {
+		toText = fromText
+	}
+	fromText, toText = strings.TrimSpace(fromText), strings.TrimSpace(toText)
+	if fromText == "" && toText == "" {
+		return archiveRange{}, fmt.Errorf("enter a date or a range such as 2026-01-01..2026-03-31")
+	}
+
+	var r archiveRange
+	if fromText != "" {
+		from, err := time.ParseInLocation(archiveDateLayout, fromText, time.Local)
+		if err != nil {
+			return archiveRange{}, fmt.Errorf("%q isn't a date (YYYY-MM-DD)", fromText)
+		}
+		r.From = from
+	}
+	if toText != "" {
+		to, err := time.ParseInLocation(archiveDateLayout, toText, time.Local)
+		if err != nil {
+			return archiveRange{}, fmt.Errorf("%q isn't a date (YYYY-MM-DD)", toText)
+		}
+		r.To = to.AddDate(0, 0, 1) // Through the end of that day
+	}
+	if !r.From.IsZero() && !r.To.IsZero() && !r.From.Before(r.To) {
+		return archiveRange{}, fmt.Errorf("the range ends before it starts")
+	}
+	return r, nil
+}
+
+// String describes the range for the archive title, e.g. "closed Jan 5 –
+// Mar 31 2026"
+func (r archiveRange) String() string {
+	switch {
+	case r.From.IsZero() && r.To.IsZero():
+		return "all closed"
+	case r.To.IsZero():
+		return "closed since " + r.From.Format("Jan 2 2006")
+	case r.From.IsZero():
+		return "closed through " + r.To.AddDate(0, 0, -1).Format("Jan 2 2006")
+	}
+	last := r.To.AddDate(0, 0, -1)
+	if last.Format(archiveDateLayout) == r.From.Format(archiveDateLayout) {
+		return "closed " + r.From.Format("Jan 2 2006")
+	}
+	return fmt.Sprintf("closed %s – %s", r.From.Format("Jan 2 2006"), last.Format("Jan 2 2006"))
+}
+
+// archivePages returns how many pages total issues fill (at least one, so
+// an empty range still has a page to show)
+func archivePages(total int) int {
+	if total <= archivePageSize {
+		return 1
+	}
+	return (total + archivePageSize - 1) / archivePageSize
+}
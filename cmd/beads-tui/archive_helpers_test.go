@@ -0,0 +1,51 @@
+package main
+
+import (
+	"testing"
+	"time"
+)
+
+func TestParseArchiveRange(t *testing.T) {
+	day := func(year int, month time.Month, d int) time.Time {
+		return time.Date(year, month, d, 0, 0, 0, 0, time.Local)
+	}
+	for _, tt := range []struct {
+		text string
+		want archiveRange
+		desc string
+	}{
+		{"2026-01-05..2026-03-31", archiveRange{day(2026, 1, 5), day(2026, 4, 1)}, "closed Jan 5 2026 – Mar 31 2026"},
+		{"2026-01-05..", archiveRange{From: day(2026, 1, 5)}, "closed since Jan 5 2026"},
+		{"..2026-03-31", archiveRange{To: day(2026, 4, 1)}, "closed through Mar 31 2026"},
+		{" 2026-02-10 ", archiveRange{day(2026, 2, 10), day(2026, 2, 11)}, "closed Feb 10 2026"},
+	} {
+		got, err := parseArchiveRange(tt.text)
+		if err != nil {
+			t.Errorf("parseArchiveRange(%q) failed: %v", tt.text, err)
+			continue
+		}
+		if !got.From.Equal(tt.want.From) || !got.To.Equal(tt.want.To) {
+			t.Errorf("parseArchiveRange(%q) = %+v, expected %+v", tt.text, got, tt.want)
+		}
+		if got.String() != tt.desc {
+			t.Errorf("parseArchiveRange(%q) reads %q, expected %q", tt.text, got.String(), tt.desc)
+		}
+	}
+
+	for _, text := range []string{"", "..", "yesterday", "2026-03-31..2026-01-05", "2026-01-05..soon"} {
+		if _, err := parseArchiveRange(text); err == nil {
+			t.Errorf("expected an error for %q", text)
+		}
+	}
+}
+
+func TestArchivePages(t *testing.T) {
+	for total, want := range map[int]int{0: 1, 1: 1, archivePageSize: 1, archivePageSize + 1: 2, 3 * archivePageSize: 3} {
+		if got := archivePages(total); got != want {
+			t.Errorf("archivePages(%d) = %d, expected %d", total, got, want)
+		}
+	}
+	if r := lastDays(0, time.Now()); !r.From.IsZero() || r.String() != "all closed" {
+		t.Errorf("expected every closed issue for 0 days, got %+v", r)
+	}
+}
@@ -4,6 +4,7 @@ import (
 	"bytes"
 	"context"
 	"encoding/json"
+	"errors"
 	"fmt"
 	"os/exec"
 	"strings"
@@ -12,6 +13,47 @@ import (
 	"github.com/andy/beads-tui/internal/parser"
 )
 
+// bdCommand is the bd executable to run (overridable via bd_path in config)
+var bdCommand = "bd"
+
+// bdDatabase, when set by --path or --db, is passed to every bd invocation
+// as --db so bd edits the database being viewed instead of the cwd's
+var bdDatabase string
+
+// bdDisabled, when set, is returned by every bd invocation instead of
+// running bd (demo mode)
+var bdDisabled error
+
+// bdTimeout bounds how long a single bd invocation may run (bd_timeout_seconds
+// in config)
+var bdTimeout = 10 * time.Second
+
+// BdError describes a failed bd invocation with enough detail to diagnose it.
+// Error() returns a one-line summary suitable for the status bar.
+type BdError struct {
+	Args     []string // Arguments passed to bd (including --json)
+	ExitCode int      // Process exit code, or -1 if bd did not exit normally
+	Stderr   string   // Captured stderr (trimmed)
+	Stdout   string   // Captured stdout (trimmed, may be truncated)
+	Message  string   // One-line summary
+	Err      error    // Underlying error (exec failure, timeout, JSON parse)
+}
+
+// Error returns the one-line summary
+func (e *BdError) Error() string {
+	return e.Message
+}
+
+// Unwrap returns the underlying error so errors.Is works for exec.ErrNotFound etc.
+func (e *BdError) Unwrap() error {
+	return e.Err
+}
+
+// Command returns the full command line that was executed
+func (e *BdError) Command() string {
+	return bdCommand + " " + strings.Join(e.Args, " ")
+}
+
 // BdCommandResult represents the result of executing a bd command with --json
 type BdCommandResult struct {
 	Issues   []parser.Issue  `json:"issues,omitempty"`
@@ -21,14 +63,99 @@ type BdCommandResult struct {
 
 // execBdJSON executes a bd command with --json flag and parses the response.
 // It handles both single object and array responses from bd commands.
+// Canceling ctx kills bd (or stops a --direct-write edit); each invocation
+// also times out after bdTimeout.
 //
 // Example usage:
-//   result, err := execBdJSON("update", "tui-123", "--priority", "1")
+//   result, err := execBdJSON(ctx, "update", "tui-123", "--priority", "1")
 //   if err != nil { ... }
 //   if len(result.Issues) > 0 {
 //     updatedIssue := result.Issues[0]
 //   }
-func execBdJSON(args ...string) (*BdCommandResult, error) {
+func execBdJSON(ctx context.Context, args ...string) (*BdCommandResult, error) {
+	if err := approveBd(ctx, bdArgs(args)); err != nil {
+		return nil, err
+	}
+
+	// With --direct-write, simple edits skip bd entirely
+	if directWriter != nil {
+		if op, ok := parseDirectOp(args); ok {
+			result, err := execDirect(ctx, op)
+			if err == nil {
+				issueHooks.Fire(args, result)
+			}
+			return result, err
+		}
+	}
+
+	args = bdArgs(args)
+	stdout, stderr, err := runBd(ctx, args)
+	if err != nil {
+		return nil, err
+	}
+
+	// Parse JSON response from stdout only
+	result, parseErr := parseBdJSON(stdout.Bytes())
+	if parseErr != nil {
+		// Provide helpful error with snippet of output
+		outputPreview := stdout.String()
+		if len(outputPreview) > 200 {
+			outputPreview = outputPreview[:200] + "..."
+		}
+		bdErr := newBdError(args, stdout, stderr, parseErr)
+		bdErr.ExitCode = 0
+		bdErr.Message = fmt.Sprintf("failed to parse JSON from bd %s: %v (output: %s)", args[0], parseErr, outputPreview)
+		return nil, bdErr
+	}
+
+	issueHooks.Fire(args, result)
+	return result, nil
+}
+
+// execBd runs a bd command whose JSON output isn't an issue or comment
+// (e.g. delete), reporting only whether it succeeded
+func execBd(ctx context.Context, args ...string) error {
+	args = bdArgs(args)
+	if err := approveBd(ctx, args); err != nil {
+		return err
+	}
+	_, _, err := runBd(ctx, args)
+	return err
+}
+
+// errBdDeclined is returned for a command the user chose not to run in
+// dry-run mode
+var errBdDeclined = errors.New("bd command not run (dry run)")
+
+// bdApprover decides whether a bd command (its full arguments) may run,
+// returning an error to stop it. It runs on the worker goroutine.
+type bdApprover func(ctx context.Context, args []string) error
+
+// bdApproverKey holds the bdApprover in a context
+type bdApproverKey struct{}
+
+// withBdApprover returns a context whose bd commands must be approved first
+func withBdApprover(ctx context.Context, approve bdApprover) context.Context {
+	return context.WithValue(ctx, bdApproverKey{}, approve)
+}
+
+// approveBd asks ctx's approver, if it has one, whether a command may run.
+// A declined command is recorded in the command log.
+func approveBd(ctx context.Context, args []string) error {
+	approve, ok := ctx.Value(bdApproverKey{}).(bdApprover)
+	if !ok {
+		return nil
+	}
+	if err := approve(ctx, args); err != nil {
+		commandLog.Add(bdLogEntry{Args: args, Started: time.Now(), ExitCode: -1, Err: err.Error()})
+		return err
+	}
+	return nil
+}
+
+// bdArgs adds --json, and --db when a database was chosen on the command
+// line, to a bd command's arguments
+func bdArgs(args []string) []string {
 	// Add --json flag if not already present
 	hasJSON := false
 	for _, arg := range args {
@@ -40,55 +167,97 @@ func execBdJSON(args ...string) (*BdCommandResult, error) {
 	if !hasJSON {
 		args = append(args, "--json")
 	}
+	if bdDatabase != "" {
+		args = append(args, "--db", bdDatabase)
+	}
+	return args
+}
+
+// runBd executes bd, capturing stdout and stderr separately, and records it
+// in the command log. A failure, timeout, or cancellation of ctx is returned
+// as a *BdError with the most useful message available.
+func runBd(parent context.Context, args []string) (stdout, stderr *bytes.Buffer, err error) {
+	if bdDisabled != nil {
+		commandLog.Add(bdLogEntry{Args: args, Started: time.Now(), ExitCode: -1, Err: bdDisabled.Error()})
+		return &bytes.Buffer{}, &bytes.Buffer{}, &BdError{Args: args, ExitCode: -1, Message: bdDisabled.Error(), Err: bdDisabled}
+	}
 
 	// Create context with timeout to prevent hanging indefinitely
-	ctx, cancel := context.WithTimeout(context.Background(), 10*time.Second)
+	ctx, cancel := context.WithTimeout(parent, bdTimeout)
 	defer cancel()
 
 	// Execute command with timeout, capturing stdout and stderr separately
 	// This is important because bd may write warnings to stderr (e.g., deprecation
 	// warnings, daemon warnings) which would corrupt the JSON output if combined
-	cmd := exec.CommandContext(ctx, "bd", args...)
-	var stdout, stderr bytes.Buffer
-	cmd.Stdout = &stdout
-	cmd.Stderr = &stderr
-	err := cmd.Run()
+	cmd := exec.CommandContext(ctx, bdCommand, args...)
+	stdout, stderr = &bytes.Buffer{}, &bytes.Buffer{}
+	cmd.Stdout = stdout
+	cmd.Stderr = stderr
+	started := time.Now()
+	err = cmd.Run()
+
+	// Record the invocation for the command log (Ctrl-L), with the error
+	// returned below
+	entry := bdLogEntry{Args: args, Started: started, Duration: time.Since(started), Output: bdLogOutput(stdout.String(), stderr.String())}
+	defer func() {
+		var bdErr *BdError
+		if errors.As(err, &bdErr) {
+			entry.ExitCode = bdErr.ExitCode
+			entry.Err = bdErr.Message
+		}
+		commandLog.Add(entry)
+	}()
 
 	// Check for timeout error specifically
 	if ctx.Err() == context.DeadlineExceeded {
-		cmdStr := "bd " + strings.Join(args, " ")
-		return nil, fmt.Errorf("bd command timed out after 10s: %s", cmdStr)
+		bdErr := newBdError(args, stdout, stderr, ctx.Err())
+		bdErr.Message = fmt.Sprintf("bd command timed out after %s: %s", bdTimeout, bdErr.Command())
+		return nil, nil, bdErr
+	}
+	if ctx.Err() == context.Canceled {
+		bdErr := newBdError(args, stdout, stderr, ctx.Err())
+		bdErr.Message = "bd command canceled: " + bdErr.Command()
+		return nil, nil, bdErr
 	}
 
 	if err != nil {
+		bdErr := newBdError(args, stdout, stderr, err)
 		// Try to parse error from JSON output first (check stdout)
 		var result BdCommandResult
 		if jsonErr := json.Unmarshal(stdout.Bytes(), &result); jsonErr == nil && result.Error != "" {
-			return nil, fmt.Errorf("bd %s failed: %s", args[0], result.Error)
+			bdErr.Message = fmt.Sprintf("bd %s failed: %s", args[0], result.Error)
+			return nil, nil, bdErr
 		}
 		// Fall back to stderr, then stdout
-		errOutput := strings.TrimSpace(stderr.String())
+		errOutput := bdErr.Stderr
 		if errOutput == "" {
-			errOutput = strings.TrimSpace(stdout.String())
+			errOutput = bdErr.Stdout
 		}
 		if errOutput == "" {
-			return nil, fmt.Errorf("bd %s command failed: %v", args[0], err)
+			bdErr.Message = fmt.Sprintf("bd %s command failed: %v", args[0], err)
+			return nil, nil, bdErr
 		}
-		return nil, fmt.Errorf("bd %s failed: %s", args[0], errOutput)
+		bdErr.Message = fmt.Sprintf("bd %s failed: %s", args[0], errOutput)
+		return nil, nil, bdErr
 	}
 
-	// Parse JSON response from stdout only
-	result, parseErr := parseBdJSON(stdout.Bytes())
-	if parseErr != nil {
-		// Provide helpful error with snippet of output
-		outputPreview := stdout.String()
-		if len(outputPreview) > 200 {
-			outputPreview = outputPreview[:200] + "..."
-		}
-		return nil, fmt.Errorf("failed to parse JSON from bd %s: %v (output: %s)", args[0], parseErr, outputPreview)
-	}
+	return stdout, stderr, nil
+}
 
-	return result, nil
+// newBdError captures the details of a failed bd invocation. Message is left for the caller.
+func newBdError(args []string, stdout, stderr *bytes.Buffer, cause error) *BdError {
+	bdErr := &BdError{
+		Args:     args,
+		ExitCode: -1,
+		Stderr:   strings.TrimSpace(stderr.String()),
+		Stdout:   strings.TrimSpace(stdout.String()),
+		Err:      cause,
+	}
+	var exitErr *exec.ExitError
+	if errors.As(cause, &exitErr) {
+		bdErr.ExitCode = exitErr.ExitCode()
+	}
+	return bdErr
 }
 
 // parseBdJSON parses bd command JSON output, handling multiple response formats:
@@ -130,8 +299,8 @@ func parseBdJSON(data []byte) (*BdCommandResult, error) {
 
 // execBdJSONIssue is a convenience wrapper that executes a bd command and returns
 // the first issue from the result, or an error if no issues were returned.
-func execBdJSONIssue(args ...string) (*parser.Issue, error) {
-	result, err := execBdJSON(args...)
+func execBdJSONIssue(ctx context.Context, args ...string) (*parser.Issue, error) {
+	result, err := execBdJSON(ctx, args...)
 	if err != nil {
 		return nil, err
 	}
@@ -149,8 +318,8 @@ func execBdJSONIssue(args ...string) (*parser.Issue, error) {
 
 // execBdJSONComment is a convenience wrapper that executes a bd command and returns
 // the first comment from the result, or an error if no comments were returned.
-func execBdJSONComment(args ...string) (*parser.Comment, error) {
-	result, err := execBdJSON(args...)
+func execBdJSONComment(ctx context.Context, args ...string) (*parser.Comment, error) {
+	result, err := execBdJSON(ctx, args...)
 	if err != nil {
 		return nil, err
 	}
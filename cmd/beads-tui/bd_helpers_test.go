@@ -1,6 +1,7 @@
 package main
 
 import (
+	"context"
 	"encoding/json"
 	"os/exec"
 	"strings"
@@ -317,7 +318,7 @@ func TestExecBdJSON_Integration_CreateAndUpdate(t *testing.T) {
 	}()
 
 	// Test: Create an issue
-	createdIssue, err := execBdJSONIssue("create", "Integration test issue", "-p", "2", "-t", "task")
+	createdIssue, err := execBdJSONIssue(context.Background(), "create", "Integration test issue", "-p", "2", "-t", "task")
 	if err != nil {
 		t.Fatalf("Failed to create issue: %v", err)
 	}
@@ -333,7 +334,7 @@ func TestExecBdJSON_Integration_CreateAndUpdate(t *testing.T) {
 	}
 
 	// Test: Update the issue
-	updatedIssue, err := execBdJSONIssue("update", createdIssue.ID, "--priority", "1")
+	updatedIssue, err := execBdJSONIssue(context.Background(), "update", createdIssue.ID, "--priority", "1")
 	if err != nil {
 		t.Fatalf("Failed to update issue: %v", err)
 	}
@@ -343,7 +344,7 @@ func TestExecBdJSON_Integration_CreateAndUpdate(t *testing.T) {
 	}
 
 	// Test: Add a comment
-	comment, err := execBdJSONComment("comment", createdIssue.ID, "Test comment")
+	comment, err := execBdJSONComment(context.Background(), "comment", createdIssue.ID, "Test comment")
 	if err != nil {
 		t.Fatalf("Failed to add comment: %v", err)
 	}
@@ -356,7 +357,7 @@ func TestExecBdJSON_Integration_CreateAndUpdate(t *testing.T) {
 	}
 
 	// Test: Close the issue
-	closedIssue, err := execBdJSONIssue("close", createdIssue.ID)
+	closedIssue, err := execBdJSONIssue(context.Background(), "close", createdIssue.ID)
 	if err != nil {
 		t.Fatalf("Failed to close issue: %v", err)
 	}
@@ -372,7 +373,7 @@ func TestExecBdJSON_ErrorHandling_InvalidIssueID(t *testing.T) {
 	}
 
 	// Try to update a non-existent issue
-	_, err := execBdJSONIssue("update", "nonexistent-issue-id", "--priority", "1")
+	_, err := execBdJSONIssue(context.Background(), "update", "nonexistent-issue-id", "--priority", "1")
 	if err == nil {
 		t.Error("Expected error when updating non-existent issue, got nil")
 	}
@@ -389,7 +390,7 @@ func TestExecBdJSON_ErrorHandling_InvalidCommand(t *testing.T) {
 	}
 
 	// Try to execute an invalid bd command
-	_, err := execBdJSON("invalid-command", "arg1", "arg2")
+	_, err := execBdJSON(context.Background(), "invalid-command", "arg1", "arg2")
 	if err == nil {
 		t.Error("Expected error when executing invalid command, got nil")
 	}
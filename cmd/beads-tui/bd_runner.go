@@ -0,0 +1,245 @@
+package main
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"log"
+	"sync"
+	"time"
+
+	"github.com/andy/beads-tui/internal/formatting"
+	"github.com/andy/beads-tui/internal/ui/notify"
+	"github.com/rivo/tview"
+)
+
+// spinnerFrames are cycled in the status bar while a bd command is running
+var spinnerFrames = []string{"⠋", "⠙", "⠹", "⠸", "⠼", "⠴", "⠦", "⠧", "⠇", "⠏"}
+
+// spinnerInterval controls how often the spinner frame advances
+const spinnerInterval = 100 * time.Millisecond
+
+// bdRunner executes bd commands on a worker goroutine so slow commands don't
+// freeze keyboard input. Only one command runs at a time: while it is pending,
+// further Run calls are rejected so conflicting mutations can't interleave.
+// Cancel stops the pending command by canceling the context passed to work.
+// In dry-run mode each bd command is shown with preview and only runs once
+// approved.
+//
+// The done callback always runs on the UI goroutine (via queueUpdate), so it
+// may touch tview primitives directly.
+type bdRunner struct {
+	statusBar   *tview.TextView
+	notifier    *notify.Center
+	queueUpdate func(func()) // Marshals a function onto the UI goroutine and redraws
+	onCancel    func()       // Called on the UI goroutine after a command is canceled
+
+	// preview shows a bd command in dry-run mode and calls decide with the
+	// user's answer; it returns a function that dismisses it unanswered
+	preview func(label string, args []string, decide func(approved bool)) (dismiss func())
+
+	mu       sync.Mutex
+	pending  bool
+	label    string
+	stop     chan struct{}
+	cancel   context.CancelFunc
+	canceled bool
+	dryRun   bool
+}
+
+// newBdRunner creates a runner that shows progress in statusBar and
+// warnings through notifier
+func newBdRunner(statusBar *tview.TextView, notifier *notify.Center, queueUpdate func(func())) *bdRunner {
+	return &bdRunner{
+		statusBar:   statusBar,
+		notifier:    notifier,
+		queueUpdate: queueUpdate,
+	}
+}
+
+// SetOnCancel sets a function to call after a command is canceled, instead
+// of its done callback (e.g. to reload issues bd may have partly changed)
+func (r *bdRunner) SetOnCancel(onCancel func()) {
+	r.onCancel = onCancel
+}
+
+// SetPreview sets how dry-run mode shows a command for approval. Called on
+// the UI goroutine.
+func (r *bdRunner) SetPreview(preview func(label string, args []string, decide func(approved bool)) (dismiss func())) {
+	r.preview = preview
+}
+
+// SetDryRun turns dry-run mode on or off. It applies to commands started
+// afterwards.
+func (r *bdRunner) SetDryRun(on bool) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	r.dryRun = on
+}
+
+// DryRun reports whether bd commands wait for approval
+func (r *bdRunner) DryRun() bool {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	return r.dryRun
+}
+
+// Busy reports whether a bd command is currently running
+func (r *bdRunner) Busy() bool {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	return r.pending
+}
+
+// Run starts work on a worker goroutine, showing label with a spinner in the
+// status bar until it finishes, then calls done with the result on the UI goroutine.
+// work should pass ctx to the bd commands it runs, so Cancel can stop them.
+// Returns false (and shows a warning) if another command is still pending.
+// Must be called from the UI goroutine.
+func (r *bdRunner) Run(label string, work func(ctx context.Context) error, done func(err error)) bool {
+	r.mu.Lock()
+	if r.pending {
+		busyLabel := r.label
+		r.mu.Unlock()
+		log.Printf("BD RUNNER: Rejected %q while %q is pending", label, busyLabel)
+		r.notifier.Warn(fmt.Sprintf("Busy: %s... (wait for it to finish)", busyLabel))
+		return false
+	}
+	ctx, cancel := context.WithCancel(context.Background())
+	if r.dryRun && r.preview != nil {
+		ctx = withBdApprover(ctx, r.approver(label))
+	}
+	r.pending = true
+	r.label = label
+	r.cancel = cancel
+	r.canceled = false
+	stop := make(chan struct{})
+	r.stop = stop
+	r.mu.Unlock()
+
+	r.statusBar.SetText(r.spinnerText(0))
+	go r.spin(stop)
+
+	go func() {
+		err := work(ctx)
+		r.queueUpdate(func() {
+			canceled := r.finish()
+			if errors.Is(err, errBdDeclined) {
+				r.notifier.Info(fmt.Sprintf("Dry run: %s not run", label))
+				if r.onCancel != nil {
+					r.onCancel() // Steps approved before it did run
+				}
+				return
+			}
+			// Work that finished before the cancel reached bd still succeeded
+			if canceled && err != nil {
+				log.Printf("BD RUNNER: %q canceled: %v", label, err)
+				r.notifier.Warn(fmt.Sprintf("Canceled: %s (bd may have made part of the change)", label))
+				if r.onCancel != nil {
+					r.onCancel()
+				}
+				return
+			}
+			if done != nil {
+				done(err)
+			}
+		})
+	}()
+	return true
+}
+
+// approver returns the dry-run approval for label's commands: each one is
+// previewed on the UI goroutine while the worker waits for the answer
+func (r *bdRunner) approver(label string) bdApprover {
+	return func(ctx context.Context, args []string) error {
+		answer := make(chan bool, 1)
+		dismissed := make(chan func(), 1)
+		r.queueUpdate(func() {
+			dismissed <- r.preview(label, args, func(approved bool) {
+				answer <- approved
+			})
+		})
+		select {
+		case approved := <-answer:
+			log.Printf("BD RUNNER: Dry run %q: approved=%v: %v", label, approved, args)
+			if !approved {
+				return errBdDeclined
+			}
+			return nil
+		case <-ctx.Done():
+			dismiss := <-dismissed
+			r.queueUpdate(dismiss)
+			return ctx.Err()
+		}
+	}
+}
+
+// Cancel stops the pending command, killing bd if it's running. Reports
+// whether there was a command to cancel. Must be called from the UI goroutine.
+func (r *bdRunner) Cancel() bool {
+	r.mu.Lock()
+	if !r.pending || r.canceled {
+		pending := r.pending
+		r.mu.Unlock()
+		return pending
+	}
+	r.canceled = true
+	r.cancel()
+	label := r.label
+	r.mu.Unlock()
+
+	log.Printf("BD RUNNER: Canceling %q", label)
+	r.statusBar.SetText(fmt.Sprintf("[%s]Canceling %s...[-]", formatting.GetWarningColor(), label))
+	return true
+}
+
+// finish clears the pending state and stops the spinner, returning whether
+// the command was canceled. Called on the UI goroutine before done, so the
+// spinner can never overwrite done's status message.
+func (r *bdRunner) finish() bool {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	r.pending = false
+	if r.stop != nil {
+		close(r.stop)
+		r.stop = nil
+	}
+	if r.cancel != nil {
+		r.cancel()
+		r.cancel = nil
+	}
+	return r.canceled
+}
+
+// spin advances the spinner frame until stop is closed
+func (r *bdRunner) spin(stop chan struct{}) {
+	ticker := time.NewTicker(spinnerInterval)
+	defer ticker.Stop()
+	frame := 0
+	for {
+		select {
+		case <-stop:
+			return
+		case <-ticker.C:
+			frame++
+			current := frame
+			r.queueUpdate(func() {
+				// Re-check on the UI goroutine: finish or Cancel may have run since the tick
+				r.mu.Lock()
+				spinning := r.pending && !r.canceled
+				r.mu.Unlock()
+				if spinning {
+					r.statusBar.SetText(r.spinnerText(current))
+				}
+			})
+		}
+	}
+}
+
+// spinnerText renders the status bar text for the given spinner frame
+func (r *bdRunner) spinnerText(frame int) string {
+	r.mu.Lock()
+	label := r.label
+	r.mu.Unlock()
+	return fmt.Sprintf("[%s]%s %s... [%s](Esc cancels)[-]", formatting.GetEmphasisColor(), spinnerFrames[frame%len(spinnerFrames)], label, formatting.GetMutedColor())
+}
@@ -0,0 +1,196 @@
+package main
+
+import (
+	"context"
+	"errors"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/andy/beads-tui/internal/ui/notify"
+	"github.com/rivo/tview"
+)
+
+// newTestRunner returns a runner whose UI updates run inline, serialized by a mutex
+func newTestRunner() *bdRunner {
+	var uiMu sync.Mutex
+	queueUpdate := func(f func()) {
+		uiMu.Lock()
+		defer uiMu.Unlock()
+		f()
+	}
+	statusBar := tview.NewTextView().SetDynamicColors(true)
+	notifier := notify.New(queueUpdate, func(text string) { statusBar.SetText(text) }, func() { statusBar.SetText("") })
+	return newBdRunner(statusBar, notifier, queueUpdate)
+}
+
+func TestBdRunner_RunsWorkAndCallsDone(t *testing.T) {
+	runner := newTestRunner()
+	wantErr := errors.New("boom")
+	doneCh := make(chan error, 1)
+
+	if !runner.Run("Testing", func(context.Context) error { return wantErr }, func(err error) { doneCh <- err }) {
+		t.Fatal("expected Run to start when idle")
+	}
+
+	select {
+	case err := <-doneCh:
+		if err != wantErr {
+			t.Errorf("expected done to receive work error, got %v", err)
+		}
+	case <-time.After(time.Second):
+		t.Fatal("done was not called")
+	}
+
+	if runner.Busy() {
+		t.Error("expected runner to be idle after done")
+	}
+}
+
+func TestBdRunner_RejectsWhilePending(t *testing.T) {
+	runner := newTestRunner()
+	release := make(chan struct{})
+	doneCh := make(chan struct{})
+
+	runner.Run("Slow", func(context.Context) error {
+		<-release
+		return nil
+	}, func(err error) { close(doneCh) })
+
+	if !runner.Busy() {
+		t.Error("expected runner to be busy while work is pending")
+	}
+	if runner.Run("Second", func(context.Context) error { return nil }, nil) {
+		t.Error("expected second Run to be rejected while pending")
+	}
+	if got := runner.statusBar.GetText(true); got != "⚠ Busy: Slow... (wait for it to finish)" {
+		t.Errorf("unexpected busy message: %q", got)
+	}
+
+	close(release)
+	select {
+	case <-doneCh:
+	case <-time.After(time.Second):
+		t.Fatal("done was not called")
+	}
+
+	if !runner.Run("Third", func(context.Context) error { return nil }, nil) {
+		t.Error("expected Run to start again once idle")
+	}
+}
+
+func TestBdRunner_Cancel(t *testing.T) {
+	runner := newTestRunner()
+	canceled := make(chan struct{})
+	runner.SetOnCancel(func() { close(canceled) })
+
+	if runner.Cancel() {
+		t.Error("expected nothing to cancel when idle")
+	}
+
+	doneCalled := false
+	runner.Run("Slow", func(ctx context.Context) error {
+		<-ctx.Done()
+		return ctx.Err()
+	}, func(err error) { doneCalled = true })
+
+	if !runner.Cancel() {
+		t.Fatal("expected the pending command to be canceled")
+	}
+	select {
+	case <-canceled:
+	case <-time.After(time.Second):
+		t.Fatal("onCancel was not called")
+	}
+
+	if doneCalled {
+		t.Error("expected done to be skipped for a canceled command")
+	}
+	if runner.Busy() {
+		t.Error("expected runner to be idle after cancel")
+	}
+	if got := runner.statusBar.GetText(true); got != "⚠ Canceled: Slow (bd may have made part of the change)" {
+		t.Errorf("unexpected cancel message: %q", got)
+	}
+}
+
+func TestBdRunner_CancelAfterSuccessCallsDone(t *testing.T) {
+	runner := newTestRunner()
+	release := make(chan struct{})
+	doneCh := make(chan error, 1)
+
+	runner.Run("Quick", func(context.Context) error {
+		<-release
+		return nil // Finished without looking at ctx
+	}, func(err error) { doneCh <- err })
+
+	runner.Cancel()
+	close(release)
+	select {
+	case err := <-doneCh:
+		if err != nil {
+			t.Errorf("expected success, got %v", err)
+		}
+	case <-time.After(time.Second):
+		t.Fatal("done was not called for work that succeeded")
+	}
+}
+
+func TestBdRunner_DryRun(t *testing.T) {
+	runner := newTestRunner()
+	var previewed [][]string
+	approve := true
+	runner.SetPreview(func(label string, args []string, decide func(bool)) func() {
+		previewed = append(previewed, args)
+		decide(approve)
+		return func() {}
+	})
+	refreshed := make(chan struct{}, 1)
+	runner.SetOnCancel(func() { refreshed <- struct{}{} })
+	runner.SetDryRun(true)
+
+	// Approved: the command runs and done gets its result
+	doneCh := make(chan error, 1)
+	runner.Run("Approved", func(ctx context.Context) error {
+		return approveBd(ctx, []string{"update", "tui-1", "--priority", "0"})
+	}, func(err error) { doneCh <- err })
+	select {
+	case err := <-doneCh:
+		if err != nil {
+			t.Errorf("expected approved command to succeed, got %v", err)
+		}
+	case <-time.After(time.Second):
+		t.Fatal("done was not called")
+	}
+	if len(previewed) != 1 || previewed[0][1] != "tui-1" {
+		t.Errorf("expected the command to be previewed, got %v", previewed)
+	}
+
+	// Declined: done is skipped and the issues are reloaded
+	approve = false
+	doneCalled := false
+	runner.Run("Declined", func(ctx context.Context) error {
+		return approveBd(ctx, []string{"close", "tui-2"})
+	}, func(err error) { doneCalled = true })
+	select {
+	case <-refreshed:
+	case <-time.After(time.Second):
+		t.Fatal("onCancel was not called for a declined command")
+	}
+	if doneCalled {
+		t.Error("expected done to be skipped for a declined command")
+	}
+	if got := runner.statusBar.GetText(true); got != "• Dry run: Declined not run" {
+		t.Errorf("unexpected message: %q", got)
+	}
+
+	// Off: nothing is previewed
+	runner.SetDryRun(false)
+	runner.Run("Direct", func(ctx context.Context) error {
+		return approveBd(ctx, []string{"close", "tui-3"})
+	}, func(err error) { doneCh <- err })
+	<-doneCh
+	if len(previewed) != 2 {
+		t.Errorf("expected no preview with dry run off, got %d previews", len(previewed))
+	}
+}
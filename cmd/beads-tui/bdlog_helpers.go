@@ -0,0 +1,108 @@
+package main
+
+import (
+	"strings"
+	"sync"
+	"time"
+)
+
+// bdLogLimit is how many bd invocations the command log keeps
+const bdLogLimit = 100
+
+// bdLogOutputLimit is how much of a command's output the log keeps
+const bdLogOutputLimit = 2000
+
+// bdLogEntry is one bd invocation made by the TUI
+type bdLogEntry struct {
+	Args     []string // Arguments passed to bd (including --json)
+	Started  time.Time
+	Duration time.Duration
+	ExitCode int    // 0 on success, -1 if bd didn't exit normally
+	Err      string // One-line failure summary ("" on success)
+	Output   string // Stdout, then stderr, truncated to bdLogOutputLimit
+}
+
+// CommandLine returns the invocation as it could be pasted into a shell
+func (e bdLogEntry) CommandLine() string {
+	words := []string{shellQuote(bdCommand)}
+	for _, arg := range e.Args {
+		words = append(words, shellQuote(arg))
+	}
+	return strings.Join(words, " ")
+}
+
+// bdLog keeps the most recent bd invocations for the command log (Ctrl-L).
+// bd runs on worker goroutines, so it's safe for concurrent use.
+type bdLog struct {
+	mu      sync.Mutex
+	entries []bdLogEntry
+}
+
+// commandLog records every bd invocation made through runBd
+var commandLog = &bdLog{}
+
+// Add records an invocation, dropping the oldest past bdLogLimit
+func (l *bdLog) Add(entry bdLogEntry) {
+	l.mu.Lock()
+	defer l.mu.Unlock()
+	l.entries = append(l.entries, entry)
+	if len(l.entries) > bdLogLimit {
+		l.entries = append([]bdLogEntry(nil), l.entries[len(l.entries)-bdLogLimit:]...)
+	}
+}
+
+// Recent returns the recorded invocations, newest first
+func (l *bdLog) Recent() []bdLogEntry {
+	l.mu.Lock()
+	defer l.mu.Unlock()
+	recent := make([]bdLogEntry, len(l.entries))
+	for i, entry := range l.entries {
+		recent[len(l.entries)-1-i] = entry
+	}
+	return recent
+}
+
+// bdLogOutput joins a command's stdout and stderr for the log, truncated to
+// bdLogOutputLimit bytes (on a rune boundary)
+func bdLogOutput(stdout, stderr string) string {
+	stdout, stderr = strings.TrimSpace(stdout), strings.TrimSpace(stderr)
+	output := stdout
+	if stderr != "" {
+		if output != "" {
+			output += "\n"
+		}
+		output += "stderr: " + stderr
+	}
+	if len(output) <= bdLogOutputLimit {
+		return output
+	}
+	cut := bdLogOutputLimit
+	for cut > 0 && !isRuneStart(output[cut]) {
+		cut--
+	}
+	return output[:cut] + "…"
+}
+
+// isRuneStart reports whether b begins a UTF-8 sequence
+func isRuneStart(b byte) bool {
+	return b&0xC0 != 0x80
+}
+
+// shellQuote single-quotes a word for a POSIX shell unless it's made only of
+// characters that don't need it
+func shellQuote(word string) string {
+	if word == "" {
+		return "''"
+	}
+	safe := true
+	for _, r := range word {
+		if !(r >= 'a' && r <= 'z' || r >= 'A' && r <= 'Z' || r >= '0' && r <= '9' || strings.ContainsRune("-_./=:,@+%", r)) {
+			safe = false
+			break
+		}
+	}
+	if safe {
+		return word
+	}
+	return "'" + strings.ReplaceAll(word, "'", `'\''`) + "'"
+}
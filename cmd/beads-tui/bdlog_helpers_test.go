@@ -0,0 +1,67 @@
+package main
+
+import (
+	"fmt"
+	"strings"
+	"testing"
+	"unicode/utf8"
+)
+
+func TestBdLogRecent(t *testing.T) {
+	history := &bdLog{}
+	for i := 0; i < bdLogLimit+5; i++ {
+		history.Add(bdLogEntry{Args: []string{"show", fmt.Sprintf("tui-%d", i)}})
+	}
+
+	recent := history.Recent()
+	if len(recent) != bdLogLimit {
+		t.Fatalf("expected %d entries, got %d", bdLogLimit, len(recent))
+	}
+	if got := recent[0].Args[1]; got != fmt.Sprintf("tui-%d", bdLogLimit+4) {
+		t.Errorf("newest entry = %s", got)
+	}
+	if got := recent[len(recent)-1].Args[1]; got != "tui-5" {
+		t.Errorf("oldest kept entry = %s, want tui-5", got)
+	}
+}
+
+func TestBdLogEntryCommandLine(t *testing.T) {
+	entry := bdLogEntry{Args: []string{"comment", "tui-1", "it's done", "--json"}}
+	if got, want := entry.CommandLine(), `bd comment tui-1 'it'\''s done' --json`; got != want {
+		t.Errorf("CommandLine() = %q, want %q", got, want)
+	}
+
+	tests := map[string]string{
+		"":             "''",
+		"--priority":   "--priority",
+		"a b":          "'a b'",
+		"$HOME":        "'$HOME'",
+		"tui-1.2":      "tui-1.2",
+		"line\nbreaks": "'line\nbreaks'",
+	}
+	for word, want := range tests {
+		if got := shellQuote(word); got != want {
+			t.Errorf("shellQuote(%q) = %q, want %q", word, got, want)
+		}
+	}
+}
+
+func TestBdLogOutput(t *testing.T) {
+	if got := bdLogOutput(" {\"id\": 1} \n", ""); got != `{"id": 1}` {
+		t.Errorf("stdout only: %q", got)
+	}
+	if got := bdLogOutput("", "Error: no issue found\n"); got != "stderr: Error: no issue found" {
+		t.Errorf("stderr only: %q", got)
+	}
+	if got := bdLogOutput("[]", "warning: daemon"); got != "[]\nstderr: warning: daemon" {
+		t.Errorf("both: %q", got)
+	}
+
+	long := bdLogOutput(strings.Repeat("é", bdLogOutputLimit), "")
+	if !strings.HasSuffix(long, "…") || len(long) > bdLogOutputLimit+len("…") {
+		t.Errorf("long output not truncated: %d bytes", len(long))
+	}
+	if !utf8.ValidString(long) {
+		t.Error("truncation split a rune")
+	}
+}
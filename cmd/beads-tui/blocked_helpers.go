@@ -0,0 +1,30 @@
+package main
+
+import "strings"
+
+// blockedReasonPrefix starts the comment recording why an issue is blocked.
+// bd has no field for the reason, so it's kept as a structured comment that
+// reads well in bd too; the newest one on an issue is the current reason.
+const blockedReasonPrefix = "BLOCKED:"
+
+// blockedReasonComment returns the comment text recording reason
+func blockedReasonComment(reason string) string {
+	return blockedReasonPrefix + " " + strings.TrimSpace(reason)
+}
+
+// blockedReasons turns the newest "BLOCKED:" comment per issue into its
+// reason: the comment's first line after the prefix. Comments with nothing
+// after the prefix are left out.
+func blockedReasons(comments map[string]string) map[string]string {
+	reasons := make(map[string]string, len(comments))
+	for issueID, text := range comments {
+		if len(text) < len(blockedReasonPrefix) || !strings.EqualFold(text[:len(blockedReasonPrefix)], blockedReasonPrefix) {
+			continue
+		}
+		reason, _, _ := strings.Cut(text[len(blockedReasonPrefix):], "\n")
+		if reason = strings.TrimSpace(reason); reason != "" {
+			reasons[issueID] = reason
+		}
+	}
+	return reasons
+}
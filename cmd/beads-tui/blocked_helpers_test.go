@@ -0,0 +1,22 @@
+package main
+
+import (
+	"reflect"
+	"testing"
+)
+
+func TestBlockedReasons(t *testing.T) {
+	got := blockedReasons(map[string]string{
+		"tui-1": blockedReasonComment("  waiting on vendor "),
+		"tui-2": "blocked: legal review\nThey meet on Fridays",
+		"tui-3": "BLOCKED:",
+		"tui-4": "Unrelated comment",
+	})
+	want := map[string]string{
+		"tui-1": "waiting on vendor",
+		"tui-2": "legal review",
+	}
+	if !reflect.DeepEqual(got, want) {
+		t.Errorf("expected %v, got %v", want, got)
+	}
+}
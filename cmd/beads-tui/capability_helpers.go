@@ -0,0 +1,183 @@
+package main
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"log"
+	"regexp"
+	"strings"
+	"time"
+
+	"github.com/andy/beads-tui/internal/config"
+)
+
+// bdFeature is something the TUI does with a bd command or flag that older
+// versions of bd don't have
+type bdFeature struct {
+	Action  string // What it does, for messages ("Setting due dates")
+	Command string // bd command, with any subcommand ("update", "comment edit")
+	Flag    string // Flag it passes ("" when the command is enough)
+}
+
+// Features that are checked against the installed bd. The basic ones
+// (create, update --status/--priority/--title, close, comment) aren't:
+// every bd beads-tui works with has them.
+var (
+	featureDueDate       = bdFeature{"Setting due dates", "update", "--due"}
+	featureExternalRef   = bdFeature{"Linking external references", "update", "--external-ref"}
+	featureChangeType    = bdFeature{"Changing an issue's type", "update", "--type"}
+	featureChildIssues   = bdFeature{"Creating child issues", "create", "--parent"}
+	featureCloseReason   = bdFeature{"Close reasons", "close", "--reason"}
+	featureReopenReason  = bdFeature{"Reopen reasons", "reopen", "--reason"}
+	featureEditComment   = bdFeature{"Editing comments", "comment edit", ""}
+	featureDeleteComment = bdFeature{"Deleting comments", "comment delete", ""}
+	featureDeleteIssue   = bdFeature{"Deleting issues", "delete", "--force"}
+)
+
+// bdFeatures lists every checked feature, so they can all be probed at once
+var bdFeatures = []bdFeature{
+	featureDueDate, featureExternalRef, featureChangeType, featureChildIssues, featureCloseReason,
+	featureReopenReason, featureEditComment, featureDeleteComment, featureDeleteIssue,
+}
+
+// bdFlagPattern matches a long flag at the start of a help line, after an
+// optional shorthand: "  -p, --priority int   Priority (0-4)"
+var bdFlagPattern = regexp.MustCompile(`(?m)^\s+(?:-[A-Za-z], )?(--[a-z][a-z0-9-]*)`)
+
+// parseBdHelp reads a bd --help page: the command path it describes (from
+// the first usage line, e.g. [comment edit] for "bd comment edit <id>
+// [flags]"; empty for bd itself) and the long flags it lists. bd shows its
+// own help for an unknown command, so a path shorter than the one asked
+// for means the command doesn't exist. ok is false if there's no usage
+// line.
+func parseBdHelp(help string) (path []string, flags []string, ok bool) {
+	lines := strings.Split(help, "\n")
+	for i, line := range lines {
+		if strings.TrimSpace(line) != "Usage:" || i+1 >= len(lines) {
+			continue
+		}
+		ok = true
+		words := strings.Fields(lines[i+1])
+		for _, word := range words[min(1, len(words)):] {
+			if strings.ContainsAny(word[:1], "[<-") {
+				break
+			}
+			path = append(path, word)
+		}
+		break
+	}
+	seen := make(map[string]bool)
+	for _, match := range bdFlagPattern.FindAllStringSubmatch(help, -1) {
+		if !seen[match[1]] {
+			seen[match[1]] = true
+			flags = append(flags, match[1])
+		}
+	}
+	return path, flags, ok
+}
+
+// firstLine returns the first non-blank line of bd's output, trimmed
+func firstLine(output string) string {
+	return strings.TrimSpace(strings.SplitN(strings.TrimSpace(output), "\n", 2)[0])
+}
+
+// probeBdCapabilities runs the --help of every command the features use
+// through run, which returns bd's stdout. A command whose help can't be
+// read is left out, so it's assumed to work.
+func probeBdCapabilities(version string, run func(args ...string) (string, error)) *config.BdCapabilities {
+	caps := &config.BdCapabilities{
+		BdPath:   bdCommand,
+		Version:  version,
+		Flags:    make(map[string][]string),
+		ProbedAt: time.Now(),
+	}
+	for _, feature := range bdFeatures {
+		if caps.Probed(feature.Command) {
+			continue
+		}
+		words := strings.Fields(feature.Command)
+		help, err := run(append(words, "--help")...)
+		var bdErr *BdError
+		if err != nil && errors.As(err, &bdErr) && strings.Contains(strings.ToLower(bdErr.Message+bdErr.Stderr), "unknown command") {
+			caps.Missing = append(caps.Missing, feature.Command)
+			continue
+		}
+		if err != nil {
+			log.Printf("BD CAPABILITIES: Couldn't read help for bd %s: %v", feature.Command, err)
+			continue
+		}
+		path, flags, ok := parseBdHelp(help)
+		if !ok {
+			continue // Not a help page we understand
+		}
+		if len(path) < len(words) {
+			caps.Missing = append(caps.Missing, feature.Command)
+			continue
+		}
+		caps.Flags[feature.Command] = flags
+	}
+	return caps
+}
+
+// cachedBdCapabilitiesUsable reports whether a cached probe still describes
+// the installed bd: same executable and version, and every feature probed
+func cachedBdCapabilitiesUsable(cached *config.BdCapabilities, version string) bool {
+	if cached == nil || cached.BdPath != bdCommand || cached.Version != version {
+		return false
+	}
+	for _, feature := range bdFeatures {
+		if !cached.Probed(feature.Command) {
+			return false
+		}
+	}
+	return true
+}
+
+// detectBdCapabilities finds out what the installed bd supports, reusing
+// the cached probe while bd's version stays the same. Returns nil (try
+// everything) when bd can't be run. Runs bd, so call it off the UI
+// goroutine.
+func detectBdCapabilities() *config.BdCapabilities {
+	run := func(args ...string) (string, error) {
+		stdout, _, err := runBd(context.Background(), args)
+		if err != nil {
+			return "", err
+		}
+		return stdout.String(), nil
+	}
+
+	output, err := run("--version")
+	if err != nil {
+		log.Printf("BD CAPABILITIES: Couldn't run bd --version: %v", err)
+		return nil
+	}
+	version := firstLine(output)
+	cached, err := config.LoadBdCapabilities()
+	if err != nil {
+		log.Printf("Warning: failed to load bd capabilities: %v", err)
+	}
+	if cachedBdCapabilitiesUsable(cached, version) {
+		return cached
+	}
+
+	caps := probeBdCapabilities(version, run)
+	log.Printf("BD CAPABILITIES: %s; missing commands %v", caps.Version, caps.Missing)
+	if err := config.SaveBdCapabilities(caps); err != nil {
+		log.Printf("Warning: failed to save bd capabilities: %v", err)
+	}
+	return caps
+}
+
+// bdUnsupportedMessage explains that the installed bd can't do feature
+func bdUnsupportedMessage(caps *config.BdCapabilities, feature bdFeature) string {
+	version := "The installed bd"
+	if caps != nil && caps.Version != "" {
+		version = caps.Version
+	}
+	missing := "bd " + feature.Command
+	if feature.Flag != "" && caps.Supports(feature.Command, "") {
+		missing += " " + feature.Flag
+	}
+	return fmt.Sprintf("%s needs a newer bd: %s has no '%s' (upgrade bd)", feature.Action, version, missing)
+}
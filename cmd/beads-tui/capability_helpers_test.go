@@ -0,0 +1,151 @@
+package main
+
+import (
+	"errors"
+	"reflect"
+	"strings"
+	"testing"
+
+	"github.com/andy/beads-tui/internal/config"
+)
+
+const bdRootHelp = `Issues chained together like beads.
+
+Usage:
+  bd [flags]
+  bd [command]
+
+Available Commands:
+  close       Close one or more issues
+  update      Update one or more issues
+
+Flags:
+      --db string   Database path
+  -h, --help        help for bd
+      --json        Output in JSON format
+`
+
+const bdUpdateHelp = `Update one or more issues.
+
+Usage:
+  bd update [id...] [flags]
+
+Flags:
+  -a, --assignee string   New assignee
+      --due string        Due date
+  -p, --priority string   New priority (0-4)
+
+Global Flags:
+      --json   Output in JSON format
+`
+
+const bdCommentEditHelp = `Edit a comment.
+
+Usage:
+  bd comment edit <comment-id> <text> [flags]
+
+Global Flags:
+      --json   Output in JSON format
+`
+
+func TestParseBdHelp(t *testing.T) {
+	path, flags, ok := parseBdHelp(bdUpdateHelp)
+	if !ok {
+		t.Fatal("expected a usage line")
+	}
+	if !reflect.DeepEqual(path, []string{"update"}) {
+		t.Errorf("path = %v, want [update]", path)
+	}
+	if !reflect.DeepEqual(flags, []string{"--assignee", "--due", "--priority", "--json"}) {
+		t.Errorf("flags = %v", flags)
+	}
+
+	path, _, _ = parseBdHelp(bdCommentEditHelp)
+	if !reflect.DeepEqual(path, []string{"comment", "edit"}) {
+		t.Errorf("path = %v, want [comment edit]", path)
+	}
+
+	path, _, ok = parseBdHelp(bdRootHelp)
+	if !ok || len(path) != 0 {
+		t.Errorf("root help path = %v (ok %v), want empty", path, ok)
+	}
+
+	if _, _, ok := parseBdHelp("something else entirely"); ok {
+		t.Error("expected no usage line")
+	}
+}
+
+func TestProbeBdCapabilities(t *testing.T) {
+	run := func(args ...string) (string, error) {
+		switch strings.Join(args, " ") {
+		case "update --help":
+			return bdUpdateHelp, nil
+		case "comment edit --help":
+			return bdCommentEditHelp, nil
+		case "comment delete --help":
+			return "", &BdError{Message: "bd comment failed: unknown command \"delete\" for \"bd comment\""}
+		case "reopen --help":
+			return "", errors.New("timed out")
+		}
+		return bdRootHelp, nil // bd's own help for commands it doesn't know
+	}
+
+	caps := probeBdCapabilities("bd version 0.9.0", run)
+	if caps.Version != "bd version 0.9.0" {
+		t.Errorf("Version = %q", caps.Version)
+	}
+
+	tests := []struct {
+		feature bdFeature
+		want    bool
+	}{
+		{featureDueDate, true},
+		{featureExternalRef, false}, // update lacks the flag
+		{featureEditComment, true},
+		{featureDeleteComment, false}, // bd said unknown command
+		{featureDeleteIssue, false},   // Root help came back
+		{featureReopenReason, true},   // Couldn't tell, so try it
+	}
+	for _, tt := range tests {
+		if got := caps.Supports(tt.feature.Command, tt.feature.Flag); got != tt.want {
+			t.Errorf("%s supported = %v, want %v", tt.feature.Action, got, tt.want)
+		}
+	}
+}
+
+func TestCachedBdCapabilitiesUsable(t *testing.T) {
+	full := &config.BdCapabilities{BdPath: bdCommand, Version: "bd version 1.0", Flags: make(map[string][]string)}
+	for _, feature := range bdFeatures {
+		full.Flags[feature.Command] = nil
+	}
+	if !cachedBdCapabilitiesUsable(full, "bd version 1.0") {
+		t.Error("matching cache should be usable")
+	}
+	if cachedBdCapabilitiesUsable(full, "bd version 1.1") {
+		t.Error("cache from another version should be probed again")
+	}
+	if cachedBdCapabilitiesUsable(nil, "bd version 1.0") {
+		t.Error("missing cache isn't usable")
+	}
+
+	partial := &config.BdCapabilities{BdPath: bdCommand, Version: "bd version 1.0", Flags: map[string][]string{"update": nil}}
+	if cachedBdCapabilitiesUsable(partial, "bd version 1.0") {
+		t.Error("cache missing a feature's command should be probed again")
+	}
+}
+
+func TestBdUnsupportedMessage(t *testing.T) {
+	caps := &config.BdCapabilities{
+		Version: "bd version 0.9.0",
+		Flags:   map[string][]string{"update": {"--priority"}},
+		Missing: []string{"delete"},
+	}
+	if got, want := bdUnsupportedMessage(caps, featureDueDate),
+		"Setting due dates needs a newer bd: bd version 0.9.0 has no 'bd update --due' (upgrade bd)"; got != want {
+		t.Errorf("got %q, want %q", got, want)
+	}
+	if got, want := bdUnsupportedMessage(caps, featureDeleteIssue),
+		"Deleting issues needs a newer bd: bd version 0.9.0 has no 'bd delete' (upgrade bd)"; got != want {
+		t.Errorf("got %q, want %q", got, want)
+	}
+}
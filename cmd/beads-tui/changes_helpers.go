@@ -0,0 +1,125 @@
+package main
+
+import (
+	"fmt"
+	"sort"
+	"strings"
+
+	"github.com/andy/beads-tui/internal/parser"
+)
+
+// changeKind is how an issue changed between two refreshes
+type changeKind int
+
+const (
+	changeNew changeKind = iota
+	changeClosed
+	changeUpdated
+	changeDeleted
+)
+
+// issueChange is one issue that changed between two refreshes. Issue is the
+// issue after the refresh, or before it for deleted issues.
+type issueChange struct {
+	Kind   changeKind
+	Issue  *parser.Issue
+	Detail string // What changed, for updates ("open → in_progress")
+}
+
+// refreshChanges compares the issues before and after a refresh: issues that
+// are new, were closed, were deleted, or were otherwise updated (status,
+// priority, title, or a newer updated_at). The issue named by skip was just
+// changed from this TUI and is left out. Changes are ordered by kind, then ID.
+func refreshChanges(before, after []*parser.Issue, skip string) []issueChange {
+	previous := make(map[string]*parser.Issue, len(before))
+	for _, issue := range before {
+		previous[issue.ID] = issue
+	}
+
+	var changes []issueChange
+	seen := make(map[string]bool, len(after))
+	for _, issue := range after {
+		seen[issue.ID] = true
+		old := previous[issue.ID]
+		switch {
+		case issue.ID == skip:
+		case old == nil:
+			changes = append(changes, issueChange{Kind: changeNew, Issue: issue})
+		case issue.Status == parser.StatusClosed && old.Status != parser.StatusClosed:
+			changes = append(changes, issueChange{Kind: changeClosed, Issue: issue})
+		default:
+			if detail := describeUpdate(old, issue); detail != "" {
+				changes = append(changes, issueChange{Kind: changeUpdated, Issue: issue, Detail: detail})
+			}
+		}
+	}
+	for _, issue := range before {
+		if !seen[issue.ID] && issue.ID != skip {
+			changes = append(changes, issueChange{Kind: changeDeleted, Issue: issue})
+		}
+	}
+
+	sort.SliceStable(changes, func(i, j int) bool {
+		if changes[i].Kind != changes[j].Kind {
+			return changes[i].Kind < changes[j].Kind
+		}
+		return changes[i].Issue.ID < changes[j].Issue.ID
+	})
+	return changes
+}
+
+// describeUpdate says what changed on an issue, or "" if nothing did
+func describeUpdate(old, issue *parser.Issue) string {
+	var parts []string
+	if issue.Status != old.Status {
+		parts = append(parts, fmt.Sprintf("%s → %s", old.Status, issue.Status))
+	}
+	if issue.Priority != old.Priority {
+		parts = append(parts, fmt.Sprintf("P%d → P%d", old.Priority, issue.Priority))
+	}
+	if issue.Title != old.Title {
+		parts = append(parts, "renamed")
+	}
+	if len(parts) == 0 && issue.UpdatedAt.After(old.UpdatedAt) {
+		parts = append(parts, "edited")
+	}
+	return strings.Join(parts, ", ")
+}
+
+// summarizeChanges is the one-line status bar summary of a refresh, e.g.
+// "+2 new, 1 closed, 3 updated", or "" when nothing changed
+func summarizeChanges(changes []issueChange) string {
+	var counts [changeDeleted + 1]int
+	for _, change := range changes {
+		counts[change.Kind]++
+	}
+
+	var parts []string
+	if counts[changeNew] > 0 {
+		parts = append(parts, fmt.Sprintf("+%d new", counts[changeNew]))
+	}
+	if counts[changeClosed] > 0 {
+		parts = append(parts, fmt.Sprintf("%d closed", counts[changeClosed]))
+	}
+	if counts[changeUpdated] > 0 {
+		parts = append(parts, fmt.Sprintf("%d updated", counts[changeUpdated]))
+	}
+	if counts[changeDeleted] > 0 {
+		parts = append(parts, fmt.Sprintf("%d deleted", counts[changeDeleted]))
+	}
+	return strings.Join(parts, ", ")
+}
+
+// String returns the kind's label in the changes overlay
+func (k changeKind) String() string {
+	switch k {
+	case changeNew:
+		return "new"
+	case changeClosed:
+		return "closed"
+	case changeDeleted:
+		return "deleted"
+	default:
+		return "updated"
+	}
+}
@@ -0,0 +1,56 @@
+package main
+
+import (
+	"testing"
+	"time"
+
+	"github.com/andy/beads-tui/internal/parser"
+)
+
+func TestRefreshChanges(t *testing.T) {
+	base := time.Date(2026, 1, 1, 0, 0, 0, 0, time.UTC)
+	before := []*parser.Issue{
+		{ID: "tui-1", Title: "Closing", Status: parser.StatusOpen, UpdatedAt: base},
+		{ID: "tui-2", Title: "Started", Status: parser.StatusOpen, Priority: 2, UpdatedAt: base},
+		{ID: "tui-3", Title: "Untouched", Status: parser.StatusOpen, UpdatedAt: base},
+		{ID: "tui-4", Title: "Deleted", Status: parser.StatusOpen, UpdatedAt: base},
+		{ID: "tui-5", Title: "Mine", Status: parser.StatusOpen, UpdatedAt: base},
+		{ID: "tui-6", Title: "Described", Status: parser.StatusOpen, UpdatedAt: base},
+	}
+	after := []*parser.Issue{
+		{ID: "tui-1", Title: "Closing", Status: parser.StatusClosed, UpdatedAt: base.Add(time.Hour)},
+		{ID: "tui-2", Title: "Started!", Status: parser.StatusInProgress, Priority: 1, UpdatedAt: base.Add(time.Hour)},
+		{ID: "tui-3", Title: "Untouched", Status: parser.StatusOpen, UpdatedAt: base},
+		{ID: "tui-5", Title: "Mine", Status: parser.StatusClosed, UpdatedAt: base.Add(time.Hour)},
+		{ID: "tui-6", Title: "Described", Status: parser.StatusOpen, UpdatedAt: base.Add(time.Hour)},
+		{ID: "tui-7", Title: "New", Status: parser.StatusOpen, UpdatedAt: base},
+	}
+
+	changes := refreshChanges(before, after, "tui-5")
+	want := []struct {
+		kind   changeKind
+		id     string
+		detail string
+	}{
+		{changeNew, "tui-7", ""},
+		{changeClosed, "tui-1", ""},
+		{changeUpdated, "tui-2", "open → in_progress, P2 → P1, renamed"},
+		{changeUpdated, "tui-6", "edited"},
+		{changeDeleted, "tui-4", ""},
+	}
+	if len(changes) != len(want) {
+		t.Fatalf("expected %d changes, got %d: %+v", len(want), len(changes), changes)
+	}
+	for i, w := range want {
+		if c := changes[i]; c.Kind != w.kind || c.Issue.ID != w.id || c.Detail != w.detail {
+			t.Errorf("change %d = %s %s %q, want %s %s %q", i, c.Kind, c.Issue.ID, c.Detail, w.kind, w.id, w.detail)
+		}
+	}
+
+	if got := summarizeChanges(changes); got != "+1 new, 1 closed, 2 updated, 1 deleted" {
+		t.Errorf("summarizeChanges = %q", got)
+	}
+	if got := summarizeChanges(refreshChanges(after, after, "")); got != "" {
+		t.Errorf("expected no summary without changes, got %q", got)
+	}
+}
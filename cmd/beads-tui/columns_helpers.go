@@ -0,0 +1,25 @@
+package main
+
+import (
+	"github.com/andy/beads-tui/internal/config"
+	"github.com/andy/beads-tui/internal/ui"
+)
+
+// listColumnsFromConfig turns the list_columns config into the list's
+// column spec, with every column present (see ui.NormalizeColumns)
+func listColumnsFromConfig(configured []config.ListColumn) []ui.Column {
+	columns := make([]ui.Column, len(configured))
+	for i, column := range configured {
+		columns[i] = ui.Column{Name: column.Name, Width: column.Width, Hidden: column.Hidden}
+	}
+	return ui.NormalizeColumns(columns)
+}
+
+// listColumnsToConfig turns a column spec back into list_columns config
+func listColumnsToConfig(columns []ui.Column) []config.ListColumn {
+	configured := make([]config.ListColumn, len(columns))
+	for i, column := range columns {
+		configured[i] = config.ListColumn{Name: column.Name, Width: column.Width, Hidden: column.Hidden}
+	}
+	return configured
+}
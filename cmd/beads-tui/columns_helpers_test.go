@@ -0,0 +1,47 @@
+package main
+
+import (
+	"reflect"
+	"testing"
+
+	"github.com/andy/beads-tui/internal/config"
+	"github.com/andy/beads-tui/internal/ui"
+)
+
+func TestListColumnsFromConfig(t *testing.T) {
+	if got := listColumnsFromConfig(nil); !reflect.DeepEqual(got, ui.DefaultColumns()) {
+		t.Errorf("expected default columns without config, got %+v", got)
+	}
+
+	got := listColumnsFromConfig([]config.ListColumn{
+		{Name: "ID"},
+		{Name: "title", Width: 40},
+		{Name: "bogus"},
+		{Name: "id", Hidden: true}, // Repeated: the first one wins
+		{Name: "assignee", Width: -3},
+	})
+	if len(got) != len(ui.DefaultColumns()) {
+		t.Fatalf("expected every column, got %+v", got)
+	}
+	want := []ui.Column{{Name: ui.ColumnID}, {Name: ui.ColumnTitle, Width: 40}, {Name: ui.ColumnAssignee}}
+	if !reflect.DeepEqual(got[:3], want) {
+		t.Errorf("configured columns = %+v, want %+v", got[:3], want)
+	}
+	for _, column := range got[3:] {
+		if !column.Hidden {
+			t.Errorf("unconfigured column %q should be hidden", column.Name)
+		}
+	}
+}
+
+func TestListColumnsToConfig(t *testing.T) {
+	columns := []ui.Column{{Name: ui.ColumnTitle, Width: 30}, {Name: ui.ColumnAge, Hidden: true}}
+	got := listColumnsToConfig(columns)
+	want := []config.ListColumn{{Name: "title", Width: 30}, {Name: "age", Hidden: true}}
+	if !reflect.DeepEqual(got, want) {
+		t.Errorf("listColumnsToConfig = %+v, want %+v", got, want)
+	}
+	if back := listColumnsFromConfig(got); !reflect.DeepEqual(back[:2], columns) {
+		t.Errorf("round trip = %+v", back[:2])
+	}
+}
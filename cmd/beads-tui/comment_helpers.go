@@ -0,0 +1,64 @@
+package main
+
+import (
+	"context"
+	"log"
+	"strings"
+	"time"
+
+	"github.com/andy/beads-tui/internal/parser"
+	"github.com/andy/beads-tui/internal/storage"
+)
+
+// commentLoadTimeout bounds reading one issue's comments or searching them
+const commentLoadTimeout = 2 * time.Second
+
+// withComments returns a copy of issue with its comments attached, since
+// issues are loaded without them. If they can't be read (or there's no
+// cache), the error is logged and issue is returned as is.
+func withComments(cache *storage.CommentCache, issue *parser.Issue) *parser.Issue {
+	if cache == nil || issue == nil {
+		return issue
+	}
+	ctx, cancel := context.WithTimeout(context.Background(), commentLoadTimeout)
+	defer cancel()
+	comments, err := cache.Comments(ctx, issue)
+	if err != nil {
+		log.Printf("COMMENTS ERROR: Failed to load comments for %s: %v", issue.ID, err)
+		return issue
+	}
+	loaded := *issue
+	loaded.Comments = comments
+	return &loaded
+}
+
+// ownComment reports whether user wrote comment, and so may edit or delete
+// it from the TUI
+func ownComment(comment *parser.Comment, user string) bool {
+	return user != "" && strings.EqualFold(strings.TrimSpace(comment.Author), user)
+}
+
+// commentSearcher searches comments in the database for the state's full-text
+// search. A failed search is logged and matches nothing.
+func commentSearcher(reader storage.IssueSource) func(text string) map[string]int {
+	return func(text string) map[string]int {
+		ctx, cancel := context.WithTimeout(context.Background(), commentLoadTimeout)
+		defer cancel()
+		matches, err := reader.SearchComments(ctx, text)
+		if err != nil {
+			log.Printf("COMMENTS ERROR: Failed to search comments for %q: %v", text, err)
+			return map[string]int{}
+		}
+		return matches
+	}
+}
+
+// inlineCommentDraft returns a comment draft's text for the one-line comment
+// input in the detail panel. fits is false if the draft has several lines,
+// which only the comment dialog can edit without losing them.
+func inlineCommentDraft(draft string) (text string, fits bool) {
+	if strings.ContainsAny(draft, "\r\n") {
+		return "", false
+	}
+	return draft, true
+}
@@ -0,0 +1,32 @@
+package main
+
+import (
+	"testing"
+
+	"github.com/andy/beads-tui/internal/parser"
+)
+
+func TestOwnComment(t *testing.T) {
+	comment := &parser.Comment{ID: 1, Author: "Alice"}
+	if !ownComment(comment, "alice") {
+		t.Error("expected the author (any case) to own the comment")
+	}
+	if ownComment(comment, "bob") {
+		t.Error("expected someone else not to own the comment")
+	}
+	if ownComment(&parser.Comment{ID: 2}, "") {
+		t.Error("expected an unknown user not to own an anonymous comment")
+	}
+}
+
+func TestInlineCommentDraft(t *testing.T) {
+	if text, fits := inlineCommentDraft("looks good"); !fits || text != "looks good" {
+		t.Errorf("inlineCommentDraft(one line) = %q, %v", text, fits)
+	}
+	if _, fits := inlineCommentDraft(""); !fits {
+		t.Error("expected no draft to fit")
+	}
+	if _, fits := inlineCommentDraft("first\nsecond"); fits {
+		t.Error("expected a draft with several lines not to fit")
+	}
+}
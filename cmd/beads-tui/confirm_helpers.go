@@ -0,0 +1,35 @@
+package main
+
+// Names of the actions that ask before running, as saved in the skip_confirm
+// config
+const (
+	confirmClose = "close" // Closing an issue without a reason (sc)
+	confirmP0    = "p0"    // Raising an issue to P0 (0)
+)
+
+// confirmPolicy decides which risky actions ask before running. A nil policy
+// never asks.
+type confirmPolicy struct {
+	Enabled bool                       // confirm_destructive
+	Skip    map[string]bool            // Actions turned off with "Don't ask again"
+	Save    func(skip map[string]bool) // Persists Skip after it changes (may be nil)
+}
+
+// Needed reports whether action should ask before running
+func (p *confirmPolicy) Needed(action string) bool {
+	return p != nil && p.Enabled && !p.Skip[action]
+}
+
+// DontAskAgain stops action from asking, and saves the change
+func (p *confirmPolicy) DontAskAgain(action string) {
+	if p == nil || p.Skip[action] {
+		return
+	}
+	if p.Skip == nil {
+		p.Skip = make(map[string]bool)
+	}
+	p.Skip[action] = true
+	if p.Save != nil {
+		p.Save(p.Skip)
+	}
+}
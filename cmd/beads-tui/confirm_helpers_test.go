@@ -0,0 +1,51 @@
+package main
+
+import "testing"
+
+func TestConfirmPolicyNeeded(t *testing.T) {
+	var none *confirmPolicy
+	if none.Needed(confirmClose) {
+		t.Error("nil policy should never ask")
+	}
+
+	disabled := &confirmPolicy{}
+	if disabled.Needed(confirmClose) {
+		t.Error("disabled policy should never ask")
+	}
+
+	policy := &confirmPolicy{Enabled: true, Skip: map[string]bool{confirmP0: true}}
+	if !policy.Needed(confirmClose) {
+		t.Error("close should ask")
+	}
+	if policy.Needed(confirmP0) {
+		t.Error("skipped p0 should not ask")
+	}
+}
+
+func TestConfirmPolicyDontAskAgain(t *testing.T) {
+	var saved map[string]bool
+	saves := 0
+	policy := &confirmPolicy{Enabled: true, Save: func(skip map[string]bool) {
+		saved = skip
+		saves++
+	}}
+
+	policy.DontAskAgain(confirmClose)
+	if policy.Needed(confirmClose) {
+		t.Error("close should stop asking")
+	}
+	if !policy.Needed(confirmP0) {
+		t.Error("p0 should still ask")
+	}
+	if !saved[confirmClose] || saves != 1 {
+		t.Errorf("expected one save with close skipped, got %d saves of %v", saves, saved)
+	}
+
+	policy.DontAskAgain(confirmClose)
+	if saves != 1 {
+		t.Errorf("repeating DontAskAgain saved again (%d saves)", saves)
+	}
+
+	var none *confirmPolicy
+	none.DontAskAgain(confirmClose) // Must not panic
+}
@@ -0,0 +1,13 @@
+package main
+
+import "errors"
+
+// errDemoBd is what bd commands fail with in demo mode, where there's no
+// real project for bd to change
+var errDemoBd = errors.New("bd commands are off in demo mode; status, priority, label, and comment changes still work")
+
+// demoBeadsDir stands in for the .beads directory in demo mode, which has
+// none: it's shown in the status bar and names the demo's saved state
+// (bookmarks, collapsed nodes, ...) in ~/.beads-tui. It isn't a path
+// anything is read from.
+const demoBeadsDir = "demo project"
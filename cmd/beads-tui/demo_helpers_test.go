@@ -0,0 +1,44 @@
+package main
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/andy/beads-tui/internal/demo"
+)
+
+func TestDemoDirectWrite(t *testing.T) {
+	data := demo.Generate(time.Date(2026, 3, 2, 9, 0, 0, 0, time.UTC), "dana")
+	source := demo.NewSource(data, "dana")
+	directWriter, bdDisabled = source, errDemoBd
+	defer func() { directWriter, bdDisabled = nil, nil }()
+	issueID := data.Issues[0].ID
+
+	// Simple edits change the issues in memory
+	result, err := execBdJSON(context.Background(), "update", issueID, "--priority", "4")
+	if err != nil || len(result.Issues) != 1 || result.Issues[0].Priority != 4 {
+		t.Fatalf("execBdJSON = %+v, %v, want %s at P4", result, err, issueID)
+	}
+	issues, _ := source.LoadIssues(context.Background())
+	if issues[0].Priority != 4 {
+		t.Errorf("expected the next load to have %s at P4, got P%d", issueID, issues[0].Priority)
+	}
+
+	// Anything else needs bd, which is off
+	if _, err := execBdJSON(context.Background(), "create", "New issue"); !errors.Is(err, errDemoBd) {
+		t.Errorf("create = %v, want errDemoBd", err)
+	}
+}
+
+func TestRunBdDisabled(t *testing.T) {
+	bdDisabled = errDemoBd
+	defer func() { bdDisabled = nil }()
+
+	_, _, err := runBd(context.Background(), []string{"update", "demo-1", "--json"})
+	var bdErr *BdError
+	if !errors.As(err, &bdErr) || !errors.Is(err, errDemoBd) {
+		t.Fatalf("runBd = %v, want a BdError wrapping errDemoBd", err)
+	}
+}
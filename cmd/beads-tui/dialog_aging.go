@@ -0,0 +1,69 @@
+package main
+
+import (
+	"fmt"
+	"time"
+
+	"github.com/andy/beads-tui/internal/formatting"
+	"github.com/andy/beads-tui/internal/theme"
+	"github.com/andy/beads-tui/internal/ui"
+	"github.com/gdamore/tcell/v2"
+	"github.com/rivo/tview"
+)
+
+// ShowAgingReport lists the unclosed issues that have been open longer than
+// their priority allows, most overdue first, with the suggested escalation.
+// Enter closes the overlay and calls jump with the selected issue's ID.
+func (h *DialogHelpers) ShowAgingReport(jump func(issueID string)) {
+	now := time.Now()
+	issues := h.AppState.AgingIssues(now)
+
+	currentTheme := theme.Current()
+	table := tview.NewTable().
+		SetSelectable(true, false).
+		SetSelectedStyle(tcell.StyleDefault.Background(currentTheme.SelectionBg()).Foreground(currentTheme.SelectionFg()))
+	table.SetBorder(true).
+		SetTitle(fmt.Sprintf(" Aging Issues (%d) ", len(issues))).
+		SetTitleAlign(tview.AlignCenter)
+
+	mutedColor := formatting.GetMutedColor()
+	if len(issues) == 0 {
+		table.SetCell(0, 0, tview.NewTableCell(fmt.Sprintf("[%s]No open issue is past its priority's age limit[-]", mutedColor)).SetSelectable(false))
+	}
+	for row, issue := range issues {
+		table.SetCell(row, 0, tview.NewTableCell(fmt.Sprintf("[%s]%s[-] %s",
+			formatting.GetAccentColor(), issue.ID, tview.Escape(issue.Title))).SetExpansion(1))
+		table.SetCell(row, 1, tview.NewTableCell(formatting.FormatAgingHint(issue, h.AppState.Aging(issue, now))))
+	}
+
+	footer := tview.NewTextView().
+		SetDynamicColors(true).
+		SetText(fmt.Sprintf("[%s]Enter jump (then 0-4 sets the priority) · Esc close · limits: \"priority_aging_days\" in config.json[-]", mutedColor))
+
+	content := tview.NewFlex().SetDirection(tview.FlexRow).
+		AddItem(table, 0, 1, true).
+		AddItem(footer, 1, 0, false)
+
+	closeReport := func() {
+		h.Pages.RemovePage("aging_report")
+		h.App.SetFocus(h.IssueList)
+	}
+	table.SetSelectedFunc(func(row, column int) {
+		if row < len(issues) {
+			closeReport()
+			jump(issues[row].ID)
+		}
+	})
+
+	modal := ui.CenterModal(content, 2, 2)
+	modal.SetInputCapture(func(event *tcell.EventKey) *tcell.EventKey {
+		if event.Key() == tcell.KeyEscape || (event.Key() == tcell.KeyRune && event.Rune() == 'q') {
+			closeReport()
+			return nil
+		}
+		return event
+	})
+
+	h.Pages.AddPage("aging_report", modal, true, true)
+	h.App.SetFocus(table)
+}
@@ -0,0 +1,187 @@
+package main
+
+import (
+	"context"
+	"fmt"
+	"log"
+	"time"
+
+	"github.com/andy/beads-tui/internal/formatting"
+	"github.com/andy/beads-tui/internal/parser"
+	"github.com/andy/beads-tui/internal/storage"
+	"github.com/andy/beads-tui/internal/theme"
+	"github.com/andy/beads-tui/internal/ui"
+	"github.com/gdamore/tcell/v2"
+	"github.com/rivo/tview"
+)
+
+// ShowArchive browses closed issues by when they were closed, a page at a
+// time, read straight from the database rather than from the issue list.
+// Tab cycles the range (last 7, 30, 90 days, everything), d takes a custom
+// one, o flips the order, ]/[ page, and Enter closes the overlay and calls
+// jump with the selected issue's ID.
+func (h *DialogHelpers) ShowArchive(jump func(issueID string)) {
+	if h.DB == nil {
+		h.Notify.Error("The archive needs the database")
+		return
+	}
+
+	currentTheme := theme.Current()
+	table := tview.NewTable().
+		SetSelectable(true, false).
+		SetSelectedStyle(tcell.StyleDefault.Background(currentTheme.SelectionBg()).Foreground(currentTheme.SelectionFg()))
+	table.SetBorder(true).SetTitleAlign(tview.AlignCenter)
+
+	mutedColor := formatting.GetMutedColor()
+	footer := tview.NewTextView().SetDynamicColors(true)
+	hint := fmt.Sprintf("[%s]Enter jump · Tab range · d custom range · o order · ]/[ page · Esc close[-]", mutedColor)
+	rangeField := tview.NewInputField().
+		SetLabel("Closed (YYYY-MM-DD..YYYY-MM-DD): ").
+		SetPlaceholder("2026-01-01..2026-03-31, either end may be left out")
+
+	period := 1 // Index into archivePeriods; -1 for the custom range
+	var custom archiveRange
+	oldestFirst := false
+	page, pages := 0, 1
+	rowIssues := make(map[int]*parser.Issue)
+
+	render := func() {
+		span := custom
+		description := span.String()
+		if period >= 0 {
+			span = lastDays(archivePeriods[period], time.Now())
+			if archivePeriods[period] > 0 {
+				description = fmt.Sprintf("closed in the last %d days", archivePeriods[period])
+			}
+		}
+
+		ctx, cancel := context.WithTimeout(context.Background(), 5*time.Second)
+		issues, total, err := h.DB.LoadClosedIssues(ctx, storage.ClosedQuery{
+			From: span.From, To: span.To, OldestFirst: oldestFirst,
+			Limit: archivePageSize, Offset: page * archivePageSize,
+		})
+		cancel()
+		table.Clear()
+		clear(rowIssues)
+		if err != nil {
+			log.Printf("ARCHIVE: Failed to load closed issues: %v", err)
+			table.SetTitle(" Archive ")
+			table.SetCell(0, 0, tview.NewTableCell(fmt.Sprintf("[%s]Failed to load closed issues: %s[-]", formatting.GetErrorColor(), tview.Escape(err.Error()))).SetSelectable(false))
+			return
+		}
+		pages = archivePages(total)
+
+		order := "newest first"
+		if oldestFirst {
+			order = "oldest first"
+		}
+		table.SetTitle(fmt.Sprintf(" Archive: %s · %d issues · %s · page %d/%d ",
+			description, total, order, page+1, pages))
+
+		if len(issues) == 0 {
+			table.SetCell(0, 0, tview.NewTableCell(fmt.Sprintf("[%s]No issues %s[-]", mutedColor, description)).SetSelectable(false))
+		}
+		for row, issue := range issues {
+			closed := ""
+			if issue.ClosedAt != nil {
+				closed = issue.ClosedAt.Local().Format("2006-01-02")
+			}
+			assignee := ""
+			if issue.Assignee != "" {
+				assignee = "@" + issue.Assignee
+			}
+			table.SetCell(row, 0, tview.NewTableCell(fmt.Sprintf("[%s]%s[-]", mutedColor, closed)))
+			table.SetCell(row, 1, tview.NewTableCell(fmt.Sprintf("[%s]%s[-]", formatting.GetAccentColor(), issue.ID)))
+			table.SetCell(row, 2, tview.NewTableCell(fmt.Sprintf("[%s]P%d[-]", formatting.GetPriorityColor(issue.Priority), issue.Priority)))
+			table.SetCell(row, 3, tview.NewTableCell(formatting.GetTypeIcon(issue.IssueType)))
+			table.SetCell(row, 4, tview.NewTableCell(tview.Escape(issue.Title)).SetExpansion(1))
+			table.SetCell(row, 5, tview.NewTableCell(fmt.Sprintf("[%s]%s[-]", mutedColor, tview.Escape(assignee))))
+			rowIssues[row] = issue
+		}
+		table.Select(0, 0)
+		table.ScrollToBeginning()
+	}
+	render()
+	footer.SetText(hint)
+
+	content := tview.NewFlex().SetDirection(tview.FlexRow).
+		AddItem(table, 0, 1, true).
+		AddItem(rangeField, 0, 0, false).
+		AddItem(footer, 1, 0, false)
+
+	closeArchive := func() {
+		h.Pages.RemovePage("archive")
+		h.App.SetFocus(h.IssueList)
+	}
+	table.SetSelectedFunc(func(row, column int) {
+		if issue, ok := rowIssues[row]; ok {
+			closeArchive()
+			jump(issue.ID)
+		}
+	})
+
+	// d shows the range field under the table; Enter applies it, Esc puts
+	// it away
+	hideRangeField := func() {
+		content.ResizeItem(rangeField, 0, 0)
+		h.App.SetFocus(table)
+	}
+	rangeField.SetDoneFunc(func(key tcell.Key) {
+		switch key {
+		case tcell.KeyEnter:
+			r, err := parseArchiveRange(rangeField.GetText())
+			if err != nil {
+				footer.SetText(fmt.Sprintf("[%s]%s[-]", formatting.GetErrorColor(), tview.Escape(err.Error())))
+				return
+			}
+			custom, period, page = r, -1, 0
+			footer.SetText(hint)
+			hideRangeField()
+			render()
+		case tcell.KeyEscape:
+			footer.SetText(hint)
+			hideRangeField()
+		}
+	})
+
+	modal := ui.CenterModal(content, 2, 2)
+	modal.SetInputCapture(func(event *tcell.EventKey) *tcell.EventKey {
+		if rangeField.HasFocus() {
+			return event
+		}
+		switch {
+		case event.Key() == tcell.KeyEscape || (event.Key() == tcell.KeyRune && event.Rune() == 'q'):
+			closeArchive()
+			return nil
+		case event.Key() == tcell.KeyTab:
+			period = (period + 1) % len(archivePeriods)
+			page = 0
+			render()
+			return nil
+		case event.Key() == tcell.KeyRune && event.Rune() == 'd':
+			content.ResizeItem(rangeField, 1, 0)
+			h.App.SetFocus(rangeField)
+			return nil
+		case event.Key() == tcell.KeyRune && event.Rune() == 'o':
+			oldestFirst = !oldestFirst
+			page = 0
+			render()
+			return nil
+		case event.Key() == tcell.KeyRune && (event.Rune() == ']' || event.Rune() == '['):
+			previous := page
+			if event.Rune() == ']' && page+1 < pages {
+				page++
+			} else if event.Rune() == '[' && page > 0 {
+				page--
+			}
+			if page != previous {
+				render()
+			}
+			return nil
+		}
+		return event
+	})
+
+	h.Pages.AddPage("archive", modal, true, true)
+	h.App.SetFocus(table)
+}
@@ -0,0 +1,101 @@
+package main
+
+import (
+	"context"
+	"fmt"
+	"log"
+	"strings"
+
+	"github.com/andy/beads-tui/internal/formatting"
+	"github.com/andy/beads-tui/internal/parser"
+	"github.com/gdamore/tcell/v2"
+	"github.com/rivo/tview"
+)
+
+// ShowAssigneeDialog displays a dialog for assigning the current issue
+func (h *DialogHelpers) ShowAssigneeDialog() {
+	// Get current issue
+	currentIndex := h.IssueList.GetCurrentItem()
+	issue, ok := (*h.IndexToIssue)[currentIndex]
+	if !ok {
+		h.Notify.Error("No issue selected")
+		return
+	}
+
+	dialog := h.newDialog("assignee_dialog", "Assign Issue (Enter to submit)")
+	form := dialog.Form
+	assignee := issue.Assignee
+	knownAssignees := h.AppState.GetAllAssignees()
+
+	form.AddTextView("Assigning", issue.ID+" - "+issue.Title, 0, 2, false, false)
+	form.AddInputField("Assignee", assignee, 30, nil, func(text string) {
+		assignee = text
+	})
+	form.AddTextView("", fmt.Sprintf("[%s]Leave empty to unassign[-]", formatting.GetMutedColor()), 0, 1, false, false)
+
+	// Autocomplete from assignees already present in the database
+	if inputField, ok := form.GetFormItemByLabel("Assignee").(*tview.InputField); ok {
+		inputField.SetAutocompleteFunc(func(currentText string) []string {
+			if currentText == "" {
+				return nil
+			}
+			var matches []string
+			for _, known := range knownAssignees {
+				if strings.HasPrefix(strings.ToLower(known), strings.ToLower(currentText)) && known != currentText {
+					matches = append(matches, known)
+				}
+			}
+			return matches
+		})
+	}
+
+	// Define assign function to be used by both button and Enter
+	assignIssue := func() {
+		newAssignee := strings.TrimSpace(assignee)
+		issueID := issue.ID // Capture before potential refresh
+		if newAssignee == issue.Assignee {
+			dialog.Close()
+			return
+		}
+
+		undo := undoFields("assign "+issueID, issue, []string{"--assignee", newAssignee})
+		log.Printf("BD COMMAND: Assigning issue: bd update %s --assignee %q", issueID, newAssignee)
+		var updatedIssue *parser.Issue
+		h.Runner.Run("Assigning "+issueID, func(ctx context.Context) error {
+			var err error
+			updatedIssue, err = execBdJSONIssue(ctx, "update", issueID, "--assignee", newAssignee)
+			return err
+		}, func(err error) {
+			if err != nil {
+				log.Printf("BD COMMAND ERROR: Assign failed: %v", err)
+				h.ShowErrorOverlay("Error assigning issue", err)
+				return
+			}
+			log.Printf("BD COMMAND: Issue assigned successfully: %s -> %q", updatedIssue.ID, updatedIssue.Assignee)
+			h.Undo.Push(undo)
+			if newAssignee == "" {
+				h.Notify.Success(fmt.Sprintf("Unassigned [%s]%s[-]", formatting.GetAccentColor(), updatedIssue.ID))
+			} else {
+				h.Notify.Success(fmt.Sprintf("Assigned [%s]%s[-] to [%s]%s[-]",
+					formatting.GetAccentColor(), updatedIssue.ID, formatting.GetEmphasisColor(), newAssignee))
+			}
+			dialog.Close()
+			h.ScheduleRefresh(issueID)
+		})
+	}
+
+	dialog.SetPrimary("Assign", assignIssue).
+		SetCancel("Cancel", nil)
+
+	// Submit on Enter from the input field. The done func only fires after the
+	// autocomplete list has handled its own Enter (selecting a suggestion).
+	if inputField, ok := form.GetFormItemByLabel("Assignee").(*tview.InputField); ok {
+		inputField.SetDoneFunc(func(key tcell.Key) {
+			if key == tcell.KeyEnter {
+				assignIssue()
+			}
+		})
+	}
+
+	dialog.Show()
+}
@@ -0,0 +1,95 @@
+package main
+
+import (
+	"context"
+	"fmt"
+	"log"
+	"strings"
+
+	"github.com/andy/beads-tui/internal/formatting"
+	"github.com/andy/beads-tui/internal/parser"
+	"github.com/rivo/tview"
+)
+
+// ShowBlockIssueDialog sets the selected issue to blocked (sb), asking why.
+// The reason is added as a "BLOCKED:" comment, which the list and details
+// show while the issue stays blocked. On an issue that is already blocked
+// it just updates the reason.
+func (h *DialogHelpers) ShowBlockIssueDialog() {
+	currentIndex := h.IssueList.GetCurrentItem()
+	issue, ok := (*h.IndexToIssue)[currentIndex]
+	if !ok {
+		h.Notify.Error("No issue selected")
+		return
+	}
+
+	dialog := h.newDialog("blocked_dialog", "Block Issue (Enter to submit)")
+	form := dialog.Form
+	issueID := issue.ID // Capture before potential refresh
+	current := h.AppState.BlockedReason(issueID)
+	reason := current
+
+	form.AddTextView("Blocking", issueID+" - "+tview.Escape(issue.Title), 0, 2, false, false)
+	form.AddInputField("Reason (optional)", current, 60, nil, func(text string) {
+		reason = text
+	})
+	form.AddTextView("", fmt.Sprintf("[%s]e.g. waiting on vendor; added as a %q comment[-]", formatting.GetMutedColor(), blockedReasonPrefix), 0, 1, false, false)
+
+	block := func() {
+		setStatus := issue.Status != parser.StatusBlocked
+		reason = strings.TrimSpace(reason)
+		addReason := reason != "" && reason != current
+		if !setStatus && !addReason {
+			dialog.Close()
+			return
+		}
+
+		log.Printf("BD COMMAND: Blocking %s (status change: %v, reason: %q)", issueID, setStatus, reason)
+		statusChanged := false
+		h.Runner.Run("Blocking "+issueID, func(ctx context.Context) error {
+			if setStatus {
+				if _, err := execBdJSONIssue(ctx, "update", issueID, "--status", string(parser.StatusBlocked)); err != nil {
+					return err
+				}
+				statusChanged = true
+			}
+			if addReason {
+				if _, err := execBdJSONComment(ctx, "comment", issueID, blockedReasonComment(reason)); err != nil {
+					return err
+				}
+			}
+			return nil
+		}, func(err error) {
+			if statusChanged {
+				h.Undo.Push(undoStatus(issue, parser.StatusBlocked))
+			}
+			if err != nil {
+				log.Printf("BD COMMAND ERROR: Block failed: %v", err)
+				summary := "Error blocking issue"
+				if statusChanged {
+					summary = "Blocked the issue, but couldn't add the reason"
+				}
+				h.ShowErrorOverlay(summary, err)
+				if statusChanged {
+					h.ScheduleRefresh(issueID)
+				}
+				return
+			}
+			if addReason && h.Comments != nil {
+				h.Comments.Forget(issueID)
+			}
+			message := fmt.Sprintf("Set %s to blocked", issueID)
+			if !setStatus {
+				message = "Updated why " + issueID + " is blocked"
+			}
+			h.Notify.Success(message)
+			dialog.Close()
+			h.ScheduleRefresh(issueID)
+		})
+	}
+
+	dialog.SetPrimary("Block", block).
+		SetCancel("Cancel", nil).
+		SetSubmitOnEnter(true)
+	dialog.Show()
+}
@@ -0,0 +1,120 @@
+package main
+
+import (
+	"context"
+	"fmt"
+	"log"
+	"strings"
+
+	"github.com/andy/beads-tui/internal/formatting"
+	"github.com/andy/beads-tui/internal/git"
+	"github.com/andy/beads-tui/internal/parser"
+	"github.com/atotto/clipboard"
+)
+
+// branchName fills in the configured branch template for an issue, falling
+// back to the default template (with a warning) if the configured one is
+// broken
+func (h *DialogHelpers) branchName(issue *parser.Issue) string {
+	name, err := git.BranchName(h.BranchTemplate, issue)
+	if err != nil {
+		log.Printf("GIT: Bad branch_template, using the default: %v", err)
+		h.Notify.Warn(fmt.Sprintf("Using the default branch template: %v", err))
+		name, _ = git.BranchName(git.DefaultBranchTemplate, issue)
+	}
+	return name
+}
+
+// copyBranchName puts a branch name on the clipboard
+func (h *DialogHelpers) copyBranchName(name string) {
+	if err := clipboard.WriteAll(name); err != nil {
+		log.Printf("CLIPBOARD ERROR: Failed to copy branch name: %v", err)
+		h.Notify.Error(fmt.Sprintf("Failed to copy: %v", err))
+		return
+	}
+	log.Printf("CLIPBOARD: Copied branch name to clipboard: %s", name)
+	h.Notify.Success(fmt.Sprintf("Copied branch name '%s' to clipboard", name))
+}
+
+// CopyBranchName copies the branch name for the selected issue without
+// opening the branch dialog
+func (h *DialogHelpers) CopyBranchName() {
+	issue, ok := (*h.IndexToIssue)[h.IssueList.GetCurrentItem()]
+	if !ok {
+		h.Notify.Error("No issue selected")
+		return
+	}
+	h.copyBranchName(h.branchName(issue))
+}
+
+// ShowBranchDialog offers the branch named after the current issue (from
+// the branch_template setting) to check out, creating it from HEAD if it
+// doesn't exist yet, or to copy. Outside a git repository it just copies
+// the name.
+func (h *DialogHelpers) ShowBranchDialog() {
+	currentIndex := h.IssueList.GetCurrentItem()
+	issue, ok := (*h.IndexToIssue)[currentIndex]
+	if !ok {
+		h.Notify.Error("No issue selected")
+		return
+	}
+	name := h.branchName(issue)
+	if h.Git == nil {
+		h.copyBranchName(name)
+		return
+	}
+
+	dialog := h.newDialog("branch_dialog", "Git Branch")
+	form := dialog.Form
+	form.AddTextView("Issue", issue.ID+" - "+issue.Title, 0, 2, false, false)
+	current := h.AppState.CurrentBranch()
+	if current == "" {
+		current = "(detached HEAD)"
+	}
+	form.AddTextView("Checked out", current, 0, 1, false, false)
+	form.AddInputField("Branch", name, 60, nil, func(text string) {
+		name = text
+	})
+	form.AddTextView("", fmt.Sprintf("[%s]Checkout creates the branch from HEAD if it doesn't exist yet[-]", formatting.GetMutedColor()), 0, 1, true, false)
+
+	issueID := issue.ID // Capture before potential refresh
+	checkout := func() {
+		name = strings.TrimSpace(name)
+		if name == "" {
+			h.Notify.Error("Branch name is required")
+			return
+		}
+		var created bool
+		log.Printf("GIT: Checking out branch %s for %s", name, issueID)
+		h.Runner.Run("Checking out "+name, func(context.Context) error {
+			var err error
+			created, err = h.Git.Checkout(name)
+			return err
+		}, func(err error) {
+			if err != nil {
+				log.Printf("GIT ERROR: Checkout failed: %v", err)
+				h.ShowErrorOverlay("Error checking out "+name, err)
+				return
+			}
+			verb := "Checked out"
+			if created {
+				verb = "Created and checked out"
+			}
+			log.Printf("GIT: %s %s", verb, name)
+			dialog.Close()
+			h.Notify.Success(fmt.Sprintf("%s [%s]%s[-]", verb, formatting.GetAccentColor(), name))
+			// The refresh picks up the new branch and marks the issue
+			h.ScheduleRefresh(issueID)
+		})
+	}
+
+	dialog.SetPrimary("Checkout", checkout).
+		AddButton("Copy Name", func() {
+			dialog.Close()
+			h.copyBranchName(strings.TrimSpace(name))
+		}).
+		SetCancel("Cancel", nil).
+		SetSubmitOnEnter(true).
+		SetFixedSize(80, 13)
+	dialog.Show()
+}
@@ -0,0 +1,94 @@
+package main
+
+import (
+	"fmt"
+
+	"github.com/andy/beads-tui/internal/formatting"
+	"github.com/andy/beads-tui/internal/parser"
+	"github.com/andy/beads-tui/internal/theme"
+	"github.com/andy/beads-tui/internal/ui"
+	"github.com/gdamore/tcell/v2"
+	"github.com/rivo/tview"
+)
+
+// ShowRefreshChanges lists the changes found by the last refresh that
+// changed anything. Enter closes the overlay and calls jump with the selected
+// issue's ID (deleted issues can't be jumped to).
+func (h *DialogHelpers) ShowRefreshChanges(changes []issueChange, jump func(issueID string)) {
+	currentTheme := theme.Current()
+	table := tview.NewTable().
+		SetSelectable(true, false).
+		SetSelectedStyle(tcell.StyleDefault.Background(currentTheme.SelectionBg()).Foreground(currentTheme.SelectionFg()))
+	title := " Last Refresh "
+	if summary := summarizeChanges(changes); summary != "" {
+		title = fmt.Sprintf(" Last Refresh: %s ", summary)
+	}
+	table.SetBorder(true).
+		SetTitle(title).
+		SetTitleAlign(tview.AlignCenter)
+
+	mutedColor := formatting.GetMutedColor()
+	if len(changes) == 0 {
+		table.SetCell(0, 0, tview.NewTableCell(fmt.Sprintf("[%s]No changes from outside the TUI since it started[-]", mutedColor)).SetSelectable(false))
+	}
+	for row, change := range changes {
+		detail := ""
+		if change.Detail != "" {
+			detail = fmt.Sprintf(" [%s](%s)[-]", mutedColor, change.Detail)
+		}
+		table.SetCell(row, 0, tview.NewTableCell(fmt.Sprintf("[%s]%-7s[-] [%s]%s[-] %s%s",
+			changeColor(change.Kind), change.Kind,
+			formatting.GetAccentColor(), change.Issue.ID,
+			tview.Escape(change.Issue.Title), detail)).SetExpansion(1))
+	}
+
+	footer := tview.NewTextView().
+		SetDynamicColors(true).
+		SetText(fmt.Sprintf("[%s]Enter jump · Esc close[-]", mutedColor))
+
+	content := tview.NewFlex().SetDirection(tview.FlexRow).
+		AddItem(table, 0, 1, true).
+		AddItem(footer, 1, 0, false)
+
+	closeChanges := func() {
+		h.Pages.RemovePage("refresh_changes")
+		h.App.SetFocus(h.IssueList)
+	}
+	table.SetSelectedFunc(func(row, column int) {
+		if row >= len(changes) {
+			return
+		}
+		if changes[row].Kind == changeDeleted {
+			footer.SetText(fmt.Sprintf("[%s]%s was deleted[-]", formatting.GetWarningColor(), changes[row].Issue.ID))
+			return
+		}
+		closeChanges()
+		jump(changes[row].Issue.ID)
+	})
+
+	modal := ui.CenterModal(content, 2, 2)
+	modal.SetInputCapture(func(event *tcell.EventKey) *tcell.EventKey {
+		if event.Key() == tcell.KeyEscape || (event.Key() == tcell.KeyRune && event.Rune() == 'q') {
+			closeChanges()
+			return nil
+		}
+		return event
+	})
+
+	h.Pages.AddPage("refresh_changes", modal, true, true)
+	h.App.SetFocus(table)
+}
+
+// changeColor colors a change's kind in the changes overlay
+func changeColor(kind changeKind) string {
+	switch kind {
+	case changeNew:
+		return formatting.GetSuccessColor()
+	case changeClosed:
+		return formatting.GetStatusColor(parser.StatusClosed)
+	case changeDeleted:
+		return formatting.GetErrorColor()
+	default:
+		return formatting.GetInfoColor()
+	}
+}
@@ -0,0 +1,95 @@
+package main
+
+import (
+	"context"
+	"fmt"
+	"log"
+	"strings"
+
+	"github.com/andy/beads-tui/internal/formatting"
+	"github.com/andy/beads-tui/internal/parser"
+	"github.com/andy/beads-tui/internal/theme"
+	"github.com/gdamore/tcell/v2"
+	"github.com/rivo/tview"
+)
+
+// ShowQuickAddChild opens a one-line input below the selected row for the
+// title of a new child issue (bd create --parent). Enter creates the child
+// and clears the input for the next one; Esc (or Enter on an empty title)
+// closes it. Quicker than the create dialog for breaking an epic down.
+func (h *DialogHelpers) ShowQuickAddChild() {
+	currentIndex := h.IssueList.GetCurrentItem()
+	parent, ok := (*h.IndexToIssue)[currentIndex]
+	if !ok {
+		h.Notify.Error("No issue selected")
+		return
+	}
+	if !h.requireBd(featureChildIssues) {
+		return
+	}
+
+	// Cover the row below the selected one, or the selected row itself at
+	// the bottom of the list
+	x, y, width, height := h.IssueList.GetInnerRect()
+	itemOffset, _ := h.IssueList.GetOffset()
+	row := currentIndex - itemOffset + 1
+	if row >= height {
+		row = height - 1
+	}
+	if row < 0 {
+		return
+	}
+
+	parentID := parent.ID // Capture before refreshes replace the issue
+	created := 0
+	currentTheme := theme.Current()
+	input := tview.NewInputField().
+		SetLabel("  ↳ child of " + parentID + " ").
+		SetLabelColor(currentTheme.SelectionBg()).
+		SetFieldBackgroundColor(currentTheme.SelectionBg()).
+		SetFieldTextColor(currentTheme.SelectionFg())
+	input.SetRect(x, y+row, width, 1)
+
+	closeInput := func() {
+		h.Pages.RemovePage("quick_add_child")
+		h.App.SetFocus(h.IssueList)
+	}
+	input.SetDoneFunc(func(key tcell.Key) {
+		switch key {
+		case tcell.KeyEscape:
+			closeInput()
+		case tcell.KeyEnter:
+			title := strings.TrimSpace(input.GetText())
+			if title == "" {
+				closeInput()
+				return
+			}
+			log.Printf("BD COMMAND: Creating child issue: bd create %q --parent %s", title, parentID)
+			var createdIssue *parser.Issue
+			h.Runner.Run("Creating child of "+parentID, func(ctx context.Context) error {
+				var err error
+				createdIssue, err = execBdJSONIssue(ctx, "create", title, "--parent", parentID)
+				return err
+			}, func(err error) {
+				if err != nil {
+					log.Printf("BD COMMAND ERROR: Child issue creation failed: %v", err)
+					h.ShowErrorOverlay("Error creating child issue", err)
+					return
+				}
+				log.Printf("BD COMMAND: Child issue created successfully: %s", createdIssue.ID)
+				created++
+				input.SetText("")
+				input.SetLabel(fmt.Sprintf("  ↳ child of %s (%d created) ", parentID, created))
+				h.Notify.Success(fmt.Sprintf("Created [%s]%s[-] under [%s]%s[-]",
+					formatting.GetAccentColor(), createdIssue.ID, formatting.GetAccentColor(), parentID))
+
+				// Keep the parent selected so the input stays below it
+				h.ScheduleRefresh(parentID)
+			})
+		}
+	})
+
+	h.Pages.AddPage("quick_add_child", input, false, true)
+	h.App.SetFocus(input)
+	h.StatusBar.SetText(fmt.Sprintf("[%s]New child of %s: Enter to create (and start the next), Esc to finish[-]", formatting.GetEmphasisColor(), parentID))
+}
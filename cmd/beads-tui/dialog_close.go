@@ -1,14 +1,13 @@
 package main
 
 import (
+	"context"
 	"fmt"
 	"log"
 	"strings"
 
 	"github.com/andy/beads-tui/internal/formatting"
 	"github.com/andy/beads-tui/internal/parser"
-	"github.com/gdamore/tcell/v2"
-	"github.com/rivo/tview"
 )
 
 // ShowCloseIssueDialog displays a dialog for closing an issue
@@ -17,90 +16,59 @@ func (h *DialogHelpers) ShowCloseIssueDialog() {
 	currentIndex := h.IssueList.GetCurrentItem()
 	issue, ok := (*h.IndexToIssue)[currentIndex]
 	if !ok {
-		h.StatusBar.SetText(fmt.Sprintf("[%s]No issue selected[-]", formatting.GetErrorColor()))
+		h.Notify.Error("No issue selected")
 		return
 	}
 
 	// Don't allow closing already closed issues
 	if issue.Status == parser.StatusClosed {
-		h.StatusBar.SetText(fmt.Sprintf("[%s]Issue is already closed[-]", formatting.GetWarningColor()))
+		h.Notify.Warn("Issue is already closed")
 		return
 	}
 
-	form := tview.NewForm()
+	dialog := h.newDialog("close_issue_dialog", "Close Issue (Enter to submit)")
+	form := dialog.Form
 	var reason string
 
 	form.AddTextView("Closing", issue.ID+" - "+issue.Title, 0, 2, false, false)
-	form.AddInputField("Reason (optional)", "", 60, nil, func(text string) {
-		reason = text
-	})
+	if directWriter != nil || h.supportsBd(featureCloseReason) {
+		form.AddInputField("Reason (optional)", "", 60, nil, func(text string) {
+			reason = text
+		})
+	}
 
-	form.AddButton("Close Issue", func() {
+	// Define close function to be used by both button and Enter
+	closeIssue := func() {
 		issueID := issue.ID // Capture before potential refresh
 		args := []string{"close", issueID}
 		if reason != "" {
 			args = append(args, "--reason", reason)
 		}
+		undo := undoClose(issue)
 		log.Printf("BD COMMAND: Closing issue: bd %s", strings.Join(args, " "))
-		closedIssue, err := execBdJSONIssue(args...)
-		if err != nil {
-			log.Printf("BD COMMAND ERROR: Close failed: %v", err)
-			h.StatusBar.SetText(fmt.Sprintf("[%s]Error closing issue: %v[-]", formatting.GetErrorColor(), err))
-		} else {
-			log.Printf("BD COMMAND: Issue closed successfully: %s", closedIssue.ID)
-			h.StatusBar.SetText(fmt.Sprintf("[%s]✓ Closed [%s]%s[-][-]", formatting.GetSuccessColor(), formatting.GetAccentColor(), closedIssue.ID))
-			h.Pages.RemovePage("close_issue_dialog")
-			h.App.SetFocus(h.IssueList)
-			h.ScheduleRefresh(issueID)
-		}
-	})
-	form.AddButton("Cancel", func() {
-		h.Pages.RemovePage("close_issue_dialog")
-		h.App.SetFocus(h.IssueList)
-	})
-
-	form.SetBorder(true).SetTitle(" Close Issue (Enter to submit) ").SetTitleAlign(tview.AlignCenter)
-	form.SetCancelFunc(func() {
-		h.Pages.RemovePage("close_issue_dialog")
-		h.App.SetFocus(h.IssueList)
-	})
-
-	// Add Enter key handler to close
-	form.SetInputCapture(func(event *tcell.EventKey) *tcell.EventKey {
-		if event.Key() == tcell.KeyEnter {
-			issueID := issue.ID
-			args := []string{"close", issueID}
-			if reason != "" {
-				args = append(args, "--reason", reason)
-			}
-			log.Printf("BD COMMAND: Closing issue (Enter): bd %s", strings.Join(args, " "))
-			closedIssue, err := execBdJSONIssue(args...)
+		var closedIssue *parser.Issue
+		h.Runner.Run("Closing "+issueID, func(ctx context.Context) error {
+			var err error
+			closedIssue, err = execBdJSONIssue(ctx, args...)
+			return err
+		}, func(err error) {
 			if err != nil {
 				log.Printf("BD COMMAND ERROR: Close failed: %v", err)
-				h.StatusBar.SetText(fmt.Sprintf("[%s]Error closing issue: %v[-]", formatting.GetErrorColor(), err))
-			} else {
-				log.Printf("BD COMMAND: Issue closed successfully: %s", closedIssue.ID)
-				h.StatusBar.SetText(fmt.Sprintf("[%s]✓ Closed [%s]%s[-][-]", formatting.GetSuccessColor(), formatting.GetAccentColor(), closedIssue.ID))
-				h.Pages.RemovePage("close_issue_dialog")
-				h.App.SetFocus(h.IssueList)
-				h.ScheduleRefresh(issueID)
+				h.ShowErrorOverlay("Error closing issue", err)
+				return
 			}
-			return nil
-		}
-		return event
-	})
-
-	// Create modal (centered)
-	modal := tview.NewFlex().
-		AddItem(nil, 0, 1, false).
-		AddItem(tview.NewFlex().SetDirection(tview.FlexRow).
-			AddItem(nil, 0, 1, false).
-			AddItem(form, 0, 2, true).
-			AddItem(nil, 0, 1, false), 0, 2, true).
-		AddItem(nil, 0, 1, false)
+			log.Printf("BD COMMAND: Issue closed successfully: %s", closedIssue.ID)
+			h.Undo.Push(undo)
+			h.Notify.Success(fmt.Sprintf("Closed [%s]%s[-]", formatting.GetAccentColor(), closedIssue.ID))
+			dialog.Close()
+			h.ScheduleRefresh(issueID)
+		})
+	}
 
-	h.Pages.AddPage("close_issue_dialog", modal, true, true)
-	h.App.SetFocus(form)
+	dialog.SetPrimary("Close Issue", closeIssue).
+		SetCancel("Cancel", nil).
+		SetSubmitOnEnter(true)
+	dialog.Show()
 }
 
 // ShowReopenIssueDialog displays a dialog for reopening a closed issue
@@ -109,88 +77,57 @@ func (h *DialogHelpers) ShowReopenIssueDialog() {
 	currentIndex := h.IssueList.GetCurrentItem()
 	issue, ok := (*h.IndexToIssue)[currentIndex]
 	if !ok {
-		h.StatusBar.SetText(fmt.Sprintf("[%s]No issue selected[-]", formatting.GetErrorColor()))
+		h.Notify.Error("No issue selected")
 		return
 	}
 
 	// Only allow reopening closed issues
 	if issue.Status != parser.StatusClosed {
-		h.StatusBar.SetText(fmt.Sprintf("[%s]Issue is not closed[-]", formatting.GetWarningColor()))
+		h.Notify.Warn("Issue is not closed")
 		return
 	}
 
-	form := tview.NewForm()
+	dialog := h.newDialog("reopen_issue_dialog", "Reopen Issue (Enter to submit)")
+	form := dialog.Form
 	var reason string
 
 	form.AddTextView("Reopening", issue.ID+" - "+issue.Title, 0, 2, false, false)
-	form.AddInputField("Reason (optional)", "", 60, nil, func(text string) {
-		reason = text
-	})
+	if directWriter != nil || h.supportsBd(featureReopenReason) {
+		form.AddInputField("Reason (optional)", "", 60, nil, func(text string) {
+			reason = text
+		})
+	}
 
-	form.AddButton("Reopen Issue", func() {
+	// Define reopen function to be used by both button and Enter
+	reopenIssue := func() {
 		issueID := issue.ID // Capture before potential refresh
 		args := []string{"reopen", issueID}
 		if reason != "" {
 			args = append(args, "--reason", reason)
 		}
+		undo := undoReopen(issue)
 		log.Printf("BD COMMAND: Reopening issue: bd %s", strings.Join(args, " "))
-		reopenedIssue, err := execBdJSONIssue(args...)
-		if err != nil {
-			log.Printf("BD COMMAND ERROR: Reopen failed: %v", err)
-			h.StatusBar.SetText(fmt.Sprintf("[%s]Error reopening issue: %v[-]", formatting.GetErrorColor(), err))
-		} else {
-			log.Printf("BD COMMAND: Issue reopened successfully: %s", reopenedIssue.ID)
-			h.StatusBar.SetText(fmt.Sprintf("[%s]✓ Reopened [%s]%s[-][-]", formatting.GetSuccessColor(), formatting.GetAccentColor(), reopenedIssue.ID))
-			h.Pages.RemovePage("reopen_issue_dialog")
-			h.App.SetFocus(h.IssueList)
-			h.ScheduleRefresh(issueID)
-		}
-	})
-	form.AddButton("Cancel", func() {
-		h.Pages.RemovePage("reopen_issue_dialog")
-		h.App.SetFocus(h.IssueList)
-	})
-
-	form.SetBorder(true).SetTitle(" Reopen Issue (Enter to submit) ").SetTitleAlign(tview.AlignCenter)
-	form.SetCancelFunc(func() {
-		h.Pages.RemovePage("reopen_issue_dialog")
-		h.App.SetFocus(h.IssueList)
-	})
-
-	// Add Enter key handler to reopen
-	form.SetInputCapture(func(event *tcell.EventKey) *tcell.EventKey {
-		if event.Key() == tcell.KeyEnter {
-			issueID := issue.ID
-			args := []string{"reopen", issueID}
-			if reason != "" {
-				args = append(args, "--reason", reason)
-			}
-			log.Printf("BD COMMAND: Reopening issue (Enter): bd %s", strings.Join(args, " "))
-			reopenedIssue, err := execBdJSONIssue(args...)
+		var reopenedIssue *parser.Issue
+		h.Runner.Run("Reopening "+issueID, func(ctx context.Context) error {
+			var err error
+			reopenedIssue, err = execBdJSONIssue(ctx, args...)
+			return err
+		}, func(err error) {
 			if err != nil {
 				log.Printf("BD COMMAND ERROR: Reopen failed: %v", err)
-				h.StatusBar.SetText(fmt.Sprintf("[%s]Error reopening issue: %v[-]", formatting.GetErrorColor(), err))
-			} else {
-				log.Printf("BD COMMAND: Issue reopened successfully: %s", reopenedIssue.ID)
-				h.StatusBar.SetText(fmt.Sprintf("[%s]✓ Reopened [%s]%s[-][-]", formatting.GetSuccessColor(), formatting.GetAccentColor(), reopenedIssue.ID))
-				h.Pages.RemovePage("reopen_issue_dialog")
-				h.App.SetFocus(h.IssueList)
-				h.ScheduleRefresh(issueID)
+				h.ShowErrorOverlay("Error reopening issue", err)
+				return
 			}
-			return nil
-		}
-		return event
-	})
-
-	// Create modal (centered)
-	modal := tview.NewFlex().
-		AddItem(nil, 0, 1, false).
-		AddItem(tview.NewFlex().SetDirection(tview.FlexRow).
-			AddItem(nil, 0, 1, false).
-			AddItem(form, 0, 2, true).
-			AddItem(nil, 0, 1, false), 0, 2, true).
-		AddItem(nil, 0, 1, false)
+			log.Printf("BD COMMAND: Issue reopened successfully: %s", reopenedIssue.ID)
+			h.Undo.Push(undo)
+			h.Notify.Success(fmt.Sprintf("Reopened [%s]%s[-]", formatting.GetAccentColor(), reopenedIssue.ID))
+			dialog.Close()
+			h.ScheduleRefresh(issueID)
+		})
+	}
 
-	h.Pages.AddPage("reopen_issue_dialog", modal, true, true)
-	h.App.SetFocus(form)
+	dialog.SetPrimary("Reopen Issue", reopenIssue).
+		SetCancel("Cancel", nil).
+		SetSubmitOnEnter(true)
+	dialog.Show()
 }
@@ -0,0 +1,41 @@
+package main
+
+import (
+	"fmt"
+
+	"github.com/andy/beads-tui/internal/ui"
+)
+
+// ShowColumnsDialog lets the user show and hide issue list columns. Apply
+// calls apply with the new spec (same order and widths); Defaults restores
+// ui.DefaultColumns.
+func (h *DialogHelpers) ShowColumnsDialog(columns []ui.Column, apply func([]ui.Column)) {
+	dialog := h.newDialog("columns_dialog", "List Columns")
+	form := dialog.Form
+
+	edited := append([]ui.Column(nil), columns...)
+	form.AddTextView("", "Widths and order come from \"list_columns\" in ~/.beads-tui/config.json", 0, 1, true, false)
+	for i, column := range edited {
+		label := column.Name
+		if column.Width > 0 {
+			label = fmt.Sprintf("%s (%d wide)", column.Name, column.Width)
+		}
+		form.AddCheckbox(label, !column.Hidden, func(checked bool) {
+			edited[i].Hidden = !checked
+		})
+	}
+
+	applyColumns := func() {
+		dialog.Close()
+		apply(edited)
+	}
+
+	dialog.SetPrimary("Apply", applyColumns).
+		SetCancel("Cancel", nil).
+		AddButton("Defaults", func() {
+			dialog.Close()
+			apply(ui.DefaultColumns())
+		}).
+		SetFixedSize(60, len(edited)+7)
+	dialog.Show()
+}
@@ -0,0 +1,105 @@
+package main
+
+import (
+	"fmt"
+	"log"
+	"time"
+
+	"github.com/andy/beads-tui/internal/formatting"
+	"github.com/andy/beads-tui/internal/theme"
+	"github.com/andy/beads-tui/internal/ui"
+	"github.com/atotto/clipboard"
+	"github.com/gdamore/tcell/v2"
+	"github.com/rivo/tview"
+)
+
+// ShowCommandLog lists the bd commands the TUI ran this session, newest
+// first, with their duration and exit status. The selected command's full
+// command line, error, and output are shown below the list; y copies the
+// command line so it can be rerun in a terminal.
+func (h *DialogHelpers) ShowCommandLog() {
+	entries := commandLog.Recent()
+	mutedColor := formatting.GetMutedColor()
+
+	currentTheme := theme.Current()
+	table := tview.NewTable().
+		SetSelectable(true, false).
+		SetSelectedStyle(tcell.StyleDefault.Background(currentTheme.SelectionBg()).Foreground(currentTheme.SelectionFg()))
+	table.SetBorder(true).
+		SetTitle(fmt.Sprintf(" bd Commands (%d) ", len(entries))).
+		SetTitleAlign(tview.AlignCenter)
+
+	if len(entries) == 0 {
+		table.SetCell(0, 0, tview.NewTableCell(fmt.Sprintf("[%s]No bd commands run yet[-]", mutedColor)).SetSelectable(false))
+	}
+	for row, entry := range entries {
+		status := fmt.Sprintf("[%s]✓[-]", formatting.GetSuccessColor())
+		if entry.Err != "" {
+			status = fmt.Sprintf("[%s]✗ %d[-]", formatting.GetErrorColor(), entry.ExitCode)
+		}
+		table.SetCell(row, 0, tview.NewTableCell(fmt.Sprintf("[%s]%s[-]", mutedColor, entry.Started.Format("15:04:05"))))
+		table.SetCell(row, 1, tview.NewTableCell(status))
+		table.SetCell(row, 2, tview.NewTableCell(fmt.Sprintf("[%s]%s[-]", mutedColor, entry.Duration.Round(time.Millisecond))).SetAlign(tview.AlignRight))
+		table.SetCell(row, 3, tview.NewTableCell(tview.Escape(entry.CommandLine())).SetExpansion(1).SetMaxWidth(100))
+	}
+
+	details := tview.NewTextView().
+		SetDynamicColors(true).
+		SetWrap(true)
+	details.SetBorder(true).SetTitle(" Output ")
+	showDetails := func(row int) {
+		if row < 0 || row >= len(entries) {
+			return
+		}
+		entry := entries[row]
+		text := tview.Escape(entry.CommandLine()) + "\n"
+		if entry.Err != "" {
+			text += fmt.Sprintf("[%s]%s[-]\n", formatting.GetErrorColor(), tview.Escape(entry.Err))
+		}
+		if entry.Output != "" {
+			text += "\n" + tview.Escape(entry.Output)
+		} else {
+			text += fmt.Sprintf("\n[%s](no output)[-]", mutedColor)
+		}
+		details.SetText(text).ScrollToBeginning()
+	}
+	table.SetSelectionChangedFunc(func(row, column int) {
+		showDetails(row)
+	})
+	showDetails(0)
+
+	footer := tview.NewTextView().
+		SetDynamicColors(true).
+		SetText(fmt.Sprintf("[%s]j/k select · y copy command line · Esc close[-]", mutedColor))
+
+	content := tview.NewFlex().SetDirection(tview.FlexRow).
+		AddItem(table, 0, 1, true).
+		AddItem(details, 0, 1, false).
+		AddItem(footer, 1, 0, false)
+
+	modal := ui.CenterModal(content, 2, 3)
+	modal.SetInputCapture(func(event *tcell.EventKey) *tcell.EventKey {
+		if event.Key() == tcell.KeyEscape || (event.Key() == tcell.KeyRune && event.Rune() == 'q') {
+			h.Pages.RemovePage("command_log")
+			h.App.SetFocus(h.IssueList)
+			return nil
+		}
+		if event.Key() == tcell.KeyRune && event.Rune() == 'y' {
+			row, _ := table.GetSelection()
+			if row < 0 || row >= len(entries) {
+				return nil
+			}
+			if err := clipboard.WriteAll(entries[row].CommandLine()); err != nil {
+				log.Printf("CLIPBOARD ERROR: Failed to copy command line: %v", err)
+				footer.SetText(fmt.Sprintf("[%s]Failed to copy: %v[-]", formatting.GetErrorColor(), err))
+			} else {
+				footer.SetText(fmt.Sprintf("[%s]✓ Copied command line to clipboard[-]", formatting.GetSuccessColor()))
+			}
+			return nil
+		}
+		return event
+	})
+
+	h.Pages.AddPage("command_log", modal, true, true)
+	h.App.SetFocus(table)
+}
@@ -0,0 +1,46 @@
+package main
+
+import (
+	"fmt"
+
+	"github.com/andy/beads-tui/internal/formatting"
+	"github.com/andy/beads-tui/internal/ui"
+	"github.com/rivo/tview"
+)
+
+// ShowCommandPreview shows a bd command that dry-run mode is holding back
+// and calls decide with whether to run it. label is the action it belongs
+// to. The returned function closes the preview without an answer (when the
+// action is canceled).
+func (h *DialogHelpers) ShowCommandPreview(label string, args []string, decide func(approved bool)) func() {
+	dialog := ui.NewDialog(h.App, h.Pages, "command_preview_dialog", "Dry Run: "+label).SetReturnFocus(h.App.GetFocus())
+	commandLine := bdLogEntry{Args: args}.CommandLine()
+	note := "Nothing runs until you approve it. Skip stops the rest of the action."
+	if directWriter != nil {
+		if _, ok := parseDirectOp(args); ok {
+			note = "--direct-write applies this straight to the database. " + note
+		}
+	}
+	dialog.Form.AddTextView("", fmt.Sprintf("%s\n\n[%s]%s[-]", tview.Escape(commandLine), formatting.GetMutedColor(), note), 0, 6, true, false)
+
+	answered := false
+	answer := func(approved bool) {
+		if answered {
+			return
+		}
+		answered = true
+		dialog.Close()
+		decide(approved)
+	}
+	dialog.SetPrimary("Run", func() { answer(true) }).
+		SetCancel("Skip", func() { answer(false) }).
+		SetFixedSize(78, 12)
+	dialog.Show()
+
+	return func() {
+		if !answered {
+			answered = true
+			dialog.Close()
+		}
+	}
+}
@@ -1,101 +1,180 @@
 package main
 
 import (
+	"context"
 	"fmt"
 	"log"
+	"strconv"
+	"strings"
 
-	"github.com/andy/beads-tui/internal/formatting"
-	"github.com/gdamore/tcell/v2"
+	"github.com/andy/beads-tui/internal/parser"
+	"github.com/andy/beads-tui/internal/ui"
 	"github.com/rivo/tview"
 )
 
-// ShowCommentDialog displays a dialog to add a comment to the current issue
+// ShowCommentDialog displays a dialog to add a comment to the current issue.
+// The text is kept as a draft until it is sent, and restored if the dialog
+// is cancelled and opened again for the same issue.
 func (h *DialogHelpers) ShowCommentDialog() {
 	// Get current issue
 	currentIndex := h.IssueList.GetCurrentItem()
 	issue, ok := (*h.IndexToIssue)[currentIndex]
 	if !ok {
-		h.StatusBar.SetText(fmt.Sprintf("[%s]No issue selected[-]", formatting.GetErrorColor()))
+		h.Notify.Error("No issue selected")
 		return
 	}
 
-	form := tview.NewForm()
-	var commentText string
+	dialog := h.newDialog("comment_dialog", "Add Comment")
+	form := dialog.Form
+	draftID := draftKey("comment", issue.ID)
+	draft, restored := h.Drafts.Load(draftID)
+	commentText := draft.Fields["comment"]
 
 	// Define save function to be used by both button and Ctrl-S
 	saveComment := func() {
 		if commentText == "" {
-			h.StatusBar.SetText(fmt.Sprintf("[%s]Error: Comment cannot be empty[-]", formatting.GetErrorColor()))
+			h.Notify.Error("Comment cannot be empty")
 			return
 		}
 
 		// Execute bd comment command with --json
-		log.Printf("BD COMMAND: Adding comment: bd comment %s %q", issue.ID, commentText)
-		comment, err := execBdJSONComment("comment", issue.ID, commentText)
-		if err != nil {
-			log.Printf("BD COMMAND ERROR: Comment failed: %v", err)
-			h.StatusBar.SetText(fmt.Sprintf("[%s]Error adding comment: %v[-]", formatting.GetErrorColor(), err))
-		} else {
+		issueID := issue.ID // Capture before potential refresh
+		log.Printf("BD COMMAND: Adding comment: bd comment %s %q", issueID, commentText)
+		var comment *parser.Comment
+		h.Runner.Run("Adding comment to "+issueID, func(ctx context.Context) error {
+			var err error
+			comment, err = execBdJSONComment(ctx, "comment", issueID, commentText)
+			return err
+		}, func(err error) {
+			if err != nil {
+				log.Printf("BD COMMAND ERROR: Comment failed: %v", err)
+				h.ShowErrorOverlay("Error adding comment", err)
+				return
+			}
 			log.Printf("BD COMMAND: Comment added successfully: ID %d", comment.ID)
-			h.StatusBar.SetText(fmt.Sprintf("[%s]✓ Comment added successfully[-]", formatting.GetSuccessColor()))
+			h.Drafts.Delete(draftID)
+			if h.Comments != nil {
+				h.Comments.Forget(issueID)
+			}
+			h.Notify.Success("Comment added successfully")
 
 			// Close dialog
-			h.Pages.RemovePage("comment_dialog")
-			h.App.SetFocus(h.IssueList)
+			dialog.Close()
 
 			// Refresh issues after a short delay, preserving selection
-			issueID := issue.ID
 			h.ScheduleRefresh(issueID)
-		}
+		})
 	}
 
 	form.AddTextView("Adding comment to", issue.ID+" - "+issue.Title, 0, 2, false, false)
-	form.AddTextArea("Comment", "", 60, 8, 0, func(text string) {
+	form.AddTextArea("Comment", commentText, 60, 8, 0, func(text string) {
 		commentText = text
+		h.Drafts.Save(draftID, map[string]string{"comment": text}, nil)
 	})
-
-	// Get the TextArea and add Ctrl-S handler directly to it
-	// (form's InputCapture doesn't receive events when TextArea has focus)
-	if textArea, ok := form.GetFormItemByLabel("Comment").(*tview.TextArea); ok {
-		textArea.SetInputCapture(func(event *tcell.EventKey) *tcell.EventKey {
-			if event.Key() == tcell.KeyCtrlS {
-				saveComment()
-				return nil
+	if restored {
+		h.addDraftNotice(dialog, draft, func() {
+			if area, ok := form.GetFormItemByLabel("Comment").(*tview.TextArea); ok {
+				area.SetText("", false)
 			}
-			return event
+			h.Drafts.Delete(draftID)
 		})
 	}
 
-	form.AddButton("Save (Ctrl-S)", saveComment)
-	form.AddButton("Cancel", func() {
-		h.Pages.RemovePage("comment_dialog")
-		h.App.SetFocus(h.IssueList)
-	})
+	dialog.SetPrimary("Save", saveComment).
+		SetCancel("Cancel", nil).
+		SetSize(3, 3)
+	dialog.Show()
+}
 
-	form.SetBorder(true).SetTitle(" Add Comment ").SetTitleAlign(tview.AlignCenter)
-	form.SetCancelFunc(func() {
-		h.Pages.RemovePage("comment_dialog")
-		h.App.SetFocus(h.IssueList)
-	})
+// ShowEditCommentDialog displays a dialog to edit one of the user's comments
+// on issueID. Focus returns to whatever had it (the detail panel) on close.
+func (h *DialogHelpers) ShowEditCommentDialog(issueID string, comment *parser.Comment) {
+	if !h.requireBd(featureEditComment) {
+		return
+	}
+	dialog := ui.NewDialog(h.App, h.Pages, "edit_comment_dialog", "Edit Comment").SetReturnFocus(h.App.GetFocus())
+	form := dialog.Form
+	commentText := comment.Text
 
-	// Add Ctrl-S handler for when buttons have focus
-	form.SetInputCapture(func(event *tcell.EventKey) *tcell.EventKey {
-		if event.Key() == tcell.KeyCtrlS {
-			saveComment()
-			return nil
+	saveComment := func() {
+		if strings.TrimSpace(commentText) == "" {
+			h.Notify.Error("Comment cannot be empty (delete it instead)")
+			return
+		}
+		if commentText == comment.Text {
+			dialog.Close()
+			return
 		}
-		return event
+
+		commentID := strconv.FormatInt(comment.ID, 10)
+		log.Printf("BD COMMAND: Editing comment: bd comment edit %s %q", commentID, commentText)
+		h.Runner.Run("Editing comment on "+issueID, func(ctx context.Context) error {
+			_, err := execBdJSONComment(ctx, "comment", "edit", commentID, commentText)
+			return err
+		}, func(err error) {
+			if err != nil {
+				log.Printf("BD COMMAND ERROR: Comment edit failed: %v", err)
+				h.ShowErrorOverlay("Error editing comment", err)
+				return
+			}
+			log.Printf("BD COMMAND: Comment %s edited successfully", commentID)
+			h.Undo.Push(undoCommentEdit(issueID, comment))
+			if h.Comments != nil {
+				h.Comments.Forget(issueID)
+			}
+			h.Notify.Success("Comment updated")
+			dialog.Close()
+			h.ScheduleRefresh(issueID)
+		})
+	}
+
+	form.AddTextView("Editing comment on", fmt.Sprintf("%s (%s, %s)", issueID, comment.Author, comment.CreatedAt.Format("2006-01-02 15:04")), 0, 2, false, false)
+	form.AddTextArea("Comment", comment.Text, 60, 8, 0, func(text string) {
+		commentText = text
 	})
 
-	// Create modal (centered)
-	modal := tview.NewFlex().
-		AddItem(nil, 0, 1, false).
-		AddItem(tview.NewFlex().SetDirection(tview.FlexRow).
-			AddItem(nil, 0, 1, false).
-			AddItem(form, 0, 3, true).
-			AddItem(nil, 0, 1, false), 0, 3, true).
-		AddItem(nil, 0, 1, false)
-
-	h.Pages.AddPage("comment_dialog", modal, true, true)
-	h.App.SetFocus(form)
+	dialog.SetPrimary("Save", saveComment).
+		SetCancel("Cancel", nil).
+		SetSize(3, 3)
+	dialog.Show()
+}
+
+// ConfirmDeleteComment asks before deleting one of the user's comments on
+// issueID. Deletion can't be undone, so nothing is pushed on the undo stack.
+func (h *DialogHelpers) ConfirmDeleteComment(issueID string, comment *parser.Comment) {
+	if !h.requireBd(featureDeleteComment) {
+		return
+	}
+	dialog := ui.NewDialog(h.App, h.Pages, "delete_comment_dialog", "Delete Comment").SetReturnFocus(h.App.GetFocus())
+	preview := comment.Text
+	if runes := []rune(preview); len(runes) > 120 {
+		preview = string(runes[:117]) + "..."
+	}
+	dialog.Form.AddTextView("", fmt.Sprintf("Delete this comment on %s? This can't be undone.\n\n%s", issueID, tview.Escape(preview)), 0, 5, true, false)
+
+	deleteComment := func() {
+		commentID := strconv.FormatInt(comment.ID, 10)
+		log.Printf("BD COMMAND: Deleting comment: bd comment delete %s", commentID)
+		h.Runner.Run("Deleting comment on "+issueID, func(ctx context.Context) error {
+			return execBd(ctx, "comment", "delete", commentID)
+		}, func(err error) {
+			if err != nil {
+				log.Printf("BD COMMAND ERROR: Comment delete failed: %v", err)
+				h.ShowErrorOverlay("Error deleting comment", err)
+				return
+			}
+			log.Printf("BD COMMAND: Comment %s deleted successfully", commentID)
+			if h.Comments != nil {
+				h.Comments.Forget(issueID)
+			}
+			h.Notify.Success("Comment deleted")
+			dialog.Close()
+			h.ScheduleRefresh(issueID)
+		})
+	}
+
+	dialog.SetPrimary("Delete", deleteComment).
+		SetCancel("Cancel", nil).
+		SetFixedSize(70, 12)
+	dialog.Show()
 }
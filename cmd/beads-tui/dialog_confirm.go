@@ -0,0 +1,37 @@
+package main
+
+import (
+	"fmt"
+
+	"github.com/andy/beads-tui/internal/formatting"
+)
+
+// ConfirmAction runs proceed once the user confirms action, or right away if
+// the policy doesn't ask for it (confirm_destructive off, or "Don't ask
+// again" ticked before). message is shown above the checkbox; label names
+// the primary button.
+func (h *DialogHelpers) ConfirmAction(action, title, message, label string, proceed func()) {
+	if !h.Confirm.Needed(action) {
+		proceed()
+		return
+	}
+
+	dialog := h.newDialog("confirm_dialog", title)
+	dialog.Form.AddTextView("", message, 0, 3, true, false)
+	dontAsk := false
+	dialog.Form.AddCheckbox("Don't ask again", false, func(checked bool) {
+		dontAsk = checked
+	})
+	dialog.Form.AddTextView("", fmt.Sprintf("[%s]confirm_destructive in config.json turns every prompt off[-]", formatting.GetMutedColor()), 0, 1, true, false)
+
+	dialog.SetPrimary(label, func() {
+		if dontAsk {
+			h.Confirm.DontAskAgain(action)
+		}
+		dialog.Close()
+		proceed()
+	}).
+		SetCancel("Cancel", nil).
+		SetFixedSize(64, 13)
+	dialog.Show()
+}
@@ -1,19 +1,24 @@
 package main
 
 import (
+	"context"
 	"fmt"
 	"log"
 	"os"
 	"strings"
 
 	"github.com/andy/beads-tui/internal/formatting"
-	"github.com/andy/beads-tui/internal/theme"
+	"github.com/andy/beads-tui/internal/parser"
 	"github.com/gdamore/tcell/v2"
 	"github.com/rivo/tview"
 	"golang.org/x/term"
 )
 
-// ShowCreateIssueDialog displays a dialog for creating a new issue
+// ShowCreateIssueDialog displays a dialog for creating a new issue. "Create +
+// New" (Ctrl-N) creates the issue and clears the title and description for
+// the next one, keeping the priority, type, and parent choices. The title and
+// description are kept as a draft until the issue is created, and restored
+// if the dialog is cancelled and opened again.
 func (h *DialogHelpers) ShowCreateIssueDialog() {
 	// Helper function to detect priority from text (natural language)
 	detectPriority := func(text string) *int {
@@ -113,16 +118,10 @@ func (h *DialogHelpers) ShowCreateIssueDialog() {
 	}
 
 	// Create form
-	form := tview.NewForm()
+	dialog := h.newDialog("create_issue", "Create New Issue")
+	form := dialog.Form
 	form.SetItemPadding(1) // Add spacing between fields
 
-	// Set field colors - use selection colors which we know work
-	currentTheme := theme.Current()
-	form.SetFieldBackgroundColor(currentTheme.SelectionBg())
-	form.SetFieldTextColor(currentTheme.SelectionFg())
-	form.SetButtonBackgroundColor(currentTheme.SelectionBg())
-	form.SetButtonTextColor(currentTheme.SelectionFg())
-
 	var title, description, priority, issueType string
 	priority = "2" // Default to P2
 	issueType = "feature" // Default to feature
@@ -135,6 +134,17 @@ func (h *DialogHelpers) ShowCreateIssueDialog() {
 		currentIssueID = issue.ID
 	}
 
+	// Pick up the text of a cancelled create
+	draftID := draftKey("create", "")
+	draft, restored := h.Drafts.Load(draftID)
+	title, description = draft.Fields["Title"], draft.Fields["Description"]
+	saveDraft := func() {
+		h.Drafts.Save(draftID, map[string]string{"Title": title, "Description": description}, nil)
+	}
+
+	// Issues created so far with "Create + New", shown in the hint view
+	var createdIDs []string
+
 	// Create a TextView to show detected keywords
 	detectionHintView := tview.NewTextView().
 		SetDynamicColors(true).
@@ -181,6 +191,10 @@ func (h *DialogHelpers) ShowCreateIssueDialog() {
 		}
 
 		// Update hint view
+		if len(createdIDs) > 0 {
+			hints = append([]string{fmt.Sprintf("[%s]Created %d:[%s] last %s",
+				formatting.GetEmphasisColor(), len(createdIDs), formatting.GetAccentColor(), createdIDs[len(createdIDs)-1])}, hints...)
+		}
 		if len(hints) > 0 {
 			detectionHintView.SetText(fmt.Sprintf("[%s]%s[-]", formatting.GetMutedColor(), strings.Join(hints, " | ")))
 		} else {
@@ -189,12 +203,14 @@ func (h *DialogHelpers) ShowCreateIssueDialog() {
 	}
 
 	// Add form fields with dynamic width
-	form.AddInputField("Title", "", fieldWidth, nil, func(text string) {
+	form.AddInputField("Title", title, fieldWidth, nil, func(text string) {
 		title = text
+		saveDraft()
 		updateFromText()
 	})
-	form.AddTextArea("Description", "", fieldWidth, 5, 0, func(text string) {
+	form.AddTextArea("Description", description, fieldWidth, 5, 0, func(text string) {
 		description = text
+		saveDraft()
 		updateFromText()
 	})
 	form.AddDropDown("Priority", []string{"P0 (Critical)", "P1 (High)", "P2 (Normal)", "P3 (Low)", "P4 (Lowest)"}, 2, func(option string, index int) {
@@ -205,14 +221,15 @@ func (h *DialogHelpers) ShowCreateIssueDialog() {
 		issueType = option
 		typeExplicitlySet = true
 	})
-	if currentIssueID != "" {
+	if currentIssueID != "" && h.supportsBd(featureChildIssues) {
 		form.AddCheckbox("Add as child of "+currentIssueID, false, nil)
 	}
 
-	// Add buttons
-	form.AddButton("Create (Ctrl-S)", func() {
+	// Define create function to be used by the buttons, Ctrl-S, and Ctrl-N.
+	// keepOpen clears the form for the next issue instead of closing it.
+	createIssue := func(keepOpen bool) {
 		if title == "" {
-			h.StatusBar.SetText(fmt.Sprintf("[%s]Error: Title is required[-]", formatting.GetErrorColor()))
+			h.Notify.Error("Title is required")
 			return
 		}
 
@@ -232,86 +249,64 @@ func (h *DialogHelpers) ShowCreateIssueDialog() {
 		}
 
 		log.Printf("BD COMMAND: Creating issue: bd %s", strings.Join(args, " "))
-		createdIssue, err := execBdJSONIssue(args...)
-		if err != nil {
-			log.Printf("BD COMMAND ERROR: Issue creation failed: %v", err)
-			h.StatusBar.SetText(fmt.Sprintf("[%s]Error creating issue: %v[-]", formatting.GetErrorColor(), err))
-		} else {
+		var createdIssue *parser.Issue
+		h.Runner.Run("Creating issue", func(ctx context.Context) error {
+			var err error
+			createdIssue, err = execBdJSONIssue(ctx, args...)
+			return err
+		}, func(err error) {
+			if err != nil {
+				log.Printf("BD COMMAND ERROR: Issue creation failed: %v", err)
+				h.ShowErrorOverlay("Error creating issue", err)
+				return
+			}
 			log.Printf("BD COMMAND: Issue created successfully: %s", createdIssue.ID)
-			h.StatusBar.SetText(fmt.Sprintf("[%s]✓ Created [%s]%s[-][-]", formatting.GetSuccessColor(), formatting.GetAccentColor(), createdIssue.ID))
+			h.Drafts.Delete(draftID)
+			h.Notify.Success(fmt.Sprintf("Created [%s]%s[-]", formatting.GetAccentColor(), createdIssue.ID))
+
+			if keepOpen {
+				// Clear the text for the next issue; the dropdowns and the
+				// parent checkbox keep their values
+				createdIDs = append(createdIDs, createdIssue.ID)
+				title, description = "", ""
+				if input, ok := form.GetFormItemByLabel("Title").(*tview.InputField); ok {
+					input.SetText("")
+				}
+				if area, ok := form.GetFormItemByLabel("Description").(*tview.TextArea); ok {
+					area.SetText("", false)
+				}
+				updateFromText()
+				form.SetFocus(0)
+				h.App.SetFocus(form)
+				h.ScheduleRefresh("")
+				return
+			}
 
 			// Close dialog
-			h.Pages.RemovePage("create_issue")
-			h.App.SetFocus(h.IssueList)
+			dialog.Close()
 
 			// Refresh issues after a short delay
 			h.ScheduleRefresh("")
-		}
-	})
-	form.AddButton("Cancel", func() {
-		h.Pages.RemovePage("create_issue")
-		h.App.SetFocus(h.IssueList)
-	})
-
-	form.SetBorder(true).SetTitle(" Create New Issue ").SetTitleAlign(tview.AlignCenter)
-	form.SetCancelFunc(func() {
-		h.Pages.RemovePage("create_issue")
-		h.App.SetFocus(h.IssueList)
-	})
+		})
+	}
 
-	// Add Ctrl-S handler to submit form (Ctrl-Enter is reserved by terminal)
-	form.SetInputCapture(func(event *tcell.EventKey) *tcell.EventKey {
-		if event.Key() == tcell.KeyCtrlS {
-			// Ctrl-S pressed - submit form
-			if title == "" {
-				h.StatusBar.SetText(fmt.Sprintf("[%s]Error: Title is required[-]", formatting.GetErrorColor()))
-				return nil
+	if restored {
+		h.addDraftNotice(dialog, draft, func() {
+			if input, ok := form.GetFormItemByLabel("Title").(*tview.InputField); ok {
+				input.SetText("")
 			}
-
-			// Build bd create command arguments
-			args := []string{"create", title, "-p", priority, "-t", issueType}
-			if description != "" {
-				args = append(args, "--description", description)
+			if area, ok := form.GetFormItemByLabel("Description").(*tview.TextArea); ok {
+				area.SetText("", false)
 			}
+			h.Drafts.Delete(draftID)
+		})
+	}
 
-			// Check if we should add parent relationship
-			if currentIssueID != "" {
-				formItem := form.GetFormItemByLabel("Add as child of " + currentIssueID)
-				if checkbox, ok := formItem.(*tview.Checkbox); ok && checkbox.IsChecked() {
-					args = append(args, "--parent", currentIssueID)
-				}
-			}
-
-			log.Printf("BD COMMAND: Creating issue (Ctrl-S): bd %s", strings.Join(args, " "))
-			createdIssue, err := execBdJSONIssue(args...)
-			if err != nil {
-				log.Printf("BD COMMAND ERROR: Issue creation failed: %v", err)
-				h.StatusBar.SetText(fmt.Sprintf("[%s]Error creating issue: %v[-]", formatting.GetErrorColor(), err))
-			} else {
-				log.Printf("BD COMMAND: Issue created successfully: %s", createdIssue.ID)
-				h.StatusBar.SetText(fmt.Sprintf("[%s]✓ Created [%s]%s[-][-]", formatting.GetSuccessColor(), formatting.GetAccentColor(), createdIssue.ID))
-				h.Pages.RemovePage("create_issue")
-				h.App.SetFocus(h.IssueList)
-				h.ScheduleRefresh("")
-			}
-			return nil
-		}
-		return event
-	})
-
-	// Create modal with hint view (centered)
-	formWithHints := tview.NewFlex().SetDirection(tview.FlexRow).
-		AddItem(form, 0, 1, true).
-		AddItem(detectionHintView, 1, 0, false)
-
-	modal := tview.NewFlex().
-		AddItem(nil, 0, 1, false).
-		AddItem(tview.NewFlex().SetDirection(tview.FlexRow).
-			AddItem(nil, 0, 1, false).
-			AddItem(formWithHints, 0, 3, true).
-			AddItem(nil, 0, 1, false), 0, 4, true).
-		AddItem(nil, 0, 1, false)
-
-	h.Pages.AddPage("create_issue", modal, true, true)
-	h.App.SetFocus(form)
+	// Add buttons (Ctrl-S submits; Ctrl-Enter is reserved by terminal)
+	dialog.SetPrimary("Create", func() { createIssue(false) }).
+		SetCancel("Cancel", nil).
+		AddKeyButton("Create + New", tcell.KeyCtrlN, func() { createIssue(true) }).
+		SetFooter(detectionHintView, 1).
+		SetSize(4, 3)
+	dialog.Show()
 }
@@ -0,0 +1,96 @@
+package main
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/andy/beads-tui/internal/formatting"
+	"github.com/andy/beads-tui/internal/state"
+	"github.com/andy/beads-tui/internal/theme"
+	"github.com/andy/beads-tui/internal/ui"
+	"github.com/gdamore/tcell/v2"
+	"github.com/rivo/tview"
+)
+
+// ShowDependencyCycles lists each dependency cycle with one row per link.
+// Enter on a link closes the overlay and calls breakCycle with the ID of the
+// issue that has the dependency, to remove it in the dependency dialog.
+func (h *DialogHelpers) ShowDependencyCycles(cycles []state.Cycle, breakCycle func(issueID string)) {
+	currentTheme := theme.Current()
+	table := tview.NewTable().
+		SetSelectable(true, false).
+		SetSelectedStyle(tcell.StyleDefault.Background(currentTheme.SelectionBg()).Foreground(currentTheme.SelectionFg()))
+	table.SetBorder(true).
+		SetTitle(fmt.Sprintf(" Dependency Cycles (%d) ", len(cycles))).
+		SetTitleAlign(tview.AlignCenter)
+
+	mutedColor := formatting.GetMutedColor()
+	accentColor := formatting.GetAccentColor()
+	if len(cycles) == 0 {
+		table.SetCell(0, 0, tview.NewTableCell(fmt.Sprintf("[%s]No dependency cycles[-]", mutedColor)).SetSelectable(false))
+	}
+
+	// Row -> link, for the selectable rows
+	links := make(map[int]state.CycleLink)
+	row := 0
+	for i, cycle := range cycles {
+		ids := cycle.IssueIDs()
+		chain := strings.Join(append(ids, ids[0]), " → ")
+		if i > 0 {
+			table.SetCell(row, 0, tview.NewTableCell("").SetSelectable(false))
+			row++
+		}
+		table.SetCell(row, 0, tview.NewTableCell(fmt.Sprintf("[%s::b]⟲ %s[-::-]", formatting.GetErrorColor(), chain)).SetSelectable(false))
+		row++
+
+		for _, link := range cycle {
+			title := ""
+			if issue := h.AppState.GetIssueByID(link.IssueID); issue != nil {
+				title = tview.Escape(issue.Title)
+			}
+			table.SetCell(row, 0, tview.NewTableCell(fmt.Sprintf("  [%s]%s[-] [%s]%s[-] [%s]%s[-] [%s]%s[-]",
+				accentColor, link.IssueID,
+				formatting.GetDependencyColor(link.Type), depTypeToPhrase(link.Type),
+				accentColor, link.DependsOnID,
+				mutedColor, title)).SetExpansion(1))
+			links[row] = link
+			row++
+		}
+	}
+	if len(cycles) > 0 {
+		table.Select(1, 0)
+	}
+
+	footer := tview.NewTextView().
+		SetDynamicColors(true).
+		SetText(fmt.Sprintf("[%s]Enter remove a link in the dependency dialog · Esc close[-]", mutedColor))
+
+	content := tview.NewFlex().SetDirection(tview.FlexRow).
+		AddItem(table, 0, 1, true).
+		AddItem(footer, 1, 0, false)
+
+	closeCycles := func() {
+		h.Pages.RemovePage("dependency_cycles")
+		h.App.SetFocus(h.IssueList)
+	}
+	table.SetSelectedFunc(func(row, column int) {
+		link, ok := links[row]
+		if !ok {
+			return
+		}
+		closeCycles()
+		breakCycle(link.IssueID)
+	})
+
+	modal := ui.CenterModal(content, 2, 2)
+	modal.SetInputCapture(func(event *tcell.EventKey) *tcell.EventKey {
+		if event.Key() == tcell.KeyEscape || (event.Key() == tcell.KeyRune && event.Rune() == 'q') {
+			closeCycles()
+			return nil
+		}
+		return event
+	})
+
+	h.Pages.AddPage("dependency_cycles", modal, true, true)
+	h.App.SetFocus(table)
+}
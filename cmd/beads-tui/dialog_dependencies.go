@@ -1,11 +1,15 @@
 package main
 
 import (
+	"context"
 	"fmt"
 	"log"
+	"slices"
+	"strings"
 
 	"github.com/andy/beads-tui/internal/formatting"
 	"github.com/andy/beads-tui/internal/parser"
+	"github.com/gdamore/tcell/v2"
 	"github.com/rivo/tview"
 )
 
@@ -32,11 +36,12 @@ func (h *DialogHelpers) ShowDependencyDialog() {
 	currentIndex := h.IssueList.GetCurrentItem()
 	issue, ok := (*h.IndexToIssue)[currentIndex]
 	if !ok {
-		h.StatusBar.SetText(fmt.Sprintf("[%s]No issue selected[-]", formatting.GetErrorColor()))
+		h.Notify.Error("No issue selected")
 		return
 	}
 
-	form := tview.NewForm()
+	dialog := h.newDialog("dependency_dialog", "Manage Dependencies")
+	form := dialog.Form
 	form.AddTextView("Managing dependencies for", issue.ID+" - "+issue.Title, 0, 2, false, false)
 
 	// Show current dependencies with human-readable phrases
@@ -51,12 +56,87 @@ func (h *DialogHelpers) ShowDependencyDialog() {
 		form.AddTextView("", "No dependencies", 0, 1, false, false)
 	}
 
-	// Add new dependency fields with descriptive labels
-	// The dropdown shows what relationship this issue will have TO the target
-	var targetID, depType string
-	form.AddInputField("Target Issue ID", "", 20, nil, func(text string) {
-		targetID = text
+	// Show the issues that depend on this one, so the downstream impact is
+	// visible before changing it
+	if dependents := h.AppState.Dependents(issue.ID); len(dependents) > 0 {
+		dependentText := "Depended On By:\n"
+		for _, dependent := range dependents {
+			dependentText += fmt.Sprintf("  %s %s (%s)\n",
+				formatting.FormatDependentPhrase(dependent.Type), dependent.Issue.ID, dependent.Issue.Status)
+		}
+		form.AddTextView("", dependentText, 0, len(dependents)+1, false, false)
+	}
+
+	// Pick the issues to depend on: the field fuzzy matches IDs and titles,
+	// Tab adds the highlighted match to the picked list (Backspace in the
+	// empty field takes the last one off), and Enter adds the dependencies
+	// on the picked issues plus the highlighted match. Typed IDs work too.
+	var depType string
+	var picked []string
+	var suggestions []string // Shown under the field, for the text typed
+	highlighted := 0         // Index into suggestions
+	excluded := func(issueID string) bool {
+		if issueID == issue.ID || slices.Contains(picked, issueID) {
+			return true
+		}
+		return slices.ContainsFunc(issue.Dependencies, func(dep *parser.Dependency) bool {
+			return dep.DependsOnID == issueID
+		})
+	}
+
+	form.AddInputField("Find Issue", "", 0, nil, nil)
+	findField := form.GetFormItemByLabel("Find Issue").(*tview.InputField)
+	findField.SetPlaceholder("ID or words from the title")
+	form.AddTextView("Picked", "", 0, 2, true, false)
+	pickedView := form.GetFormItemByLabel("Picked").(*tview.TextView)
+	pickedView.SetDynamicColors(true)
+	showPicked := func() {
+		if len(picked) == 0 {
+			pickedView.SetText(fmt.Sprintf("[%s]Tab adds the highlighted issue, Enter adds the dependencies[-]", formatting.GetMutedColor()))
+			return
+		}
+		pickedView.SetText(fmt.Sprintf("[%s]%s[-]", formatting.GetAccentColor(), strings.Join(picked, ", ")))
+	}
+	showPicked()
+
+	findField.SetAutocompleteFunc(func(currentText string) []string {
+		suggestions, highlighted = nil, 0
+		if strings.TrimSpace(currentText) == "" {
+			return nil
+		}
+		matches := h.AppState.FuzzyFind(currentText, issuePickerLimit+len(picked)+len(issue.Dependencies)+1)
+		suggestions = issuePickerSuggestions(matches, excluded, issuePickerLimit)
+		entries := make([]string, len(suggestions))
+		for i, suggestion := range suggestions {
+			entries[i] = tview.Escape(suggestion)
+		}
+		return entries
+	})
+	pick := func(index int) {
+		if index < len(suggestions) {
+			picked = append(picked, issuePickerID(suggestions[index]))
+			showPicked()
+		}
+		suggestions, highlighted = nil, 0
+		findField.SetText("")
+	}
+	findField.SetAutocompletedFunc(func(text string, index, source int) bool {
+		if source == tview.AutocompletedNavigate {
+			highlighted = index
+			return false
+		}
+		pick(index)
+		return true
+	})
+	findField.SetInputCapture(func(event *tcell.EventKey) *tcell.EventKey {
+		if (event.Key() == tcell.KeyBackspace || event.Key() == tcell.KeyBackspace2) && findField.GetText() == "" && len(picked) > 0 {
+			picked = picked[:len(picked)-1]
+			showPicked()
+			return nil
+		}
+		return event
 	})
+
 	// Use descriptive labels that explain the relationship from this issue's perspective
 	depOptions := []string{
 		"blocked by (this issue waits for target)",
@@ -66,38 +146,95 @@ func (h *DialogHelpers) ShowDependencyDialog() {
 	}
 	// Map display options back to bd command values
 	depTypeValues := []string{"blocks", "parent-child", "related", "discovered-from"}
+	var reasonField *tview.InputField // Only for "blocked by"
 	form.AddDropDown("Relationship", depOptions, 0, func(option string, index int) {
 		depType = depTypeValues[index]
+		if reasonField != nil {
+			reasonField.SetDisabled(depType != string(parser.DepBlocks))
+		}
 	})
+	form.AddInputField("Blocked Reason", "", 0, nil, nil)
+	reasonField = form.GetFormItemByLabel("Blocked Reason").(*tview.InputField)
+	reasonField.SetPlaceholder("optional, e.g. waiting on vendor")
 
 	// Add button
-	form.AddButton("Add Dependency", func() {
-		if targetID == "" {
-			h.StatusBar.SetText(fmt.Sprintf("[%s]Error: Issue ID required[-]", formatting.GetErrorColor()))
-			return
+	dialog.SetPrimary("Add Dependencies", func() {
+		targets := slices.Clone(picked)
+		if text := findField.GetText(); strings.TrimSpace(text) != "" {
+			if ids, ok := splitIssueIDs(text, func(issueID string) bool { return h.AppState.GetIssueByID(issueID) != nil }); ok {
+				targets = append(targets, ids...)
+			} else if highlighted < len(suggestions) {
+				targets = append(targets, issuePickerID(suggestions[highlighted]))
+			} else {
+				h.Notify.Error(fmt.Sprintf("No issue matches %q", text))
+				return
+			}
 		}
-
-		// Validate target issue exists
-		if h.AppState.GetIssueByID(targetID) == nil {
-			h.StatusBar.SetText(fmt.Sprintf("[%s]Error: Issue %s not found[-]", formatting.GetErrorColor(), targetID))
+		if len(targets) == 0 {
+			h.Notify.Error("Pick an issue first")
 			return
 		}
 
 		issueID := issue.ID // Capture before potential refresh
-		log.Printf("BD COMMAND: Adding dependency: bd dep add %s %s --type %s", issueID, targetID, depType)
-		updatedIssue, err := execBdJSONIssue("dep", "add", issueID, targetID, "--type", depType)
-		if err != nil {
-			log.Printf("BD COMMAND ERROR: Dependency add failed: %v", err)
-			h.StatusBar.SetText(fmt.Sprintf("[%s]Error adding dependency: %v[-]", formatting.GetErrorColor(), err))
-		} else {
+		relationship := parser.DependencyType(depType)
+		reason := ""
+		if relationship == parser.DepBlocks {
+			reason = strings.TrimSpace(reasonField.GetText())
+		}
+		completed := 0
+		progress := "Adding dependency to " + issueID
+		if len(targets) > 1 {
+			progress = fmt.Sprintf("Adding %d dependencies to %s", len(targets), issueID)
+		}
+		h.Runner.Run(progress, func(ctx context.Context) error {
+			for _, targetID := range targets {
+				log.Printf("BD COMMAND: Adding dependency: bd dep add %s %s --type %s", issueID, targetID, depType)
+				if _, err := execBdJSONIssue(ctx, "dep", "add", issueID, targetID, "--type", depType); err != nil {
+					return err
+				}
+				completed++
+			}
+			if reason != "" {
+				if _, err := execBdJSONComment(ctx, "comment", issueID, blockedReasonComment(reason)); err != nil {
+					return err
+				}
+			}
+			return nil
+		}, func(err error) {
+			// Whatever was added can be undone as one step
+			if completed > 0 {
+				undo := undoDependency(issueID, targets[0], relationship, true)
+				for _, targetID := range targets[1:completed] {
+					undo.Steps = append(undo.Steps, undoDependency(issueID, targetID, relationship, true).Steps...)
+				}
+				if completed > 1 {
+					undo.Description = fmt.Sprintf("add %d dependencies to %s", completed, issueID)
+				}
+				h.Undo.Push(undo)
+			}
+
+			if err != nil {
+				log.Printf("BD COMMAND ERROR: Dependency add failed: %v", err)
+				if completed == len(targets) {
+					h.ShowErrorOverlay("Added the dependencies, but couldn't add the blocked reason", err)
+				} else {
+					h.ShowErrorOverlay(fmt.Sprintf("Error adding dependency on %s", targets[completed]), err)
+				}
+				if completed > 0 {
+					h.ScheduleRefresh(issueID)
+				}
+				return
+			}
 			// Show human-readable phrase in success message
-			phrase := depTypeToPhrase(parser.DependencyType(depType))
-			log.Printf("BD COMMAND: Dependency added successfully to %s", updatedIssue.ID)
-			h.StatusBar.SetText(fmt.Sprintf("[%s]✓ Now [%s]%s[-] [%s]%s[-][-]", formatting.GetSuccessColor(), formatting.GetEmphasisColor(), phrase, formatting.GetAccentColor(), targetID))
-			h.Pages.RemovePage("dependency_dialog")
-			h.App.SetFocus(h.IssueList)
+			phrase := depTypeToPhrase(relationship)
+			log.Printf("BD COMMAND: Added %d dependencies to %s", completed, issueID)
+			if reason != "" && h.Comments != nil {
+				h.Comments.Forget(issueID)
+			}
+			h.Notify.Success(fmt.Sprintf("Now [%s]%s[-] [%s]%s[-]", formatting.GetEmphasisColor(), phrase, formatting.GetAccentColor(), strings.Join(targets, ", ")))
+			dialog.Close()
 			h.ScheduleRefresh(issueID)
-		}
+		})
 	})
 
 	// Remove dependency buttons
@@ -108,46 +245,43 @@ func (h *DialogHelpers) ShowDependencyDialog() {
 			depToRemove := dep
 			phrase := depTypeToPhrase(depToRemove.Type)
 			buttonLabel := fmt.Sprintf("Remove: %s %s", phrase, depToRemove.DependsOnID)
-			form.AddButton(buttonLabel, func() {
+			dialog.AddButton(buttonLabel, func() {
 				issueID := issue.ID
 				log.Printf("BD COMMAND: Removing dependency: bd dep remove %s %s --type %s", issueID, depToRemove.DependsOnID, depToRemove.Type)
-				updatedIssue, err := execBdJSONIssue("dep", "remove", issueID, depToRemove.DependsOnID, "--type", string(depToRemove.Type))
-				if err != nil {
-					log.Printf("BD COMMAND ERROR: Dependency remove failed: %v", err)
-					h.StatusBar.SetText(fmt.Sprintf("[%s]Error removing dependency: %v[-]", formatting.GetErrorColor(), err))
-				} else {
+				var updatedIssue *parser.Issue
+				h.Runner.Run("Removing dependency from "+issueID, func(ctx context.Context) error {
+					var err error
+					updatedIssue, err = execBdJSONIssue(ctx, "dep", "remove", issueID, depToRemove.DependsOnID, "--type", string(depToRemove.Type))
+					return err
+				}, func(err error) {
+					if err != nil {
+						log.Printf("BD COMMAND ERROR: Dependency remove failed: %v", err)
+						h.ShowErrorOverlay("Error removing dependency", err)
+						return
+					}
 					removePhrase := depTypeToPhrase(depToRemove.Type)
 					log.Printf("BD COMMAND: Dependency removed successfully from %s", updatedIssue.ID)
-					h.StatusBar.SetText(fmt.Sprintf("[%s]✓ No longer [%s]%s[-] [%s]%s[-][-]", formatting.GetSuccessColor(), formatting.GetEmphasisColor(), removePhrase, formatting.GetAccentColor(), depToRemove.DependsOnID))
-					h.Pages.RemovePage("dependency_dialog")
-					h.App.SetFocus(h.IssueList)
+					h.Undo.Push(undoDependency(issueID, depToRemove.DependsOnID, depToRemove.Type, false))
+					h.Notify.Success(fmt.Sprintf("No longer [%s]%s[-] [%s]%s[-]", formatting.GetEmphasisColor(), removePhrase, formatting.GetAccentColor(), depToRemove.DependsOnID))
+					dialog.Close()
 					h.ScheduleRefresh(issueID)
-				}
+				})
 			})
 		}
 	}
 
-	// Close button
-	form.AddButton("Close", func() {
-		h.Pages.RemovePage("dependency_dialog")
-		h.App.SetFocus(h.IssueList)
-	})
-
-	form.SetBorder(true).SetTitle(" Manage Dependencies ").SetTitleAlign(tview.AlignCenter)
-	form.SetCancelFunc(func() {
-		h.Pages.RemovePage("dependency_dialog")
-		h.App.SetFocus(h.IssueList)
-	})
+	// Offer to link relationships the description declares but bd doesn't have
+	if declared := parseTextDependencies(issue, h.AppState.GetIssueByID); len(declared) > 0 {
+		form.AddTextView("", fmt.Sprintf("\nDescription declares %d unlinked dependencies", len(declared)), 0, 2, false, false)
+		dialog.AddButton(fmt.Sprintf("Import %d from description", len(declared)), func() {
+			dialog.Close()
+			h.ShowTextDependenciesDialog(issue)
+		})
+	}
 
-	// Create modal (centered)
-	modal := tview.NewFlex().
-		AddItem(nil, 0, 1, false).
-		AddItem(tview.NewFlex().SetDirection(tview.FlexRow).
-			AddItem(nil, 0, 1, false).
-			AddItem(form, 0, 3, true).
-			AddItem(nil, 0, 1, false), 0, 2, true).
-		AddItem(nil, 0, 1, false)
-
-	h.Pages.AddPage("dependency_dialog", modal, true, true)
-	h.App.SetFocus(form)
+	// Close button (secondary remove buttons follow it in focus order)
+	dialog.SetCancel("Close", nil).
+		SetSubmitOnEnter(true).
+		SetSize(2, 3)
+	dialog.Show()
 }
@@ -0,0 +1,72 @@
+package main
+
+import (
+	"context"
+	"fmt"
+	"log"
+	"strings"
+
+	"github.com/andy/beads-tui/internal/formatting"
+	"github.com/andy/beads-tui/internal/parser"
+	"github.com/rivo/tview"
+)
+
+// ShowDiscardDialog deletes the selected issue with bd delete after the user
+// types its ID to confirm, warning what happens to the issues depending on
+// it (see discardWarnings). Deletion can't be undone, so nothing is pushed on
+// the undo stack; onDiscarded is called with the issue so it can be recorded.
+func (h *DialogHelpers) ShowDiscardDialog(onDiscarded func(issue *parser.Issue)) {
+	currentIndex := h.IssueList.GetCurrentItem()
+	issue, ok := (*h.IndexToIssue)[currentIndex]
+	if !ok {
+		h.Notify.Error("No issue selected")
+		return
+	}
+	if !h.requireBd(featureDeleteIssue) {
+		return
+	}
+
+	dialog := h.newDialog("discard_dialog", "Discard Issue")
+	form := dialog.Form
+	issueID := issue.ID // Capture before potential refresh
+	var typedID string
+
+	warning := fmt.Sprintf("[%s::b]Permanently delete %s?[-::-]\n%s\n\nbd removes the issue and its dependencies, labels, and comments. This can't be undone with u.",
+		formatting.GetErrorColor(), issueID, tview.Escape(issue.Title))
+	warnings := discardWarnings(h.AppState.Dependents(issueID))
+	for _, line := range warnings {
+		warning += fmt.Sprintf("\n[%s]• %s[-]", formatting.GetWarningColor(), line)
+	}
+	form.AddTextView("", warning, 0, 6+2*len(warnings), true, false) // A warning can wrap
+	form.AddInputField("Type the ID", "", 20, nil, func(text string) {
+		typedID = text
+	})
+
+	discardIssue := func() {
+		if strings.TrimSpace(typedID) != issueID {
+			h.Notify.Error(fmt.Sprintf("Type %s exactly to confirm", issueID))
+			return
+		}
+
+		log.Printf("BD COMMAND: Discarding issue: bd delete %s --force", issueID)
+		h.Runner.Run(fmt.Sprintf("Discarding %s", issueID), func(ctx context.Context) error {
+			return execBd(ctx, "delete", issueID, "--force")
+		}, func(err error) {
+			if err != nil {
+				log.Printf("BD COMMAND ERROR: Discard failed: %v", err)
+				h.ShowErrorOverlay("Error discarding issue", err)
+				return
+			}
+			onDiscarded(issue)
+			h.Notify.Success(fmt.Sprintf("Discarded [%s]%s[-]", formatting.GetAccentColor(), issueID))
+			dialog.Close()
+			h.ScheduleRefresh("")
+		})
+	}
+
+	dialog.SetPrimary("Discard", discardIssue).
+		SetCancel("Cancel", nil).
+		SetFixedSize(70, 14+2*len(warnings))
+	dialog.Show()
+	form.SetFocus(1)
+}
@@ -0,0 +1,201 @@
+package main
+
+import (
+	"context"
+	"fmt"
+	"log"
+	"strings"
+	"time"
+
+	"github.com/andy/beads-tui/internal/formatting"
+	"github.com/andy/beads-tui/internal/parser"
+	"github.com/andy/beads-tui/internal/theme"
+	"github.com/andy/beads-tui/internal/ui"
+	"github.com/atotto/clipboard"
+	"github.com/gdamore/tcell/v2"
+	"github.com/rivo/tview"
+)
+
+// ToggleDiscussion adds the selected issue to the discussion queue, or
+// removes it if it is already queued
+func (h *DialogHelpers) ToggleDiscussion() {
+	currentIndex := h.IssueList.GetCurrentItem()
+	issue, ok := (*h.IndexToIssue)[currentIndex]
+	if !ok {
+		h.Notify.Error("No issue selected")
+		return
+	}
+
+	issueID := issue.ID // Capture before potential refresh
+	action, label := "add", discussLabel
+	if isQueuedForDiscussion(issue) {
+		action = "remove"
+		for _, existing := range issue.Labels {
+			if strings.EqualFold(existing, discussLabel) {
+				label = existing // Remove it as spelled on the issue
+			}
+		}
+	}
+
+	log.Printf("BD COMMAND: Toggling discussion flag: bd label %s %s %q", action, issueID, label)
+	h.Runner.Run("Updating discussion queue", func(ctx context.Context) error {
+		_, err := execBdJSONIssue(ctx, "label", action, issueID, label)
+		return err
+	}, func(err error) {
+		if err != nil {
+			log.Printf("BD COMMAND ERROR: Discussion flag failed: %v", err)
+			h.ShowErrorOverlay("Error updating discussion queue", err)
+			return
+		}
+		h.Undo.Push(undoLabel(issueID, label, action == "add"))
+		if action == "add" {
+			h.Notify.Success(fmt.Sprintf("Queued [%s]%s[-] for discussion (gd to review)", formatting.GetAccentColor(), issueID))
+		} else {
+			h.Notify.Success(fmt.Sprintf("Removed [%s]%s[-] from the discussion queue", formatting.GetAccentColor(), issueID))
+		}
+		h.ScheduleRefresh(issueID)
+	})
+}
+
+// ShowDiscussionQueue displays the issues queued for discussion. Enter closes
+// the overlay and calls jump with the selected issue's ID; y copies the queue
+// as a Markdown agenda; C clears the queue after the meeting.
+func (h *DialogHelpers) ShowDiscussionQueue(jump func(issueID string)) {
+	queue := discussionQueue(h.AppState.GetAllIssues())
+
+	currentTheme := theme.Current()
+	table := tview.NewTable().
+		SetSelectable(true, false).
+		SetSelectedStyle(tcell.StyleDefault.Background(currentTheme.SelectionBg()).Foreground(currentTheme.SelectionFg()))
+	table.SetBorder(true).
+		SetTitle(fmt.Sprintf(" Discussion Queue (%d) ", len(queue))).
+		SetTitleAlign(tview.AlignCenter)
+
+	mutedColor := formatting.GetMutedColor()
+	if len(queue) == 0 {
+		table.SetCell(0, 0, tview.NewTableCell(fmt.Sprintf("[%s]Nothing queued. Press F on an issue to add it.[-]", mutedColor)).SetSelectable(false))
+	}
+	for row, issue := range queue {
+		assignee := ""
+		if issue.Assignee != "" {
+			assignee = fmt.Sprintf(" [%s]@%s[-]", mutedColor, tview.Escape(issue.Assignee))
+		}
+		table.SetCell(row, 0, tview.NewTableCell(fmt.Sprintf("[%s]%s[-] [%s]%s[-] [%s][P%d][-] %s%s",
+			formatting.GetStatusColor(issue.Status), formatting.Icons().Bullet,
+			formatting.GetAccentColor(), issue.ID,
+			formatting.GetPriorityColor(issue.Priority), issue.Priority,
+			tview.Escape(issue.Title), assignee)).SetExpansion(1))
+	}
+
+	footer := tview.NewTextView().
+		SetDynamicColors(true).
+		SetText(fmt.Sprintf("[%s]Enter jump · y copy agenda (Markdown) · C clear queue · Esc close[-]", mutedColor))
+
+	content := tview.NewFlex().SetDirection(tview.FlexRow).
+		AddItem(table, 0, 1, true).
+		AddItem(footer, 1, 0, false)
+
+	closeQueue := func() {
+		h.Pages.RemovePage("discussion")
+		h.App.SetFocus(h.IssueList)
+	}
+	table.SetSelectedFunc(func(row, column int) {
+		if row < len(queue) {
+			closeQueue()
+			jump(queue[row].ID)
+		}
+	})
+
+	modal := ui.CenterModal(content, 2, 2)
+	modal.SetInputCapture(func(event *tcell.EventKey) *tcell.EventKey {
+		if event.Key() == tcell.KeyEscape {
+			closeQueue()
+			return nil
+		}
+		if event.Key() != tcell.KeyRune {
+			return event
+		}
+		switch event.Rune() {
+		case 'q':
+			closeQueue()
+			return nil
+		case 'y':
+			if err := clipboard.WriteAll(discussionAgenda(queue, time.Now())); err != nil {
+				log.Printf("CLIPBOARD ERROR: Failed to copy agenda: %v", err)
+				footer.SetText(fmt.Sprintf("[%s]Failed to copy: %v[-]", formatting.GetErrorColor(), err))
+			} else {
+				footer.SetText(fmt.Sprintf("[%s]✓ Copied agenda for %d issues to clipboard[-]", formatting.GetSuccessColor(), len(queue)))
+			}
+			return nil
+		case 'C':
+			if len(queue) > 0 {
+				h.Pages.RemovePage("discussion")
+				h.confirmClearDiscussion(queue)
+			}
+			return nil
+		}
+		return event
+	})
+
+	h.Pages.AddPage("discussion", modal, true, true)
+	h.App.SetFocus(table)
+}
+
+// confirmClearDiscussion asks before removing the discussion label from
+// every queued issue
+func (h *DialogHelpers) confirmClearDiscussion(queue []*parser.Issue) {
+	dialog := h.newDialog("clear_discussion_dialog", "Clear Discussion Queue")
+	dialog.Form.AddTextView("", fmt.Sprintf("Remove the %q label from %d issues?", discussLabel, len(queue)), 0, 2, false, false)
+
+	clearQueue := func() {
+		var steps []bdStep
+		for _, issue := range queue {
+			for _, label := range issue.Labels {
+				if strings.EqualFold(label, discussLabel) {
+					steps = append(steps, bdStep{
+						Description: "unqueue " + issue.ID,
+						Args:        []string{"label", "remove", issue.ID, label},
+					})
+				}
+			}
+		}
+
+		completed := 0
+		h.Runner.Run(fmt.Sprintf("Clearing %d queued issues", len(queue)), func(ctx context.Context) error {
+			for i, step := range steps {
+				log.Printf("BD COMMAND: Clear discussion step %d/%d: bd %s", i+1, len(steps), strings.Join(step.Args, " "))
+				if _, err := execBdJSON(ctx, step.Args...); err != nil {
+					return err
+				}
+				completed++
+			}
+			return nil
+		}, func(err error) {
+			// Re-adding the labels undoes whatever was cleared
+			undo := undoEntry{Description: fmt.Sprintf("clear %d issues from the discussion queue", completed)}
+			for _, step := range steps[:completed] {
+				issueID, label := step.Args[2], step.Args[3]
+				undo.IssueID = issueID
+				undo.Steps = append(undo.Steps, undoLabel(issueID, label, false).Steps...)
+			}
+			h.Undo.Push(undo)
+
+			if err != nil {
+				log.Printf("BD COMMAND ERROR: Clearing discussion queue failed: %v", err)
+				h.ShowErrorOverlay(fmt.Sprintf("Clearing stopped at %d/%d (%s)", completed+1, len(steps), steps[completed].Description), err)
+				if completed > 0 {
+					h.ScheduleRefresh("")
+				}
+				return
+			}
+			h.Notify.Success(fmt.Sprintf("Cleared %d issues from the discussion queue", completed))
+			dialog.Close()
+			h.ScheduleRefresh("")
+		})
+	}
+
+	dialog.SetPrimary(fmt.Sprintf("Clear %d", len(queue)), clearQueue).
+		SetCancel("Cancel", nil).
+		SetFixedSize(60, 9)
+	dialog.Show()
+}
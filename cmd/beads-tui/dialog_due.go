@@ -0,0 +1,79 @@
+package main
+
+import (
+	"context"
+	"fmt"
+	"log"
+	"time"
+
+	"github.com/andy/beads-tui/internal/formatting"
+	"github.com/andy/beads-tui/internal/parser"
+	"github.com/rivo/tview"
+)
+
+// ShowDueDateDialog sets or clears the due date of the selected issue
+func (h *DialogHelpers) ShowDueDateDialog() {
+	currentIndex := h.IssueList.GetCurrentItem()
+	issue, ok := (*h.IndexToIssue)[currentIndex]
+	if !ok {
+		h.Notify.Error("No issue selected")
+		return
+	}
+	if !h.requireBd(featureDueDate) {
+		return
+	}
+
+	dialog := h.newDialog("due_dialog", "Due Date (Enter to submit)")
+	form := dialog.Form
+	issueID := issue.ID // Capture before potential refresh
+	current := formatDueInput(issue.DueDate)
+	dueText := current
+
+	form.AddTextView("Issue", issueID+" - "+tview.Escape(issue.Title), 0, 2, false, false)
+	form.AddInputField("Due", current, 14, nil, func(text string) {
+		dueText = text
+	})
+	form.AddTextView("", fmt.Sprintf("[%s]YYYY-MM-DD, today, tomorrow, +3d, or +2w; leave empty to clear[-]", formatting.GetMutedColor()), 0, 1, false, false)
+
+	setDue := func() {
+		due, err := parseDueInput(dueText, time.Now())
+		if err != nil {
+			h.Notify.Error(err.Error())
+			return
+		}
+		if due == current {
+			dialog.Close()
+			return
+		}
+
+		undo := undoFields("due date "+issueID, issue, []string{"--due", due})
+		log.Printf("BD COMMAND: Setting due date: bd update %s --due %q", issueID, due)
+		var updatedIssue *parser.Issue
+		h.Runner.Run("Setting due date on "+issueID, func(ctx context.Context) error {
+			var err error
+			updatedIssue, err = execBdJSONIssue(ctx, "update", issueID, "--due", due)
+			return err
+		}, func(err error) {
+			if err != nil {
+				log.Printf("BD COMMAND ERROR: Due date update failed: %v", err)
+				h.ShowErrorOverlay("Error setting due date", err)
+				return
+			}
+			log.Printf("BD COMMAND: Due date updated: %s -> %q", updatedIssue.ID, due)
+			h.Undo.Push(undo)
+			if due == "" {
+				h.Notify.Success(fmt.Sprintf("Cleared the due date of [%s]%s[-]", formatting.GetAccentColor(), updatedIssue.ID))
+			} else {
+				h.Notify.Success(fmt.Sprintf("[%s]%s[-] is due %s", formatting.GetAccentColor(), updatedIssue.ID, due))
+			}
+			dialog.Close()
+			h.ScheduleRefresh(issueID)
+		})
+	}
+
+	dialog.SetPrimary("Set", setDue).
+		SetCancel("Cancel", nil).
+		SetSubmitOnEnter(true).
+		SetFixedSize(72, 12)
+	dialog.Show()
+}
@@ -0,0 +1,129 @@
+package main
+
+import (
+	"context"
+	"fmt"
+	"log"
+	"strings"
+
+	"github.com/andy/beads-tui/internal/formatting"
+	"github.com/andy/beads-tui/internal/parser"
+)
+
+// ShowDuplicateDialog displays a dialog for splitting off a copy of the
+// current issue, for when one ticket turns out to be two. The copy keeps the
+// type and, optionally, the priority, text sections, and labels, and can be
+// linked back to the original.
+func (h *DialogHelpers) ShowDuplicateDialog() {
+	// Get current issue
+	currentIndex := h.IssueList.GetCurrentItem()
+	issue, ok := (*h.IndexToIssue)[currentIndex]
+	if !ok {
+		h.Notify.Error("No issue selected")
+		return
+	}
+
+	dialog := h.newDialog("duplicate_dialog", "Split Off a Copy")
+	form := dialog.Form
+	title := duplicateTitle(issue.Title)
+	opts := duplicateOptions{Priority: true, Description: true, Labels: true}
+
+	form.AddTextView("Copying", issue.ID+" - "+issue.Title, 0, 2, false, false)
+	form.AddInputField("Title", title, 60, nil, func(text string) {
+		title = text
+	})
+	form.AddCheckbox(fmt.Sprintf("Copy priority (P%d)", issue.Priority), opts.Priority, func(checked bool) {
+		opts.Priority = checked
+	})
+	form.AddCheckbox("Copy description, design, acceptance, notes", opts.Description, func(checked bool) {
+		opts.Description = checked
+	})
+	if len(issue.Labels) > 0 {
+		form.AddCheckbox("Copy labels ("+strings.Join(issue.Labels, ", ")+")", opts.Labels, func(checked bool) {
+			opts.Labels = checked
+		})
+	}
+	// The copy is the issue that HAS the dependency, pointing at the original
+	linkOptions := []string{
+		"related to (informational link)",
+		"discovered from (provenance)",
+		"none",
+	}
+	linkTypes := []parser.DependencyType{parser.DepRelated, parser.DepDiscoveredFrom, ""}
+	linkType := linkTypes[0]
+	form.AddDropDown("Link to original", linkOptions, 0, func(option string, index int) {
+		linkType = linkTypes[index]
+	})
+
+	// Define duplicate function to be used by both button and Ctrl-S
+	duplicateIssue := func() {
+		title = strings.TrimSpace(title)
+		if title == "" {
+			h.Notify.Error("Title is required")
+			return
+		}
+
+		issueID := issue.ID // Capture before potential refresh
+		var created *parser.Issue
+		var failedStep string
+		h.Runner.Run("Copying "+issueID, func(ctx context.Context) error {
+			args := duplicateCreateArgs(issue, title, opts)
+			log.Printf("BD COMMAND: Copying issue: bd %s", strings.Join(args, " "))
+			var err error
+			if created, err = execBdJSONIssue(ctx, args...); err != nil {
+				failedStep = "creating the copy"
+				return err
+			}
+
+			// The rest is best-effort on top of the created copy
+			if args := duplicateUpdateArgs(issue, created.ID, opts); args != nil {
+				log.Printf("BD COMMAND: Copying text sections: bd update %s ...", created.ID)
+				if _, err := execBdJSONIssue(ctx, args...); err != nil {
+					failedStep = "copying the design, acceptance, and notes"
+					return err
+				}
+			}
+			if opts.Labels {
+				for _, label := range issue.Labels {
+					log.Printf("BD COMMAND: Copying label: bd label add %s %s", created.ID, label)
+					if _, err := execBdJSONIssue(ctx, "label", "add", created.ID, label); err != nil {
+						failedStep = fmt.Sprintf("adding label %q", label)
+						return err
+					}
+				}
+			}
+			if linkType != "" {
+				log.Printf("BD COMMAND: Linking copy: bd dep add %s %s --type %s", created.ID, issueID, linkType)
+				if _, err := execBdJSONIssue(ctx, "dep", "add", created.ID, issueID, "--type", string(linkType)); err != nil {
+					failedStep = "linking it to " + issueID
+					return err
+				}
+			}
+			return nil
+		}, func(err error) {
+			if err != nil {
+				log.Printf("BD COMMAND ERROR: Copy failed while %s: %v", failedStep, err)
+				if created == nil {
+					h.ShowErrorOverlay("Error copying "+issueID, err)
+					return
+				}
+				dialog.Close()
+				h.ScheduleRefresh(created.ID)
+				h.ShowErrorOverlay(fmt.Sprintf("Created %s but failed %s", created.ID, failedStep), err)
+				return
+			}
+
+			log.Printf("BD COMMAND: Copied %s to %s", issueID, created.ID)
+			dialog.Close()
+			h.Notify.Success(fmt.Sprintf("Copied [%s]%s[-] to [%s]%s[-]",
+				formatting.GetAccentColor(), issueID, formatting.GetAccentColor(), created.ID))
+			h.ScheduleRefresh(created.ID)
+		})
+	}
+
+	dialog.SetPrimary("Copy", duplicateIssue).
+		SetCancel("Cancel", nil).
+		SetSubmitOnEnter(true).
+		SetFixedSize(80, 19)
+	dialog.Show()
+}
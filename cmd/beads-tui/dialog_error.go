@@ -0,0 +1,112 @@
+package main
+
+import (
+	"fmt"
+	"log"
+	"strings"
+
+	"github.com/andy/beads-tui/internal/formatting"
+	"github.com/andy/beads-tui/internal/ui"
+	"github.com/atotto/clipboard"
+	"github.com/gdamore/tcell/v2"
+	"github.com/rivo/tview"
+)
+
+// ShowErrorOverlay reports a failed bd or SQLite operation: a one-line summary
+// in the status bar plus a dismissible overlay with the full command, exit code,
+// captured output, and suggested remedies. Must be called on the UI goroutine.
+func (h *DialogHelpers) ShowErrorOverlay(summary string, err error) {
+	log.Printf("ERROR OVERLAY: %s: %v", summary, err)
+	firstLine := strings.SplitN(err.Error(), "\n", 2)[0]
+	h.Notify.Error(fmt.Sprintf("%s: %s", summary, tview.Escape(firstLine)))
+
+	report := formatErrorReport(summary, err)
+
+	// Return focus to whatever was active (often the dialog that triggered the error)
+	previousFocus := h.App.GetFocus()
+	if h.Pages.HasPage("error_overlay") {
+		// Replacing an existing overlay: its focus target is unknown, fall back to the list
+		previousFocus = h.IssueList
+		h.Pages.RemovePage("error_overlay")
+	}
+	closeOverlay := func() {
+		h.Pages.RemovePage("error_overlay")
+		if previousFocus != nil {
+			h.App.SetFocus(previousFocus)
+		} else {
+			h.App.SetFocus(h.IssueList)
+		}
+	}
+
+	errorColor := formatting.GetErrorColor()
+	emphasisColor := formatting.GetEmphasisColor()
+	var sb strings.Builder
+	for i, line := range strings.Split(strings.TrimRight(report, "\n"), "\n") {
+		escaped := tview.Escape(line)
+		switch {
+		case i == 0:
+			sb.WriteString(fmt.Sprintf("[%s::b]%s[-::-]\n", errorColor, escaped))
+		case line == "Stderr:" || line == "Stdout:" || line == "Suggestions:":
+			sb.WriteString(fmt.Sprintf("[%s::b]%s[-::-]\n", emphasisColor, escaped))
+		default:
+			sb.WriteString(escaped + "\n")
+		}
+	}
+
+	textView := tview.NewTextView().
+		SetDynamicColors(true).
+		SetWrap(true).
+		SetText(sb.String())
+	textView.SetBorder(true).SetTitle(" Error (y: copy, Esc: close) ").SetTitleAlign(tview.AlignCenter)
+
+	copyReport := func() {
+		if err := clipboard.WriteAll(report); err != nil {
+			h.Notify.Error(fmt.Sprintf("Failed to copy: %v", err))
+			return
+		}
+		h.Notify.Success("Copied error details to clipboard")
+	}
+
+	buttons := tview.NewForm().
+		AddButton("Copy details", copyReport).
+		AddButton("Close", closeOverlay).
+		SetButtonsAlign(tview.AlignCenter)
+
+	layout := tview.NewFlex().SetDirection(tview.FlexRow).
+		AddItem(textView, 0, 1, false).
+		AddItem(buttons, 3, 0, true)
+
+	layout.SetInputCapture(func(event *tcell.EventKey) *tcell.EventKey {
+		switch {
+		case event.Key() == tcell.KeyEscape || event.Rune() == 'q':
+			closeOverlay()
+			return nil
+		case event.Rune() == 'y':
+			copyReport()
+			return nil
+		case event.Rune() == 'j' || event.Key() == tcell.KeyDown:
+			row, col := textView.GetScrollOffset()
+			textView.ScrollTo(row+1, col)
+			return nil
+		case event.Rune() == 'k' || event.Key() == tcell.KeyUp:
+			row, col := textView.GetScrollOffset()
+			if row > 0 {
+				textView.ScrollTo(row-1, col)
+			}
+			return nil
+		case event.Key() == tcell.KeyHome:
+			textView.ScrollToBeginning()
+			return nil
+		case event.Key() == tcell.KeyEnd:
+			textView.ScrollToEnd()
+			return nil
+		}
+		return event
+	})
+
+	// Create modal (centered)
+	modal := ui.CenterModal(layout, 3, 3)
+
+	h.Pages.AddPage("error_overlay", modal, true, true)
+	h.App.SetFocus(buttons)
+}
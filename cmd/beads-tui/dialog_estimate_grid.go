@@ -0,0 +1,92 @@
+package main
+
+import (
+	"fmt"
+
+	"github.com/andy/beads-tui/internal/formatting"
+	"github.com/andy/beads-tui/internal/state"
+	"github.com/andy/beads-tui/internal/theme"
+	"github.com/andy/beads-tui/internal/ui"
+	"github.com/gdamore/tcell/v2"
+	"github.com/rivo/tview"
+)
+
+// ShowEstimateGrid displays unclosed issues counted on a priority × estimate
+// grid. High priority cells with big estimates (candidates for splitting)
+// and low priority cells with tiny ones (quick wins) are highlighted. Enter
+// closes the overlay and calls applyFilter with the cell's quick filter query.
+func (h *DialogHelpers) ShowEstimateGrid(applyFilter func(query string)) {
+	grid := estimateGrid(h.AppState.GetAllIssues())
+
+	currentTheme := theme.Current()
+	table := tview.NewTable().
+		SetSelectable(true, true).
+		SetFixed(1, 1).
+		SetSelectedStyle(tcell.StyleDefault.Background(currentTheme.SelectionBg()).Foreground(currentTheme.SelectionFg()))
+	table.SetBorder(true).
+		SetTitle(" Priority × Estimate (unclosed issues) ").
+		SetTitleAlign(tview.AlignCenter)
+
+	accentColor := formatting.GetAccentColor()
+	mutedColor := formatting.GetMutedColor()
+	splitColor := formatting.GetErrorColor()
+	quickColor := formatting.GetSuccessColor()
+
+	table.SetCell(0, 0, tview.NewTableCell("").SetSelectable(false))
+	for col, bucket := range state.EstimateBuckets {
+		table.SetCell(0, col+1, tview.NewTableCell(fmt.Sprintf("[%s::b]%s[-::-]", accentColor, bucket.Label)).
+			SetAlign(tview.AlignCenter).SetExpansion(1).SetSelectable(false))
+	}
+	for priority, row := range grid {
+		table.SetCell(priority+1, 0, tview.NewTableCell(fmt.Sprintf("[%s::b]P%d[-::-] ", formatting.GetPriorityColor(priority), priority)).
+			SetSelectable(false))
+		for col, count := range row {
+			text := fmt.Sprintf("[%s]·[-]", mutedColor)
+			if count > 0 {
+				text = fmt.Sprintf("%d", count)
+				switch estimateCellKind(priority, state.EstimateBuckets[col].Name) {
+				case "split":
+					text = fmt.Sprintf("[%s::b]%d[-::-]", splitColor, count)
+				case "quick":
+					text = fmt.Sprintf("[%s::b]%d[-::-]", quickColor, count)
+				}
+			}
+			table.SetCell(priority+1, col+1, tview.NewTableCell(text).SetAlign(tview.AlignCenter).SetExpansion(1))
+		}
+	}
+	table.Select(1, 1)
+
+	footer := tview.NewTextView().
+		SetDynamicColors(true).
+		SetText(fmt.Sprintf("[%s]■[-] needs splitting  [%s]■[-] quick win\n[%s]Arrows/hjkl move · Enter filter the list to a cell · Esc close[-]",
+			splitColor, quickColor, mutedColor))
+
+	content := tview.NewFlex().SetDirection(tview.FlexRow).
+		AddItem(table, len(grid)+3, 0, true).
+		AddItem(footer, 2, 0, false).
+		AddItem(nil, 0, 1, false)
+
+	closeGrid := func() {
+		h.Pages.RemovePage("estimate_grid")
+		h.App.SetFocus(h.IssueList)
+	}
+	table.SetSelectedFunc(func(row, column int) {
+		if row < 1 || column < 1 {
+			return
+		}
+		closeGrid()
+		applyFilter(estimateGridQuery(row-1, state.EstimateBuckets[column-1].Name))
+	})
+
+	modal := ui.CenterModal(content, 2, 2)
+	modal.SetInputCapture(func(event *tcell.EventKey) *tcell.EventKey {
+		if event.Key() == tcell.KeyEscape || (event.Key() == tcell.KeyRune && event.Rune() == 'q') {
+			closeGrid()
+			return nil
+		}
+		return event
+	})
+
+	h.Pages.AddPage("estimate_grid", modal, true, true)
+	h.App.SetFocus(table)
+}
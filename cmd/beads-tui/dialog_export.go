@@ -0,0 +1,83 @@
+package main
+
+import (
+	"bytes"
+	"fmt"
+	"log"
+	"os"
+	"strings"
+	"time"
+
+	"github.com/andy/beads-tui/internal/formatting"
+	"github.com/rivo/tview"
+)
+
+// ShowExportDialog writes the issues currently shown in the list (after
+// filters, the closed-issue toggle, and tree folding) to a CSV, JSON, or
+// Markdown file
+func (h *DialogHelpers) ShowExportDialog() {
+	issues := visibleIssues(h.IssueList.GetItemCount(), *h.IndexToIssue)
+	if len(issues) == 0 {
+		h.Notify.Warn("No issues in the list to export")
+		return
+	}
+
+	dialog := h.newDialog("export_dialog", "Export Issues")
+	form := dialog.Form
+
+	summary := fmt.Sprintf("%d issues in the list", len(issues))
+	if filters := h.AppState.GetActiveFilters(); filters != "" {
+		summary += fmt.Sprintf(" [%s](%s)[-]", formatting.GetMutedColor(), tview.Escape(filters))
+	}
+	form.AddTextView("Exporting", summary, 0, 1, true, false)
+
+	format := formatting.ExportCSV
+	path := defaultExportPath(format, time.Now())
+	var options []string
+	for _, f := range formatting.ExportFormats {
+		options = append(options, string(f))
+	}
+	form.AddDropDown("Format", options, 0, func(option string, index int) {
+		format = formatting.ExportFormats[index]
+		// Keep the file extension in step with the format
+		if input, ok := form.GetFormItemByLabel("Path").(*tview.InputField); ok {
+			if updated := withExportExtension(path, format); updated != path {
+				input.SetText(updated)
+			}
+		}
+	})
+	form.AddInputField("Path", path, 50, nil, func(text string) {
+		path = text
+	})
+	form.AddTextView("", fmt.Sprintf("[%s]Relative paths are written to the current directory; an existing file is replaced[-]", formatting.GetMutedColor()), 0, 1, false, false)
+
+	export := func() {
+		target := expandHome(strings.TrimSpace(path))
+		if target == "" {
+			h.Notify.Error("Path is required")
+			return
+		}
+
+		var buf bytes.Buffer
+		if err := formatting.ExportIssues(&buf, issues, format); err != nil {
+			log.Printf("EXPORT ERROR: Failed to format %d issues as %s: %v", len(issues), format, err)
+			h.Notify.Error(fmt.Sprintf("Export failed: %v", err))
+			return
+		}
+		if err := os.WriteFile(target, buf.Bytes(), 0o644); err != nil {
+			log.Printf("EXPORT ERROR: Failed to write %s: %v", target, err)
+			h.Notify.Error(fmt.Sprintf("Export failed: %v", err))
+			return
+		}
+
+		log.Printf("EXPORT: Wrote %d issues as %s to %s", len(issues), format, target)
+		h.Notify.Success(fmt.Sprintf("Exported %d issues to %s", len(issues), target))
+		dialog.Close()
+	}
+
+	dialog.SetPrimary("Export", export).
+		SetCancel("Cancel", nil).
+		SetSubmitOnEnter(true).
+		SetFixedSize(76, 13)
+	dialog.Show()
+}
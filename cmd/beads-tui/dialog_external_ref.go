@@ -0,0 +1,130 @@
+package main
+
+import (
+	"context"
+	"fmt"
+	"log"
+	"net/http"
+	"strings"
+
+	"github.com/andy/beads-tui/internal/formatting"
+	"github.com/rivo/tview"
+)
+
+// ShowExternalRefDialog displays a dialog for linking the current issue to
+// an upstream tracker (bd update --external-ref). The reference can be a URL
+// or a key that an external_ref_urls rule turns into one; Check Link fetches
+// the page so its title confirms the link before saving.
+func (h *DialogHelpers) ShowExternalRefDialog() {
+	currentIndex := h.IssueList.GetCurrentItem()
+	issue, ok := (*h.IndexToIssue)[currentIndex]
+	if !ok {
+		h.Notify.Error("No issue selected")
+		return
+	}
+	if !h.requireBd(featureExternalRef) {
+		return
+	}
+
+	dialog := h.newDialog("external_ref_dialog", "Link External Reference")
+	form := dialog.Form
+	ref := externalRef(issue)
+	mutedColor := formatting.GetMutedColor()
+
+	form.AddTextView("Linking", issue.ID+" - "+issue.Title, 0, 2, false, false)
+	form.AddInputField("External Ref", ref, 60, nil, nil)
+	refField := form.GetFormItemByLabel("External Ref").(*tview.InputField)
+	form.AddTextView("Opens", "", 0, 1, false, false)
+	urlView := form.GetFormItemByLabel("Opens").(*tview.TextView)
+	form.AddTextView("Remote title", "", 0, 2, false, false)
+	titleView := form.GetFormItemByLabel("Remote title").(*tview.TextView)
+
+	// showURL resolves the reference as it is typed; a fetched title no
+	// longer applies once the reference changes
+	showURL := func() string {
+		titleView.SetText("")
+		link, err := externalRefURL(ref, h.ExternalRefURLs)
+		switch {
+		case err != nil:
+			urlView.SetText(fmt.Sprintf("[%s]%s[-]", formatting.GetErrorColor(), tview.Escape(err.Error())))
+		case link == "" && strings.TrimSpace(ref) == "":
+			urlView.SetText(fmt.Sprintf("[%s](saving an empty reference removes the link)[-]", mutedColor))
+		case link == "":
+			urlView.SetText(fmt.Sprintf("[%s](not a URL, and no external_ref_urls rule matches)[-]", mutedColor))
+		default:
+			urlView.SetText(tview.Escape(link))
+		}
+		return link
+	}
+	refField.SetChangedFunc(func(text string) {
+		ref = text
+		showURL()
+	})
+
+	// checkLink fetches the linked page's title off the UI goroutine
+	checkLink := func() {
+		link := showURL()
+		if link == "" {
+			titleView.SetText(fmt.Sprintf("[%s]Nothing to check[-]", mutedColor))
+			return
+		}
+		titleView.SetText(fmt.Sprintf("[%s]Fetching %s...[-]", mutedColor, tview.Escape(link)))
+		checkedRef := ref
+		go func() {
+			log.Printf("EXTERNAL REF: Fetching title of %s", link)
+			title, err := fetchRemoteTitle(context.Background(), http.DefaultClient, link)
+			h.App.QueueUpdateDraw(func() {
+				if ref != checkedRef {
+					return // Edited while fetching
+				}
+				if err != nil {
+					log.Printf("EXTERNAL REF ERROR: Failed to fetch %s: %v", link, err)
+					titleView.SetText(fmt.Sprintf("[%s]%s[-]", formatting.GetWarningColor(), tview.Escape(err.Error())))
+					return
+				}
+				titleView.SetText(fmt.Sprintf("[%s]%s[-]", formatting.GetSuccessColor(), tview.Escape(title)))
+			})
+		}()
+	}
+
+	issueID := issue.ID // Capture before potential refresh
+	saveRef := func() {
+		ref = strings.TrimSpace(ref)
+		if ref == externalRef(issue) {
+			dialog.Close()
+			return
+		}
+		args := []string{"update", issueID, "--external-ref", ref}
+		log.Printf("BD COMMAND: Setting external ref: bd update %s --external-ref %q", issueID, ref)
+		h.Runner.Run("Linking "+issueID, func(ctx context.Context) error {
+			_, err := execBdJSONIssue(ctx, args...)
+			return err
+		}, func(err error) {
+			if err != nil {
+				log.Printf("BD COMMAND ERROR: External ref update failed: %v", err)
+				h.ShowErrorOverlay("Error linking "+issueID, err)
+				return
+			}
+			h.Undo.Push(undoFields("link "+issueID, issue, args[2:]))
+			dialog.Close()
+			if ref == "" {
+				h.Notify.Success(fmt.Sprintf("Removed the external reference from %s", issueID))
+			} else {
+				h.Notify.Success(fmt.Sprintf("Linked %s to [%s]%s[-]", issueID, formatting.GetAccentColor(), tview.Escape(ref)))
+			}
+			h.ScheduleRefresh(issueID)
+		})
+	}
+
+	dialog.SetPrimary("Save", saveRef).
+		SetCancel("Cancel", nil).
+		AddButton("Check Link", checkLink).
+		SetSubmitOnEnter(true).
+		SetFixedSize(84, 15)
+	dialog.Show()
+
+	// An existing link is checked straight away
+	if showURL() != "" {
+		checkLink()
+	}
+}
@@ -2,18 +2,20 @@ package main
 
 import (
 	"fmt"
-	"strings"
 
 	"github.com/andy/beads-tui/internal/formatting"
-	"github.com/andy/beads-tui/internal/parser"
-	"github.com/gdamore/tcell/v2"
 	"github.com/rivo/tview"
 )
 
-// ShowQuickFilter displays a dialog for quick filtering of issues
-func (h *DialogHelpers) ShowQuickFilter() {
-	form := tview.NewForm()
-	var filterQuery string
+// ShowQuickFilter displays a dialog for quick filtering of issues. The query
+// starts as initial (e.g. "#" to pick a label); onApply is called after the
+// filters change. Labels, assignees, and est:/due: values autocomplete, and
+// a preview line counts the issues the query matches and marks the tokens
+// it doesn't know, before anything is applied.
+func (h *DialogHelpers) ShowQuickFilter(initial string, onApply func()) {
+	dialog := h.newDialog("quick_filter", "Quick Filter")
+	form := dialog.Form
+	filterQuery := initial
 
 	emphasisColor := formatting.GetEmphasisColor()
 	accentColor := formatting.GetAccentColor()
@@ -23,129 +25,74 @@ func (h *DialogHelpers) ShowQuickFilter() {
   p0-p4    Priority (e.g., 'p1' or 'p1,p2')
   bug, feature, task, epic, chore    Types
   open, in_progress, blocked, closed    Statuses
-  #label   Label (e.g., '#ui' or '#bug,#urgent')
+  #label   Label (e.g., '#ui'; '#ui,#docs' any of them; '#ui+#urgent' all of them)
+  @name    Assignee (e.g., '@alice' or '@alice,@bob')
+  title:text    Title contains text (e.g., 'title:login')
+  est:1h, est:4h, est:8h, est:24h, est:24h+, est:none    Estimate
+  due:overdue, due:soon, due:later, due:none    Due date
+  blocked-by:<id>, blocks:<id>, no-deps, has-children    Dependencies
+  !token or -token    Everything the token doesn't match (e.g., '!chore', '-p0')
 
 [%s]Examples:[-]
   p1 bug          P1 bugs only
   feature,task    Features and tasks
   p0,p1 open      High priority open issues
   #ui #urgent     Issues with 'ui' or 'urgent' labels
-
-[%s]Leave empty to clear all filters[-]`, emphasisColor, accentColor, mutedColor)
-
-	form.AddTextView("", helpText, 0, 11, false, false)
-	form.AddInputField("Filter", "", 50, nil, func(text string) {
-		filterQuery = text
-	})
-
-	// Apply filter function
-	applyQuickFilter := func() {
-		// Clear existing filters
-		h.AppState.ClearAllFilters()
-
-		if filterQuery == "" {
-			// Empty query = clear all filters
-			h.Pages.RemovePage("quick_filter")
-			h.App.SetFocus(h.IssueList)
+  #ui+#urgent     Issues with both labels
+  @alice open     Open issues assigned to alice
+  blocked-by:tui-abc   Everything waiting on tui-abc
+  no-deps task    Leaf tasks with no blocking dependencies
+  p3 est:1h       Low priority quick wins
+  !chore -closed  Everything except chores and closed issues
+
+[%s]Tab or ↓ completes a label, assignee, est: or due: value
+Leave empty to clear all filters[-]`, emphasisColor, accentColor, mutedColor)
+
+	form.AddTextView("", helpText, 0, 26, false, false)
+	form.AddInputField("Filter", initial, 50, nil, nil)
+	form.AddTextView("Preview", "", 0, 1, true, false)
+
+	preview, _ := form.GetFormItemByLabel("Preview").(*tview.TextView)
+	updatePreview := func() {
+		if preview == nil {
 			return
 		}
-
-		// Parse filter query (space or comma separated)
-		query := strings.ToLower(strings.TrimSpace(filterQuery))
-		tokens := strings.FieldsFunc(query, func(r rune) bool {
-			return r == ' ' || r == ','
-		})
-
-		// Process each token
-		for _, token := range tokens {
-			token = strings.TrimSpace(token)
-			if token == "" {
-				continue
-			}
-
-			// Check for label (starts with #)
-			if strings.HasPrefix(token, "#") {
-				label := strings.TrimPrefix(token, "#")
-				if label != "" {
-					h.AppState.ToggleLabelFilter(label)
-				}
-				continue
-			}
-
-			// Check for priority (p0-p4)
-			if len(token) == 2 && token[0] == 'p' && token[1] >= '0' && token[1] <= '4' {
-				priority := int(token[1] - '0')
-				h.AppState.TogglePriorityFilter(priority)
-				continue
-			}
-
-			// Check for type
-			switch token {
-			case "bug":
-				h.AppState.ToggleTypeFilter(parser.TypeBug)
-			case "feature":
-				h.AppState.ToggleTypeFilter(parser.TypeFeature)
-			case "task":
-				h.AppState.ToggleTypeFilter(parser.TypeTask)
-			case "epic":
-				h.AppState.ToggleTypeFilter(parser.TypeEpic)
-			case "chore":
-				h.AppState.ToggleTypeFilter(parser.TypeChore)
-			}
-
-			// Check for status
-			switch token {
-			case "open":
-				h.AppState.ToggleStatusFilter(parser.StatusOpen)
-			case "in_progress", "inprogress":
-				h.AppState.ToggleStatusFilter(parser.StatusInProgress)
-			case "blocked":
-				h.AppState.ToggleStatusFilter(parser.StatusBlocked)
-			case "closed":
-				h.AppState.ToggleStatusFilter(parser.StatusClosed)
-			}
+		check := h.AppState.CheckFilterQuery(filterQuery)
+		if len(check.Unknown) > 0 {
+			preview.SetText(fmt.Sprintf("%s [%s]→ %d of %d issues match (unknown tokens ignored)[-]",
+				highlightFilterQuery(filterQuery, check.Unknown, formatting.GetErrorColor()), mutedColor, check.Matches, check.Total))
+		} else {
+			preview.SetText(fmt.Sprintf("[%s]%d of %d issues match[-]", mutedColor, check.Matches, check.Total))
 		}
-
-		// Apply filters
-		h.Pages.RemovePage("quick_filter")
-		h.App.SetFocus(h.IssueList)
 	}
 
-	form.AddButton("Apply (Enter)", applyQuickFilter)
-	form.AddButton("Clear All", func() {
-		h.AppState.ClearAllFilters()
-		h.Pages.RemovePage("quick_filter")
-		h.App.SetFocus(h.IssueList)
-	})
-	form.AddButton("Cancel (ESC)", func() {
-		h.Pages.RemovePage("quick_filter")
-		h.App.SetFocus(h.IssueList)
-	})
-
-	form.SetBorder(true).SetTitle(" Quick Filter ").SetTitleAlign(tview.AlignCenter)
-	form.SetCancelFunc(func() {
-		h.Pages.RemovePage("quick_filter")
-		h.App.SetFocus(h.IssueList)
-	})
-
-	// Add Enter key handler to apply filter
-	form.SetInputCapture(func(event *tcell.EventKey) *tcell.EventKey {
-		if event.Key() == tcell.KeyEnter {
-			applyQuickFilter()
-			return nil
-		}
-		return event
-	})
+	if inputField, ok := form.GetFormItemByLabel("Filter").(*tview.InputField); ok {
+		labels := h.AppState.GetAllLabels()
+		assignees := h.AppState.GetAllAssignees()
+		inputField.SetChangedFunc(func(text string) {
+			filterQuery = text
+			updatePreview()
+		})
+		inputField.SetAutocompleteFunc(func(currentText string) []string {
+			return filterCompletions(currentText, labels, assignees)
+		})
+	}
+	updatePreview()
 
-	// Create modal (centered)
-	modal := tview.NewFlex().
-		AddItem(nil, 0, 1, false).
-		AddItem(tview.NewFlex().SetDirection(tview.FlexRow).
-			AddItem(nil, 0, 1, false).
-			AddItem(form, 0, 2, true).
-			AddItem(nil, 0, 1, false), 0, 2, true).
-		AddItem(nil, 0, 1, false)
+	// Apply filter function (empty query clears all filters)
+	applyQuickFilter := func() {
+		h.AppState.ApplyFilterQuery(filterQuery)
+		dialog.Close()
+		onApply()
+	}
 
-	h.Pages.AddPage("quick_filter", modal, true, true)
-	h.App.SetFocus(form)
+	dialog.SetPrimary("Apply", applyQuickFilter).
+		SetCancel("Cancel", nil).
+		AddButton("Clear All", func() {
+			h.AppState.ClearAllFilters()
+			dialog.Close()
+			onApply()
+		}).
+		SetSubmitOnEnter(true)
+	dialog.Show()
 }
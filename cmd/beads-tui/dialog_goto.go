@@ -0,0 +1,101 @@
+package main
+
+import (
+	"fmt"
+
+	"github.com/andy/beads-tui/internal/formatting"
+	"github.com/andy/beads-tui/internal/parser"
+	"github.com/andy/beads-tui/internal/theme"
+	"github.com/andy/beads-tui/internal/ui"
+	"github.com/gdamore/tcell/v2"
+	"github.com/rivo/tview"
+)
+
+// gotoResultLimit caps the matches listed in the goto issue overlay
+const gotoResultLimit = 50
+
+// ShowGotoIssue opens a fuzzy finder over every issue's ID and title,
+// including closed issues. Typing narrows the matches; Up/Down (or
+// Ctrl-P/Ctrl-N) pick one, and Enter closes the overlay and calls jump with
+// its ID.
+func (h *DialogHelpers) ShowGotoIssue(jump func(issueID string)) {
+	currentTheme := theme.Current()
+	mutedColor := formatting.GetMutedColor()
+
+	input := tview.NewInputField().
+		SetLabel("Go to: ").
+		SetFieldBackgroundColor(currentTheme.SelectionBg()).
+		SetFieldTextColor(currentTheme.SelectionFg())
+	table := tview.NewTable().
+		SetSelectable(true, false).
+		SetSelectedStyle(tcell.StyleDefault.Background(currentTheme.SelectionBg()).Foreground(currentTheme.SelectionFg()))
+	footer := tview.NewTextView().
+		SetDynamicColors(true).
+		SetText(fmt.Sprintf("[%s]Type to filter by ID or title · ↑/↓ select · Enter jump · Esc close[-]", mutedColor))
+
+	content := tview.NewFlex().SetDirection(tview.FlexRow).
+		AddItem(input, 1, 0, true).
+		AddItem(table, 0, 1, false).
+		AddItem(footer, 1, 0, false)
+	content.SetBorder(true).
+		SetTitle(" Go to Issue ").
+		SetTitleAlign(tview.AlignCenter)
+
+	var matches []*parser.Issue
+	refresh := func(query string) {
+		matches = matches[:0]
+		table.Clear()
+		for row, match := range h.AppState.FuzzyFind(query, gotoResultLimit) {
+			issue := match.Issue
+			matches = append(matches, issue)
+			title := tview.Escape(issue.Title)
+			if issue.Status == parser.StatusClosed {
+				title = fmt.Sprintf("[%s]%s (closed)[-]", mutedColor, title)
+			}
+			table.SetCell(row, 0, tview.NewTableCell(fmt.Sprintf("[%s]%s[-] [%s]%s[-] [%s][P%d][-] %s",
+				formatting.GetStatusColor(issue.Status), formatting.Icons().Bullet,
+				formatting.GetAccentColor(), issue.ID,
+				formatting.GetPriorityColor(issue.Priority), issue.Priority,
+				title)).SetExpansion(1))
+		}
+		if len(matches) == 0 {
+			table.SetCell(0, 0, tview.NewTableCell(fmt.Sprintf("[%s]No matching issues[-]", mutedColor)).SetSelectable(false))
+		}
+		table.Select(0, 0).ScrollToBeginning()
+	}
+	refresh("")
+	input.SetChangedFunc(refresh)
+
+	closeGoto := func() {
+		h.Pages.RemovePage("goto_issue")
+		h.App.SetFocus(h.IssueList)
+	}
+
+	// Focus stays in the input; movement keys are forwarded to the table
+	input.SetInputCapture(func(event *tcell.EventKey) *tcell.EventKey {
+		switch event.Key() {
+		case tcell.KeyEscape:
+			closeGoto()
+			return nil
+		case tcell.KeyEnter:
+			row, _ := table.GetSelection()
+			if row < len(matches) {
+				closeGoto()
+				jump(matches[row].ID)
+			}
+			return nil
+		case tcell.KeyCtrlN:
+			event = tcell.NewEventKey(tcell.KeyDown, 0, tcell.ModNone)
+		case tcell.KeyCtrlP:
+			event = tcell.NewEventKey(tcell.KeyUp, 0, tcell.ModNone)
+		case tcell.KeyUp, tcell.KeyDown, tcell.KeyPgUp, tcell.KeyPgDn:
+		default:
+			return event
+		}
+		table.InputHandler()(event, func(p tview.Primitive) {})
+		return nil
+	})
+
+	h.Pages.AddPage("goto_issue", ui.CenterModal(content, 2, 2), true, true)
+	h.App.SetFocus(input)
+}
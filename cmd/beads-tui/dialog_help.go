@@ -1,6 +1,10 @@
 package main
 
 import (
+	"strings"
+
+	"github.com/andy/beads-tui/internal/formatting"
+	"github.com/andy/beads-tui/internal/ui"
 	"github.com/gdamore/tcell/v2"
 	"github.com/rivo/tview"
 )
@@ -8,94 +12,39 @@ import (
 // ShowHelpScreen displays the keyboard shortcuts help screen
 func (h *DialogHelpers) ShowHelpScreen() {
 	// Note: This help screen uses hardcoded colors for documentation purposes
-	// showing the current theme's colors as examples
+	// showing the current theme's colors as examples. The icons are the ones
+	// in use (icon_set in the config).
+	icons := formatting.Icons()
 	helpText := `[yellow::b]beads-tui Keyboard Shortcuts[-::-]
 
-[green::b]Quick Start[-::-]
-  j / k         Navigate up/down (or use arrow keys)
-  Enter         View issue details
-  a             Create new issue
-  /             Search issues
-  ?             This help screen
-  q             Quit
-
-[cyan::b]Navigation[-::-]
-  j / ↓       Move down
-  k / ↑       Move up
-  gg          Jump to top
-  G           Jump to bottom
-  Tab         Focus detail panel for scrolling
-  Enter       Focus detail panel (when on issue)
-  ESC         Return focus to issue list
-
-[cyan::b]Search[-::-]
-  /           Start search mode
-  n           Next search result
-  N           Previous search result
-  ESC         Exit search mode
-
-[cyan::b]Quick Actions[-::-]
-  0-4         Set priority (P0=critical, P1=high, P2=normal, P3=low, P4=lowest)
-  s           Cycle status (open → in_progress → blocked → closed → open)
-  R           Rename issue (edit title)
-  a           Create new issue (vim-style "add")
-  c           Add comment to selected issue
-  e           Edit issue (title, description, design, acceptance, notes, priority, type)
-  x           Close issue with optional reason
-  X           Reopen closed issue with optional reason
-  D           Manage dependencies (add/remove blocks, parent-child, related)
-  L           Manage labels (add/remove labels)
-  y           Yank (copy) issue ID to clipboard
-  Y           Yank (copy) issue ID with title to clipboard
-  B           Copy git branch name to clipboard
-
-[cyan::b]Two-Character Shortcuts[-::-]
-  So          Set status to open
-  Si          Set status to in_progress
-  Sb          Set status to blocked
-  Sc          Set status to closed
-
-[cyan::b]View Controls[-::-]
-  t           Toggle between list and tree view
-  o           Collapse/expand node in tree view (vim-style fold)
-  O           Expand all nodes in tree view
-  Z           Collapse all nodes in tree view
-  T           Cycle to next theme (live theme switching)
-  C           Toggle showing closed issues in list view
-  p           Toggle issue ID prefix (tui-abc vs abc)
-  f           Quick filter (type: p1 bug, feature, etc.)
-  S           Show statistics dashboard
-  m           Toggle mouse mode on/off
-  r           Manual refresh
-
-[cyan::b]Detail Panel Scrolling (when focused)[-::-]
-  Ctrl-d      Scroll down half page
-  Ctrl-u      Scroll up half page
-  Ctrl-f      Scroll down full page (vim)
-  Ctrl-b      Scroll up full page (vim)
-  Ctrl-e      Scroll down one line
-  Ctrl-y      Scroll up one line
-  PageDown    Scroll down full page
-  PageUp      Scroll up full page
-  Home        Jump to top of details
-  End         Jump to bottom of details
-
-[cyan::b]General[-::-]
-  ?           Show this help screen
-  q           Quit
-
-[cyan::b]Command Line Options[-::-]
+` + renderKeymapHelp(keymap) + `[cyan::b]Command Line Options[-::-]
   --theme <name>      Set color theme
     beads-tui --theme gruvbox-dark
 
   --view <mode>       Start in list or tree view
     beads-tui --view tree
 
-  --issue <id>        Show only a specific issue
+  --filter <query>    Start with a quick filter applied (f syntax)
+    beads-tui --filter "p1 bug #ui"
+
+  --issue <id>        Follow one issue's details, live-updating (q quits)
     beads-tui --issue tui-abc
 
+  --path <dir>        Open the beads project in another directory
+    beads-tui --path ~/src/other-repo
+
+  --db <file>         Open a specific beads database file
+    beads-tui --db ~/src/other-repo/.beads/beads.db
+
   --debug             Enable debug logging
 
+  --direct-write      Change status, priority, labels, and comments
+                      in beads.db directly when bd is unavailable
+
+  keys [--format markdown]  Print a cheat sheet of these shortcuts,
+                            with the project's leader sequences
+    beads-tui keys --format markdown > keys.md
+
 [cyan::b]Themes[-::-]
   Available themes: default, gruvbox-dark, gruvbox-light, nord,
   solarized-dark, solarized-light, dracula, tokyo-night,
@@ -104,11 +53,12 @@ func (h *DialogHelpers) ShowHelpScreen() {
   Set via environment variable:
     export BEADS_THEME=gruvbox-dark
 
-[cyan::b]Status Icons[-::-]
-  ●           Open/Ready
-  ○           Blocked
-  ◆           In Progress
-  ·           Other
+  Compare themes without opening a database:
+    beads-tui themes --preview [theme...]
+
+[cyan::b]Status Icons[-::-] (icon_set "` + icons.Name + `")
+` + iconLegend(icons.Ready, "Open/Ready") + iconLegend(icons.Blocked, "Blocked") +
+		iconLegend(icons.InProgress, "In Progress") + iconLegend(icons.Closed, "Closed") + `
 
 [cyan::b]Priority Colors[-::-]
   [red]P0[-]          Critical
@@ -118,10 +68,8 @@ func (h *DialogHelpers) ShowHelpScreen() {
   [gray]P4[-]          Lowest
 
 [cyan::b]Status Colors[-::-]
-  [limegreen]●[-]           Ready
-  [gold]○[-]           Blocked
-  [deepskyblue]◆[-]           In Progress
-  [gray]·[-]           Closed
+` + iconLegend("[limegreen]"+icons.Ready+"[-]", "Ready") + iconLegend("[gold]"+icons.Blocked+"[-]", "Blocked") +
+		iconLegend("[deepskyblue]"+icons.InProgress+"[-]", "In Progress") + iconLegend("[gray]"+icons.Closed+"[-]", "Closed") + `
 
 [gray]━━━━━━━━━━━━━━━━━━━━━━━━━━━━━━━━━━━━━━━━━━[-]
 [yellow]Press ESC or ? to close this help[-]`
@@ -136,13 +84,7 @@ func (h *DialogHelpers) ShowHelpScreen() {
 		SetTitleAlign(tview.AlignCenter)
 
 	// Create modal (centered)
-	modal := tview.NewFlex().
-		AddItem(nil, 0, 1, false).
-		AddItem(tview.NewFlex().SetDirection(tview.FlexRow).
-			AddItem(nil, 0, 1, false).
-			AddItem(helpTextView, 0, 3, true).
-			AddItem(nil, 0, 1, false), 0, 2, true).
-		AddItem(nil, 0, 1, false)
+	modal := ui.CenterModal(helpTextView, 2, 3)
 
 	// Add input capture to close on ESC, q, or ?
 	modal.SetInputCapture(func(event *tcell.EventKey) *tcell.EventKey {
@@ -157,3 +99,10 @@ func (h *DialogHelpers) ShowHelpScreen() {
 	h.Pages.AddPage("help", modal, true, true)
 	h.App.SetFocus(modal)
 }
+
+// iconLegend is one line of the help screen's icon legends, with the
+// description lined up after the (possibly color tagged) icon
+func iconLegend(icon, description string) string {
+	padding := max(keyColumnWidth+1-tview.TaggedStringWidth(icon), 1)
+	return "  " + icon + strings.Repeat(" ", padding) + description + "\n"
+}
@@ -0,0 +1,97 @@
+package main
+
+import (
+	"fmt"
+	"time"
+
+	"github.com/andy/beads-tui/internal/formatting"
+	"github.com/andy/beads-tui/internal/theme"
+	"github.com/andy/beads-tui/internal/ui"
+	"github.com/gdamore/tcell/v2"
+	"github.com/rivo/tview"
+)
+
+// ShowHomeScreen displays the workspace summary. Enter on a section title or
+// row closes the screen and calls jump with the row's target; Esc or q goes
+// to the issue list unchanged.
+func (h *DialogHelpers) ShowHomeScreen(jump func(homeTarget)) {
+	now := time.Now()
+	sections := buildHomeSections(h.AppState, currentUser(), now)
+
+	currentTheme := theme.Current()
+	table := tview.NewTable().
+		SetSelectable(true, false).
+		SetSelectedStyle(tcell.StyleDefault.Background(currentTheme.SelectionBg()).Foreground(currentTheme.SelectionFg()))
+	table.SetBorder(true).
+		SetTitle(" Home - Workspace Summary ").
+		SetTitleAlign(tview.AlignCenter)
+
+	emphasisColor := formatting.GetEmphasisColor()
+	accentColor := formatting.GetAccentColor()
+	mutedColor := formatting.GetMutedColor()
+
+	// Row index -> jump target; rows without a target are not selectable
+	targets := make(map[int]homeTarget)
+	addRow := func(text string, target *homeTarget) {
+		row := table.GetRowCount()
+		cell := tview.NewTableCell(text).SetExpansion(1)
+		if target == nil {
+			cell.SetSelectable(false)
+		} else {
+			targets[row] = *target
+		}
+		table.SetCell(row, 0, cell)
+	}
+
+	for i, section := range sections {
+		if i > 0 {
+			addRow("", nil)
+		}
+		addRow(fmt.Sprintf("[%s::b]%s[-::-]", emphasisColor, section.Title), &section.Target)
+		if len(section.Rows) == 0 && section.Empty != "" {
+			addRow(fmt.Sprintf("  [%s]%s[-]", mutedColor, section.Empty), nil)
+		}
+		for _, row := range section.Rows {
+			target := row.Target
+			if row.Issue == nil {
+				addRow("  "+row.Text, &target)
+				continue
+			}
+			issue := row.Issue
+			addRow(fmt.Sprintf("  [%s]%s[-] %s [%s]%s[-] [%s][P%d][-] %s [%s]%s[-]",
+				formatting.GetStatusColor(issue.Status), formatting.Icons().Bullet, formatting.GetTypeIcon(issue.IssueType),
+				accentColor, issue.ID,
+				formatting.GetPriorityColor(issue.Priority), issue.Priority,
+				tview.Escape(issue.Title),
+				mutedColor, formatting.FormatAge(issue.UpdatedAt, now)), &target)
+		}
+	}
+	addRow("", nil)
+	addRow(fmt.Sprintf("[%s]Enter jump to list · Esc/q go to list · gh reopen this screen[-]", mutedColor), nil)
+
+	closeHome := func() {
+		h.Pages.RemovePage("home")
+		h.App.SetFocus(h.IssueList)
+	}
+	table.SetSelectedFunc(func(row, column int) {
+		target, ok := targets[row]
+		if !ok {
+			return
+		}
+		closeHome()
+		jump(target)
+	})
+	table.Select(1, 0) // First status row
+
+	modal := ui.CenterModal(table, 2, 3)
+	modal.SetInputCapture(func(event *tcell.EventKey) *tcell.EventKey {
+		if event.Key() == tcell.KeyEscape || (event.Key() == tcell.KeyRune && event.Rune() == 'q') {
+			closeHome()
+			return nil
+		}
+		return event // The table handles j/k, g/G and arrows itself
+	})
+
+	h.Pages.AddPage("home", modal, true, true)
+	h.App.SetFocus(table)
+}
@@ -0,0 +1,91 @@
+package main
+
+import (
+	"context"
+	"fmt"
+	"log"
+	"time"
+
+	"github.com/andy/beads-tui/internal/formatting"
+	"github.com/andy/beads-tui/internal/ui"
+	"github.com/atotto/clipboard"
+	"github.com/gdamore/tcell/v2"
+	"github.com/rivo/tview"
+)
+
+// ShowInspector displays the selected issue's rows exactly as stored in
+// SQLite (every column of the issue, its dependencies in both directions,
+// labels, and comments) for debugging differences between bd's output and
+// what the TUI shows. y copies the dump for a bug report.
+func (h *DialogHelpers) ShowInspector() {
+	currentIndex := h.IssueList.GetCurrentItem()
+	issue, ok := (*h.IndexToIssue)[currentIndex]
+	if !ok {
+		h.Notify.Error("No issue selected")
+		return
+	}
+	if h.DB == nil {
+		h.Notify.Error("No database connection to inspect")
+		return
+	}
+
+	issueID := issue.ID
+	mutedColor := formatting.GetMutedColor()
+	textView := tview.NewTextView().
+		SetDynamicColors(true).
+		SetText(fmt.Sprintf("[%s]Reading %s...[-]", mutedColor, issueID))
+	textView.SetBorder(true).
+		SetTitle(fmt.Sprintf(" Raw Data: %s ", issueID)).
+		SetTitleAlign(tview.AlignCenter)
+
+	footer := tview.NewTextView().
+		SetDynamicColors(true).
+		SetText(fmt.Sprintf("[%s]j/k scroll · y copy · Esc close[-]", mutedColor))
+
+	content := tview.NewFlex().SetDirection(tview.FlexRow).
+		AddItem(textView, 0, 1, true).
+		AddItem(footer, 1, 0, false)
+
+	var dump string
+	modal := ui.CenterModal(content, 2, 3)
+	modal.SetInputCapture(func(event *tcell.EventKey) *tcell.EventKey {
+		if event.Key() == tcell.KeyEscape || (event.Key() == tcell.KeyRune && event.Rune() == 'q') {
+			h.Pages.RemovePage("inspector")
+			h.App.SetFocus(h.IssueList)
+			return nil
+		}
+		if event.Key() == tcell.KeyRune && event.Rune() == 'y' && dump != "" {
+			if err := clipboard.WriteAll(dump); err != nil {
+				log.Printf("CLIPBOARD ERROR: Failed to copy raw data: %v", err)
+				footer.SetText(fmt.Sprintf("[%s]Failed to copy: %v[-]", formatting.GetErrorColor(), err))
+			} else {
+				footer.SetText(fmt.Sprintf("[%s]✓ Copied raw data for %s to clipboard[-]", formatting.GetSuccessColor(), issueID))
+			}
+			return nil
+		}
+		return event
+	})
+
+	h.Pages.AddPage("inspector", modal, true, true)
+	h.App.SetFocus(textView)
+
+	// Read off the UI goroutine; the database may be busy with a refresh
+	go func() {
+		ctx, cancel := context.WithTimeout(context.Background(), 5*time.Second)
+		defer cancel()
+		tables, err := h.DB.RawIssue(ctx, issueID)
+		h.App.QueueUpdateDraw(func() {
+			if err != nil {
+				log.Printf("INSPECTOR ERROR: Failed to read %s: %v", issueID, err)
+				textView.SetText(fmt.Sprintf("[%s]Failed to read %s: %s[-]", formatting.GetErrorColor(), issueID, tview.Escape(err.Error())))
+				return
+			}
+			if len(tables) > 0 && len(tables[0].Rows) == 0 {
+				textView.SetText(fmt.Sprintf("[%s]%s is not in %s (deleted since the last refresh?)[-]", formatting.GetWarningColor(), issueID, tview.Escape(h.DB.Path())))
+				return
+			}
+			dump = fmt.Sprintf("Database: %s\n\n%s", h.DB.Path(), formatRawTables(tables))
+			textView.SetText(tview.Escape(dump)).ScrollToBeginning()
+		})
+	}()
+}
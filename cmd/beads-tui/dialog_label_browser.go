@@ -0,0 +1,107 @@
+package main
+
+import (
+	"fmt"
+
+	"github.com/andy/beads-tui/internal/formatting"
+	"github.com/andy/beads-tui/internal/parser"
+	"github.com/andy/beads-tui/internal/theme"
+	"github.com/andy/beads-tui/internal/ui"
+	"github.com/gdamore/tcell/v2"
+	"github.com/rivo/tview"
+)
+
+// ShowLabelBrowser lists every label with its open and closed issue counts.
+// Enter filters the list to the selected label (on top of other filters) and
+// closes the overlay; Space toggles a label's filter and stays open, so
+// several can be picked. onFilter is called after each change to refresh the
+// list.
+func (h *DialogHelpers) ShowLabelBrowser(onFilter func()) {
+	labels := h.AppState.Labels()
+	currentTheme := theme.Current()
+	table := tview.NewTable().
+		SetSelectable(true, false).
+		SetFixed(1, 0).
+		SetSelectedStyle(tcell.StyleDefault.Background(currentTheme.SelectionBg()).Foreground(currentTheme.SelectionFg()))
+	table.SetBorder(true).
+		SetTitle(fmt.Sprintf(" Labels (%d) ", len(labels))).
+		SetTitleAlign(tview.AlignCenter)
+
+	mutedColor := formatting.GetMutedColor()
+	openColor := formatting.GetStatusColor(parser.StatusOpen)
+	closedColor := formatting.GetStatusColor(parser.StatusClosed)
+	headers := []string{"", "Label", "Open", "Closed"}
+	for column, header := range headers {
+		table.SetCell(0, column, tview.NewTableCell(fmt.Sprintf("[%s::b]%s[-::-]", mutedColor, header)).SetSelectable(false))
+	}
+
+	render := func() {
+		for i, label := range labels {
+			row := i + 1
+			mark := " "
+			if h.AppState.IsLabelFiltered(label.Name) {
+				mark = fmt.Sprintf("[%s]✓[-]", formatting.GetSuccessColor())
+			}
+			table.SetCell(row, 0, tview.NewTableCell(mark))
+			table.SetCell(row, 1, tview.NewTableCell(fmt.Sprintf("[%s]#%s[-]", formatting.GetAccentColor(), tview.Escape(label.Name))).SetExpansion(1))
+			table.SetCell(row, 2, tview.NewTableCell(fmt.Sprintf("[%s]%d[-]", openColor, label.Open)).SetAlign(tview.AlignRight))
+			table.SetCell(row, 3, tview.NewTableCell(fmt.Sprintf("[%s]%d[-]", closedColor, label.Closed)).SetAlign(tview.AlignRight))
+		}
+	}
+	if len(labels) == 0 {
+		table.SetCell(1, 1, tview.NewTableCell(fmt.Sprintf("[%s]No issue has a label yet (L adds one)[-]", mutedColor)).SetSelectable(false))
+	}
+	render()
+	table.Select(1, 0)
+
+	footer := tview.NewTextView().
+		SetDynamicColors(true).
+		SetText(fmt.Sprintf("[%s]Enter filter · Space toggle · Esc close[-]", mutedColor))
+
+	content := tview.NewFlex().SetDirection(tview.FlexRow).
+		AddItem(table, 0, 1, true).
+		AddItem(footer, 1, 0, false)
+
+	closeBrowser := func() {
+		h.Pages.RemovePage("label_browser")
+		h.App.SetFocus(h.IssueList)
+	}
+	selectedLabel := func() (string, bool) {
+		row, _ := table.GetSelection()
+		if row < 1 || row > len(labels) {
+			return "", false
+		}
+		return labels[row-1].Name, true
+	}
+	table.SetSelectedFunc(func(row, column int) {
+		label, ok := selectedLabel()
+		if !ok {
+			return
+		}
+		if !h.AppState.IsLabelFiltered(label) {
+			h.AppState.ToggleLabelFilter(label)
+		}
+		closeBrowser()
+		onFilter()
+	})
+
+	modal := ui.CenterModal(content, 2, 2)
+	modal.SetInputCapture(func(event *tcell.EventKey) *tcell.EventKey {
+		if event.Key() == tcell.KeyEscape || (event.Key() == tcell.KeyRune && event.Rune() == 'q') {
+			closeBrowser()
+			return nil
+		}
+		if event.Key() == tcell.KeyRune && event.Rune() == ' ' {
+			if label, ok := selectedLabel(); ok {
+				h.AppState.ToggleLabelFilter(label)
+				render()
+				onFilter()
+			}
+			return nil
+		}
+		return event
+	})
+
+	h.Pages.AddPage("label_browser", modal, true, true)
+	h.App.SetFocus(table)
+}
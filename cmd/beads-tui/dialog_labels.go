@@ -1,12 +1,13 @@
 package main
 
 import (
+	"context"
 	"fmt"
 	"log"
 	"strings"
 
 	"github.com/andy/beads-tui/internal/formatting"
-	"github.com/rivo/tview"
+	"github.com/andy/beads-tui/internal/parser"
 )
 
 // ShowLabelDialog displays a dialog for managing labels
@@ -15,11 +16,12 @@ func (h *DialogHelpers) ShowLabelDialog() {
 	currentIndex := h.IssueList.GetCurrentItem()
 	issue, ok := (*h.IndexToIssue)[currentIndex]
 	if !ok {
-		h.StatusBar.SetText(fmt.Sprintf("[%s]No issue selected[-]", formatting.GetErrorColor()))
+		h.Notify.Error("No issue selected")
 		return
 	}
 
-	form := tview.NewForm()
+	dialog := h.newDialog("label_dialog", "Manage Labels")
+	form := dialog.Form
 	form.AddTextView("Managing labels for", issue.ID+" - "+issue.Title, 0, 2, false, false)
 
 	// Show current labels
@@ -43,34 +45,40 @@ func (h *DialogHelpers) ShowLabelDialog() {
 	})
 
 	// Add button
-	form.AddButton("Add Label", func() {
+	dialog.SetPrimary("Add Label", func() {
 		trimmedLabel := strings.TrimSpace(newLabel)
 		if trimmedLabel == "" {
-			h.StatusBar.SetText(fmt.Sprintf("[%s]Error: Label cannot be empty[-]", formatting.GetErrorColor()))
+			h.Notify.Error("Label cannot be empty")
 			return
 		}
 
 		// Check if label already exists
 		for _, existing := range issue.Labels {
 			if existing == trimmedLabel {
-				h.StatusBar.SetText(fmt.Sprintf("[%s]Error: Label '%s' already exists[-]", formatting.GetErrorColor(), trimmedLabel))
+				h.Notify.Error(fmt.Sprintf("Label '%s' already exists", trimmedLabel))
 				return
 			}
 		}
 
 		issueID := issue.ID // Capture before potential refresh
 		log.Printf("BD COMMAND: Adding label: bd label add %s %q", issueID, trimmedLabel)
-		updatedIssue, err := execBdJSONIssue("label", "add", issueID, trimmedLabel)
-		if err != nil {
-			log.Printf("BD COMMAND ERROR: Label add failed: %v", err)
-			h.StatusBar.SetText(fmt.Sprintf("[%s]Error adding label: %v[-]", formatting.GetErrorColor(), err))
-		} else {
+		var updatedIssue *parser.Issue
+		h.Runner.Run("Adding label to "+issueID, func(ctx context.Context) error {
+			var err error
+			updatedIssue, err = execBdJSONIssue(ctx, "label", "add", issueID, trimmedLabel)
+			return err
+		}, func(err error) {
+			if err != nil {
+				log.Printf("BD COMMAND ERROR: Label add failed: %v", err)
+				h.ShowErrorOverlay("Error adding label", err)
+				return
+			}
 			log.Printf("BD COMMAND: Label added successfully to %s", updatedIssue.ID)
-			h.StatusBar.SetText(fmt.Sprintf("[%s]✓ Added label [%s]'%s'[-][-]", formatting.GetSuccessColor(), formatting.GetEmphasisColor(), trimmedLabel))
-			h.Pages.RemovePage("label_dialog")
-			h.App.SetFocus(h.IssueList)
+			h.Undo.Push(undoLabel(issueID, trimmedLabel, true))
+			h.Notify.Success(fmt.Sprintf("Added label [%s]'%s'[-]", formatting.GetEmphasisColor(), trimmedLabel))
+			dialog.Close()
 			h.ScheduleRefresh(issueID)
-		}
+		})
 	})
 
 	// Remove label buttons
@@ -80,45 +88,33 @@ func (h *DialogHelpers) ShowLabelDialog() {
 			// Capture label in closure
 			labelToRemove := label
 			buttonLabel := fmt.Sprintf("Remove '%s'", labelToRemove)
-			form.AddButton(buttonLabel, func() {
+			dialog.AddButton(buttonLabel, func() {
 				issueID := issue.ID
 				log.Printf("BD COMMAND: Removing label: bd label remove %s %q", issueID, labelToRemove)
-				updatedIssue, err := execBdJSONIssue("label", "remove", issueID, labelToRemove)
-				if err != nil {
-					log.Printf("BD COMMAND ERROR: Label remove failed: %v", err)
-					h.StatusBar.SetText(fmt.Sprintf("[%s]Error removing label: %v[-]", formatting.GetErrorColor(), err))
-				} else {
+				var updatedIssue *parser.Issue
+				h.Runner.Run("Removing label from "+issueID, func(ctx context.Context) error {
+					var err error
+					updatedIssue, err = execBdJSONIssue(ctx, "label", "remove", issueID, labelToRemove)
+					return err
+				}, func(err error) {
+					if err != nil {
+						log.Printf("BD COMMAND ERROR: Label remove failed: %v", err)
+						h.ShowErrorOverlay("Error removing label", err)
+						return
+					}
 					log.Printf("BD COMMAND: Label removed successfully from %s", updatedIssue.ID)
-					h.StatusBar.SetText(fmt.Sprintf("[%s]✓ Removed label [%s]'%s'[-][-]", formatting.GetSuccessColor(), formatting.GetEmphasisColor(), labelToRemove))
-					h.Pages.RemovePage("label_dialog")
-					h.App.SetFocus(h.IssueList)
+					h.Undo.Push(undoLabel(issueID, labelToRemove, false))
+					h.Notify.Success(fmt.Sprintf("Removed label [%s]'%s'[-]", formatting.GetEmphasisColor(), labelToRemove))
+					dialog.Close()
 					h.ScheduleRefresh(issueID)
-				}
+				})
 			})
 		}
 	}
 
-	// Close button
-	form.AddButton("Close", func() {
-		h.Pages.RemovePage("label_dialog")
-		h.App.SetFocus(h.IssueList)
-	})
-
-	form.SetBorder(true).SetTitle(" Manage Labels ").SetTitleAlign(tview.AlignCenter)
-	form.SetCancelFunc(func() {
-		h.Pages.RemovePage("label_dialog")
-		h.App.SetFocus(h.IssueList)
-	})
-
-	// Create modal (centered)
-	modal := tview.NewFlex().
-		AddItem(nil, 0, 1, false).
-		AddItem(tview.NewFlex().SetDirection(tview.FlexRow).
-			AddItem(nil, 0, 1, false).
-			AddItem(form, 0, 3, true).
-			AddItem(nil, 0, 1, false), 0, 2, true).
-		AddItem(nil, 0, 1, false)
-
-	h.Pages.AddPage("label_dialog", modal, true, true)
-	h.App.SetFocus(form)
+	// Close button (secondary remove buttons follow it in focus order)
+	dialog.SetCancel("Close", nil).
+		SetSubmitOnEnter(true).
+		SetSize(2, 3)
+	dialog.Show()
 }
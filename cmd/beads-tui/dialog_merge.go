@@ -0,0 +1,117 @@
+package main
+
+import (
+	"context"
+	"fmt"
+	"log"
+	"strings"
+
+	"github.com/andy/beads-tui/internal/formatting"
+	"github.com/rivo/tview"
+)
+
+// ShowMergeDialog displays a dialog for merging a duplicate issue into a survivor
+func (h *DialogHelpers) ShowMergeDialog() {
+	// Get current issue (default survivor)
+	currentIndex := h.IssueList.GetCurrentItem()
+	issue, ok := (*h.IndexToIssue)[currentIndex]
+	if !ok {
+		h.Notify.Error("No issue selected")
+		return
+	}
+
+	dialog := h.newDialog("merge_dialog", "Merge Issues")
+	form := dialog.Form
+	survivorID := issue.ID
+	var duplicateID string
+
+	// Preview of the bd commands the merge will run
+	previewView := tview.NewTextView().
+		SetDynamicColors(true).
+		SetWrap(true)
+	previewView.SetBorder(true).SetTitle(" Plan ")
+
+	updatePreview := func() {
+		survivor := h.AppState.GetIssueByID(strings.TrimSpace(survivorID))
+		duplicate := h.AppState.GetIssueByID(strings.TrimSpace(duplicateID))
+		mutedColor := formatting.GetMutedColor()
+		switch {
+		case survivor == nil:
+			previewView.SetText(fmt.Sprintf("[%s]Enter an existing survivor issue ID[-]", mutedColor))
+		case duplicate == nil:
+			previewView.SetText(fmt.Sprintf("[%s]Enter an existing duplicate issue ID[-]", mutedColor))
+		case survivor.ID == duplicate.ID:
+			previewView.SetText(fmt.Sprintf("[%s]Survivor and duplicate must differ[-]", formatting.GetErrorColor()))
+		default:
+			steps := planMerge(survivor, withComments(h.Comments, duplicate), h.AppState.GetAllIssues())
+			var sb strings.Builder
+			sb.WriteString(fmt.Sprintf("[%s]Keep[-] %s - %s\n", formatting.GetSuccessColor(), survivor.ID, survivor.Title))
+			sb.WriteString(fmt.Sprintf("[%s]Close[-] %s - %s\n\n", formatting.GetWarningColor(), duplicate.ID, duplicate.Title))
+			for i, step := range steps {
+				sb.WriteString(fmt.Sprintf("%d. %s\n", i+1, tview.Escape(step.Description)))
+			}
+			previewView.SetText(sb.String())
+		}
+	}
+
+	form.AddInputField("Survivor ID", survivorID, 20, nil, func(text string) {
+		survivorID = text
+		updatePreview()
+	})
+	form.AddInputField("Duplicate ID", "", 20, nil, func(text string) {
+		duplicateID = text
+		updatePreview()
+	})
+
+	mergeIssues := func() {
+		survivor := h.AppState.GetIssueByID(strings.TrimSpace(survivorID))
+		duplicate := h.AppState.GetIssueByID(strings.TrimSpace(duplicateID))
+		if survivor == nil || duplicate == nil {
+			h.Notify.Error("Both survivor and duplicate must be existing issues")
+			return
+		}
+		if survivor.ID == duplicate.ID {
+			h.Notify.Error("Cannot merge an issue into itself")
+			return
+		}
+
+		steps := planMerge(survivor, withComments(h.Comments, duplicate), h.AppState.GetAllIssues())
+		survivorIssueID := survivor.ID // Capture before potential refresh
+		duplicateIssueID := duplicate.ID
+		completed := 0
+		h.Runner.Run(fmt.Sprintf("Merging %s into %s", duplicateIssueID, survivorIssueID), func(ctx context.Context) error {
+			for i, step := range steps {
+				log.Printf("BD COMMAND: Merge step %d/%d (%s): bd %s", i+1, len(steps), step.Description, strings.Join(step.Args, " "))
+				if _, err := execBdJSON(ctx, step.Args...); err != nil {
+					return err
+				}
+				completed++
+			}
+			return nil
+		}, func(err error) {
+			if err != nil {
+				failed := steps[completed]
+				log.Printf("BD COMMAND ERROR: Merge step failed: %v", err)
+				h.ShowErrorOverlay(fmt.Sprintf("Merge stopped at step %d/%d (%s)", completed+1, len(steps), failed.Description), err)
+				if completed > 0 {
+					h.ScheduleRefresh(survivorIssueID)
+				}
+				return
+			}
+
+			log.Printf("BD COMMAND: Merged %s into %s (%d steps)", duplicateIssueID, survivorIssueID, len(steps))
+			h.Notify.Success(fmt.Sprintf("Merged %s into [%s]%s[-]",
+				duplicateIssueID, formatting.GetAccentColor(), survivorIssueID))
+			dialog.Close()
+			h.ScheduleRefresh(survivorIssueID)
+		})
+	}
+
+	updatePreview()
+
+	dialog.SetPrimary("Merge", mergeIssues).
+		SetCancel("Cancel", nil).
+		SetFooter(previewView, 0).
+		SetSize(3, 3)
+	dialog.Show()
+}
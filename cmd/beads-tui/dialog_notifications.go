@@ -0,0 +1,58 @@
+package main
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/andy/beads-tui/internal/formatting"
+	"github.com/andy/beads-tui/internal/ui"
+	"github.com/andy/beads-tui/internal/ui/notify"
+	"github.com/gdamore/tcell/v2"
+	"github.com/rivo/tview"
+)
+
+// ShowNotifications lists recent status bar notifications, newest first, so
+// a message that was replaced or expired before it could be read can still
+// be found
+func (h *DialogHelpers) ShowNotifications() {
+	mutedColor := formatting.GetMutedColor()
+	textView := tview.NewTextView().
+		SetDynamicColors(true).
+		SetText(formatNotifications(h.Notify.Recent(), mutedColor))
+	textView.SetBorder(true).
+		SetTitle(" Recent Notifications ").
+		SetTitleAlign(tview.AlignCenter)
+
+	footer := tview.NewTextView().
+		SetDynamicColors(true).
+		SetText(fmt.Sprintf("[%s]j/k scroll · Esc close[-]", mutedColor))
+
+	content := tview.NewFlex().SetDirection(tview.FlexRow).
+		AddItem(textView, 0, 1, true).
+		AddItem(footer, 1, 0, false)
+
+	modal := ui.CenterModal(content, 2, 3)
+	modal.SetInputCapture(func(event *tcell.EventKey) *tcell.EventKey {
+		if event.Key() == tcell.KeyEscape || (event.Key() == tcell.KeyRune && event.Rune() == 'q') {
+			h.Pages.RemovePage("notifications")
+			h.App.SetFocus(h.IssueList)
+			return nil
+		}
+		return event
+	})
+
+	h.Pages.AddPage("notifications", modal, true, true)
+	h.App.SetFocus(textView)
+}
+
+// formatNotifications renders one line per notification with its time
+func formatNotifications(recent []notify.Notification, mutedColor string) string {
+	if len(recent) == 0 {
+		return fmt.Sprintf("[%s]No notifications yet[-]", mutedColor)
+	}
+	var sb strings.Builder
+	for _, n := range recent {
+		sb.WriteString(fmt.Sprintf("[%s]%s[-] %s\n", mutedColor, n.Time.Format("15:04:05"), n.Format()))
+	}
+	return strings.TrimSuffix(sb.String(), "\n")
+}
@@ -0,0 +1,74 @@
+package main
+
+import (
+	"fmt"
+
+	"github.com/andy/beads-tui/internal/formatting"
+	"github.com/andy/beads-tui/internal/parser"
+	"github.com/andy/beads-tui/internal/theme"
+	"github.com/andy/beads-tui/internal/ui"
+	"github.com/gdamore/tcell/v2"
+	"github.com/rivo/tview"
+)
+
+// ShowRecentIssues lists the issues recently shown in the detail panel, most
+// recent first (issues deleted since are left out). Enter closes the overlay
+// and calls jump with the selected issue's ID.
+func (h *DialogHelpers) ShowRecentIssues(issueIDs []string, jump func(issueID string)) {
+	var issues []*parser.Issue
+	for _, id := range issueIDs {
+		if issue := h.AppState.GetIssueByID(id); issue != nil {
+			issues = append(issues, issue)
+		}
+	}
+
+	currentTheme := theme.Current()
+	table := tview.NewTable().
+		SetSelectable(true, false).
+		SetSelectedStyle(tcell.StyleDefault.Background(currentTheme.SelectionBg()).Foreground(currentTheme.SelectionFg()))
+	table.SetBorder(true).
+		SetTitle(fmt.Sprintf(" Recent Issues (%d) ", len(issues))).
+		SetTitleAlign(tview.AlignCenter)
+
+	mutedColor := formatting.GetMutedColor()
+	if len(issues) == 0 {
+		table.SetCell(0, 0, tview.NewTableCell(fmt.Sprintf("[%s]No issues viewed yet[-]", mutedColor)).SetSelectable(false))
+	}
+	for row, issue := range issues {
+		table.SetCell(row, 0, tview.NewTableCell(fmt.Sprintf("[%s]%s[-] %s",
+			formatting.GetAccentColor(), issue.ID, tview.Escape(issue.Title))).SetExpansion(1))
+		table.SetCell(row, 1, tview.NewTableCell(fmt.Sprintf("[%s]%s[-]",
+			formatting.GetStatusColor(issue.Status), issue.Status)))
+	}
+
+	footer := tview.NewTextView().
+		SetDynamicColors(true).
+		SetText(fmt.Sprintf("[%s]Enter jump · Esc close · g; and g, go back and forward[-]", mutedColor))
+
+	content := tview.NewFlex().SetDirection(tview.FlexRow).
+		AddItem(table, 0, 1, true).
+		AddItem(footer, 1, 0, false)
+
+	closeRecent := func() {
+		h.Pages.RemovePage("recent_issues")
+		h.App.SetFocus(h.IssueList)
+	}
+	table.SetSelectedFunc(func(row, column int) {
+		if row < len(issues) {
+			closeRecent()
+			jump(issues[row].ID)
+		}
+	})
+
+	modal := ui.CenterModal(content, 2, 2)
+	modal.SetInputCapture(func(event *tcell.EventKey) *tcell.EventKey {
+		if event.Key() == tcell.KeyEscape || (event.Key() == tcell.KeyRune && event.Rune() == 'q') {
+			closeRecent()
+			return nil
+		}
+		return event
+	})
+
+	h.Pages.AddPage("recent_issues", modal, true, true)
+	h.App.SetFocus(table)
+}
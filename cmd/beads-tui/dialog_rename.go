@@ -1,10 +1,14 @@
 package main
 
 import (
+	"context"
 	"fmt"
 	"log"
+	"strings"
 
 	"github.com/andy/beads-tui/internal/formatting"
+	"github.com/andy/beads-tui/internal/parser"
+	"github.com/andy/beads-tui/internal/theme"
 	"github.com/gdamore/tcell/v2"
 	"github.com/rivo/tview"
 )
@@ -15,11 +19,12 @@ func (h *DialogHelpers) ShowRenameDialog() {
 	currentIndex := h.IssueList.GetCurrentItem()
 	issue, ok := (*h.IndexToIssue)[currentIndex]
 	if !ok {
-		h.StatusBar.SetText(fmt.Sprintf("[%s]No issue selected[-]", formatting.GetErrorColor()))
+		h.Notify.Error("No issue selected")
 		return
 	}
 
-	form := tview.NewForm()
+	dialog := h.newDialog("rename_dialog", "Rename Issue")
+	form := dialog.Form
 	var newTitle string
 
 	form.AddTextView("Renaming issue", issue.ID, 0, 1, false, false)
@@ -27,78 +32,102 @@ func (h *DialogHelpers) ShowRenameDialog() {
 		newTitle = text
 	})
 
-	form.AddButton("Save (Ctrl-S)", func() {
+	// Define save function to be used by both button and Ctrl-S
+	saveTitle := func() {
 		if newTitle == "" {
-			h.StatusBar.SetText(fmt.Sprintf("[%s]Error: Title cannot be empty[-]", formatting.GetErrorColor()))
+			h.Notify.Error("Title cannot be empty")
 			return
 		}
 
-		// Execute bd update command with --json
-		log.Printf("BD COMMAND: Renaming issue: bd update %s --title %q", issue.ID, newTitle)
-		updatedIssue, err := execBdJSONIssue("update", issue.ID, "--title", newTitle)
-		if err != nil {
-			log.Printf("BD COMMAND ERROR: Rename failed: %v", err)
-			h.StatusBar.SetText(fmt.Sprintf("[%s]Error renaming issue: %v[-]", formatting.GetErrorColor(), err))
-		} else {
-			log.Printf("BD COMMAND: Issue renamed successfully: %s", updatedIssue.Title)
-			h.StatusBar.SetText(fmt.Sprintf("[%s]✓ Renamed %s[-]", formatting.GetSuccessColor(), updatedIssue.ID))
-
-			// Close dialog
-			h.Pages.RemovePage("rename_dialog")
-			h.App.SetFocus(h.IssueList)
-
-			// Refresh issues after a short delay, preserving selection
-			issueID := issue.ID
-			h.ScheduleRefresh(issueID)
-		}
-	})
-	form.AddButton("Cancel", func() {
-		h.Pages.RemovePage("rename_dialog")
-		h.App.SetFocus(h.IssueList)
-	})
+		h.renameIssue(issue, newTitle, dialog.Close)
+	}
 
-	form.SetBorder(true).SetTitle(" Rename Issue ").SetTitleAlign(tview.AlignCenter)
-	form.SetCancelFunc(func() {
-		h.Pages.RemovePage("rename_dialog")
-		h.App.SetFocus(h.IssueList)
-	})
+	dialog.SetPrimary("Save", saveTitle).
+		SetCancel("Cancel", nil).
+		SetFixedSize(80, 12)
+	dialog.Show()
+}
 
-	// Add Ctrl-S handler for save
-	form.SetInputCapture(func(event *tcell.EventKey) *tcell.EventKey {
-		if event.Key() == tcell.KeyCtrlS {
-			// Save directly
-			if newTitle == "" {
-				h.StatusBar.SetText(fmt.Sprintf("[%s]Error: Title cannot be empty[-]", formatting.GetErrorColor()))
-				return nil
-			}
+// ShowInlineRename turns the selected list row into an input field holding
+// the title: Enter saves, Esc cancels. Quicker than the rename dialog for
+// small tweaks.
+func (h *DialogHelpers) ShowInlineRename() {
+	currentIndex := h.IssueList.GetCurrentItem()
+	issue, ok := (*h.IndexToIssue)[currentIndex]
+	if !ok {
+		h.Notify.Error("No issue selected")
+		return
+	}
 
-			log.Printf("BD COMMAND: Renaming issue: bd update %s --title %q", issue.ID, newTitle)
-			updatedIssue, err := execBdJSONIssue("update", issue.ID, "--title", newTitle)
-			if err != nil {
-				log.Printf("BD COMMAND ERROR: Rename failed: %v", err)
-				h.StatusBar.SetText(fmt.Sprintf("[%s]Error renaming issue: %v[-]", formatting.GetErrorColor(), err))
-			} else {
-				log.Printf("BD COMMAND: Issue renamed successfully: %s", updatedIssue.Title)
-				h.StatusBar.SetText(fmt.Sprintf("[%s]✓ Renamed %s[-]", formatting.GetSuccessColor(), updatedIssue.ID))
-				h.Pages.RemovePage("rename_dialog")
-				h.App.SetFocus(h.IssueList)
-				issueID := issue.ID
-				h.ScheduleRefresh(issueID)
+	// Cover the selected row (one line per item, starting at the list's scroll offset)
+	x, y, width, height := h.IssueList.GetInnerRect()
+	itemOffset, _ := h.IssueList.GetOffset()
+	row := currentIndex - itemOffset
+	if row < 0 || row >= height {
+		return
+	}
+
+	currentTheme := theme.Current()
+	input := tview.NewInputField().
+		SetLabel(issue.ID + " ").
+		SetLabelColor(currentTheme.SelectionBg()).
+		SetText(issue.Title).
+		SetFieldBackgroundColor(currentTheme.SelectionBg()).
+		SetFieldTextColor(currentTheme.SelectionFg())
+	input.SetRect(x, y+row, width, 1)
+
+	closeInput := func() {
+		h.Pages.RemovePage("inline_rename")
+		h.App.SetFocus(h.IssueList)
+	}
+	input.SetDoneFunc(func(key tcell.Key) {
+		switch key {
+		case tcell.KeyEscape:
+			closeInput()
+		case tcell.KeyEnter:
+			newTitle := strings.TrimSpace(input.GetText())
+			if newTitle == issue.Title {
+				closeInput()
+				return
+			}
+			if newTitle == "" {
+				h.Notify.Error("Title cannot be empty")
+				return
 			}
-			return nil
+			h.renameIssue(issue, newTitle, closeInput)
 		}
-		return event
 	})
 
-	// Create modal (centered)
-	modal := tview.NewFlex().
-		AddItem(nil, 0, 1, false).
-		AddItem(tview.NewFlex().SetDirection(tview.FlexRow).
-			AddItem(nil, 0, 1, false).
-			AddItem(form, 12, 1, true).
-			AddItem(nil, 0, 1, false), 80, 1, true).
-		AddItem(nil, 0, 1, false)
-
-	h.Pages.AddPage("rename_dialog", modal, true, true)
-	h.App.SetFocus(form)
+	h.Pages.AddPage("inline_rename", input, false, true)
+	h.App.SetFocus(input)
+	h.StatusBar.SetText(fmt.Sprintf("[%s]Rename %s: Enter to save, Esc to cancel[-]", formatting.GetEmphasisColor(), issue.ID))
+}
+
+// renameIssue sets issue's title with bd update, calling onSuccess (e.g. to
+// close the editor) once it succeeds
+func (h *DialogHelpers) renameIssue(issue *parser.Issue, newTitle string, onSuccess func()) {
+	// Execute bd update command with --json
+	issueID := issue.ID // Capture before potential refresh
+	undo := undoFields("rename "+issueID, issue, []string{"--title", newTitle})
+	log.Printf("BD COMMAND: Renaming issue: bd update %s --title %q", issueID, newTitle)
+	var updatedIssue *parser.Issue
+	h.Runner.Run("Renaming "+issueID, func(ctx context.Context) error {
+		var err error
+		updatedIssue, err = execBdJSONIssue(ctx, "update", issueID, "--title", newTitle)
+		return err
+	}, func(err error) {
+		if err != nil {
+			log.Printf("BD COMMAND ERROR: Rename failed: %v", err)
+			h.ShowErrorOverlay("Error renaming issue", err)
+			return
+		}
+		log.Printf("BD COMMAND: Issue renamed successfully: %s", updatedIssue.Title)
+		h.Undo.Push(undo)
+		h.Notify.Success(fmt.Sprintf("Renamed %s", updatedIssue.ID))
+
+		onSuccess()
+
+		// Refresh issues after a short delay, preserving selection
+		h.ScheduleRefresh(issueID)
+	})
 }
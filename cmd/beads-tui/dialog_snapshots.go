@@ -0,0 +1,156 @@
+package main
+
+import (
+	"context"
+	"fmt"
+	"log"
+	"strings"
+	"time"
+
+	"github.com/andy/beads-tui/internal/formatting"
+	"github.com/andy/beads-tui/internal/parser"
+	"github.com/andy/beads-tui/internal/ui"
+	"github.com/gdamore/tcell/v2"
+	"github.com/rivo/tview"
+)
+
+// snapshotTextLines caps the removed and added lines shown for each text
+// field in a revision
+const snapshotTextLines = 6
+
+// ShowSnapshotDiff shows how the selected issue changed across the last
+// few refreshes, field by field, newest first, with who made each change
+// going by the audit trail (or the comments made at the time). For edits
+// made outside the TUI by teammates or agents.
+func (h *DialogHelpers) ShowSnapshotDiff() {
+	currentIndex := h.IssueList.GetCurrentItem()
+	issue, ok := (*h.IndexToIssue)[currentIndex]
+	if !ok {
+		h.Notify.Error("No issue selected")
+		return
+	}
+
+	issueID := issue.ID
+	revisions := h.Snapshots.Revisions(issueID)
+	count, oldest := h.Snapshots.Span()
+	mutedColor := formatting.GetMutedColor()
+
+	textView := tview.NewTextView().
+		SetDynamicColors(true).
+		SetWrap(true)
+	textView.SetBorder(true).
+		SetTitle(fmt.Sprintf(" Recent Changes: %s ", issueID)).
+		SetTitleAlign(tview.AlignCenter)
+	render := func(authors [][]string) {
+		textView.SetText(renderRevisions(issue, revisions, authors, count, oldest)).ScrollToBeginning()
+	}
+	render(nil)
+
+	footer := tview.NewTextView().
+		SetDynamicColors(true).
+		SetText(fmt.Sprintf("[%s]j/k scroll · Esc close[-]", mutedColor))
+
+	content := tview.NewFlex().SetDirection(tview.FlexRow).
+		AddItem(textView, 0, 1, true).
+		AddItem(footer, 1, 0, false)
+
+	modal := ui.CenterModal(content, 2, 3)
+	modal.SetInputCapture(func(event *tcell.EventKey) *tcell.EventKey {
+		if event.Key() == tcell.KeyEscape || (event.Key() == tcell.KeyRune && event.Rune() == 'q') {
+			h.Pages.RemovePage("snapshot_diff")
+			h.App.SetFocus(h.IssueList)
+			return nil
+		}
+		return event
+	})
+
+	h.Pages.AddPage("snapshot_diff", modal, true, true)
+	h.App.SetFocus(textView)
+
+	if len(revisions) == 0 || h.DB == nil {
+		return
+	}
+	// Who made each change, read off the UI goroutine
+	go func() {
+		ctx, cancel := context.WithTimeout(context.Background(), 5*time.Second)
+		defer cancel()
+		events, err := h.DB.LoadEvents(ctx, issueID)
+		if err != nil {
+			log.Printf("SNAPSHOTS: Failed to load events for %s: %v", issueID, err)
+		}
+		var comments []*parser.Comment
+		if h.Comments != nil {
+			if comments, err = h.Comments.Comments(ctx, issue); err != nil {
+				log.Printf("SNAPSHOTS: Failed to load comments for %s: %v", issueID, err)
+			}
+		}
+		authors := make([][]string, len(revisions))
+		for i, revision := range revisions {
+			authors[i] = revisionAuthors(revision, events, comments)
+		}
+		h.App.QueueUpdateDraw(func() {
+			render(authors)
+		})
+	}()
+}
+
+// renderRevisions formats an issue's revisions for the overlay. authors
+// holds who made each revision, once known.
+func renderRevisions(issue *parser.Issue, revisions []issueRevision, authors [][]string, count int, oldest time.Time) string {
+	mutedColor := formatting.GetMutedColor()
+	var sb strings.Builder
+	fmt.Fprintf(&sb, "[%s::b]%s[-::-] %s\n", formatting.GetAccentColor(), issue.ID, tview.Escape(issue.Title))
+	span := "no refreshes yet"
+	switch {
+	case count == 1:
+		span = "one refresh, at " + formatSnapshotTime(oldest)
+	case count > 1:
+		span = fmt.Sprintf("the last %d refreshes, since %s", count, formatSnapshotTime(oldest))
+	}
+	fmt.Fprintf(&sb, "[%s]Compared across %s[-]\n", mutedColor, span)
+	if len(revisions) == 0 {
+		fmt.Fprintf(&sb, "\n[%s]No changes seen in that time. Changes show up here as refreshes pick them up.[-]\n", mutedColor)
+		return sb.String()
+	}
+
+	for i, revision := range revisions {
+		by := ""
+		if i < len(authors) && len(authors[i]) > 0 {
+			by = " · by " + tview.Escape(strings.Join(authors[i], ", "))
+		}
+		fmt.Fprintf(&sb, "\n[%s::b]Between %s and %s[-::-][%s]%s[-]\n", formatting.GetEmphasisColor(),
+			formatSnapshotTime(revision.Since), formatSnapshotTime(revision.At), mutedColor, by)
+		for _, change := range revision.Changes {
+			if !change.Text {
+				fmt.Fprintf(&sb, "  %-13s [%s]%s[-] → [%s]%s[-]\n", change.Field,
+					formatting.GetErrorColor(), snapshotValue(change.Old), formatting.GetSuccessColor(), snapshotValue(change.New))
+				continue
+			}
+			removed, added := lineChanges(change.Old, change.New)
+			fmt.Fprintf(&sb, "  %-13s [%s]-%d +%d lines[-]\n", change.Field, mutedColor, len(removed), len(added))
+			writeChangedLines(&sb, "-", formatting.GetErrorColor(), removed)
+			writeChangedLines(&sb, "+", formatting.GetSuccessColor(), added)
+		}
+	}
+	return sb.String()
+}
+
+// snapshotValue escapes a field value for the overlay, showing an empty
+// one as (none)
+func snapshotValue(value string) string {
+	if value == "" {
+		return "(none)"
+	}
+	return tview.Escape(value)
+}
+
+// writeChangedLines writes up to snapshotTextLines removed or added lines
+func writeChangedLines(sb *strings.Builder, sign, color string, lines []string) {
+	for i, line := range lines {
+		if i == snapshotTextLines {
+			fmt.Fprintf(sb, "    [%s]… %d more[-]\n", formatting.GetMutedColor(), len(lines)-i)
+			return
+		}
+		fmt.Fprintf(sb, "    [%s]%s %s[-]\n", color, sign, tview.Escape(line))
+	}
+}
@@ -0,0 +1,118 @@
+package main
+
+import (
+	"context"
+	"fmt"
+	"log"
+	"strings"
+
+	"github.com/andy/beads-tui/internal/formatting"
+	"github.com/andy/beads-tui/internal/parser"
+)
+
+// ShowSplitIssueDialog displays a dialog for splitting the current issue into child issues
+func (h *DialogHelpers) ShowSplitIssueDialog() {
+	// Get current issue
+	currentIndex := h.IssueList.GetCurrentItem()
+	issue, ok := (*h.IndexToIssue)[currentIndex]
+	if !ok {
+		h.Notify.Error("No issue selected")
+		return
+	}
+
+	if issue.Status == parser.StatusClosed {
+		h.Notify.Warn("Cannot split a closed issue")
+		return
+	}
+	if !h.requireBd(featureChildIssues) {
+		return
+	}
+
+	dialog := h.newDialog("split_dialog", "Split Issue into Children")
+	form := dialog.Form
+	var childrenText string
+	copySections := true
+	canConvert := issue.IssueType != parser.TypeEpic && h.supportsBd(featureChangeType)
+	convertToEpic := canConvert
+
+	helpText := fmt.Sprintf("[%s]One child per line (%d-%d). Optional tags: [p0]-[p4], [bug] [feature] [task] [epic] [chore][-]",
+		formatting.GetMutedColor(), minSplitChildren, maxSplitChildren)
+
+	form.AddTextView("Splitting", issue.ID+" - "+issue.Title, 0, 2, false, false)
+	form.AddTextView("", helpText, 0, 2, false, false)
+	form.AddTextArea("Children", "", 60, 7, 0, func(text string) {
+		childrenText = text
+	})
+	form.AddCheckbox("Copy relevant parent sections", copySections, func(checked bool) {
+		copySections = checked
+	})
+	if canConvert {
+		form.AddCheckbox("Convert parent to epic", convertToEpic, func(checked bool) {
+			convertToEpic = checked
+		})
+	}
+
+	// Define split function to be used by both button and Ctrl-S
+	splitIssue := func() {
+		specs, err := parseSplitLines(childrenText, issue.Priority, string(parser.TypeTask))
+		if err != nil {
+			h.Notify.Error(err.Error())
+			return
+		}
+
+		issueID := issue.ID // Capture before potential refresh
+		var createdIDs []string
+		var failedTitle string
+		var epicErr error
+		h.Runner.Run(fmt.Sprintf("Splitting %s into %d children", issueID, len(specs)), func(ctx context.Context) error {
+			for _, spec := range specs {
+				args := []string{"create", spec.Title,
+					"-p", fmt.Sprintf("%d", spec.Priority),
+					"-t", spec.IssueType,
+					"--parent", issueID,
+					"--description", buildSplitChildDescription(issue, spec.Title, copySections),
+				}
+				log.Printf("BD COMMAND: Creating split child: bd %s", strings.Join(args, " "))
+				created, err := execBdJSONIssue(ctx, args...)
+				if err != nil {
+					failedTitle = spec.Title
+					return err
+				}
+				createdIDs = append(createdIDs, created.ID)
+			}
+
+			if convertToEpic && issue.IssueType != parser.TypeEpic {
+				log.Printf("BD COMMAND: Converting parent to epic: bd update %s --type epic", issueID)
+				_, epicErr = execBdJSONIssue(ctx, "update", issueID, "--type", string(parser.TypeEpic))
+			}
+			return nil
+		}, func(err error) {
+			if err != nil {
+				log.Printf("BD COMMAND ERROR: Split child creation failed: %v", err)
+				h.ShowErrorOverlay(fmt.Sprintf("Error creating '%s' (created %d of %d)", failedTitle, len(createdIDs), len(specs)), err)
+				if len(createdIDs) > 0 {
+					h.ScheduleRefresh(issueID)
+				}
+				return
+			}
+
+			dialog.Close()
+			h.ScheduleRefresh(issueID)
+
+			if epicErr != nil {
+				log.Printf("BD COMMAND ERROR: Epic conversion failed: %v", epicErr)
+				h.ShowErrorOverlay(fmt.Sprintf("Created %s but failed to convert %s to epic", strings.Join(createdIDs, ", "), issueID), epicErr)
+				return
+			}
+
+			log.Printf("BD COMMAND: Split %s into %d children: %s", issueID, len(createdIDs), strings.Join(createdIDs, ", "))
+			h.Notify.Success(fmt.Sprintf("Split [%s]%s[-] into %s",
+				formatting.GetAccentColor(), issueID, strings.Join(createdIDs, ", ")))
+		})
+	}
+
+	dialog.SetPrimary("Split", splitIssue).
+		SetCancel("Cancel", nil).
+		SetSize(3, 3)
+	dialog.Show()
+}
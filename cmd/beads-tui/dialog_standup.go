@@ -0,0 +1,163 @@
+package main
+
+import (
+	"context"
+	"fmt"
+	"log"
+	"time"
+
+	"github.com/andy/beads-tui/internal/formatting"
+	"github.com/andy/beads-tui/internal/parser"
+	"github.com/andy/beads-tui/internal/standup"
+	"github.com/andy/beads-tui/internal/theme"
+	"github.com/andy/beads-tui/internal/ui"
+	"github.com/atotto/clipboard"
+	"github.com/gdamore/tcell/v2"
+	"github.com/rivo/tview"
+)
+
+// ShowStandup summarizes the activity since the standup window began,
+// grouped by assignee: issues closed, started, and created, and in-progress
+// issues that weren't updated in the window. Tab widens the window, y copies
+// the summary as Markdown, and Enter closes the overlay and calls jump with
+// the selected issue's ID.
+func (h *DialogHelpers) ShowStandup(jump func(issueID string)) {
+	currentTheme := theme.Current()
+	table := tview.NewTable().
+		SetSelectable(true, false).
+		SetSelectedStyle(tcell.StyleDefault.Background(currentTheme.SelectionBg()).Foreground(currentTheme.SelectionFg()))
+	table.SetBorder(true).SetTitleAlign(tview.AlignCenter)
+
+	mutedColor := formatting.GetMutedColor()
+	footer := tview.NewTextView().SetDynamicColors(true)
+	hint := fmt.Sprintf("[%s]Enter jump · Tab change window · y copy Markdown · Esc close[-]", mutedColor)
+
+	windows := standupWindows(h.StandupWindow)
+	current := 0
+	var summary standup.Summary
+	rowIssues := make(map[int]*parser.Issue)
+
+	render := func() {
+		now := time.Now()
+		since := now.Add(-windows[current])
+		var changes []*parser.Event
+		if h.DB != nil {
+			ctx, cancel := context.WithTimeout(context.Background(), 5*time.Second)
+			var err error
+			changes, err = h.DB.LoadStatusChanges(ctx, since)
+			cancel()
+			if err != nil {
+				log.Printf("STANDUP: Failed to load status changes: %v", err)
+				changes = nil
+			}
+		}
+		summary = standup.Build(h.AppState.GetAllIssues(), changes, since, now)
+
+		closed, started, created, stale := summary.Totals()
+		table.SetTitle(fmt.Sprintf(" Standup: last %s · %d closed · %d started · %d new · %d stale ",
+			formatStandupWindow(windows[current]), closed, started, created, stale))
+		table.Clear()
+		clear(rowIssues)
+
+		if len(summary.Groups) == 0 {
+			table.SetCell(0, 0, tview.NewTableCell(fmt.Sprintf("[%s]No activity since %s[-]", mutedColor, since.Format("Mon Jan 2 15:04"))).SetSelectable(false))
+		}
+		row := 0
+		for i, group := range summary.Groups {
+			if i > 0 {
+				table.SetCell(row, 0, tview.NewTableCell("").SetSelectable(false))
+				row++
+			}
+			table.SetCell(row, 0, tview.NewTableCell(fmt.Sprintf("[%s::b]%s[-::-] [%s](%d)[-]",
+				formatting.GetEmphasisColor(), tview.Escape(group.Assignee), mutedColor, group.Count())).SetSelectable(false))
+			row++
+			for _, category := range standupCategories(group) {
+				if len(category.Issues) == 0 {
+					continue
+				}
+				table.SetCell(row, 0, tview.NewTableCell(fmt.Sprintf("  [%s]%s[-]", standupCategoryColor(category), category.Label)).SetSelectable(false))
+				row++
+				for _, issue := range category.Issues {
+					detail := ""
+					if category.Stale {
+						detail = fmt.Sprintf(" [%s](no update since %s)[-]", mutedColor, issue.UpdatedAt.Format("Jan 2"))
+					}
+					table.SetCell(row, 0, tview.NewTableCell(fmt.Sprintf("    [%s]%s[-] [%s]P%d[-] %s%s",
+						formatting.GetAccentColor(), issue.ID,
+						formatting.GetPriorityColor(issue.Priority), issue.Priority,
+						tview.Escape(issue.Title), detail)).SetExpansion(1))
+					rowIssues[row] = issue
+					row++
+				}
+			}
+		}
+
+		footer.SetText(hint)
+		if !summary.FromEvents && len(summary.Groups) > 0 {
+			footer.SetText(fmt.Sprintf("[%s]No audit trail in the database: Started shows in-progress issues updated in the window[-] · %s", formatting.GetWarningColor(), hint))
+		}
+		// Start on the first issue rather than a heading
+		for r := 0; r < row; r++ {
+			if _, ok := rowIssues[r]; ok {
+				table.Select(r, 0)
+				break
+			}
+		}
+		table.ScrollToBeginning()
+	}
+	render()
+
+	content := tview.NewFlex().SetDirection(tview.FlexRow).
+		AddItem(table, 0, 1, true).
+		AddItem(footer, 1, 0, false)
+
+	closeStandup := func() {
+		h.Pages.RemovePage("standup")
+		h.App.SetFocus(h.IssueList)
+	}
+	table.SetSelectedFunc(func(row, column int) {
+		if issue, ok := rowIssues[row]; ok {
+			closeStandup()
+			jump(issue.ID)
+		}
+	})
+
+	modal := ui.CenterModal(content, 2, 2)
+	modal.SetInputCapture(func(event *tcell.EventKey) *tcell.EventKey {
+		switch {
+		case event.Key() == tcell.KeyEscape || (event.Key() == tcell.KeyRune && event.Rune() == 'q'):
+			closeStandup()
+			return nil
+		case event.Key() == tcell.KeyTab:
+			current = (current + 1) % len(windows)
+			render()
+			return nil
+		case event.Key() == tcell.KeyRune && event.Rune() == 'y':
+			if err := clipboard.WriteAll(standupMarkdown(summary)); err != nil {
+				log.Printf("CLIPBOARD ERROR: Failed to copy standup: %v", err)
+				footer.SetText(fmt.Sprintf("[%s]Failed to copy: %v[-]", formatting.GetErrorColor(), err))
+			} else {
+				footer.SetText(fmt.Sprintf("[%s]✓ Copied standup summary to clipboard[-]", formatting.GetSuccessColor()))
+			}
+			return nil
+		}
+		return event
+	})
+
+	h.Pages.AddPage("standup", modal, true, true)
+	h.App.SetFocus(table)
+}
+
+// standupCategoryColor colors a category heading in the standup overlay
+func standupCategoryColor(category standupCategory) string {
+	switch category.Label {
+	case "Closed":
+		return formatting.GetStatusColor(parser.StatusClosed)
+	case "Started":
+		return formatting.GetStatusColor(parser.StatusInProgress)
+	case "New":
+		return formatting.GetSuccessColor()
+	default:
+		return formatting.GetWarningColor()
+	}
+}
@@ -2,16 +2,22 @@ package main
 
 import (
 	"fmt"
+	"slices"
 	"strings"
+	"time"
 
 	"github.com/andy/beads-tui/internal/formatting"
+	"github.com/andy/beads-tui/internal/ui"
 	"github.com/andy/beads-tui/internal/parser"
+	"github.com/andy/beads-tui/internal/state"
+	"github.com/andy/beads-tui/internal/stats"
 	"github.com/gdamore/tcell/v2"
 	"github.com/rivo/tview"
 )
 
-// ShowStatsOverlay displays a statistics dashboard
-func (h *DialogHelpers) ShowStatsOverlay() {
+// ShowStatsOverlay displays a statistics dashboard. Pressing e switches to
+// the priority × estimate grid, whose cells call applyFilter.
+func (h *DialogHelpers) ShowStatsOverlay(applyFilter func(query string)) {
 	allIssues := h.AppState.GetAllIssues()
 
 	// Calculate statistics
@@ -126,8 +132,12 @@ func (h *DialogHelpers) ShowStatsOverlay() {
 	sb.WriteString(fmt.Sprintf("  Total:           %d\n", stats.totalDeps))
 	sb.WriteString(fmt.Sprintf("  Avg per issue:   %.2f\n", stats.avgDepsPerIssue))
 
+	writeTrends(&sb, allIssues, time.Now())
+	writeTimeTracking(&sb, allIssues, h.AppState)
+	writeEstimates(&sb, allIssues)
+
 	sb.WriteString(fmt.Sprintf("\n[%s]━━━━━━━━━━━━━━━━━━━━━━━━━━━━━━━━━━━━━━━━━━[-]\n", mutedColor))
-	sb.WriteString(fmt.Sprintf("[%s]Press ESC or S to close[-]", emphasisColor))
+	sb.WriteString(fmt.Sprintf("[%s]Press e for the priority × estimate grid · ESC or S to close[-]", emphasisColor))
 
 	// Create stats text view
 	statsTextView := tview.NewTextView().
@@ -139,13 +149,7 @@ func (h *DialogHelpers) ShowStatsOverlay() {
 		SetTitleAlign(tview.AlignCenter)
 
 	// Create modal (centered, slightly smaller than help)
-	modal := tview.NewFlex().
-		AddItem(nil, 0, 1, false).
-		AddItem(tview.NewFlex().SetDirection(tview.FlexRow).
-			AddItem(nil, 0, 1, false).
-			AddItem(statsTextView, 0, 2, true).
-			AddItem(nil, 0, 1, false), 0, 2, true).
-		AddItem(nil, 0, 1, false)
+	modal := ui.CenterModal(statsTextView, 2, 2)
 
 	// Add input capture to close on ESC, q, or S
 	modal.SetInputCapture(func(event *tcell.EventKey) *tcell.EventKey {
@@ -154,6 +158,11 @@ func (h *DialogHelpers) ShowStatsOverlay() {
 			h.App.SetFocus(h.IssueList)
 			return nil
 		}
+		if event.Key() == tcell.KeyRune && event.Rune() == 'e' {
+			h.Pages.RemovePage("stats")
+			h.ShowEstimateGrid(applyFilter)
+			return nil
+		}
 		return event
 	})
 
@@ -161,3 +170,119 @@ func (h *DialogHelpers) ShowStatsOverlay() {
 	h.Pages.AddPage("stats", modal, true, true)
 	h.App.SetFocus(modal)
 }
+
+// statsWeeks is how many weeks the flow and burndown charts cover
+const statsWeeks = 8
+
+// statsBarWidth is the width in cells of the longest chart bar
+const statsBarWidth = 20
+
+// writeTrends appends the time-series sections to the dashboard: opened vs
+// closed per week with the open backlog at the end of each week, time to
+// close, and the oldest open issues
+func writeTrends(sb *strings.Builder, issues []*parser.Issue, now time.Time) {
+	accentColor := formatting.GetAccentColor()
+	mutedColor := formatting.GetMutedColor()
+	openColor := formatting.GetStatusColor(parser.StatusOpen)
+	closedColor := formatting.GetStatusColor(parser.StatusClosed)
+
+	// Weekly flow, with bars scaled together so weeks compare at a glance
+	flow := stats.WeeklyFlow(issues, now, statsWeeks)
+	maxFlow, maxOpen := 0, 0
+	for _, w := range flow {
+		maxFlow = max(maxFlow, w.Opened, w.Closed)
+		maxOpen = max(maxOpen, w.Open)
+	}
+	sb.WriteString(fmt.Sprintf("\n[%s::b]Opened vs Closed (last %d weeks):[-::-]\n", accentColor, statsWeeks))
+	sb.WriteString(fmt.Sprintf("  [%s]Week of  Opened                   Closed                   Open[-]\n", mutedColor))
+	for _, w := range flow {
+		sb.WriteString(fmt.Sprintf("  %s  %3d [%s]%-*s[-] %3d [%s]%-*s[-] %4d [%s]%s[-]\n",
+			w.Start.Format("Jan 02"),
+			w.Opened, openColor, statsBarWidth, formatting.Bar(w.Opened, maxFlow, statsBarWidth),
+			w.Closed, closedColor, statsBarWidth, formatting.Bar(w.Closed, maxFlow, statsBarWidth),
+			w.Open, mutedColor, formatting.Bar(w.Open, maxOpen, statsBarWidth/2)))
+	}
+
+	// Time to close
+	closeTimes := stats.TimeToClose(issues)
+	sb.WriteString(fmt.Sprintf("\n[%s::b]Time to Close:[-::-]\n", accentColor))
+	if closeTimes.Count == 0 {
+		sb.WriteString(fmt.Sprintf("  [%s]No closed issues yet[-]\n", mutedColor))
+	} else {
+		sb.WriteString(fmt.Sprintf("  Average:         %s\n", formatDays(closeTimes.Average)))
+		sb.WriteString(fmt.Sprintf("  Median:          %s  [%s](%d closed issues)[-]\n", formatDays(closeTimes.Median), mutedColor, closeTimes.Count))
+	}
+
+	// Oldest open issues
+	oldest := stats.OldestOpen(issues, 5)
+	sb.WriteString(fmt.Sprintf("\n[%s::b]Oldest Open Issues:[-::-]\n", accentColor))
+	if len(oldest) == 0 {
+		sb.WriteString(fmt.Sprintf("  [%s]No open issues[-]\n", mutedColor))
+	}
+	for _, issue := range oldest {
+		sb.WriteString(fmt.Sprintf("  [%s]%s[-] [%s]%8s[-]  %s\n",
+			accentColor, issue.ID, mutedColor, formatDays(now.Sub(issue.CreatedAt)), tview.Escape(issue.Title)))
+	}
+}
+
+// writeTimeTracking appends logged time against estimates to the dashboard
+func writeTimeTracking(sb *strings.Builder, issues []*parser.Issue, appState *state.State) {
+	accentColor := formatting.GetAccentColor()
+	mutedColor := formatting.GetMutedColor()
+
+	logged := make(map[string]int)
+	for _, issue := range issues {
+		logged[issue.ID] = appState.LoggedMinutes(issue.ID).Own
+	}
+	tracking := stats.CompareToEstimates(issues, logged)
+
+	sb.WriteString(fmt.Sprintf("\n[%s::b]Time Tracking:[-::-]\n", accentColor))
+	if tracking.LoggedIssues == 0 {
+		sb.WriteString(fmt.Sprintf("  [%s]No time logged yet (press w on an issue)[-]\n", mutedColor))
+		return
+	}
+	sb.WriteString(fmt.Sprintf("  Logged:          %s  [%s](%d issues)[-]\n", formatting.FormatMinutes(tracking.LoggedMinutes), mutedColor, tracking.LoggedIssues))
+	if tracking.Compared > 0 {
+		sb.WriteString(fmt.Sprintf("  vs estimate:     %s logged / %s estimated (%d%%)  [%s](%d issues with both)[-]\n",
+			formatting.FormatMinutes(tracking.ComparedLogged), formatting.FormatMinutes(tracking.EstimatedMinutes),
+			tracking.ComparedLogged*100/tracking.EstimatedMinutes, mutedColor, tracking.Compared))
+	}
+	if len(tracking.OverEstimate) > 0 {
+		sb.WriteString(fmt.Sprintf("  [%s]Furthest over estimate:[-]\n", mutedColor))
+	}
+	for i, issue := range tracking.OverEstimate {
+		if i == 5 {
+			break
+		}
+		sb.WriteString(fmt.Sprintf("    [%s]%s[-] [%s]%s over[-]  %s\n", accentColor, issue.ID, formatting.GetWarningColor(),
+			formatting.FormatMinutes(logged[issue.ID]-*issue.EstimatedMinutes), tview.Escape(issue.Title)))
+	}
+}
+
+// writeEstimates appends the work estimated on open issues, by priority
+func writeEstimates(sb *strings.Builder, issues []*parser.Issue) {
+	accentColor := formatting.GetAccentColor()
+	mutedColor := formatting.GetMutedColor()
+
+	estimates := stats.EstimateOpenWork(issues)
+	sb.WriteString(fmt.Sprintf("\n[%s::b]Estimates (open work):[-::-]\n", accentColor))
+	if estimates.Issues == 0 {
+		sb.WriteString(fmt.Sprintf("  [%s]No open issues have an estimate[-]\n", mutedColor))
+		return
+	}
+	sb.WriteString(fmt.Sprintf("  Total:           %s  [%s](%d issues, %d without an estimate)[-]\n",
+		formatting.FormatMinutes(estimates.Minutes), mutedColor, estimates.Issues, estimates.Unestimated))
+	maxMinutes := slices.Max(estimates.ByPriority[:])
+	for priority, minutes := range estimates.ByPriority {
+		sb.WriteString(fmt.Sprintf("  P%d  %8s  [%s]%s[-]\n", priority, formatting.FormatMinutes(minutes),
+			formatting.GetPriorityColor(priority), formatting.Bar(minutes, maxMinutes, statsBarWidth)))
+	}
+}
+
+// formatDays renders a duration in days, or hours when under a day
+func formatDays(d time.Duration) string {
+	if d < 24*time.Hour {
+		return fmt.Sprintf("%.1fh", d.Hours())
+	}
+	return fmt.Sprintf("%.1fd", d.Hours()/24)
+}
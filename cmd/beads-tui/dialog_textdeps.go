@@ -0,0 +1,96 @@
+package main
+
+import (
+	"context"
+	"fmt"
+	"log"
+	"strings"
+
+	"github.com/andy/beads-tui/internal/formatting"
+	"github.com/andy/beads-tui/internal/parser"
+	"github.com/rivo/tview"
+)
+
+// ShowTextDependenciesDialog offers to turn the relationships declared in
+// issue's description ("Depends on: ..." lines and task list items) into bd
+// dependencies. Each one can be unchecked before adding.
+func (h *DialogHelpers) ShowTextDependenciesDialog(issue *parser.Issue) {
+	deps := parseTextDependencies(issue, h.AppState.GetIssueByID)
+	if len(deps) == 0 {
+		h.Notify.Warn(fmt.Sprintf("No unlinked dependencies in %s's description", issue.ID))
+		return
+	}
+
+	dialog := h.newDialog("text_dependencies_dialog", "Import Dependencies from Description")
+	form := dialog.Form
+	form.AddTextView("Declared in", issue.ID+" - "+issue.Title, 0, 2, false, false)
+
+	selected := make([]bool, len(deps))
+	for i, dep := range deps {
+		selected[i] = true
+		// Name the other issue so the user can tell what they're linking
+		other := dep.DependsOnID
+		if dep.Type == parser.DepParentChild {
+			other = dep.IssueID
+		}
+		label := dep.String()
+		if otherIssue := h.AppState.GetIssueByID(other); otherIssue != nil {
+			label += " - " + otherIssue.Title
+		}
+		form.AddCheckbox(tview.Escape(label), true, func(checked bool) {
+			selected[i] = checked
+		})
+	}
+
+	importDependencies := func() {
+		var chosen []textDependency
+		for i, dep := range deps {
+			if selected[i] {
+				chosen = append(chosen, dep)
+			}
+		}
+		if len(chosen) == 0 {
+			dialog.Close()
+			return
+		}
+
+		issueID := issue.ID // Capture before potential refresh
+		completed := 0
+		h.Runner.Run(fmt.Sprintf("Adding %d dependencies", len(chosen)), func(ctx context.Context) error {
+			for i, dep := range chosen {
+				log.Printf("BD COMMAND: Import dependency %d/%d (%s): bd %s", i+1, len(chosen), dep, strings.Join(dep.Args(), " "))
+				if _, err := execBdJSON(ctx, dep.Args()...); err != nil {
+					return err
+				}
+				completed++
+			}
+			return nil
+		}, func(err error) {
+			// Whatever was added can be undone as one step
+			undo := undoEntry{Description: fmt.Sprintf("import %d dependencies into %s", completed, issueID), IssueID: issueID}
+			for _, dep := range chosen[:completed] {
+				undo.Steps = append(undo.Steps, undoDependency(dep.IssueID, dep.DependsOnID, dep.Type, true).Steps...)
+			}
+			h.Undo.Push(undo)
+
+			if err != nil {
+				log.Printf("BD COMMAND ERROR: Dependency import failed: %v", err)
+				h.ShowErrorOverlay(fmt.Sprintf("Import stopped at %d/%d (%s)", completed+1, len(chosen), chosen[completed]), err)
+				if completed > 0 {
+					h.ScheduleRefresh(issueID)
+				}
+				return
+			}
+			log.Printf("BD COMMAND: Imported %d dependencies for %s", completed, issueID)
+			h.Notify.Success(fmt.Sprintf("Added %d dependencies from [%s]%s[-]'s description",
+				completed, formatting.GetAccentColor(), issueID))
+			dialog.Close()
+			h.ScheduleRefresh(issueID)
+		})
+	}
+
+	dialog.SetPrimary("Add Dependencies", importDependencies).
+		SetCancel("Cancel", nil).
+		SetSize(2, 3)
+	dialog.Show()
+}
@@ -0,0 +1,86 @@
+package main
+
+import (
+	"context"
+	"fmt"
+	"log"
+	"time"
+
+	"github.com/andy/beads-tui/internal/formatting"
+	"github.com/andy/beads-tui/internal/parser"
+	"github.com/andy/beads-tui/internal/ui"
+	"github.com/gdamore/tcell/v2"
+	"github.com/rivo/tview"
+)
+
+// ShowTimeline displays the selected issue's history, oldest first. bd's
+// audit trail (the events table) is read when the database has one;
+// otherwise the history is inferred from the issue's timestamps and comments.
+func (h *DialogHelpers) ShowTimeline() {
+	currentIndex := h.IssueList.GetCurrentItem()
+	issue, ok := (*h.IndexToIssue)[currentIndex]
+	if !ok {
+		h.Notify.Error("No issue selected")
+		return
+	}
+
+	mutedColor := formatting.GetMutedColor()
+	textView := tview.NewTextView().
+		SetDynamicColors(true).
+		SetText(fmt.Sprintf("[%s]Reading history of %s...[-]", mutedColor, issue.ID))
+	textView.SetBorder(true).
+		SetTitle(fmt.Sprintf(" History: %s ", issue.ID)).
+		SetTitleAlign(tview.AlignCenter)
+
+	footer := tview.NewTextView().SetDynamicColors(true)
+
+	content := tview.NewFlex().SetDirection(tview.FlexRow).
+		AddItem(textView, 0, 1, true).
+		AddItem(footer, 1, 0, false)
+
+	modal := ui.CenterModal(content, 2, 3)
+	modal.SetInputCapture(func(event *tcell.EventKey) *tcell.EventKey {
+		if event.Key() == tcell.KeyEscape || (event.Key() == tcell.KeyRune && (event.Rune() == 'q' || event.Rune() == 'H')) {
+			h.Pages.RemovePage("timeline")
+			h.App.SetFocus(h.IssueList)
+			return nil
+		}
+		return event
+	})
+
+	h.Pages.AddPage("timeline", modal, true, true)
+	h.App.SetFocus(textView)
+
+	show := func(events []*parser.Event, err error) {
+		entries := formatting.BuildTimeline(issue, events)
+		textView.SetText(formatting.FormatTimeline(entries, time.Now())).ScrollToEnd()
+
+		source := fmt.Sprintf("%d entries from bd's audit trail", len(entries))
+		switch {
+		case err != nil:
+			source = fmt.Sprintf("[%s]Audit trail unavailable (%s); inferred from timestamps[-][%s]", formatting.GetWarningColor(), tview.Escape(err.Error()), mutedColor)
+		case len(events) == 0:
+			source = "No audit trail recorded; inferred from timestamps and comments"
+		}
+		footer.SetText(fmt.Sprintf("[%s]%s · j/k scroll · Esc close[-]", mutedColor, source))
+	}
+
+	if h.DB == nil {
+		show(nil, nil)
+		return
+	}
+
+	// Read off the UI goroutine; the database may be busy with a refresh
+	go func() {
+		ctx, cancel := context.WithTimeout(context.Background(), 5*time.Second)
+		defer cancel()
+		events, err := h.DB.LoadEvents(ctx, issue.ID)
+		if err != nil {
+			log.Printf("TIMELINE ERROR: Failed to load events for %s: %v", issue.ID, err)
+		}
+		issue = withComments(h.Comments, issue)
+		h.App.QueueUpdateDraw(func() {
+			show(events, err)
+		})
+	}()
+}
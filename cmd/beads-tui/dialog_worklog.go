@@ -0,0 +1,78 @@
+package main
+
+import (
+	"fmt"
+	"log"
+	"strings"
+	"time"
+
+	"github.com/andy/beads-tui/internal/config"
+	"github.com/andy/beads-tui/internal/formatting"
+	"github.com/rivo/tview"
+)
+
+// ShowWorklogDialog logs time spent on the selected issue. bd has no time
+// tracking, so logWork records the entry in the TUI's per-project worklog.
+func (h *DialogHelpers) ShowWorklogDialog(logWork func(entry config.WorklogEntry) error) {
+	currentIndex := h.IssueList.GetCurrentItem()
+	issue, ok := (*h.IndexToIssue)[currentIndex]
+	if !ok {
+		h.Notify.Error("No issue selected")
+		return
+	}
+
+	dialog := h.newDialog("worklog_dialog", "Log Work (Enter to submit)")
+	form := dialog.Form
+	issueID := issue.ID // Capture before potential refresh
+	var spent, note string
+
+	mutedColor := formatting.GetMutedColor()
+	summary := "Nothing logged yet"
+	if logged := h.AppState.LoggedMinutes(issueID); logged.Total > 0 {
+		summary = formatting.FormatMinutes(logged.Total) + " logged"
+	}
+	if issue.EstimatedMinutes != nil {
+		summary += fmt.Sprintf(" of a %s estimate", formatting.FormatMinutes(*issue.EstimatedMinutes))
+	}
+
+	form.AddTextView("Issue", issueID+" - "+tview.Escape(issue.Title), 0, 2, false, false)
+	form.AddTextView("So far", fmt.Sprintf("[%s]%s[-]", mutedColor, summary), 0, 1, true, false)
+	form.AddInputField("Time spent", "", 12, nil, func(text string) {
+		spent = text
+	})
+	form.AddInputField("Note", "", 50, nil, func(text string) {
+		note = text
+	})
+	form.AddTextView("", fmt.Sprintf("[%s]e.g. 45m, 1h30m, 1.5h, or minutes (90)[-]", mutedColor), 0, 1, false, false)
+
+	submit := func() {
+		minutes, err := parseWorkDuration(spent)
+		if err != nil {
+			h.Notify.Error(err.Error())
+			return
+		}
+
+		entry := config.WorklogEntry{
+			IssueID:  issueID,
+			Minutes:  minutes,
+			Note:     strings.TrimSpace(note),
+			Author:   currentUser(),
+			LoggedAt: time.Now(),
+		}
+		if err := logWork(entry); err != nil {
+			log.Printf("WORKLOG ERROR: Failed to log %dm on %s: %v", minutes, issueID, err)
+			h.Notify.Error(fmt.Sprintf("Failed to save worklog: %v", err))
+			return
+		}
+
+		log.Printf("WORKLOG: Logged %dm on %s", minutes, issueID)
+		h.Notify.Success(fmt.Sprintf("Logged %s on [%s]%s[-]", formatting.FormatMinutes(minutes), formatting.GetAccentColor(), issueID))
+		dialog.Close()
+	}
+
+	dialog.SetPrimary("Log", submit).
+		SetCancel("Cancel", nil).
+		SetSubmitOnEnter(true).
+		SetFixedSize(72, 15)
+	dialog.Show()
+}
@@ -1,31 +1,118 @@
 package main
 
 import (
+	"fmt"
+	"time"
+
+	"github.com/andy/beads-tui/internal/config"
+	"github.com/andy/beads-tui/internal/formatting"
+	"github.com/andy/beads-tui/internal/git"
 	"github.com/andy/beads-tui/internal/parser"
 	"github.com/andy/beads-tui/internal/state"
+	"github.com/andy/beads-tui/internal/storage"
+	"github.com/andy/beads-tui/internal/ui"
+	"github.com/andy/beads-tui/internal/ui/notify"
 	"github.com/rivo/tview"
 )
 
 // DialogHelpers holds references to UI components needed by dialog functions
 //
-// This struct is shared across all dialog implementations in separate files:
+// This struct is shared across all dialog implementations in separate files
+// (form dialogs are built with ui.Dialog via newDialog):
 // - dialog_comment.go: ShowCommentDialog
 // - dialog_rename.go: ShowRenameDialog
 // - dialog_filter.go: ShowQuickFilter
 // - dialog_stats.go: ShowStatsOverlay
+// - dialog_estimate_grid.go: ShowEstimateGrid
+// - dialog_discard.go: ShowDiscardDialog
+// - dialog_home.go: ShowHomeScreen
+// - dialog_discussion.go: ToggleDiscussion, ShowDiscussionQueue
+// - dialog_inspector.go: ShowInspector
+// - dialog_notifications.go: ShowNotifications
+// - dialog_command_log.go: ShowCommandLog
+// - dialog_changes.go: ShowRefreshChanges
+// - dialog_snapshots.go: ShowSnapshotDiff
+// - dialog_cycles.go: ShowDependencyCycles
+// - dialog_standup.go: ShowStandup
+// - dialog_aging.go: ShowAgingReport
+// - dialog_archive.go: ShowArchive
+// - dialog_columns.go: ShowColumnsDialog
+// - dialog_goto.go: ShowGotoIssue
+// - dialog_recent.go: ShowRecentIssues
+// - dialog_export.go: ShowExportDialog
+// - dialog_worklog.go: ShowWorklogDialog
+// - dialog_due.go: ShowDueDateDialog
+// - dialog_timeline.go: ShowTimeline
 // - dialog_help.go: ShowHelpScreen
 // - dialog_dependencies.go: ShowDependencyDialog
+// - dialog_textdeps.go: ShowTextDependenciesDialog
 // - dialog_labels.go: ShowLabelDialog
+// - dialog_label_browser.go: ShowLabelBrowser
 // - dialog_close.go: ShowCloseIssueDialog, ShowReopenIssueDialog
+// - dialog_blocked.go: ShowBlockIssueDialog
 // - dialog_edit.go: ShowEditForm
 // - dialog_create.go: ShowCreateIssueDialog
+// - dialog_child.go: ShowQuickAddChild
+// - dialog_split.go: ShowSplitIssueDialog
+// - dialog_duplicate.go: ShowDuplicateDialog
+// - dialog_merge.go: ShowMergeDialog
+// - dialog_branch.go: ShowBranchDialog, CopyBranchName
+// - dialog_external_ref.go: ShowExternalRefDialog
+// - dialog_assignee.go: ShowAssigneeDialog
+// - dialog_error.go: ShowErrorOverlay
+// - dialog_confirm.go: ConfirmAction (confirm_destructive prompts)
+// - dialog_command_preview.go: ShowCommandPreview (dry-run approval)
+// - undo.go: UndoLastAction (undo stack of inverse bd commands)
 type DialogHelpers struct {
 	App             *tview.Application
 	Pages           *tview.Pages
-	IssueList       *tview.List
+	IssueList       *ui.VirtualList
 	IndexToIssue    *map[int]*parser.Issue
 	StatusBar       *tview.TextView
+	Notify          *notify.Center // Success, warning, and error messages in the status bar
 	AppState        *state.State
 	RefreshIssues   func(...string)
 	ScheduleRefresh func(string)
+	Runner          *bdRunner               // Runs bd commands off the UI goroutine
+	Undo            *undoStack              // Inverse commands of recent mutations ('u')
+	DB              storage.IssueSource     // Events, the closed archive, and raw rows for the inspector ('gi')
+	Comments        *storage.CommentCache   // Comments, which aren't loaded with the issues
+	Snapshots       *snapshotHistory        // Issues as the last few refreshes loaded them ('gv')
+	Drafts          *draftStore             // Unsent comment, edit, and create text
+	Git             *git.Repo               // Repository the project lives in (nil outside one)
+	BranchTemplate  string                  // Branch name template for B (see git.BranchName)
+	ExternalRefURLs []config.ExternalRefURL // Rules turning external refs into URLs (gx)
+	StandupWindow   time.Duration           // How far back the standup summary looks (gu)
+	Confirm         *confirmPolicy          // Which risky actions ask first (ConfirmAction)
+	Bd              *config.BdCapabilities  // What the installed bd supports (nil until probed)
+}
+
+// newDialog creates a form dialog shown as page name that returns focus to the
+// issue list when closed
+func (h *DialogHelpers) newDialog(name, title string) *ui.Dialog {
+	return ui.NewDialog(h.App, h.Pages, name, title).SetReturnFocus(h.IssueList)
+}
+
+// addDraftNotice tells the user the dialog was filled in from an unsent
+// draft, and adds a "Discard Draft" button that runs discard (which should
+// reset the fields) and closes nothing
+func (h *DialogHelpers) addDraftNotice(dialog *ui.Dialog, draft config.Draft, discard func()) {
+	notice := fmt.Sprintf("[%s]Restored your unsent draft from %s[-]", formatting.GetWarningColor(), draft.SavedAt.Format("Jan 2 15:04"))
+	dialog.Form.AddTextView("", notice, 0, 1, true, false)
+	dialog.AddButton("Discard Draft", discard)
+}
+
+// supportsBd reports whether the installed bd can do feature
+func (h *DialogHelpers) supportsBd(feature bdFeature) bool {
+	return h.Bd.Supports(feature.Command, feature.Flag)
+}
+
+// requireBd reports whether the installed bd can do feature, and when it
+// can't, says so in the status bar instead of letting bd fail
+func (h *DialogHelpers) requireBd(feature bdFeature) bool {
+	if h.supportsBd(feature) {
+		return true
+	}
+	h.Notify.Warn(bdUnsupportedMessage(h.Bd, feature))
+	return false
 }
@@ -0,0 +1,113 @@
+package main
+
+import (
+	"context"
+	"fmt"
+	"log"
+	"strconv"
+
+	"github.com/andy/beads-tui/internal/parser"
+	"github.com/andy/beads-tui/internal/storage"
+)
+
+// directWriter, when set by --direct-write, applies simple bd commands
+// straight to the database instead of running bd (in demo mode, to the
+// issues in memory). Anything it doesn't handle still goes through bd.
+var directWriter storage.IssueWriter
+
+// directOp is a bd command that can be applied without bd
+type directOp struct {
+	Kind    string // "status", "priority", "label add", "label remove", "comment"
+	IssueID string
+	Value   string // New status, priority, label, or comment text
+	Reason  string // close/reopen --reason
+}
+
+// parseDirectOp recognizes the bd commands SQLiteWriter can apply:
+//
+//	update ID --status S | update ID --priority N
+//	close ID [--reason R] | reopen ID [--reason R]
+//	label add|remove ID LABEL
+//	comment ID TEXT
+//
+// --json is ignored. Any other command or extra flag returns false.
+func parseDirectOp(args []string) (directOp, bool) {
+	var rest []string
+	for _, arg := range args {
+		if arg != "--json" {
+			rest = append(rest, arg)
+		}
+	}
+	if len(rest) < 2 {
+		return directOp{}, false
+	}
+
+	switch rest[0] {
+	case "update":
+		if len(rest) == 4 && rest[2] == "--status" {
+			return directOp{Kind: "status", IssueID: rest[1], Value: rest[3]}, true
+		}
+		if len(rest) == 4 && rest[2] == "--priority" {
+			return directOp{Kind: "priority", IssueID: rest[1], Value: rest[3]}, true
+		}
+	case "close", "reopen":
+		status := string(parser.StatusClosed)
+		if rest[0] == "reopen" {
+			status = string(parser.StatusOpen)
+		}
+		switch {
+		case len(rest) == 2:
+			return directOp{Kind: "status", IssueID: rest[1], Value: status}, true
+		case len(rest) == 4 && rest[2] == "--reason":
+			return directOp{Kind: "status", IssueID: rest[1], Value: status, Reason: rest[3]}, true
+		}
+	case "label":
+		if len(rest) == 4 && (rest[1] == "add" || rest[1] == "remove") {
+			return directOp{Kind: "label " + rest[1], IssueID: rest[2], Value: rest[3]}, true
+		}
+	case "comment":
+		if len(rest) == 3 {
+			return directOp{Kind: "comment", IssueID: rest[1], Value: rest[2]}, true
+		}
+	}
+	return directOp{}, false
+}
+
+// execDirect applies op with directWriter and returns the result in the
+// same shape bd's JSON output is parsed into. Like bd, the write stops when
+// ctx is canceled, and times out after bdTimeout.
+func execDirect(ctx context.Context, op directOp) (*BdCommandResult, error) {
+	ctx, cancel := context.WithTimeout(ctx, bdTimeout)
+	defer cancel()
+
+	log.Printf("DIRECT WRITE: %s %s %q", op.Kind, op.IssueID, op.Value)
+
+	var issue *parser.Issue
+	var err error
+	switch op.Kind {
+	case "status":
+		issue, err = directWriter.UpdateStatus(ctx, op.IssueID, parser.Status(op.Value), op.Reason)
+	case "priority":
+		priority, convErr := strconv.Atoi(op.Value)
+		if convErr != nil {
+			return nil, fmt.Errorf("invalid priority %q", op.Value)
+		}
+		issue, err = directWriter.UpdatePriority(ctx, op.IssueID, priority)
+	case "label add":
+		issue, err = directWriter.AddLabel(ctx, op.IssueID, op.Value)
+	case "label remove":
+		issue, err = directWriter.RemoveLabel(ctx, op.IssueID, op.Value)
+	case "comment":
+		comment, commentErr := directWriter.AddComment(ctx, op.IssueID, op.Value)
+		if commentErr != nil {
+			return nil, fmt.Errorf("direct write failed: %w", commentErr)
+		}
+		return &BdCommandResult{Comments: []parser.Comment{*comment}}, nil
+	default:
+		return nil, fmt.Errorf("unsupported direct write %q", op.Kind)
+	}
+	if err != nil {
+		return nil, fmt.Errorf("direct write failed: %w", err)
+	}
+	return &BdCommandResult{Issues: []parser.Issue{*issue}}, nil
+}
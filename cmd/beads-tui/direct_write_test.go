@@ -0,0 +1,61 @@
+package main
+
+import (
+	"context"
+	"errors"
+	"strconv"
+	"testing"
+	"time"
+
+	"github.com/andy/beads-tui/internal/demo"
+)
+
+func TestParseDirectOp(t *testing.T) {
+	tests := []struct {
+		args []string
+		want directOp
+		ok   bool
+	}{
+		{[]string{"update", "tui-1", "--status", "in_progress"}, directOp{Kind: "status", IssueID: "tui-1", Value: "in_progress"}, true},
+		{[]string{"update", "tui-1", "--priority", "0", "--json"}, directOp{Kind: "priority", IssueID: "tui-1", Value: "0"}, true},
+		{[]string{"close", "tui-1"}, directOp{Kind: "status", IssueID: "tui-1", Value: "closed"}, true},
+		{[]string{"close", "tui-1", "--reason", "Done"}, directOp{Kind: "status", IssueID: "tui-1", Value: "closed", Reason: "Done"}, true},
+		{[]string{"reopen", "tui-1", "--reason", "Undo close"}, directOp{Kind: "status", IssueID: "tui-1", Value: "open", Reason: "Undo close"}, true},
+		{[]string{"label", "add", "tui-1", "ui"}, directOp{Kind: "label add", IssueID: "tui-1", Value: "ui"}, true},
+		{[]string{"label", "remove", "tui-1", "ui"}, directOp{Kind: "label remove", IssueID: "tui-1", Value: "ui"}, true},
+		{[]string{"comment", "tui-1", "Looks good"}, directOp{Kind: "comment", IssueID: "tui-1", Value: "Looks good"}, true},
+
+		// Left to bd
+		{[]string{"update", "tui-1", "--title", "New"}, directOp{}, false},
+		{[]string{"update", "tui-1", "--status", "open", "--priority", "1"}, directOp{}, false},
+		{[]string{"create", "New issue", "-p", "2"}, directOp{}, false},
+		{[]string{"dep", "add", "tui-1", "tui-2"}, directOp{}, false},
+		{[]string{"label", "list", "tui-1", "ui"}, directOp{}, false},
+		{[]string{"close"}, directOp{}, false},
+	}
+
+	for _, tt := range tests {
+		got, ok := parseDirectOp(tt.args)
+		if ok != tt.ok || got != tt.want {
+			t.Errorf("parseDirectOp(%q) = %+v, %v; want %+v, %v", tt.args, got, ok, tt.want, tt.ok)
+		}
+	}
+}
+
+func TestExecDirectCanceled(t *testing.T) {
+	data := demo.Generate(time.Date(2026, 3, 2, 9, 0, 0, 0, time.UTC), "dana")
+	source := demo.NewSource(data, "dana")
+	directWriter = source
+	defer func() { directWriter = nil }()
+	issue := data.Issues[0]
+
+	// Cancelling (Esc in the TUI) stops the write like it kills bd
+	ctx, cancel := context.WithCancel(context.Background())
+	cancel()
+	if _, err := execDirect(ctx, directOp{Kind: "priority", IssueID: issue.ID, Value: strconv.Itoa((issue.Priority + 1) % 5)}); !errors.Is(err, context.Canceled) {
+		t.Fatalf("execDirect = %v, want context.Canceled", err)
+	}
+	if issues, _ := source.LoadIssues(context.Background()); issues[0].Priority != issue.Priority {
+		t.Errorf("expected a canceled write to change nothing, got P%d", issues[0].Priority)
+	}
+}
@@ -0,0 +1,109 @@
+package main
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/andy/beads-tui/internal/config"
+	"github.com/andy/beads-tui/internal/parser"
+	"github.com/andy/beads-tui/internal/state"
+)
+
+// discardWarnings describes what deleting an issue does to the issues that
+// depend on it, one line per kind of dependency: the issues it blocks are
+// unblocked, its children lose their parent, and other links are dropped
+func discardWarnings(dependents []state.Dependent) []string {
+	var blocked, children, linked []string
+	openChildren := 0
+	for _, dependent := range dependents {
+		switch dependent.Type {
+		case parser.DepBlocks:
+			blocked = append(blocked, dependent.Issue.ID)
+		case parser.DepParentChild:
+			children = append(children, dependent.Issue.ID)
+			if dependent.Issue.Status != parser.StatusClosed {
+				openChildren++
+			}
+		default:
+			linked = append(linked, dependent.Issue.ID)
+		}
+	}
+
+	var warnings []string
+	if len(blocked) > 0 {
+		warnings = append(warnings, fmt.Sprintf("%s %s blocked by it and will be unblocked", listIDs(blocked), isAre(len(blocked))))
+	}
+	if len(children) > 0 {
+		warning := fmt.Sprintf("%s will lose %s parent", listIDs(children), theirIts(len(children)))
+		if openChildren > 0 {
+			warning += " (move open children first with gm in the tree)"
+		}
+		warnings = append(warnings, warning)
+	}
+	if len(linked) > 0 {
+		warnings = append(warnings, fmt.Sprintf("%s will lose %s link to it", listIDs(linked), theirIts(len(linked))))
+	}
+	return warnings
+}
+
+// listIDs joins up to three issue IDs, counting the rest
+func listIDs(ids []string) string {
+	const shown = 3
+	if len(ids) <= shown {
+		return strings.Join(ids, ", ")
+	}
+	return fmt.Sprintf("%s and %d more", strings.Join(ids[:shown], ", "), len(ids)-shown)
+}
+
+func isAre(n int) string {
+	if n == 1 {
+		return "is"
+	}
+	return "are"
+}
+
+func theirIts(n int) string {
+	if n == 1 {
+		return "its"
+	}
+	return "their"
+}
+
+// danglingReferences lists references to discarded issues that turned up in
+// issues: a discarded issue back in the database (e.g. re-imported from an
+// old JSONL), a dependency on one, or its ID mentioned in an issue's text.
+// Messages are in issue order, one per referencing issue and discarded ID.
+func danglingReferences(issues []*parser.Issue, discarded []config.DiscardedIssue) []string {
+	if len(discarded) == 0 {
+		return nil
+	}
+	isDiscarded := make(map[string]bool, len(discarded))
+	for _, entry := range discarded {
+		isDiscarded[entry.ID] = true
+	}
+
+	var refs []string
+	for _, issue := range issues {
+		if isDiscarded[issue.ID] {
+			refs = append(refs, fmt.Sprintf("discarded %s is back in the database", issue.ID))
+			continue
+		}
+
+		reported := make(map[string]bool)
+		for _, dep := range issue.Dependencies {
+			if isDiscarded[dep.DependsOnID] && !reported[dep.DependsOnID] {
+				reported[dep.DependsOnID] = true
+				refs = append(refs, fmt.Sprintf("%s has a %s dependency on discarded %s", issue.ID, dep.Type, dep.DependsOnID))
+			}
+		}
+		for _, text := range []string{issue.Title, issue.Description, issue.Design, issue.AcceptanceCriteria, issue.Notes} {
+			for _, id := range textIssueIDPattern.FindAllString(text, -1) {
+				if isDiscarded[id] && !reported[id] {
+					reported[id] = true
+					refs = append(refs, fmt.Sprintf("%s mentions discarded %s", issue.ID, id))
+				}
+			}
+		}
+	}
+	return refs
+}
@@ -0,0 +1,64 @@
+package main
+
+import (
+	"reflect"
+	"testing"
+
+	"github.com/andy/beads-tui/internal/config"
+	"github.com/andy/beads-tui/internal/parser"
+	"github.com/andy/beads-tui/internal/state"
+)
+
+func TestDanglingReferences(t *testing.T) {
+	discarded := []config.DiscardedIssue{{ID: "tui-1"}, {ID: "tui-2"}}
+	issues := []*parser.Issue{
+		{ID: "tui-3", Dependencies: []*parser.Dependency{{IssueID: "tui-3", DependsOnID: "tui-1", Type: parser.DepBlocks}}, Description: "See tui-1 for context"},
+		{ID: "tui-4", Notes: "Replaces tui-2, not tui-20"},
+		{ID: "tui-2", Title: "Resurrected"},
+		{ID: "tui-5", Description: "Nothing to see"},
+	}
+
+	want := []string{
+		"tui-3 has a blocks dependency on discarded tui-1",
+		"tui-4 mentions discarded tui-2",
+		"discarded tui-2 is back in the database",
+	}
+	if got := danglingReferences(issues, discarded); !reflect.DeepEqual(got, want) {
+		t.Errorf("danglingReferences() = %q, want %q", got, want)
+	}
+
+	if got := danglingReferences(issues, nil); got != nil {
+		t.Errorf("expected no references without discarded issues, got %q", got)
+	}
+}
+
+func TestDiscardWarnings(t *testing.T) {
+	dependent := func(id string, depType parser.DependencyType, status parser.Status) state.Dependent {
+		return state.Dependent{Issue: &parser.Issue{ID: id, Status: status}, Type: depType}
+	}
+	dependents := []state.Dependent{
+		dependent("tui-2", parser.DepBlocks, parser.StatusOpen),
+		dependent("tui-1.1", parser.DepParentChild, parser.StatusClosed),
+		dependent("tui-1.2", parser.DepParentChild, parser.StatusOpen),
+		dependent("tui-3", parser.DepRelated, parser.StatusOpen),
+		dependent("tui-4", parser.DepDiscoveredFrom, parser.StatusOpen),
+	}
+
+	want := []string{
+		"tui-2 is blocked by it and will be unblocked",
+		"tui-1.1, tui-1.2 will lose their parent (move open children first with gm in the tree)",
+		"tui-3, tui-4 will lose their link to it",
+	}
+	if got := discardWarnings(dependents); !reflect.DeepEqual(got, want) {
+		t.Errorf("discardWarnings() = %q, want %q", got, want)
+	}
+	if got := discardWarnings(dependents[1:2]); !reflect.DeepEqual(got, []string{"tui-1.1 will lose its parent"}) {
+		t.Errorf("expected no advice to move a closed child, got %q", got)
+	}
+	if got := discardWarnings(nil); got != nil {
+		t.Errorf("expected no warnings without dependents, got %q", got)
+	}
+	if got := listIDs([]string{"a", "b", "c", "d", "e"}); got != "a, b, c and 2 more" {
+		t.Errorf("listIDs() = %q", got)
+	}
+}
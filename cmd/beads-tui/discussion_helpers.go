@@ -0,0 +1,67 @@
+package main
+
+import (
+	"fmt"
+	"sort"
+	"strings"
+	"time"
+
+	"github.com/andy/beads-tui/internal/parser"
+)
+
+// discussLabel marks an issue as queued for discussion. It is an ordinary bd
+// label, so the queue is shared with everyone using the database.
+const discussLabel = "discuss"
+
+// isQueuedForDiscussion reports whether issue carries the discussion label
+func isQueuedForDiscussion(issue *parser.Issue) bool {
+	for _, label := range issue.Labels {
+		if strings.EqualFold(label, discussLabel) {
+			return true
+		}
+	}
+	return false
+}
+
+// discussionQueue returns the issues queued for discussion, highest priority
+// first, then oldest first
+func discussionQueue(issues []*parser.Issue) []*parser.Issue {
+	var queue []*parser.Issue
+	for _, issue := range issues {
+		if isQueuedForDiscussion(issue) {
+			queue = append(queue, issue)
+		}
+	}
+	sort.SliceStable(queue, func(i, j int) bool {
+		if queue[i].Priority != queue[j].Priority {
+			return queue[i].Priority < queue[j].Priority
+		}
+		return queue[i].CreatedAt.Before(queue[j].CreatedAt)
+	})
+	return queue
+}
+
+// discussionAgenda renders the queue as a Markdown meeting agenda: one
+// checklist item per issue with its status, assignee, and a link when the
+// issue's external reference is a URL
+func discussionAgenda(queue []*parser.Issue, date time.Time) string {
+	var sb strings.Builder
+	sb.WriteString(fmt.Sprintf("# Discussion agenda - %s\n\n", date.Format("2006-01-02")))
+	if len(queue) == 0 {
+		sb.WriteString("Nothing queued for discussion.\n")
+		return sb.String()
+	}
+
+	for _, issue := range queue {
+		id := issue.ID
+		if ref := issue.ExternalRef; ref != nil && (strings.HasPrefix(*ref, "http://") || strings.HasPrefix(*ref, "https://")) {
+			id = fmt.Sprintf("[%s](%s)", issue.ID, *ref)
+		}
+		details := []string{string(issue.Status)}
+		if issue.Assignee != "" {
+			details = append(details, "@"+issue.Assignee)
+		}
+		sb.WriteString(fmt.Sprintf("- [ ] **%s** [P%d] %s (%s)\n", id, issue.Priority, issue.Title, strings.Join(details, ", ")))
+	}
+	return sb.String()
+}
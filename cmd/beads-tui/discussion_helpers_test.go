@@ -0,0 +1,48 @@
+package main
+
+import (
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/andy/beads-tui/internal/parser"
+)
+
+func TestDiscussionQueue(t *testing.T) {
+	base := time.Date(2026, 3, 1, 12, 0, 0, 0, time.UTC)
+	issues := []*parser.Issue{
+		{ID: "tui-1", Priority: 2, CreatedAt: base, Labels: []string{"discuss"}},
+		{ID: "tui-2", Priority: 1, CreatedAt: base, Labels: []string{"ui"}},
+		{ID: "tui-3", Priority: 2, CreatedAt: base.Add(-time.Hour), Labels: []string{"ui", "Discuss"}},
+		{ID: "tui-4", Priority: 0, CreatedAt: base, Labels: []string{"discuss"}},
+	}
+
+	queue := discussionQueue(issues)
+	var ids []string
+	for _, issue := range queue {
+		ids = append(ids, issue.ID)
+	}
+	if got := strings.Join(ids, ","); got != "tui-4,tui-3,tui-1" {
+		t.Errorf("expected queue ordered by priority then age, got %s", got)
+	}
+}
+
+func TestDiscussionAgenda(t *testing.T) {
+	date := time.Date(2026, 3, 2, 9, 0, 0, 0, time.UTC)
+	if got := discussionAgenda(nil, date); !strings.Contains(got, "Nothing queued") {
+		t.Errorf("expected empty agenda note, got %q", got)
+	}
+
+	url := "https://github.com/example/repo/issues/7"
+	jira := "PROJ-12"
+	queue := []*parser.Issue{
+		{ID: "tui-1", Title: "Pick a license", Status: parser.StatusOpen, Priority: 1, Assignee: "alice", ExternalRef: &url},
+		{ID: "tui-2", Title: "Release cadence", Status: parser.StatusBlocked, Priority: 2, ExternalRef: &jira},
+	}
+	want := "# Discussion agenda - 2026-03-02\n\n" +
+		"- [ ] **[tui-1](https://github.com/example/repo/issues/7)** [P1] Pick a license (open, @alice)\n" +
+		"- [ ] **tui-2** [P2] Release cadence (blocked)\n"
+	if got := discussionAgenda(queue, date); got != want {
+		t.Errorf("unexpected agenda:\n%s\nwant:\n%s", got, want)
+	}
+}
@@ -0,0 +1,94 @@
+package main
+
+import (
+	"log"
+	"time"
+
+	"github.com/andy/beads-tui/internal/config"
+)
+
+// draftStore keeps the text typed into comment, edit, and create dialogs on
+// disk as it changes, so a stray Esc or a crash doesn't lose it. It is only
+// touched from the UI goroutine. A nil store keeps nothing.
+type draftStore struct {
+	beadsDir string
+	drafts   *config.Drafts
+	now      func() time.Time
+}
+
+// newDraftStore loads the project's drafts. If they can't be read, the error
+// is logged and the store starts empty.
+func newDraftStore(beadsDir string) *draftStore {
+	drafts, err := config.LoadDrafts(beadsDir)
+	if err != nil {
+		log.Printf("Warning: failed to load drafts: %v", err)
+		drafts = &config.Drafts{Entries: make(map[string]config.Draft)}
+	}
+	return &draftStore{beadsDir: beadsDir, drafts: drafts, now: time.Now}
+}
+
+// draftKey names a dialog's draft, e.g. "comment:tui-1"
+func draftKey(dialog, issueID string) string {
+	if issueID == "" {
+		return dialog
+	}
+	return dialog + ":" + issueID
+}
+
+// Load returns the saved draft for key, if there is one
+func (s *draftStore) Load(key string) (config.Draft, bool) {
+	if s == nil {
+		return config.Draft{}, false
+	}
+	draft, ok := s.drafts.Entries[key]
+	return draft, ok
+}
+
+// Save records the dialog's current fields under key. Fields that match
+// original (the values the dialog opened with; empty for new text) aren't
+// a draft, so the entry is dropped once every field matches again.
+func (s *draftStore) Save(key string, fields, original map[string]string) {
+	if s == nil {
+		return
+	}
+	if !draftChanged(fields, original) {
+		s.Delete(key)
+		return
+	}
+	copied := make(map[string]string, len(fields))
+	for name, value := range fields {
+		copied[name] = value
+	}
+	s.drafts.Entries[key] = config.Draft{Fields: copied, SavedAt: s.now()}
+	s.write()
+}
+
+// Delete drops the draft for key (after the dialog was submitted)
+func (s *draftStore) Delete(key string) {
+	if s == nil {
+		return
+	}
+	if _, ok := s.drafts.Entries[key]; !ok {
+		return
+	}
+	delete(s.drafts.Entries, key)
+	s.write()
+}
+
+// write saves the drafts, logging a failure (losing a draft isn't worth
+// interrupting the typing for)
+func (s *draftStore) write() {
+	if err := config.SaveDrafts(s.beadsDir, s.drafts); err != nil {
+		log.Printf("Warning: failed to save drafts: %v", err)
+	}
+}
+
+// draftChanged reports whether any field differs from original
+func draftChanged(fields, original map[string]string) bool {
+	for name, value := range fields {
+		if value != original[name] {
+			return true
+		}
+	}
+	return false
+}
@@ -0,0 +1,50 @@
+package main
+
+import (
+	"os"
+	"testing"
+	"time"
+)
+
+func TestDraftStore(t *testing.T) {
+	tmpDir := t.TempDir()
+	oldHome := os.Getenv("HOME")
+	os.Setenv("HOME", tmpDir)
+	defer os.Setenv("HOME", oldHome)
+
+	savedAt := time.Date(2026, 1, 2, 3, 4, 5, 0, time.UTC)
+	store := newDraftStore("/work/alpha/.beads")
+	store.now = func() time.Time { return savedAt }
+
+	key := draftKey("comment", "tui-1")
+	if key != "comment:tui-1" || draftKey("create", "") != "create" {
+		t.Errorf("unexpected draft keys %q, %q", key, draftKey("create", ""))
+	}
+
+	store.Save(key, map[string]string{"comment": "half a thought"}, nil)
+	reloaded := newDraftStore("/work/alpha/.beads")
+	draft, ok := reloaded.Load(key)
+	if !ok || draft.Fields["comment"] != "half a thought" || !draft.SavedAt.Equal(savedAt) {
+		t.Fatalf("expected the draft to be saved to disk, got %+v (found %v)", draft, ok)
+	}
+
+	// Back to the original text: nothing left to restore
+	store.Save(key, map[string]string{"comment": ""}, nil)
+	if _, ok := newDraftStore("/work/alpha/.beads").Load(key); ok {
+		t.Error("expected an emptied draft to be dropped")
+	}
+
+	edit := draftKey("edit", "tui-1")
+	original := map[string]string{"title": "Fix it", "notes": ""}
+	store.Save(edit, map[string]string{"title": "Fix it", "notes": "more"}, original)
+	store.Delete(edit)
+	if _, ok := newDraftStore("/work/alpha/.beads").Load(edit); ok {
+		t.Error("expected a deleted draft to be gone")
+	}
+
+	var none *draftStore
+	none.Save(key, map[string]string{"comment": "x"}, nil)
+	if _, ok := none.Load(key); ok {
+		t.Error("expected a nil store to keep nothing")
+	}
+}
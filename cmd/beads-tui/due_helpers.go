@@ -0,0 +1,52 @@
+package main
+
+import (
+	"fmt"
+	"strconv"
+	"strings"
+	"time"
+)
+
+// dueDateLayout is the date format passed to bd update --due
+const dueDateLayout = "2006-01-02"
+
+// parseDueInput parses the date entered in the due date dialog and returns
+// it as YYYY-MM-DD, or "" to clear the due date. Besides dates it accepts
+// "today", "tomorrow", and offsets from today such as "+3d" or "2w".
+func parseDueInput(text string, now time.Time) (string, error) {
+	text = strings.ToLower(strings.TrimSpace(text))
+	switch text {
+	case "":
+		return "", nil
+	case "today":
+		return now.Format(dueDateLayout), nil
+	case "tomorrow":
+		return now.AddDate(0, 0, 1).Format(dueDateLayout), nil
+	}
+
+	if due, err := time.ParseInLocation(dueDateLayout, text, now.Location()); err == nil {
+		return due.Format(dueDateLayout), nil
+	}
+
+	offset := strings.TrimPrefix(text, "+")
+	if len(offset) > 1 {
+		n, err := strconv.Atoi(offset[:len(offset)-1])
+		if err == nil && n >= 0 {
+			switch offset[len(offset)-1] {
+			case 'd':
+				return now.AddDate(0, 0, n).Format(dueDateLayout), nil
+			case 'w':
+				return now.AddDate(0, 0, 7*n).Format(dueDateLayout), nil
+			}
+		}
+	}
+	return "", fmt.Errorf("can't read %q as a date; use YYYY-MM-DD, today, tomorrow, or +3d", text)
+}
+
+// formatDueInput is the dialog's starting text for an existing due date
+func formatDueInput(due *time.Time) string {
+	if due == nil {
+		return ""
+	}
+	return due.Local().Format(dueDateLayout)
+}
@@ -0,0 +1,34 @@
+package main
+
+import (
+	"testing"
+	"time"
+)
+
+func TestParseDueInput(t *testing.T) {
+	now := time.Date(2026, 5, 30, 18, 0, 0, 0, time.Local)
+	tests := []struct {
+		text string
+		want string
+	}{
+		{"", ""},
+		{"2026-06-15", "2026-06-15"},
+		{" Today ", "2026-05-30"},
+		{"tomorrow", "2026-05-31"},
+		{"+3d", "2026-06-02"},
+		{"2w", "2026-06-13"},
+		{"0d", "2026-05-30"},
+	}
+	for _, tt := range tests {
+		got, err := parseDueInput(tt.text, now)
+		if err != nil || got != tt.want {
+			t.Errorf("parseDueInput(%q) = %q, %v; want %q", tt.text, got, err, tt.want)
+		}
+	}
+
+	for _, text := range []string{"soon", "2026-13-01", "-3d", "+d", "3m"} {
+		if _, err := parseDueInput(text, now); err == nil {
+			t.Errorf("parseDueInput(%q) expected an error", text)
+		}
+	}
+}
@@ -0,0 +1,67 @@
+package main
+
+import (
+	"fmt"
+	"regexp"
+	"strconv"
+
+	"github.com/andy/beads-tui/internal/parser"
+)
+
+// duplicateOptions are the parts of the original carried over to a duplicate
+type duplicateOptions struct {
+	Priority    bool // Otherwise bd's default priority
+	Description bool // Description, design, acceptance criteria, and notes
+	Labels      bool
+}
+
+// duplicateSuffix matches a trailing " (N)" copy number on a title
+var duplicateSuffix = regexp.MustCompile(`^(.*) \((\d+)\)$`)
+
+// duplicateTitle suggests a title for a copy of an issue: "Title (2)", or
+// the next number if the title already ends in one ("Title (3)")
+func duplicateTitle(title string) string {
+	if m := duplicateSuffix.FindStringSubmatch(title); m != nil {
+		if n, err := strconv.Atoi(m[2]); err == nil {
+			return fmt.Sprintf("%s (%d)", m[1], n+1)
+		}
+	}
+	return title + " (2)"
+}
+
+// duplicateCreateArgs builds the bd create arguments for a copy of issue
+// titled title. The type is always kept; the other text sections and the
+// labels are added after it is created.
+func duplicateCreateArgs(issue *parser.Issue, title string, opts duplicateOptions) []string {
+	args := []string{"create", title, "-t", string(issue.IssueType)}
+	if opts.Priority {
+		args = append(args, "-p", strconv.Itoa(issue.Priority))
+	}
+	if opts.Description && issue.Description != "" {
+		args = append(args, "--description", issue.Description)
+	}
+	return args
+}
+
+// duplicateUpdateArgs builds the bd update arguments that copy the design,
+// acceptance criteria, and notes to the duplicate newID, or nil if there is
+// nothing to copy
+func duplicateUpdateArgs(issue *parser.Issue, newID string, opts duplicateOptions) []string {
+	if !opts.Description {
+		return nil
+	}
+	var fields []string
+	for _, field := range []struct{ flag, value string }{
+		{"--design", issue.Design},
+		{"--acceptance", issue.AcceptanceCriteria},
+		{"--notes", issue.Notes},
+	} {
+		if field.value != "" {
+			fields = append(fields, field.flag, field.value)
+		}
+	}
+	if len(fields) == 0 {
+		return nil
+	}
+	return append([]string{"update", newID}, fields...)
+}
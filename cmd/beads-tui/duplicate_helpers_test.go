@@ -0,0 +1,57 @@
+package main
+
+import (
+	"reflect"
+	"testing"
+
+	"github.com/andy/beads-tui/internal/parser"
+)
+
+func TestDuplicateTitle(t *testing.T) {
+	tests := []struct {
+		title string
+		want  string
+	}{
+		{"Fix login", "Fix login (2)"},
+		{"Fix login (2)", "Fix login (3)"},
+		{"Fix login (v2)", "Fix login (v2) (2)"},
+		{"(9)", "(9) (2)"},
+	}
+	for _, tt := range tests {
+		if got := duplicateTitle(tt.title); got != tt.want {
+			t.Errorf("duplicateTitle(%q) = %q, want %q", tt.title, got, tt.want)
+		}
+	}
+}
+
+func TestDuplicateArgs(t *testing.T) {
+	issue := &parser.Issue{
+		ID:          "tui-1",
+		IssueType:   parser.TypeBug,
+		Priority:    1,
+		Description: "Crashes",
+		Notes:       "Seen on macOS",
+	}
+
+	all := duplicateOptions{Priority: true, Description: true, Labels: true}
+	wantCreate := []string{"create", "Crash (2)", "-t", "bug", "-p", "1", "--description", "Crashes"}
+	if got := duplicateCreateArgs(issue, "Crash (2)", all); !reflect.DeepEqual(got, wantCreate) {
+		t.Errorf("duplicateCreateArgs = %q, want %q", got, wantCreate)
+	}
+	wantUpdate := []string{"update", "tui-2", "--notes", "Seen on macOS"}
+	if got := duplicateUpdateArgs(issue, "tui-2", all); !reflect.DeepEqual(got, wantUpdate) {
+		t.Errorf("duplicateUpdateArgs = %q, want %q", got, wantUpdate)
+	}
+
+	none := duplicateOptions{}
+	wantCreate = []string{"create", "Crash (2)", "-t", "bug"}
+	if got := duplicateCreateArgs(issue, "Crash (2)", none); !reflect.DeepEqual(got, wantCreate) {
+		t.Errorf("duplicateCreateArgs without options = %q, want %q", got, wantCreate)
+	}
+	if got := duplicateUpdateArgs(issue, "tui-2", none); got != nil {
+		t.Errorf("expected no update without the description option, got %q", got)
+	}
+	if got := duplicateUpdateArgs(&parser.Issue{ID: "tui-3"}, "tui-4", all); got != nil {
+		t.Errorf("expected no update for an issue without design or notes, got %q", got)
+	}
+}
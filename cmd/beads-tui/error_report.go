@@ -0,0 +1,107 @@
+package main
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"os/exec"
+	"strings"
+
+	"github.com/andy/beads-tui/internal/storage"
+)
+
+// errorRemedies suggests likely fixes for a failed bd or SQLite operation,
+// based on the error type and the text bd printed
+func errorRemedies(err error) []string {
+	var remedies []string
+	add := func(remedy string) {
+		for _, existing := range remedies {
+			if existing == remedy {
+				return
+			}
+		}
+		remedies = append(remedies, remedy)
+	}
+
+	var bdErr *BdError
+	isBd := errors.As(err, &bdErr)
+
+	if errors.Is(err, exec.ErrNotFound) {
+		add(fmt.Sprintf("'%s' was not found - install beads or set \"bd_path\" in ~/.beads-tui/config.json", bdCommand))
+	}
+	if errors.Is(err, context.DeadlineExceeded) {
+		if isBd {
+			add("bd did not respond in time - check for a stuck daemon ('bd daemon status') and retry")
+		} else {
+			add("The database took too long to load - another process may be holding a lock; press 'r' to retry")
+		}
+	}
+	if errors.Is(err, storage.ErrDatabaseCorrupted) {
+		add("The database is corrupted - run 'bd doctor --fix' to recover from backup")
+	}
+
+	// Match on the text bd and SQLite print
+	text := strings.ToLower(err.Error())
+	if isBd {
+		text += "\n" + strings.ToLower(bdErr.Stderr) + "\n" + strings.ToLower(bdErr.Stdout)
+	}
+	switch {
+	case strings.Contains(text, "database is locked") || strings.Contains(text, "sqlite_busy"):
+		add("The database is locked by another process - wait a moment and retry")
+	case strings.Contains(text, "malformed") || strings.Contains(text, "corrupt"):
+		add("The database may be damaged - run 'bd doctor --fix'")
+	}
+	if strings.Contains(text, "no issue found") || strings.Contains(text, "issue not found") {
+		add("The issue may have been deleted or renamed - press 'r' to refresh")
+	}
+	if strings.Contains(text, "unknown flag") || strings.Contains(text, "unknown command") {
+		add("Your bd version may not support this operation - upgrade bd ('bd version' shows the current one)")
+	}
+	if strings.Contains(text, "not initialized") || strings.Contains(text, "no .beads") {
+		add("No beads database found for bd - run 'bd init' or start beads-tui from the project directory")
+	}
+	if strings.Contains(text, "permission denied") {
+		add("Permission denied - check ownership of the .beads directory")
+	}
+	if isBd && strings.HasPrefix(bdErr.Message, "failed to parse JSON") {
+		add("bd returned unexpected output - beads-tui and bd versions may be out of sync")
+	}
+
+	if len(remedies) == 0 {
+		add("Run the command above in a terminal to see bd's full output")
+	}
+	return remedies
+}
+
+// formatErrorReport renders a plain-text report of a failure: summary, command,
+// exit code, captured output, and suggested remedies. Used for both the error
+// overlay and the clipboard copy.
+func formatErrorReport(summary string, err error) string {
+	var sb strings.Builder
+	sb.WriteString(summary + "\n\n")
+
+	var bdErr *BdError
+	if errors.As(err, &bdErr) {
+		sb.WriteString(fmt.Sprintf("Command:   %s\n", bdErr.Command()))
+		if bdErr.ExitCode >= 0 {
+			sb.WriteString(fmt.Sprintf("Exit code: %d\n", bdErr.ExitCode))
+		} else {
+			sb.WriteString("Exit code: (did not exit normally)\n")
+		}
+		sb.WriteString(fmt.Sprintf("Error:     %s\n", bdErr.Message))
+		if bdErr.Stderr != "" {
+			sb.WriteString("\nStderr:\n" + bdErr.Stderr + "\n")
+		}
+		if bdErr.Stdout != "" {
+			sb.WriteString("\nStdout:\n" + bdErr.Stdout + "\n")
+		}
+	} else {
+		sb.WriteString(fmt.Sprintf("Error: %v\n", err))
+	}
+
+	sb.WriteString("\nSuggestions:\n")
+	for _, remedy := range errorRemedies(err) {
+		sb.WriteString("- " + remedy + "\n")
+	}
+	return sb.String()
+}
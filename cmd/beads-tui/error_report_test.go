@@ -0,0 +1,96 @@
+package main
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"os/exec"
+	"strings"
+	"testing"
+
+	"github.com/andy/beads-tui/internal/storage"
+)
+
+func containsRemedy(remedies []string, substr string) bool {
+	for _, remedy := range remedies {
+		if strings.Contains(remedy, substr) {
+			return true
+		}
+	}
+	return false
+}
+
+func TestErrorRemedies(t *testing.T) {
+	tests := []struct {
+		name string
+		err  error
+		want string
+	}{
+		{
+			name: "bd not installed",
+			err:  &BdError{Args: []string{"update"}, ExitCode: -1, Message: "bd update command failed", Err: &exec.Error{Name: "bd", Err: exec.ErrNotFound}},
+			want: "bd_path",
+		},
+		{
+			name: "bd timeout",
+			err:  &BdError{Args: []string{"close"}, ExitCode: -1, Message: "timed out", Err: context.DeadlineExceeded},
+			want: "daemon",
+		},
+		{
+			name: "locked database in stderr",
+			err:  &BdError{Args: []string{"update"}, ExitCode: 1, Stderr: "Error: database is locked", Message: "bd update failed"},
+			want: "locked",
+		},
+		{
+			name: "missing issue",
+			err:  &BdError{Args: []string{"update"}, ExitCode: 1, Message: "bd update failed: no issue found matching tui-x"},
+			want: "refresh",
+		},
+		{
+			name: "corrupted sqlite",
+			err:  fmt.Errorf("load failed: %w", storage.ErrDatabaseCorrupted),
+			want: "bd doctor --fix",
+		},
+		{
+			name: "unrecognized failure",
+			err:  errors.New("something odd"),
+			want: "terminal",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			remedies := errorRemedies(tt.err)
+			if !containsRemedy(remedies, tt.want) {
+				t.Errorf("expected a remedy mentioning %q, got %v", tt.want, remedies)
+			}
+		})
+	}
+}
+
+func TestFormatErrorReport(t *testing.T) {
+	err := &BdError{
+		Args:     []string{"close", "tui-1", "--json"},
+		ExitCode: 1,
+		Stderr:   "Error: database is locked",
+		Message:  "bd close failed: Error: database is locked",
+	}
+
+	report := formatErrorReport("Error closing issue", err)
+	for _, want := range []string{
+		"Error closing issue",
+		"Command:   bd close tui-1 --json",
+		"Exit code: 1",
+		"Stderr:\nError: database is locked",
+		"Suggestions:\n- The database is locked",
+	} {
+		if !strings.Contains(report, want) {
+			t.Errorf("expected report to contain %q, got:\n%s", want, report)
+		}
+	}
+
+	plain := formatErrorReport("Error loading issues", errors.New("boom"))
+	if !strings.Contains(plain, "Error: boom") || strings.Contains(plain, "Command:") {
+		t.Errorf("unexpected report for non-bd error:\n%s", plain)
+	}
+}
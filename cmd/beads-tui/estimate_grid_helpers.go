@@ -0,0 +1,47 @@
+package main
+
+import (
+	"fmt"
+
+	"github.com/andy/beads-tui/internal/parser"
+	"github.com/andy/beads-tui/internal/state"
+)
+
+// estimateGrid counts unclosed issues by priority (rows, P0-P4) and estimate
+// bucket (columns, in state.EstimateBuckets order)
+func estimateGrid(issues []*parser.Issue) [5][]int {
+	var grid [5][]int
+	for p := range grid {
+		grid[p] = make([]int, len(state.EstimateBuckets))
+	}
+
+	column := make(map[string]int, len(state.EstimateBuckets))
+	for i, bucket := range state.EstimateBuckets {
+		column[bucket.Name] = i
+	}
+	for _, issue := range issues {
+		if issue.Status == parser.StatusClosed || issue.Priority < 0 || issue.Priority > 4 {
+			continue
+		}
+		grid[issue.Priority][column[state.EstimateBucketOf(issue)]]++
+	}
+	return grid
+}
+
+// estimateCellKind classifies a grid cell for highlighting: high priority
+// work estimated at over a day probably needs splitting, and low priority
+// work under an hour is a quick win
+func estimateCellKind(priority int, bucket string) string {
+	switch {
+	case priority <= 1 && (bucket == "24h" || bucket == "24h+"):
+		return "split"
+	case priority >= 3 && bucket == "1h":
+		return "quick"
+	}
+	return ""
+}
+
+// estimateGridQuery is the quick filter query matching one grid cell
+func estimateGridQuery(priority int, bucket string) string {
+	return fmt.Sprintf("p%d est:%s", priority, bucket)
+}
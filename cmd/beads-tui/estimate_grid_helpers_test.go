@@ -0,0 +1,75 @@
+package main
+
+import (
+	"testing"
+
+	"github.com/andy/beads-tui/internal/parser"
+	"github.com/andy/beads-tui/internal/state"
+)
+
+func TestEstimateGrid(t *testing.T) {
+	minutes := func(m int) *int { return &m }
+	issues := []*parser.Issue{
+		{ID: "tui-1", Status: parser.StatusOpen, Priority: 0, EstimatedMinutes: minutes(3000)},
+		{ID: "tui-2", Status: parser.StatusInProgress, Priority: 0, EstimatedMinutes: minutes(2000)},
+		{ID: "tui-3", Status: parser.StatusBlocked, Priority: 4, EstimatedMinutes: minutes(15)},
+		{ID: "tui-4", Status: parser.StatusOpen, Priority: 2},
+		{ID: "tui-5", Status: parser.StatusClosed, Priority: 0, EstimatedMinutes: minutes(3000)},
+	}
+
+	grid := estimateGrid(issues)
+	column := func(name string) int {
+		for i, bucket := range state.EstimateBuckets {
+			if bucket.Name == name {
+				return i
+			}
+		}
+		t.Fatalf("no bucket %q", name)
+		return -1
+	}
+
+	if got := grid[0][column("24h+")]; got != 2 {
+		t.Errorf("expected 2 open P0 issues over a day (closed excluded), got %d", got)
+	}
+	if got := grid[4][column("1h")]; got != 1 {
+		t.Errorf("expected 1 P4 quick issue, got %d", got)
+	}
+	if got := grid[2][column(state.EstimateNone)]; got != 1 {
+		t.Errorf("expected 1 unestimated P2 issue, got %d", got)
+	}
+
+	total := 0
+	for _, row := range grid {
+		for _, count := range row {
+			total += count
+		}
+	}
+	if total != 4 {
+		t.Errorf("expected 4 issues in the grid, got %d", total)
+	}
+}
+
+func TestEstimateCellKind(t *testing.T) {
+	tests := []struct {
+		priority int
+		bucket   string
+		want     string
+	}{
+		{0, "24h+", "split"},
+		{1, "24h", "split"},
+		{2, "24h+", ""},
+		{3, "1h", "quick"},
+		{4, "1h", "quick"},
+		{4, "4h", ""},
+		{0, state.EstimateNone, ""},
+	}
+	for _, tt := range tests {
+		if got := estimateCellKind(tt.priority, tt.bucket); got != tt.want {
+			t.Errorf("estimateCellKind(%d, %q) = %q, want %q", tt.priority, tt.bucket, got, tt.want)
+		}
+	}
+
+	if got := estimateGridQuery(3, "1h"); got != "p3 est:1h" {
+		t.Errorf("unexpected query %q", got)
+	}
+}
@@ -0,0 +1,57 @@
+package main
+
+import (
+	"os"
+	"path/filepath"
+	"strings"
+	"time"
+
+	"github.com/andy/beads-tui/internal/formatting"
+	"github.com/andy/beads-tui/internal/parser"
+)
+
+// visibleIssues returns the issues shown in the list, in list order. An issue
+// listed more than once (under several parents in the tree) is exported once.
+func visibleIssues(itemCount int, indexToIssue map[int]*parser.Issue) []*parser.Issue {
+	var issues []*parser.Issue
+	seen := make(map[string]bool)
+	for index := 0; index < itemCount; index++ {
+		issue, ok := indexToIssue[index]
+		if !ok || seen[issue.ID] {
+			continue
+		}
+		seen[issue.ID] = true
+		issues = append(issues, issue)
+	}
+	return issues
+}
+
+// defaultExportPath names an export after the date, in the current directory
+func defaultExportPath(format formatting.ExportFormat, now time.Time) string {
+	return "beads-export-" + now.Format("2006-01-02") + format.Extension()
+}
+
+// withExportExtension switches path to format's extension when it ends in
+// another export format's extension, so picking a format after typing a name
+// keeps the name. Other paths are left as typed.
+func withExportExtension(path string, format formatting.ExportFormat) string {
+	ext := filepath.Ext(path)
+	for _, other := range formatting.ExportFormats {
+		if strings.EqualFold(ext, other.Extension()) {
+			return strings.TrimSuffix(path, ext) + format.Extension()
+		}
+	}
+	return path
+}
+
+// expandHome replaces a leading ~/ with the user's home directory
+func expandHome(path string) string {
+	if path != "~" && !strings.HasPrefix(path, "~/") {
+		return path
+	}
+	home, err := os.UserHomeDir()
+	if err != nil {
+		return path
+	}
+	return filepath.Join(home, strings.TrimPrefix(path, "~"))
+}
@@ -0,0 +1,49 @@
+package main
+
+import (
+	"reflect"
+	"testing"
+	"time"
+
+	"github.com/andy/beads-tui/internal/formatting"
+	"github.com/andy/beads-tui/internal/parser"
+)
+
+func TestVisibleIssues(t *testing.T) {
+	a := &parser.Issue{ID: "tui-1"}
+	b := &parser.Issue{ID: "tui-2"}
+	// Row 0 is a section header; tui-1 shows up twice in the tree
+	indexToIssue := map[int]*parser.Issue{1: b, 2: a, 4: a}
+
+	var ids []string
+	for _, issue := range visibleIssues(5, indexToIssue) {
+		ids = append(ids, issue.ID)
+	}
+	if want := []string{"tui-2", "tui-1"}; !reflect.DeepEqual(ids, want) {
+		t.Errorf("visibleIssues = %v, want %v", ids, want)
+	}
+}
+
+func TestExportPaths(t *testing.T) {
+	now := time.Date(2026, 3, 4, 12, 0, 0, 0, time.UTC)
+	if got := defaultExportPath(formatting.ExportMarkdown, now); got != "beads-export-2026-03-04.md" {
+		t.Errorf("unexpected default path %q", got)
+	}
+
+	tests := []struct {
+		path   string
+		format formatting.ExportFormat
+		want   string
+	}{
+		{"report.csv", formatting.ExportJSON, "report.json"},
+		{"out/report.JSON", formatting.ExportMarkdown, "out/report.md"},
+		{"report.md", formatting.ExportCSV, "report.csv"},
+		{"report.txt", formatting.ExportCSV, "report.txt"}, // not ours to change
+		{"report", formatting.ExportCSV, "report"},
+	}
+	for _, tt := range tests {
+		if got := withExportExtension(tt.path, tt.format); got != tt.want {
+			t.Errorf("withExportExtension(%q, %s) = %q, want %q", tt.path, tt.format, got, tt.want)
+		}
+	}
+}
@@ -0,0 +1,135 @@
+package main
+
+import (
+	"context"
+	"fmt"
+	"html"
+	"io"
+	"net/http"
+	"net/url"
+	"os/exec"
+	"regexp"
+	"runtime"
+	"strings"
+	"time"
+
+	"github.com/andy/beads-tui/internal/config"
+	"github.com/andy/beads-tui/internal/parser"
+)
+
+// remoteTitleTimeout bounds fetching a linked page to read its title
+const remoteTitleTimeout = 5 * time.Second
+
+// maxTitlePageBytes is how much of a linked page is read looking for its title
+const maxTitlePageBytes = 1 << 20
+
+// externalRef returns an issue's external reference, or "" if it has none
+func externalRef(issue *parser.Issue) string {
+	if issue.ExternalRef == nil {
+		return ""
+	}
+	return *issue.ExternalRef
+}
+
+// externalRefURL returns the web address for an issue's external reference:
+// the reference itself if it is an http(s) URL, otherwise the URL template
+// of the first rule whose pattern matches it, with the captures filled in.
+// Returns "" if no rule matches.
+func externalRefURL(ref string, rules []config.ExternalRefURL) (string, error) {
+	ref = strings.TrimSpace(ref)
+	if isWebURL(ref) {
+		return ref, nil
+	}
+	for _, rule := range rules {
+		pattern, err := regexp.Compile(rule.Pattern)
+		if err != nil {
+			return "", fmt.Errorf("bad external_ref_urls pattern %q: %w", rule.Pattern, err)
+		}
+		match := pattern.FindStringSubmatchIndex(ref)
+		if match == nil {
+			continue
+		}
+		link := string(pattern.ExpandString(nil, rule.URL, ref, match))
+		if !isWebURL(link) {
+			return "", fmt.Errorf("external_ref_urls turned %q into %q, which isn't an http(s) URL", ref, link)
+		}
+		return link, nil
+	}
+	return "", nil
+}
+
+// isWebURL reports whether text is an absolute http or https URL
+func isWebURL(text string) bool {
+	parsed, err := url.Parse(text)
+	return err == nil && (parsed.Scheme == "http" || parsed.Scheme == "https") && parsed.Host != ""
+}
+
+// fetchRemoteTitle downloads a linked page and returns its HTML title, to
+// confirm an external reference points at the intended upstream issue.
+// Trackers that need a login usually answer with an error status or a login
+// page, whose title shows as much.
+func fetchRemoteTitle(ctx context.Context, client *http.Client, link string) (string, error) {
+	ctx, cancel := context.WithTimeout(ctx, remoteTitleTimeout)
+	defer cancel()
+
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, link, nil)
+	if err != nil {
+		return "", err
+	}
+	req.Header.Set("Accept", "text/html")
+	resp, err := client.Do(req)
+	if err != nil {
+		return "", err
+	}
+	defer resp.Body.Close()
+	if resp.StatusCode != http.StatusOK {
+		return "", fmt.Errorf("%s returned %s", link, resp.Status)
+	}
+
+	body, err := io.ReadAll(io.LimitReader(resp.Body, maxTitlePageBytes))
+	if err != nil {
+		return "", err
+	}
+	title := pageTitle(string(body))
+	if title == "" {
+		return "", fmt.Errorf("%s has no page title", link)
+	}
+	return title, nil
+}
+
+// titleTag matches the <title> element of an HTML page
+var titleTag = regexp.MustCompile(`(?is)<title[^>]*>(.*?)</title>`)
+
+// pageTitle returns the text of an HTML page's <title>, with entities
+// decoded and whitespace collapsed, or "" if it has none
+func pageTitle(page string) string {
+	match := titleTag.FindStringSubmatch(page)
+	if match == nil {
+		return ""
+	}
+	return strings.Join(strings.Fields(html.UnescapeString(match[1])), " ")
+}
+
+// browserCommand returns the command that opens a URL in the default
+// browser on the given OS
+func browserCommand(goos, link string) (string, []string) {
+	switch goos {
+	case "darwin":
+		return "open", []string{link}
+	case "windows":
+		return "rundll32", []string{"url.dll,FileProtocolHandler", link}
+	default:
+		return "xdg-open", []string{link}
+	}
+}
+
+// openInBrowser opens a URL in the default browser without waiting for it
+func openInBrowser(link string) error {
+	name, args := browserCommand(runtime.GOOS, link)
+	cmd := exec.Command(name, args...)
+	if err := cmd.Start(); err != nil {
+		return fmt.Errorf("failed to run %s: %w", name, err)
+	}
+	go cmd.Wait() // Reap the process
+	return nil
+}
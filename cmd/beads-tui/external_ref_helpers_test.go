@@ -0,0 +1,102 @@
+package main
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"reflect"
+	"strings"
+	"testing"
+
+	"github.com/andy/beads-tui/internal/config"
+)
+
+func TestExternalRefURL(t *testing.T) {
+	rules := []config.ExternalRefURL{
+		{Pattern: `^([A-Z]+-[0-9]+)$`, URL: "https://example.atlassian.net/browse/$1"},
+		{Pattern: `^gh-(?P<number>[0-9]+)$`, URL: "https://github.com/acme/app/issues/${number}"},
+		{Pattern: `^(\w+)/(\w+)#([0-9]+)$`, URL: "https://github.com/$1/$2/issues/$3"},
+	}
+
+	tests := []struct {
+		ref  string
+		want string
+	}{
+		{"https://example.com/ticket/9", "https://example.com/ticket/9"},
+		{" http://example.com ", "http://example.com"},
+		{"PROJ-123", "https://example.atlassian.net/browse/PROJ-123"},
+		{"gh-42", "https://github.com/acme/app/issues/42"},
+		{"acme/app#7", "https://github.com/acme/app/issues/7"},
+		{"proj-123", ""},
+		{"file:///etc/passwd", ""},
+		{"", ""},
+	}
+	for _, tt := range tests {
+		got, err := externalRefURL(tt.ref, rules)
+		if err != nil || got != tt.want {
+			t.Errorf("externalRefURL(%q) = %q, %v; want %q", tt.ref, got, err, tt.want)
+		}
+	}
+
+	if _, err := externalRefURL("X-1", []config.ExternalRefURL{{Pattern: "(", URL: "https://x/$1"}}); err == nil {
+		t.Error("expected an error for an invalid pattern")
+	}
+	_, err := externalRefURL("X-1", []config.ExternalRefURL{{Pattern: ".*", URL: "$0"}})
+	if err == nil || !strings.Contains(err.Error(), "isn't an http(s) URL") {
+		t.Errorf("expected an error for a template that doesn't make a URL, got %v", err)
+	}
+}
+
+func TestBrowserCommand(t *testing.T) {
+	tests := []struct {
+		goos string
+		name string
+		args []string
+	}{
+		{"linux", "xdg-open", []string{"https://x"}},
+		{"darwin", "open", []string{"https://x"}},
+		{"windows", "rundll32", []string{"url.dll,FileProtocolHandler", "https://x"}},
+	}
+	for _, tt := range tests {
+		name, args := browserCommand(tt.goos, "https://x")
+		if name != tt.name || !reflect.DeepEqual(args, tt.args) {
+			t.Errorf("browserCommand(%q) = %s %v, want %s %v", tt.goos, name, args, tt.name, tt.args)
+		}
+	}
+}
+
+func TestPageTitle(t *testing.T) {
+	page := "<html><head><meta charset=utf-8>\n<TITLE data-x=1>\n  Fix crash &amp; hang · Issue #7 · acme/app\n</TITLE></head></html>"
+	if got, want := pageTitle(page), "Fix crash & hang · Issue #7 · acme/app"; got != want {
+		t.Errorf("pageTitle = %q, want %q", got, want)
+	}
+	if got := pageTitle("<html><body>No title</body></html>"); got != "" {
+		t.Errorf("pageTitle without a title = %q, want empty", got)
+	}
+}
+
+func TestFetchRemoteTitle(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		switch r.URL.Path {
+		case "/issues/7":
+			fmt.Fprint(w, "<html><head><title>Fix crash</title></head></html>")
+		case "/blank":
+			fmt.Fprint(w, "<html></html>")
+		default:
+			http.NotFound(w, r)
+		}
+	}))
+	defer server.Close()
+
+	title, err := fetchRemoteTitle(context.Background(), server.Client(), server.URL+"/issues/7")
+	if err != nil || title != "Fix crash" {
+		t.Errorf("fetchRemoteTitle = %q, %v; want Fix crash", title, err)
+	}
+	if _, err := fetchRemoteTitle(context.Background(), server.Client(), server.URL+"/missing"); err == nil || !strings.Contains(err.Error(), "404") {
+		t.Errorf("expected a 404 error, got %v", err)
+	}
+	if _, err := fetchRemoteTitle(context.Background(), server.Client(), server.URL+"/blank"); err == nil {
+		t.Error("expected an error for a page without a title")
+	}
+}
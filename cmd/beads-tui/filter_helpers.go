@@ -0,0 +1,94 @@
+package main
+
+import (
+	"slices"
+	"strings"
+
+	"github.com/andy/beads-tui/internal/state"
+	"github.com/rivo/tview"
+)
+
+// filterCompletionLimit caps the quick filter's autocomplete list
+const filterCompletionLimit = 10
+
+// filterCompletions suggests ways to finish the last token of a quick filter
+// query: a label after # (also after + in "#a+#b"), an assignee after @, or
+// an est: or due: value. Each suggestion is the whole query with the token
+// completed; names match case-insensitively by prefix. A negated token
+// (!#ui) completes like the token itself.
+func filterCompletions(text string, labels, assignees []string) []string {
+	start := strings.LastIndexAny(text, " ,") + 1
+	token := text[start:]
+	if len(token) > 1 && (token[0] == '!' || token[0] == '-') {
+		start++
+		token = token[1:] // Negated tokens complete the same way
+	}
+
+	var prefix string
+	var candidates []string
+	switch {
+	case strings.HasPrefix(token, "#"):
+		// Only the last label of "#a+#b" is being typed
+		plus := strings.LastIndex(token, "+") + 1
+		if !strings.HasPrefix(token[plus:], "#") {
+			return nil
+		}
+		start += plus + 1
+		prefix, candidates = token[plus+1:], labels
+	case strings.HasPrefix(token, "@"):
+		start++
+		prefix, candidates = token[1:], assignees
+	case strings.HasPrefix(strings.ToLower(token), "est:"):
+		start += len("est:")
+		prefix = token[len("est:"):]
+		for _, bucket := range state.EstimateBuckets {
+			candidates = append(candidates, bucket.Name)
+		}
+	case strings.HasPrefix(strings.ToLower(token), "due:"):
+		start += len("due:")
+		prefix = token[len("due:"):]
+		candidates = []string{string(state.DueOverdue), string(state.DueSoon), string(state.DueLater), string(state.DueNone)}
+	default:
+		return nil
+	}
+
+	var completions []string
+	for _, candidate := range candidates {
+		if strings.ContainsAny(candidate, " ,") || strings.EqualFold(candidate, prefix) {
+			continue // Can't be typed as one token, or already complete
+		}
+		if strings.HasPrefix(strings.ToLower(candidate), strings.ToLower(prefix)) {
+			completions = append(completions, text[:start]+candidate)
+			if len(completions) == filterCompletionLimit {
+				break
+			}
+		}
+	}
+	return completions
+}
+
+// highlightFilterQuery renders a quick filter query with its unknown tokens
+// (as listed by CheckFilterQuery, lowercased) in color, escaping the rest
+func highlightFilterQuery(query string, unknown []string, color string) string {
+	var sb strings.Builder
+	flush := func(token string) {
+		if token == "" {
+			return
+		}
+		if slices.Contains(unknown, strings.ToLower(token)) {
+			sb.WriteString("[" + color + "]" + tview.Escape(token) + "[-]")
+		} else {
+			sb.WriteString(tview.Escape(token))
+		}
+	}
+	start := 0
+	for i, r := range query {
+		if r == ' ' || r == ',' {
+			flush(query[start:i])
+			sb.WriteRune(r)
+			start = i + 1
+		}
+	}
+	flush(query[start:])
+	return sb.String()
+}
@@ -0,0 +1,40 @@
+package main
+
+import (
+	"reflect"
+	"testing"
+)
+
+func TestFilterCompletions(t *testing.T) {
+	labels := []string{"ui", "UX", "urgent", "has space"}
+	assignees := []string{"alice", "Alan", "bob"}
+	tests := []struct {
+		text string
+		want []string
+	}{
+		{"p1 #u", []string{"p1 #ui", "p1 #UX", "p1 #urgent"}},
+		{"#ui+#ur", []string{"#ui+#urgent"}},
+		{"bug,@al", []string{"bug,@alice", "bug,@Alan"}},
+		{"!#ur", []string{"!#urgent"}},
+		{"p1 -@b", []string{"p1 -@bob"}},
+		{"@bob", nil},
+		{"est:2", []string{"est:24h", "est:24h+"}},
+		{"p1 DUE:o", []string{"p1 DUE:overdue"}},
+		{"#has", nil},
+		{"p1 bu", nil},
+		{"", nil},
+	}
+	for _, tt := range tests {
+		if got := filterCompletions(tt.text, labels, assignees); !reflect.DeepEqual(got, tt.want) {
+			t.Errorf("filterCompletions(%q) = %q, want %q", tt.text, got, tt.want)
+		}
+	}
+}
+
+func TestHighlightFilterQuery(t *testing.T) {
+	got := highlightFilterQuery("p1 Nonsense,[x] #ui", []string{"nonsense", "[x]"}, "red")
+	want := "p1 [red]Nonsense[-],[red][x[][-] #ui"
+	if got != want {
+		t.Errorf("highlightFilterQuery = %q, want %q", got, want)
+	}
+}
@@ -0,0 +1,43 @@
+package main
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/andy/beads-tui/internal/parser"
+)
+
+// followActionKeys are the issue actions --issue (follow mode) passes on to
+// the normal key bindings: comment, edit, rename, close/reopen, priority,
+// assign, labels, worklog, watch, flag for discussion, copy, and undo. Keys
+// that move through or rearrange the (hidden) list are ignored.
+const followActionKeys = "ceRxX01234ALwVFyYKu"
+
+// findIssueByID finds the issue --issue names: the exact ID, or else the one
+// issue matching it without regard to case, or by the part after the prefix
+// ("abc" for "tui-abc")
+func findIssueByID(issues []*parser.Issue, id string) (*parser.Issue, error) {
+	id = strings.TrimSpace(id)
+	var matches []*parser.Issue
+	for _, issue := range issues {
+		if issue.ID == id {
+			return issue, nil
+		}
+		_, suffix, _ := strings.Cut(issue.ID, "-")
+		if strings.EqualFold(issue.ID, id) || strings.EqualFold(suffix, id) {
+			matches = append(matches, issue)
+		}
+	}
+	switch len(matches) {
+	case 0:
+		return nil, fmt.Errorf("issue %s not found", id)
+	case 1:
+		return matches[0], nil
+	default:
+		ids := make([]string, len(matches))
+		for i, match := range matches {
+			ids[i] = match.ID
+		}
+		return nil, fmt.Errorf("%s matches %s; use the full ID", id, strings.Join(ids, ", "))
+	}
+}
@@ -0,0 +1,35 @@
+package main
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/andy/beads-tui/internal/parser"
+)
+
+func TestFindIssueByID(t *testing.T) {
+	issues := []*parser.Issue{
+		{ID: "tui-abc"},
+		{ID: "tui-abc.1"},
+		{ID: "web-xyz"},
+		{ID: "api-xyz"},
+	}
+	for query, want := range map[string]string{
+		"tui-abc":   "tui-abc",
+		"TUI-ABC.1": "tui-abc.1",
+		"abc":       "tui-abc",
+		" abc.1 ":   "tui-abc.1",
+	} {
+		got, err := findIssueByID(issues, query)
+		if err != nil || got.ID != want {
+			t.Errorf("findIssueByID(%q) = %v, %v; want %s", query, got, err, want)
+		}
+	}
+
+	if _, err := findIssueByID(issues, "nope"); err == nil || !strings.Contains(err.Error(), "not found") {
+		t.Errorf("expected not found, got %v", err)
+	}
+	if _, err := findIssueByID(issues, "xyz"); err == nil || !strings.Contains(err.Error(), "api-xyz, web-xyz") && !strings.Contains(err.Error(), "web-xyz, api-xyz") {
+		t.Errorf("expected an ambiguity error, got %v", err)
+	}
+}
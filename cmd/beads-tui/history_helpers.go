@@ -0,0 +1,81 @@
+package main
+
+// historyLimit is how many issues the navigation history keeps
+const historyLimit = 100
+
+// issueHistory is the navigation history of the issues shown in the detail
+// panel, for going back (g;) and forward (g,) like vim's jumplist (whose
+// Ctrl-O is go to issue here, and whose Ctrl-I arrives as Tab). Jumps
+// (from the issue finder, a report, and so on) are kept; moving through the
+// list one issue at a time only keeps the issue where the moving stopped.
+type issueHistory struct {
+	entries []historyEntry
+	pos     int // Index of the entry shown (-1 when empty)
+}
+
+// historyEntry is one issue in the history. browsed entries were reached by
+// moving through the list, so the next move replaces them.
+type historyEntry struct {
+	issueID string
+	browsed bool
+}
+
+func newIssueHistory() *issueHistory {
+	return &issueHistory{pos: -1}
+}
+
+// Visit records that the issue's details are shown, by a jump or by moving
+// through the list. Entries after the current one (gone back from) are
+// dropped, as in a browser.
+func (h *issueHistory) Visit(issueID string, jump bool) {
+	if h.pos >= 0 && h.entries[h.pos].issueID == issueID {
+		if jump {
+			h.entries[h.pos].browsed = false // Keep it when moving on
+		}
+		return
+	}
+	h.entries = h.entries[:h.pos+1]
+	if !jump && h.pos >= 0 && h.entries[h.pos].browsed {
+		h.entries[h.pos].issueID = issueID
+		return
+	}
+	h.entries = append(h.entries, historyEntry{issueID: issueID, browsed: !jump})
+	if len(h.entries) > historyLimit {
+		h.entries = h.entries[len(h.entries)-historyLimit:]
+	}
+	h.pos = len(h.entries) - 1
+}
+
+// Back moves to the previous issue in the history. ok is false at the start.
+func (h *issueHistory) Back() (issueID string, ok bool) {
+	if h.pos <= 0 {
+		return "", false
+	}
+	h.pos--
+	h.entries[h.pos].browsed = false
+	return h.entries[h.pos].issueID, true
+}
+
+// Forward moves to the next issue after going back. ok is false at the end.
+func (h *issueHistory) Forward() (issueID string, ok bool) {
+	if h.pos < 0 || h.pos >= len(h.entries)-1 {
+		return "", false
+	}
+	h.pos++
+	h.entries[h.pos].browsed = false
+	return h.entries[h.pos].issueID, true
+}
+
+// Recent returns up to limit issues from the history, most recently shown
+// first, each once
+func (h *issueHistory) Recent(limit int) []string {
+	var recent []string
+	seen := make(map[string]bool)
+	for i := len(h.entries) - 1; i >= 0 && len(recent) < limit; i-- {
+		if id := h.entries[i].issueID; !seen[id] {
+			seen[id] = true
+			recent = append(recent, id)
+		}
+	}
+	return recent
+}
@@ -0,0 +1,71 @@
+package main
+
+import (
+	"slices"
+	"testing"
+)
+
+func TestIssueHistoryBackAndForward(t *testing.T) {
+	h := newIssueHistory()
+	if _, ok := h.Back(); ok {
+		t.Error("expected no going back in an empty history")
+	}
+
+	h.Visit("tui-1", false)
+	h.Visit("tui-2", false) // Browsed past tui-1: replaces it
+	h.Visit("tui-9", true)  // Jump
+	h.Visit("tui-10", false)
+	h.Visit("tui-11", false)
+
+	for _, want := range []string{"tui-9", "tui-2"} {
+		if got, ok := h.Back(); !ok || got != want {
+			t.Errorf("Back() = %q, %v; want %q", got, ok, want)
+		}
+	}
+	if _, ok := h.Back(); ok {
+		t.Error("expected the start of the history")
+	}
+	h.Visit("tui-2", false) // Showing the issue gone back to changes nothing
+	for _, want := range []string{"tui-9", "tui-11"} {
+		if got, ok := h.Forward(); !ok || got != want {
+			t.Errorf("Forward() = %q, %v; want %q", got, ok, want)
+		}
+	}
+	if _, ok := h.Forward(); ok {
+		t.Error("expected the end of the history")
+	}
+
+	// Moving on after going back drops the entries ahead, but keeps the
+	// issue gone back to
+	h.Back()
+	h.Visit("tui-12", false)
+	if _, ok := h.Forward(); ok {
+		t.Error("expected nothing ahead after moving on")
+	}
+	if got, _ := h.Back(); got != "tui-9" {
+		t.Errorf("Back() = %q, want tui-9", got)
+	}
+}
+
+func TestIssueHistoryRecent(t *testing.T) {
+	h := newIssueHistory()
+	for _, id := range []string{"tui-1", "tui-2", "tui-1", "tui-3"} {
+		h.Visit(id, true)
+	}
+	if got := h.Recent(10); !slices.Equal(got, []string{"tui-3", "tui-1", "tui-2"}) {
+		t.Errorf("Recent(10) = %v", got)
+	}
+	if got := h.Recent(1); !slices.Equal(got, []string{"tui-3"}) {
+		t.Errorf("Recent(1) = %v", got)
+	}
+}
+
+func TestIssueHistoryLimit(t *testing.T) {
+	h := newIssueHistory()
+	for i := 0; i < historyLimit+5; i++ {
+		h.Visit(string(rune('a'+i%26))+string(rune('0'+i/26)), true)
+	}
+	if len(h.entries) != historyLimit || h.pos != historyLimit-1 {
+		t.Errorf("expected %d entries ending at the last, got %d at %d", historyLimit, len(h.entries), h.pos)
+	}
+}
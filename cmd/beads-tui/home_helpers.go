@@ -0,0 +1,153 @@
+package main
+
+import (
+	"fmt"
+	"os"
+	"sort"
+	"strings"
+	"time"
+
+	"github.com/andy/beads-tui/internal/parser"
+	"github.com/andy/beads-tui/internal/state"
+)
+
+// homeSectionLimit caps the issues listed in each home screen section
+const homeSectionLimit = 5
+
+// homeStaleAfter is how long an in-progress issue can go without updates
+// before the home screen flags it as stale
+const homeStaleAfter = 14 * 24 * time.Hour
+
+// homeTarget describes where Enter on a home screen row jumps to
+type homeTarget struct {
+	Query      string // Quick filter query to apply ("" clears filters)
+	IssueID    string // Issue to select, if any
+	ShowClosed bool   // Also turn on showing closed issues
+}
+
+// homeRow is one selectable row in a home screen section: either a summary
+// line (Text) or an issue
+type homeRow struct {
+	Text   string
+	Issue  *parser.Issue
+	Target homeTarget
+}
+
+// homeSection is a titled group of rows. Enter on the title jumps to Target.
+type homeSection struct {
+	Title  string
+	Target homeTarget
+	Rows   []homeRow
+	Empty  string // Shown when there are no rows
+}
+
+// currentUser returns the name bd records as the actor: $BD_ACTOR, then $USER
+func currentUser() string {
+	if actor := os.Getenv("BD_ACTOR"); actor != "" {
+		return actor
+	}
+	return os.Getenv("USER")
+}
+
+// buildHomeSections summarizes the workspace for the home screen, ignoring
+// active filters: counts by status, top ready P0/P1 issues, recently updated
+// issues, the user's in-progress work, and stale in-progress issues
+func buildHomeSections(appState *state.State, user string, now time.Time) []homeSection {
+	issues := appState.GetAllIssues()
+
+	// Counts by status
+	counts := make(map[parser.Status]int)
+	ready := 0
+	for _, issue := range issues {
+		counts[issue.Status]++
+		if issue.Status == parser.StatusOpen && !appState.IsEffectivelyBlocked(issue.ID) {
+			ready++
+		}
+	}
+	statusSection := homeSection{Title: fmt.Sprintf("Issues (%d)", len(issues))}
+	for _, status := range []parser.Status{parser.StatusOpen, parser.StatusInProgress, parser.StatusBlocked, parser.StatusClosed} {
+		text := fmt.Sprintf("%-12s %4d", status, counts[status])
+		if status == parser.StatusOpen {
+			text += fmt.Sprintf("  (%d ready)", ready)
+		}
+		statusSection.Rows = append(statusSection.Rows, homeRow{
+			Text:   text,
+			Target: homeTarget{Query: string(status), ShowClosed: status == parser.StatusClosed},
+		})
+	}
+
+	// Highest priority ready work
+	var topReady []*parser.Issue
+	for _, issue := range issues {
+		if issue.Status == parser.StatusOpen && issue.Priority <= 1 && !appState.IsEffectivelyBlocked(issue.ID) {
+			topReady = append(topReady, issue)
+		}
+	}
+	sort.SliceStable(topReady, func(i, j int) bool {
+		if topReady[i].Priority != topReady[j].Priority {
+			return topReady[i].Priority < topReady[j].Priority
+		}
+		return topReady[i].ID < topReady[j].ID
+	})
+	const topReadyQuery = "p0,p1 open"
+	readySection := homeSection{Title: "Top ready (P0/P1)", Target: homeTarget{Query: topReadyQuery}, Empty: "No P0/P1 issues ready"}
+	readySection.Rows = issueRows(topReady, func(issue *parser.Issue) homeTarget {
+		return homeTarget{Query: topReadyQuery, IssueID: issue.ID}
+	})
+
+	// Recently updated, newest first
+	recent := append([]*parser.Issue(nil), issues...)
+	sort.SliceStable(recent, func(i, j int) bool {
+		return recent[i].UpdatedAt.After(recent[j].UpdatedAt)
+	})
+	recentSection := homeSection{Title: "Recently active", Empty: "No issues yet"}
+	recentSection.Rows = issueRows(recent, func(issue *parser.Issue) homeTarget {
+		return homeTarget{IssueID: issue.ID, ShowClosed: issue.Status == parser.StatusClosed}
+	})
+
+	// The user's in-progress work
+	var mine []*parser.Issue
+	for _, issue := range issues {
+		if issue.Status == parser.StatusInProgress && user != "" && strings.EqualFold(issue.Assignee, user) {
+			mine = append(mine, issue)
+		}
+	}
+	myQuery := "in_progress @" + strings.ToLower(user)
+	mySection := homeSection{Title: fmt.Sprintf("My work (@%s)", user), Target: homeTarget{Query: myQuery}, Empty: "Nothing in progress assigned to you"}
+	mySection.Rows = issueRows(mine, func(issue *parser.Issue) homeTarget {
+		return homeTarget{Query: myQuery, IssueID: issue.ID}
+	})
+
+	// In-progress issues nobody has touched in a while, oldest first
+	var stale []*parser.Issue
+	for _, issue := range issues {
+		if issue.Status == parser.StatusInProgress && now.Sub(issue.UpdatedAt) >= homeStaleAfter {
+			stale = append(stale, issue)
+		}
+	}
+	sort.SliceStable(stale, func(i, j int) bool {
+		return stale[i].UpdatedAt.Before(stale[j].UpdatedAt)
+	})
+	staleSection := homeSection{
+		Title:  fmt.Sprintf("Stale (in progress, no update in %d days)", int(homeStaleAfter.Hours()/24)),
+		Target: homeTarget{Query: "in_progress"},
+		Empty:  "No stale work",
+	}
+	staleSection.Rows = issueRows(stale, func(issue *parser.Issue) homeTarget {
+		return homeTarget{Query: "in_progress", IssueID: issue.ID}
+	})
+
+	return []homeSection{statusSection, readySection, recentSection, mySection, staleSection}
+}
+
+// issueRows turns the first homeSectionLimit issues into rows
+func issueRows(issues []*parser.Issue, target func(*parser.Issue) homeTarget) []homeRow {
+	var rows []homeRow
+	for i, issue := range issues {
+		if i == homeSectionLimit {
+			break
+		}
+		rows = append(rows, homeRow{Issue: issue, Target: target(issue)})
+	}
+	return rows
+}
@@ -0,0 +1,75 @@
+package main
+
+import (
+	"testing"
+	"time"
+
+	"github.com/andy/beads-tui/internal/formatting"
+	"github.com/andy/beads-tui/internal/parser"
+	"github.com/andy/beads-tui/internal/state"
+)
+
+func TestBuildHomeSections(t *testing.T) {
+	now := time.Date(2026, 3, 1, 12, 0, 0, 0, time.UTC)
+	day := 24 * time.Hour
+	appState := state.New()
+	appState.LoadIssues([]*parser.Issue{
+		{ID: "tui-1", Title: "Urgent", Status: parser.StatusOpen, Priority: 0, UpdatedAt: now.Add(-2 * day)},
+		{ID: "tui-2", Title: "High but blocked", Status: parser.StatusOpen, Priority: 1, UpdatedAt: now.Add(-day),
+			Dependencies: []*parser.Dependency{{IssueID: "tui-2", DependsOnID: "tui-1", Type: parser.DepBlocks}}},
+		{ID: "tui-3", Title: "Mine", Status: parser.StatusInProgress, Priority: 2, Assignee: "Alice", UpdatedAt: now.Add(-time.Hour)},
+		{ID: "tui-4", Title: "Forgotten", Status: parser.StatusInProgress, Priority: 2, Assignee: "bob", UpdatedAt: now.Add(-20 * day)},
+		{ID: "tui-5", Title: "Done", Status: parser.StatusClosed, Priority: 1, UpdatedAt: now.Add(-3 * day)},
+	})
+
+	sections := buildHomeSections(appState, "alice", now)
+	if len(sections) != 5 {
+		t.Fatalf("expected 5 sections, got %d", len(sections))
+	}
+	status, ready, recent, mine, stale := sections[0], sections[1], sections[2], sections[3], sections[4]
+
+	if got := status.Rows[0].Text; got != "open            2  (1 ready)" {
+		t.Errorf("unexpected open row %q", got)
+	}
+	if closed := status.Rows[3]; closed.Target.Query != "closed" || !closed.Target.ShowClosed {
+		t.Errorf("expected closed row to filter closed issues and show them, got %+v", closed.Target)
+	}
+
+	if ids := rowIDs(ready.Rows); len(ids) != 1 || ids[0] != "tui-1" {
+		t.Errorf("expected only unblocked P0/P1 open issues, got %v", ids)
+	}
+	if ids := rowIDs(recent.Rows); len(ids) != 5 || ids[0] != "tui-3" || ids[4] != "tui-4" {
+		t.Errorf("expected issues newest first, got %v", ids)
+	}
+	if ids := rowIDs(mine.Rows); len(ids) != 1 || ids[0] != "tui-3" {
+		t.Errorf("expected the user's in-progress issue (case-insensitive), got %v", ids)
+	}
+	if mine.Rows[0].Target.Query != "in_progress @alice" {
+		t.Errorf("unexpected my-work query %q", mine.Rows[0].Target.Query)
+	}
+	if ids := rowIDs(stale.Rows); len(ids) != 1 || ids[0] != "tui-4" {
+		t.Errorf("expected stale in-progress issue, got %v", ids)
+	}
+}
+
+func rowIDs(rows []homeRow) []string {
+	var ids []string
+	for _, row := range rows {
+		ids = append(ids, row.Issue.ID)
+	}
+	return ids
+}
+
+func TestFormatAge(t *testing.T) {
+	now := time.Date(2026, 3, 1, 12, 0, 0, 0, time.UTC)
+	tests := map[time.Duration]string{
+		time.Hour:           "today",
+		3 * 24 * time.Hour:  "3d",
+		35 * 24 * time.Hour: "5w",
+	}
+	for ago, want := range tests {
+		if got := formatting.FormatAge(now.Add(-ago), now); got != want {
+			t.Errorf("formatting.FormatAge(-%v) = %q, want %q", ago, got, want)
+		}
+	}
+}
@@ -0,0 +1,175 @@
+package main
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"log"
+	"os"
+	"os/exec"
+	"runtime"
+	"strings"
+	"time"
+
+	"github.com/andy/beads-tui/internal/config"
+	"github.com/andy/beads-tui/internal/parser"
+)
+
+// hookTimeout is how long a hook command may run before it's stopped
+const hookTimeout = 30 * time.Second
+
+// Hook events, named as in the config
+const (
+	hookIssueClosed  = "on_issue_closed"
+	hookStatusChange = "on_status_change"
+	hookCreate       = "on_create"
+)
+
+// issueHooks runs the hooks in the config after the bd commands that
+// trigger them (see execBdJSON). nil when no hook is set, and in demo mode.
+var issueHooks *hookRunner
+
+// hookRunner runs the user's hook commands in the background
+type hookRunner struct {
+	commands map[string]string // Command by event, only those set
+	dir      string            // Directory hooks run in: the project's
+
+	// onError reports a hook that failed, from the goroutine it ran on
+	onError func(event string, err error)
+}
+
+// newHookRunner returns a runner for the hooks set in hooks, or nil if none is
+func newHookRunner(hooks config.Hooks, dir string, onError func(event string, err error)) *hookRunner {
+	commands := make(map[string]string)
+	for event, command := range map[string]string{
+		hookIssueClosed:  hooks.OnIssueClosed,
+		hookStatusChange: hooks.OnStatusChange,
+		hookCreate:       hooks.OnCreate,
+	} {
+		if strings.TrimSpace(command) != "" {
+			commands[event] = command
+		}
+	}
+	if len(commands) == 0 {
+		return nil
+	}
+	return &hookRunner{commands: commands, dir: dir, onError: onError}
+}
+
+// hookEvents returns the events a bd command that succeeded triggers, in
+// the order their hooks run: create is on_create; close, reopen, and an
+// update that sets --status are on_status_change, followed by
+// on_issue_closed when the issue ends up closed
+func hookEvents(args []string) []string {
+	if len(args) < 2 {
+		return nil
+	}
+	switch args[0] {
+	case "create":
+		return []string{hookCreate}
+	case "close":
+		return []string{hookStatusChange, hookIssueClosed}
+	case "reopen":
+		return []string{hookStatusChange}
+	case "update":
+		for i := 2; i+1 < len(args); i++ {
+			if args[i] != "--status" {
+				continue
+			}
+			if parser.Status(args[i+1]) == parser.StatusClosed {
+				return []string{hookStatusChange, hookIssueClosed}
+			}
+			return []string{hookStatusChange}
+		}
+	}
+	return nil
+}
+
+// Fire starts the hooks a bd command that succeeded triggers, for each
+// issue in its result. Status commands whose output had no issue (older bd
+// versions print none) still run them, with just the ID. Safe to call on a
+// nil runner.
+func (r *hookRunner) Fire(args []string, result *BdCommandResult) {
+	if r == nil {
+		return
+	}
+	var events []string
+	for _, event := range hookEvents(args) {
+		if r.commands[event] != "" {
+			events = append(events, event)
+		}
+	}
+	if len(events) == 0 {
+		return
+	}
+
+	var issues []parser.Issue
+	if result != nil {
+		issues = result.Issues
+	}
+	if len(issues) == 0 && args[0] != "create" {
+		issues = []parser.Issue{{ID: args[1]}}
+	}
+	for _, issue := range issues {
+		go func() {
+			for _, event := range events {
+				if err := runHook(event, r.commands[event], r.dir, &issue); err != nil {
+					log.Printf("HOOK: %s for %s failed: %v", event, issue.ID, err)
+					if r.onError != nil {
+						r.onError(event, err)
+					}
+				}
+			}
+		}()
+	}
+}
+
+// runHook runs a hook command with the shell, passing the issue as JSON on
+// stdin and its ID, title, and status in BEADS_ISSUE_ID, BEADS_ISSUE_TITLE,
+// and BEADS_ISSUE_STATUS (the event is in BEADS_TUI_HOOK)
+func runHook(event, command, dir string, issue *parser.Issue) error {
+	data, err := json.Marshal(issue)
+	if err != nil {
+		return fmt.Errorf("failed to encode %s: %w", issue.ID, err)
+	}
+
+	ctx, cancel := context.WithTimeout(context.Background(), hookTimeout)
+	defer cancel()
+
+	name, args := hookShell(runtime.GOOS, command)
+	cmd := exec.CommandContext(ctx, name, args...)
+	cmd.Dir = dir
+	cmd.Env = append(os.Environ(),
+		"BEADS_TUI_HOOK="+event,
+		"BEADS_ISSUE_ID="+issue.ID,
+		"BEADS_ISSUE_TITLE="+issue.Title,
+		"BEADS_ISSUE_STATUS="+string(issue.Status),
+	)
+	cmd.Stdin = bytes.NewReader(data)
+	var output bytes.Buffer
+	cmd.Stdout = &output
+	cmd.Stderr = &output
+
+	log.Printf("HOOK: Running %s for %s: %s", event, issue.ID, command)
+	err = cmd.Run()
+	if ctx.Err() == context.DeadlineExceeded {
+		return fmt.Errorf("timed out after %s", hookTimeout)
+	}
+	if err != nil {
+		if out := strings.TrimSpace(output.String()); out != "" {
+			return fmt.Errorf("%w: %s", err, out)
+		}
+		return err
+	}
+	return nil
+}
+
+// hookShell returns the command that runs a hook's command line on the
+// given OS
+func hookShell(goos, command string) (string, []string) {
+	if goos == "windows" {
+		return "cmd", []string{"/C", command}
+	}
+	return "sh", []string{"-c", command}
+}
@@ -0,0 +1,82 @@
+package main
+
+import (
+	"encoding/json"
+	"os"
+	"path/filepath"
+	"runtime"
+	"slices"
+	"strings"
+	"testing"
+
+	"github.com/andy/beads-tui/internal/config"
+	"github.com/andy/beads-tui/internal/parser"
+)
+
+func TestHookEvents(t *testing.T) {
+	tests := []struct {
+		args []string
+		want []string
+	}{
+		{[]string{"create", "Title", "-p", "2", "--json"}, []string{hookCreate}},
+		{[]string{"close", "tui-1", "--reason", "Done", "--json"}, []string{hookStatusChange, hookIssueClosed}},
+		{[]string{"reopen", "tui-1", "--json"}, []string{hookStatusChange}},
+		{[]string{"update", "tui-1", "--status", "in_progress", "--json"}, []string{hookStatusChange}},
+		{[]string{"update", "tui-1", "--title", "T", "--status", "closed"}, []string{hookStatusChange, hookIssueClosed}},
+		{[]string{"update", "tui-1", "--priority", "1", "--json"}, nil},
+		{[]string{"label", "add", "tui-1", "ui"}, nil},
+		{[]string{"show", "tui-1"}, nil},
+		{[]string{"close"}, nil},
+	}
+	for _, tt := range tests {
+		if got := hookEvents(tt.args); !slices.Equal(got, tt.want) {
+			t.Errorf("hookEvents(%q) = %v, want %v", tt.args, got, tt.want)
+		}
+	}
+}
+
+func TestNewHookRunner(t *testing.T) {
+	if r := newHookRunner(config.Hooks{OnCreate: "  "}, "", nil); r != nil {
+		t.Errorf("expected no runner without hooks, got %+v", r)
+	}
+	// A nil runner fires nothing
+	var none *hookRunner
+	none.Fire([]string{"close", "tui-1"}, nil)
+
+	r := newHookRunner(config.Hooks{OnIssueClosed: "notify"}, "", nil)
+	if r == nil || len(r.commands) != 1 || r.commands[hookIssueClosed] != "notify" {
+		t.Errorf("expected only the close hook, got %+v", r)
+	}
+}
+
+func TestRunHook(t *testing.T) {
+	if runtime.GOOS == "windows" {
+		t.Skip("hook commands here are sh scripts")
+	}
+	dir := t.TempDir()
+	issue := &parser.Issue{ID: "tui-1", Title: "Fix login", Status: parser.StatusClosed}
+
+	// The issue comes on stdin, the event and ID in the environment, and
+	// the hook runs in the project directory
+	command := `cat > issue.json && printf '%s %s %s' "$BEADS_TUI_HOOK" "$BEADS_ISSUE_ID" "$BEADS_ISSUE_STATUS" > env.txt`
+	if err := runHook(hookIssueClosed, command, dir, issue); err != nil {
+		t.Fatalf("runHook: %v", err)
+	}
+	data, err := os.ReadFile(filepath.Join(dir, "issue.json"))
+	if err != nil {
+		t.Fatal(err)
+	}
+	var got parser.Issue
+	if err := json.Unmarshal(data, &got); err != nil || got.ID != "tui-1" || got.Title != "Fix login" {
+		t.Errorf("stdin = %s (%v), want the issue's JSON", data, err)
+	}
+	if env, _ := os.ReadFile(filepath.Join(dir, "env.txt")); string(env) != "on_issue_closed tui-1 closed" {
+		t.Errorf("environment = %q", env)
+	}
+
+	// A failing hook reports its output
+	err = runHook(hookCreate, "echo no webhook configured >&2; exit 3", dir, issue)
+	if err == nil || !strings.Contains(err.Error(), "no webhook configured") {
+		t.Errorf("expected the hook's error output, got %v", err)
+	}
+}
@@ -0,0 +1,46 @@
+package main
+
+import (
+	"fmt"
+	"strconv"
+	"strings"
+
+	"github.com/andy/beads-tui/internal/storage"
+)
+
+// formatRawTables renders raw database rows as plain text, one column per
+// line. Values are quoted so stray whitespace and newlines are visible;
+// NULL is shown bare. Each value is followed by the column's declared type and
+// the type the driver returned, e.g. TIMESTAMP/time.Time.
+func formatRawTables(tables []storage.RawTable) string {
+	var sb strings.Builder
+	for i, table := range tables {
+		if i > 0 {
+			sb.WriteString("\n")
+		}
+		rowWord := "rows"
+		if len(table.Rows) == 1 {
+			rowWord = "row"
+		}
+		sb.WriteString(fmt.Sprintf("== %s (%d %s) ==\n", table.Name, len(table.Rows), rowWord))
+
+		width := 0
+		for _, column := range table.Columns {
+			width = max(width, len(column.Name))
+		}
+		for r, row := range table.Rows {
+			if len(table.Rows) > 1 {
+				sb.WriteString(fmt.Sprintf("-- row %d --\n", r+1))
+			}
+			for c, value := range row {
+				column := table.Columns[c]
+				if value.Null {
+					sb.WriteString(fmt.Sprintf("%-*s  NULL  (%s)\n", width, column.Name, column.DeclType))
+					continue
+				}
+				sb.WriteString(fmt.Sprintf("%-*s  %s  (%s/%s)\n", width, column.Name, strconv.Quote(value.Value), column.DeclType, value.Type))
+			}
+		}
+	}
+	return sb.String()
+}
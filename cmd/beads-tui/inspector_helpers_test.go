@@ -0,0 +1,35 @@
+package main
+
+import (
+	"testing"
+
+	"github.com/andy/beads-tui/internal/storage"
+)
+
+func TestFormatRawTables(t *testing.T) {
+	tables := []storage.RawTable{
+		{
+			Name:    "issues",
+			Columns: []storage.RawColumn{{Name: "id", DeclType: "TEXT"}, {Name: "assignee", DeclType: "TEXT"}},
+			Rows:    [][]storage.RawValue{{{Value: "tui-1", Type: "string"}, {Type: "NULL", Null: true}}},
+		},
+		{
+			Name:    "labels",
+			Columns: []storage.RawColumn{{Name: "label", DeclType: "TEXT"}},
+			Rows:    [][]storage.RawValue{{{Value: "ui ", Type: "string"}}, {{Value: "a\nb", Type: "string"}}},
+		},
+	}
+
+	want := "== issues (1 row) ==\n" +
+		"id        \"tui-1\"  (TEXT/string)\n" +
+		"assignee  NULL  (TEXT)\n" +
+		"\n" +
+		"== labels (2 rows) ==\n" +
+		"-- row 1 --\n" +
+		"label  \"ui \"  (TEXT/string)\n" +
+		"-- row 2 --\n" +
+		"label  \"a\\nb\"  (TEXT/string)\n"
+	if got := formatRawTables(tables); got != want {
+		t.Errorf("unexpected output:\n%s\nwant:\n%s", got, want)
+	}
+}
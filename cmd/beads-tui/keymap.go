@@ -0,0 +1,215 @@
+package main
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/rivo/tview"
+)
+
+// keyBinding documents one key (or key sequence) and what it does
+type keyBinding struct {
+	Keys        string
+	Description string // May span lines
+}
+
+// keySection groups related bindings under a heading
+type keySection struct {
+	Title    string
+	Bindings []keyBinding
+}
+
+// keymap lists every key binding, by section. The help screen (?) and the
+// `beads-tui keys` cheat sheet are both rendered from it, with the project's
+// leader sequences (see useLeaderBindings). TestKeymapMatchesHandlers checks
+// it against the key handlers in main.go.
+var keymap = []keySection{
+	{"Quick Start", []keyBinding{
+		{"j / k", "Navigate up/down (or use arrow keys)"},
+		{"Enter", "View issue details"},
+		{"a", "Create new issue"},
+		{"/", "Search issues"},
+		{"?", "This help screen"},
+		{"q", "Quit"},
+	}},
+	{"Navigation", []keyBinding{
+		{"j / ↓", "Move down"},
+		{"k / ↑", "Move up"},
+		{"gg", "Jump to top"},
+		{"G", "Jump to bottom"},
+		{"]] / [[", "Next / previous section in list view"},
+		{"Alt-1…Alt-9", "Jump to a list view section (1 = first)"},
+		{"gh", "Home screen (workspace summary; Enter jumps to the list)"},
+		{"gd", "Discussion queue (y copies a Markdown agenda, C clears it)"},
+		{"gi", "Inspect raw database rows for the selected issue (y copies)"},
+		{"gn", "Recent notifications (status bar messages)"},
+		{"gc", "Changes found by the last refresh (new, closed, updated, deleted)"},
+		{"gv", "How the selected issue changed over the last refreshes, field by field"},
+		{"g; / g,", "Back / forward through the issues shown in the details, like vim's jumplist\n(not Ctrl-o / Ctrl-i: Ctrl-o is go to issue, and terminals send Ctrl-i as Tab)"},
+		{"gr", "Recent issues (Enter jumps back to one)"},
+		{"gl", "Dependency cycles (⟲ in the list; Enter removes a link)"},
+		{"g#", "Label browser: every label with open/closed counts (Enter filters)"},
+		{"ga", "Aging issues: open longer than their priority allows (⏳ in the details)"},
+		{"gu", "Standup: closed, started, new, and stale issues by assignee"},
+		{"gC", "Archive: closed issues by close date (Tab range, d custom, ]/[ page)"},
+		{"gx", "Open the external reference in the browser (or click it in the details)"},
+		{"Ctrl-o", "Go to issue: fuzzy find by ID or title, including closed issues"},
+		{"Ctrl-l", "Command log: recent bd commands with exit status and output (y copies)"},
+		{"H", "History timeline of the selected issue"},
+		{"Tab", "Focus detail panel for scrolling"},
+		{"Enter", "Focus detail panel (when on issue)"},
+		{"ESC", "Return focus to issue list"},
+	}},
+	{"Search", []keyBinding{
+		{"/", "Search titles, descriptions, design, acceptance,\nnotes, comments and labels (best match first)\nPrefix a word to limit it to one field:\ntitle: desc: design: ac: notes: comment: label: id:\nUse \"quotes\" for phrases"},
+		{"n", "Next search result"},
+		{"N", "Previous search result"},
+		{"ESC", "Exit search mode"},
+	}},
+	{"Quick Actions", []keyBinding{
+		{"0-4", "Set priority (P0=critical, P1=high, P2=normal, P3=low, P4=lowest)"},
+		{"R", "Rename issue (edit title)"},
+		{"i", "Rename inline on the list row (Enter saves, ESC cancels)"},
+		{"I", "Add child issues inline below the selected row (Enter creates\nand starts the next, ESC finishes)"},
+		{"a", "Create new issue (vim-style \"add\"; Ctrl-N in the dialog creates\nand starts the next one)"},
+		{"c", "Add comment to selected issue"},
+		{"e", "Edit issue (title, description, design, acceptance, notes, priority, type);\nor double-click the row"},
+		{".", "Context menu: edit, status, priority, close, labels, comment, copy ID\n(or right-click the row)"},
+		{"E", "Split issue into 2-5 child issues (optionally convert to epic)"},
+		{"x", "Close issue with optional reason"},
+		{"X", "Reopen closed issue with optional reason"},
+		{"w", "Log time spent on the issue (shown against the estimate)"},
+		{"U", "Set or clear the due date"},
+		{"V", "Watch/unwatch the issue (◉; ! when it changes after a refresh)"},
+		{"u", "Undo last change (status, priority, close/reopen, title, edit, labels, dependencies, assignee)"},
+		{"F", "Flag/unflag issue for discussion (adds the \"discuss\" label)"},
+		{"D", "Manage dependencies (add/remove blocks, parent-child, related; import\n\"Depends on:\" lines and task list IDs from the description)"},
+		{"L", "Manage labels (add/remove labels)"},
+		{"A", "Assign issue (empty to unassign)"},
+		{"M", "Merge a duplicate issue into the selected one"},
+		{"y", "Yank (copy) issue ID to clipboard"},
+		{"Y", "Yank (copy) issue ID with title to clipboard"},
+		{"K", "Yank (copy) whole issue as Markdown (metadata, text fields,\ndependencies, comments)"},
+		{"B", "Check out a git branch for the issue (or copy its name)"},
+	}},
+	{"Two-Character Shortcuts", []keyBinding{
+		{"so", "Set status to open"},
+		{"si", "Set status to in_progress"},
+		{"sb", "Set status to blocked, with an optional reason (shown in the list)"},
+		{"sc", "Set status to closed"},
+		{"dD", "Discard (delete) issue after typing its ID to confirm"},
+	}},
+	{leaderSectionTitle, leaderKeyBindings(leaderBindings)},
+	{"View Controls", []keyBinding{
+		{"t", "Toggle between list and tree view"},
+		{"P", "Group list view by status → assignee → label"},
+		{"o", "Collapse/expand node in tree view (vim-style fold);\nin list view, cycle sort: created → priority → updated → id → title → estimate → due"},
+		{"h", "Collapse node (or jump to parent) in tree view"},
+		{"l", "Expand node (or step into first child) in tree view"},
+		{"} / {", "Next / previous sibling in tree view"},
+		{"g1-g9", "Jump to the Nth child in tree view"},
+		{"O", "Expand all nodes in tree view"},
+		{"Z", "Collapse all nodes in tree view"},
+		{"gm", "Move the issue: mark it, then gm on its new parent (Esc cancels)"},
+		{"v", "Toggle layout (details beside or below the list)"},
+		{"T", "Cycle to next theme (live theme switching)"},
+		{"C", "Toggle showing closed issues in list view"},
+		{"p", "Toggle issue ID prefix (tui-abc vs abc);\nin tree view, jump to the parent node"},
+		{"f", "Quick filter (type: p1 bug, feature, etc.)"},
+		{"S", "Show statistics dashboard (e: priority × estimate grid)"},
+		{"W", "Export the listed (filtered) issues to CSV, JSON, or Markdown"},
+		{"m", "Toggle mouse mode on/off"},
+		{"r", "Manual refresh"},
+	}},
+	{"Detail Panel Scrolling (when focused)", []keyBinding{
+		{"Ctrl-d", "Scroll down half page"},
+		{"Ctrl-u", "Scroll up half page"},
+		{"Ctrl-f", "Scroll down full page (vim)"},
+		{"Ctrl-b", "Scroll up full page (vim)"},
+		{"Ctrl-e", "Scroll down one line"},
+		{"Ctrl-y", "Scroll up one line"},
+		{"PageDown", "Scroll down full page"},
+		{"PageUp", "Scroll up full page"},
+		{"Home", "Jump to top of details"},
+		{"End", "Jump to bottom of details"},
+		{"] / [", "Pick the next/previous comment"},
+		{"c", "Add a comment on one line under the details (Enter posts, Esc closes)"},
+		{"e", "Edit the picked comment (your own only)"},
+		{"d", "Delete the picked comment after confirming (your own only)"},
+		{"} / {", "Pick the next/previous linked issue ID"},
+		{"Enter", "Jump to the picked linked issue (or click the link)"},
+	}},
+	{"In Dialogs", []keyBinding{
+		{"Tab", "Next field, then primary, cancel, other buttons"},
+		{"Shift-Tab", "Previous field or button"},
+		{"Ctrl-S", "Primary action (Save, Create, Apply, ...)"},
+		{"ESC", "Cancel / close"},
+		{"Home/End", "First/last field (Ctrl-Home/Ctrl-End in text inputs)"},
+	}},
+	{"General", []keyBinding{
+		{"?", "Show this help screen"},
+		{"Esc / Ctrl-C", "Cancel the running bd command"},
+		{"Qa ... Q", "Record the keys typed into macro register a (a-z)"},
+		{"@a / @@", "Replay macro register a / the last one replayed (Esc stops)"},
+		{"q", "Quit"},
+	}},
+}
+
+// keyColumnWidth is the width of the key column in the help screen and the
+// plain text cheat sheet
+const keyColumnWidth = 11
+
+// renderKeymapHelp renders the keymap for the help screen, with tview color
+// tags for the section headings
+func renderKeymapHelp(sections []keySection) string {
+	var sb strings.Builder
+	for i, section := range sections {
+		color := "cyan"
+		if i == 0 {
+			color = "green"
+		}
+		sb.WriteString(fmt.Sprintf("[%s::b]%s[-::-]\n", color, section.Title))
+		writeKeyLines(&sb, section.Bindings, tview.Escape)
+		sb.WriteString("\n")
+	}
+	return sb.String()
+}
+
+// renderKeymapText renders the keymap as a plain text cheat sheet
+func renderKeymapText(sections []keySection) string {
+	var sb strings.Builder
+	sb.WriteString("beads-tui keyboard shortcuts\n")
+	for _, section := range sections {
+		sb.WriteString("\n" + strings.ToUpper(section.Title) + "\n")
+		writeKeyLines(&sb, section.Bindings, func(s string) string { return s })
+	}
+	return sb.String()
+}
+
+// renderKeymapMarkdown renders the keymap as a Markdown cheat sheet with one
+// table per section
+func renderKeymapMarkdown(sections []keySection) string {
+	var sb strings.Builder
+	sb.WriteString("# beads-tui keyboard shortcuts\n")
+	for _, section := range sections {
+		sb.WriteString(fmt.Sprintf("\n## %s\n\n| Key | Action |\n| --- | --- |\n", section.Title))
+		for _, binding := range section.Bindings {
+			description := strings.ReplaceAll(binding.Description, "\n", " ")
+			sb.WriteString(fmt.Sprintf("| `%s` | %s |\n", binding.Keys, strings.ReplaceAll(description, "|", "\\|")))
+		}
+	}
+	return sb.String()
+}
+
+// writeKeyLines writes one aligned line per binding, indenting the
+// continuation lines of multi-line descriptions under the first
+func writeKeyLines(sb *strings.Builder, bindings []keyBinding, escape func(string) string) {
+	indent := strings.Repeat(" ", keyColumnWidth+3)
+	for _, binding := range bindings {
+		lines := strings.Split(binding.Description, "\n")
+		sb.WriteString(fmt.Sprintf("  %-*s %s\n", keyColumnWidth, escape(binding.Keys), escape(lines[0])))
+		for _, line := range lines[1:] {
+			sb.WriteString(indent + escape(line) + "\n")
+		}
+	}
+}
@@ -0,0 +1,241 @@
+package main
+
+import (
+	"go/ast"
+	"go/parser"
+	"go/token"
+	"slices"
+	"strconv"
+	"strings"
+	"testing"
+	"unicode/utf8"
+)
+
+// keymapUnchecked lists the keymap sections whose keys handleKey doesn't
+// handle itself: the detail panel and dialogs have their own handlers, and
+// the leader section is generated from leaderBindings (the keys those send
+// are checked instead)
+var keymapUnchecked = []string{
+	"Detail Panel Scrolling (when focused)",
+	"In Dialogs",
+	leaderSectionTitle,
+}
+
+// TestKeymapMatchesHandlers fails when main.go handles a key on the issue
+// list that the keymap doesn't document, or the keymap documents (or a
+// leader sequence sends) one that isn't handled. Named keys (Tab, Ctrl-x,
+// arrows) aren't checked.
+func TestKeymapMatchesHandlers(t *testing.T) {
+	handled := handledKeys(t)
+	documented := documentedKeys()
+
+	for _, key := range sortedKeys(handled) {
+		if !documented[key] {
+			t.Errorf("main.go handles %q, but the keymap doesn't list it", key)
+		}
+	}
+	for _, key := range sortedKeys(documented) {
+		if !handled[key] {
+			t.Errorf("the keymap lists %q, but main.go doesn't handle it", key)
+		}
+	}
+	for _, binding := range leaderBindings {
+		if binding.Sends != "" && !handled[binding.Sends] {
+			t.Errorf("leader sequence %s sends %q, but main.go doesn't handle it", leaderSequenceName(binding.Keys), binding.Sends)
+		}
+	}
+}
+
+// handledKeys returns the keys handleKey acts on: the runes in its largest
+// switch on event.Rune() (the single keys), and the second keys of the
+// sequences started by lastKeyWasG, lastKeyWasS, and lastKeyWasD, as "gh" etc.
+func handledKeys(t *testing.T) map[string]bool {
+	t.Helper()
+	file, err := parser.ParseFile(token.NewFileSet(), "main.go", nil, 0)
+	if err != nil {
+		t.Fatal(err)
+	}
+	var handler *ast.FuncLit
+	ast.Inspect(file, func(n ast.Node) bool {
+		if assign, ok := n.(*ast.AssignStmt); ok && len(assign.Lhs) == 1 && isIdent(assign.Lhs[0], "handleKey") {
+			handler, _ = assign.Rhs[0].(*ast.FuncLit)
+		}
+		return handler == nil
+	})
+	if handler == nil {
+		t.Fatal("handleKey not found in main.go")
+	}
+
+	keys := make(map[string]bool)
+	var singles *ast.SwitchStmt
+	ast.Inspect(handler.Body, func(n ast.Node) bool {
+		switch n := n.(type) {
+		case *ast.FuncLit:
+			return false // Callbacks, such as the sequence timeouts
+		case *ast.SwitchStmt:
+			if isRuneCall(n.Tag) && (singles == nil || len(n.Body.List) > len(singles.Body.List)) {
+				singles = n
+			}
+		case *ast.IfStmt:
+			prefix := sequencePrefix(n.Cond)
+			if prefix == "" {
+				return true
+			}
+			runes := comparedRunes(n.Cond)
+			if len(runes) == 0 {
+				runes = comparedRunes(n.Body)
+			}
+			if len(runes) == 0 {
+				// The first key again (gg)
+				runes = []rune(prefix)
+			}
+			for _, r := range runes {
+				keys[prefix+string(r)] = true
+			}
+		}
+		return true
+	})
+	if singles == nil {
+		t.Fatal("no switch on event.Rune() in handleKey")
+	}
+	for _, stmt := range singles.Body.List {
+		for _, expr := range stmt.(*ast.CaseClause).List {
+			if r, ok := runeLiteral(expr); ok {
+				keys[string(r)] = true
+			}
+		}
+	}
+	return keys
+}
+
+// sequencePrefix returns the first key of the sequence an if condition
+// checks for ("g" for lastKeyWasG), or "" if it checks none
+func sequencePrefix(cond ast.Expr) string {
+	prefix := ""
+	ast.Inspect(cond, func(n ast.Node) bool {
+		if ident, ok := n.(*ast.Ident); ok && strings.HasPrefix(ident.Name, "lastKeyWas") {
+			prefix = strings.ToLower(strings.TrimPrefix(ident.Name, "lastKeyWas"))
+		}
+		return prefix == ""
+	})
+	return prefix
+}
+
+// comparedRunes returns the runes event.Rune() is compared to under n: the
+// cases of switches on it, == comparisons, and >= / <= ranges
+func comparedRunes(n ast.Node) []rune {
+	var runes []rune
+	var low, high rune
+	ast.Inspect(n, func(n ast.Node) bool {
+		switch n := n.(type) {
+		case *ast.SwitchStmt:
+			if isRuneCall(n.Tag) {
+				for _, stmt := range n.Body.List {
+					for _, expr := range stmt.(*ast.CaseClause).List {
+						if r, ok := runeLiteral(expr); ok {
+							runes = append(runes, r)
+						}
+					}
+				}
+			}
+		case *ast.BinaryExpr:
+			r, ok := runeLiteral(n.Y)
+			if !ok || !isRuneCall(n.X) {
+				return true
+			}
+			switch n.Op {
+			case token.EQL:
+				runes = append(runes, r)
+			case token.GEQ:
+				low = r
+			case token.LEQ:
+				high = r
+			}
+		}
+		return true
+	})
+	for r := low; low != 0 && r <= high; r++ {
+		runes = append(runes, r)
+	}
+	return runes
+}
+
+// documentedKeys returns the keys the checked keymap sections list, with
+// the first key of each two-key sequence. Sequences handleKey doesn't track
+// with a lastKeyWas flag (]], Qa, @@) stand for just their first key.
+func documentedKeys() map[string]bool {
+	keys := make(map[string]bool)
+	for _, section := range keymap {
+		if slices.Contains(keymapUnchecked, section.Title) {
+			continue
+		}
+		for _, binding := range section.Bindings {
+			for _, name := range strings.FieldsFunc(binding.Keys, func(r rune) bool { return r == ' ' || r == '/' && binding.Keys != "/" }) {
+				for _, key := range expandKeyName(name) {
+					if utf8.RuneCountInString(key) == 2 {
+						if !strings.ContainsAny(key[:1], "gsd") {
+							key = key[:1]
+						}
+						keys[key[:1]] = true
+					}
+					keys[key] = true
+				}
+			}
+		}
+	}
+	return keys
+}
+
+// expandKeyName returns the keys a key name in the keymap stands for:
+// itself for a rune or a two-key sequence, each key of a range ("0-4",
+// "g1-g9"), and none for named keys such as Tab or Ctrl-o
+func expandKeyName(name string) []string {
+	if from, to, ok := strings.Cut(name, "-"); ok && name != "-" {
+		prefix := strings.TrimSuffix(from, from[len(from)-1:])
+		if len(from) != len(to) || len(from) > 2 || !strings.HasPrefix(to, prefix) {
+			return nil
+		}
+		var keys []string
+		for r := from[len(from)-1]; r <= to[len(to)-1]; r++ {
+			keys = append(keys, prefix+string(r))
+		}
+		return keys
+	}
+	if len(name) > 2 || strings.ContainsFunc(name, func(r rune) bool { return r > utf8.RuneSelf }) {
+		return nil
+	}
+	return []string{name}
+}
+
+func isIdent(expr ast.Expr, name string) bool {
+	ident, ok := expr.(*ast.Ident)
+	return ok && ident.Name == name
+}
+
+// isRuneCall reports whether expr is event.Rune()
+func isRuneCall(expr ast.Expr) bool {
+	call, ok := expr.(*ast.CallExpr)
+	if !ok {
+		return false
+	}
+	sel, ok := call.Fun.(*ast.SelectorExpr)
+	return ok && sel.Sel.Name == "Rune" && isIdent(sel.X, "event")
+}
+
+func runeLiteral(expr ast.Expr) (rune, bool) {
+	lit, ok := expr.(*ast.BasicLit)
+	if !ok || lit.Kind != token.CHAR {
+		return 0, false
+	}
+	value, _, _, err := strconv.UnquoteChar(lit.Value[1:len(lit.Value)-1], '\'')
+	return value, err == nil
+}
+
+func sortedKeys(keys map[string]bool) []string {
+	sorted := make([]string, 0, len(keys))
+	for key := range keys {
+		sorted = append(sorted, key)
+	}
+	slices.Sort(sorted)
+	return sorted
+}
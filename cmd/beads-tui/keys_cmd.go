@@ -0,0 +1,76 @@
+package main
+
+import (
+	"flag"
+	"fmt"
+	"io"
+
+	"github.com/andy/beads-tui/internal/app"
+	"github.com/andy/beads-tui/internal/config"
+)
+
+// runKeysCommand implements `beads-tui keys [--format text|markdown]
+// [--path dir]`, printing a one-page cheat sheet of the key bindings,
+// including the project's leader sequences. Returns the process exit code.
+func runKeysCommand(args []string, stdout, stderr io.Writer) int {
+	fs := flag.NewFlagSet("keys", flag.ContinueOnError)
+	fs.SetOutput(stderr)
+	format := fs.String("format", "text", "Output format: text or markdown")
+	projectPath := fs.String("path", "", "Project directory or .beads directory whose leader sequences to include (default: search up from the current directory)")
+	fs.Usage = func() {
+		fmt.Fprintln(stderr, "Usage: beads-tui keys [--format text|markdown] [--path dir]")
+		fmt.Fprintln(stderr, "")
+		fmt.Fprintln(stderr, "Prints a printable cheat sheet of the keyboard shortcuts, generated")
+		fmt.Fprintln(stderr, "from the same key map as the in-app help screen, with the leader")
+		fmt.Fprintln(stderr, "sequences the project adds in .beads/tui.toml.")
+		fs.PrintDefaults()
+	}
+	if err := fs.Parse(args); err != nil {
+		return 2
+	}
+	if *format != "text" && *format != "txt" && *format != "markdown" && *format != "md" {
+		fmt.Fprintf(stderr, "Error: unknown format %q (use text or markdown)\n", *format)
+		return 2
+	}
+
+	// Outside a project, the built-in bindings alone
+	var beadsDir string
+	if *projectPath != "" {
+		var err error
+		if beadsDir, _, err = app.ResolveBeadsPath(*projectPath); err != nil {
+			fmt.Fprintf(stderr, "Error: %v\n", err)
+			return 1
+		}
+	} else if dir, err := app.FindBeadsDir(); err == nil {
+		beadsDir = dir
+	}
+	sections := keymap
+	if beadsDir != "" {
+		sections = projectKeymap(beadsDir, stderr)
+	}
+
+	switch *format {
+	case "text", "txt":
+		fmt.Fprint(stdout, renderKeymapText(sections))
+	case "markdown", "md":
+		fmt.Fprint(stdout, renderKeymapMarkdown(sections))
+	}
+	return 0
+}
+
+// projectKeymap returns the keymap with the leader sequences of the project
+// in beadsDir, warning on w and keeping the built-in ones if they can't be
+// used (as the TUI does)
+func projectKeymap(beadsDir string, w io.Writer) []keySection {
+	projectCfg, err := config.LoadProjectConfig(beadsDir)
+	if err != nil {
+		fmt.Fprintf(w, "Warning: %v, ignoring it\n", err)
+		return keymap
+	}
+	bindings, err := withProjectLeaders(leaderBindings, projectCfg.Leader)
+	if err != nil {
+		fmt.Fprintf(w, "Warning: ignoring the leader sequences in %s: %v\n", config.ProjectConfigPath(beadsDir), err)
+		return keymap
+	}
+	return keymapWithLeaders(keymap, bindings)
+}
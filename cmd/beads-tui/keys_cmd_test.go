@@ -0,0 +1,80 @@
+package main
+
+import (
+	"bytes"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+)
+
+func TestRunKeysCommand_Text(t *testing.T) {
+	var stdout, stderr bytes.Buffer
+	if code := runKeysCommand(nil, &stdout, &stderr); code != 0 {
+		t.Fatalf("expected exit code 0, got %d (stderr: %s)", code, stderr.String())
+	}
+	out := stdout.String()
+	for _, want := range []string{
+		"NAVIGATION\n",
+		"  gg          Jump to top\n",
+		// Continuation lines are indented under the description
+		"  D           Manage dependencies (add/remove blocks, parent-child, related; import\n              \"Depends on:\"",
+	} {
+		if !strings.Contains(out, want) {
+			t.Errorf("expected cheat sheet to contain %q", want)
+		}
+	}
+}
+
+func TestRunKeysCommand_Markdown(t *testing.T) {
+	var stdout, stderr bytes.Buffer
+	if code := runKeysCommand([]string{"--format", "markdown"}, &stdout, &stderr); code != 0 {
+		t.Fatalf("expected exit code 0, got %d (stderr: %s)", code, stderr.String())
+	}
+	out := stdout.String()
+
+	// Every binding in the key map appears as a table row
+	rows := 0
+	for _, section := range keymap {
+		if !strings.Contains(out, "\n## "+section.Title+"\n") {
+			t.Errorf("expected a heading for section %q", section.Title)
+		}
+		rows += len(section.Bindings)
+	}
+	if got := strings.Count(out, "\n| `"); got != rows {
+		t.Errorf("expected %d binding rows, got %d", rows, got)
+	}
+	if strings.Contains(out, "\n| `/` | Search titles, descriptions, design, acceptance,\n") {
+		t.Error("expected multi-line descriptions to be joined into one table row")
+	}
+}
+
+func TestRunKeysCommand_UnknownFormat(t *testing.T) {
+	var stdout, stderr bytes.Buffer
+	if code := runKeysCommand([]string{"--format", "pdf"}, &stdout, &stderr); code != 2 {
+		t.Errorf("expected exit code 2, got %d", code)
+	}
+}
+
+func TestRunKeysCommand_ProjectLeaders(t *testing.T) {
+	dir := t.TempDir()
+	if err := os.Mkdir(filepath.Join(dir, ".beads"), 0o755); err != nil {
+		t.Fatal(err)
+	}
+	toml := "[[leader]]\nkeys = \"rn\"\ndescription = \"Release notes\"\nsends = \"gd\"\n"
+	if err := os.WriteFile(filepath.Join(dir, ".beads", "tui.toml"), []byte(toml), 0o644); err != nil {
+		t.Fatal(err)
+	}
+
+	var stdout, stderr bytes.Buffer
+	if code := runKeysCommand([]string{"--path", dir}, &stdout, &stderr); code != 0 {
+		t.Fatalf("expected exit code 0, got %d (stderr: %s)", code, stderr.String())
+	}
+	if !strings.Contains(stdout.String(), "  Space r n   Release notes\n") {
+		t.Errorf("expected the project's leader sequence in the cheat sheet, got:\n%s", stdout.String())
+	}
+
+	if code := runKeysCommand([]string{"--path", filepath.Join(dir, "missing")}, &stdout, &stderr); code != 1 {
+		t.Errorf("expected exit code 1 for a missing project, got %d", code)
+	}
+}
@@ -0,0 +1,276 @@
+package main
+
+import (
+	"errors"
+	"fmt"
+	"slices"
+	"sort"
+	"strings"
+
+	"github.com/andy/beads-tui/internal/config"
+	"github.com/rivo/tview"
+)
+
+// leaderSectionTitle is the keymap section listing the leader sequences
+const leaderSectionTitle = "Leader (Space, then keys shown in a popup)"
+
+// leaderKey starts a leader sequence: Space, then a group key, then an
+// action key (Space f l filters by label). After Space, a which-key popup
+// lists the keys that can come next.
+const leaderKey = ' '
+
+// leaderBinding is one complete leader sequence (without the leader key).
+// It either replays existing single-key shortcuts (Sends) so both stay in
+// step, or runs a named action that has no single key (Action).
+type leaderBinding struct {
+	Keys        string
+	Description string
+	Sends       string
+	Action      string
+}
+
+// leaderGroups names the first key of each sequence in the which-key popup
+var leaderGroups = map[string]string{
+	"f": "filter",
+	"i": "issue",
+	"p": "priority",
+	"v": "view",
+	"g": "go to",
+	"y": "yank",
+}
+
+// Named leader actions, implemented in main.go
+const (
+	leaderPageDown       = "page-down"
+	leaderFilterLabel    = "filter-label"
+	leaderFilterAssignee = "filter-assignee"
+	leaderFilterMine     = "filter-mine"
+	leaderFilterClear    = "filter-clear"
+	leaderGotoIssue      = "goto-issue"
+	leaderTogglePrefix   = "toggle-prefix"
+	leaderDuplicate      = "duplicate"
+	leaderCopyBranch     = "copy-branch"
+	leaderExternalRef    = "external-ref"
+	leaderColumns        = "columns"
+	leaderDryRun         = "dry-run"
+)
+
+// leaderBindings lists every leader sequence. The help screen and cheat
+// sheet list them in their own section of the keymap.
+var leaderBindings = []leaderBinding{
+	{Keys: " ", Description: "Page down (wraps to top)", Action: leaderPageDown},
+
+	{Keys: "ff", Description: "Quick filter", Sends: "f"},
+	{Keys: "fl", Description: "Filter by label", Action: leaderFilterLabel},
+	{Keys: "fb", Description: "Browse labels", Sends: "g#"},
+	{Keys: "fa", Description: "Filter by assignee", Action: leaderFilterAssignee},
+	{Keys: "fm", Description: "Filter to my issues", Action: leaderFilterMine},
+	{Keys: "fc", Description: "Clear all filters", Action: leaderFilterClear},
+
+	{Keys: "in", Description: "New issue", Sends: "a"},
+	{Keys: "ie", Description: "Edit issue", Sends: "e"},
+	{Keys: "ir", Description: "Rename issue", Sends: "R"},
+	{Keys: "ic", Description: "Close issue", Sends: "x"},
+	{Keys: "io", Description: "Reopen issue", Sends: "X"},
+	{Keys: "ik", Description: "Comment", Sends: "c"},
+	{Keys: "ia", Description: "Assign", Sends: "A"},
+	{Keys: "il", Description: "Labels", Sends: "L"},
+	{Keys: "id", Description: "Dependencies", Sends: "D"},
+	{Keys: "is", Description: "Split into child issues", Sends: "E"},
+	{Keys: "iS", Description: "Split off a copy of the issue", Action: leaderDuplicate},
+	{Keys: "iC", Description: "Add child issues inline", Sends: "I"},
+	{Keys: "iM", Description: "Move under another parent (tree view)", Sends: "gm"},
+	{Keys: "im", Description: "Merge a duplicate into this issue", Sends: "M"},
+	{Keys: "if", Description: "Flag for discussion", Sends: "F"},
+	{Keys: "it", Description: "History timeline", Sends: "H"},
+	{Keys: "iw", Description: "Log work", Sends: "w"},
+	{Keys: "iU", Description: "Due date", Sends: "U"},
+	{Keys: "iW", Description: "Watch/unwatch", Sends: "V"},
+	{Keys: "ib", Description: "Check out git branch", Sends: "B"},
+	{Keys: "iR", Description: "Link external reference", Action: leaderExternalRef},
+	{Keys: "ix", Description: "Discard (delete) issue", Sends: "dD"},
+	{Keys: "iu", Description: "Undo last change", Sends: "u"},
+
+	{Keys: "p0", Description: "Set P0 (critical)", Sends: "0"},
+	{Keys: "p1", Description: "Set P1 (high)", Sends: "1"},
+	{Keys: "p2", Description: "Set P2 (normal)", Sends: "2"},
+	{Keys: "p3", Description: "Set P3 (low)", Sends: "3"},
+	{Keys: "p4", Description: "Set P4 (lowest)", Sends: "4"},
+
+	{Keys: "vt", Description: "Toggle list/tree view", Sends: "t"},
+	{Keys: "vl", Description: "Toggle layout", Sends: "v"},
+	{Keys: "vc", Description: "Toggle closed issues", Sends: "C"},
+	{Keys: "vp", Description: "Toggle ID prefix", Action: leaderTogglePrefix},
+	{Keys: "vg", Description: "Group by status/assignee/label", Sends: "P"},
+	{Keys: "vo", Description: "Show/hide list columns", Action: leaderColumns},
+	{Keys: "vm", Description: "Toggle mouse mode", Sends: "m"},
+	{Keys: "vT", Description: "Next theme", Sends: "T"},
+	{Keys: "vD", Description: "Toggle dry run (approve each bd command)", Action: leaderDryRun},
+
+	{Keys: "gg", Description: "Top of the list", Sends: "gg"},
+	{Keys: "gh", Description: "Home screen", Sends: "gh"},
+	{Keys: "gd", Description: "Discussion queue", Sends: "gd"},
+	{Keys: "gi", Description: "Raw database inspector", Sends: "gi"},
+	{Keys: "gn", Description: "Recent notifications", Sends: "gn"},
+	{Keys: "gc", Description: "Changes from the last refresh", Sends: "gc"},
+	{Keys: "gv", Description: "Issue changes across refreshes", Sends: "gv"},
+	{Keys: "gl", Description: "Dependency cycles", Sends: "gl"},
+	{Keys: "gu", Description: "Standup summary", Sends: "gu"},
+	{Keys: "gC", Description: "Closed issues archive", Sends: "gC"},
+	{Keys: "ga", Description: "Aging issues", Sends: "ga"},
+	{Keys: "gx", Description: "Open external reference", Sends: "gx"},
+	{Keys: "gb", Description: "Back to the previous issue", Sends: "g;"},
+	{Keys: "gf", Description: "Forward to the next issue", Sends: "g,"},
+	{Keys: "gr", Description: "Recent issues", Sends: "gr"},
+	{Keys: "go", Description: "Issue by ID or title (fuzzy)", Action: leaderGotoIssue},
+	{Keys: "gs", Description: "Statistics dashboard", Sends: "S"},
+	{Keys: "g?", Description: "Help", Sends: "?"},
+
+	{Keys: "yi", Description: "Copy issue ID", Sends: "y"},
+	{Keys: "yt", Description: "Copy ID and title", Sends: "Y"},
+	{Keys: "ym", Description: "Copy whole issue as Markdown", Sends: "K"},
+	{Keys: "yb", Description: "Copy git branch name", Action: leaderCopyBranch},
+}
+
+// leaderChoice is a key that can follow the typed part of a leader sequence
+type leaderChoice struct {
+	Key         string
+	Description string // Action description, or group name for a prefix
+	Group       bool   // More keys follow
+}
+
+// leaderLookup matches a typed leader sequence. It returns the binding when
+// prefix completes one, otherwise the keys that can come next (none means
+// the sequence is unknown).
+func leaderLookup(bindings []leaderBinding, prefix string) (*leaderBinding, []leaderChoice) {
+	seen := make(map[string]bool)
+	var choices []leaderChoice
+	for i := range bindings {
+		binding := &bindings[i]
+		if binding.Keys == prefix {
+			return binding, nil
+		}
+		rest, ok := strings.CutPrefix(binding.Keys, prefix)
+		if !ok {
+			continue
+		}
+		next := string([]rune(rest)[0])
+		if seen[next] {
+			continue
+		}
+		seen[next] = true
+		if len([]rune(rest)) == 1 {
+			choices = append(choices, leaderChoice{Key: next, Description: binding.Description})
+		} else {
+			group, ok := leaderGroups[prefix+next]
+			if !ok {
+				group = "more" // A group only a project's bindings use
+			}
+			choices = append(choices, leaderChoice{Key: next, Description: group, Group: true})
+		}
+	}
+	sort.SliceStable(choices, func(i, j int) bool {
+		return choices[i].Key < choices[j].Key
+	})
+	return nil, choices
+}
+
+// leaderSequenceName spells out a leader sequence for display, e.g.
+// "Space f l" for the keys "fl"
+func leaderSequenceName(keys string) string {
+	names := []string{"Space"}
+	for _, r := range keys {
+		names = append(names, leaderKeyName(r))
+	}
+	return strings.Join(names, " ")
+}
+
+// leaderKeyName spells one key for display
+func leaderKeyName(r rune) string {
+	if r == ' ' {
+		return "Space"
+	}
+	return string(r)
+}
+
+// renderLeaderPopup renders the which-key popup listing the next keys, with
+// groups marked by a leading "+"
+func renderLeaderPopup(choices []leaderChoice, keyColor, groupColor string) string {
+	var sb strings.Builder
+	for _, choice := range choices {
+		description := tview.Escape(choice.Description)
+		if choice.Group {
+			description = fmt.Sprintf("[%s]+%s[-]", groupColor, description)
+		}
+		sb.WriteString(fmt.Sprintf(" [%s::b]%-5s[-::-] %s\n", keyColor, tview.Escape(leaderKeyName([]rune(choice.Key)[0])), description))
+	}
+	return strings.TrimSuffix(sb.String(), "\n")
+}
+
+// leaderKeyBindings documents leader sequences for the keymap
+func leaderKeyBindings(leaders []leaderBinding) []keyBinding {
+	bindings := make([]keyBinding, 0, len(leaders))
+	for _, binding := range leaders {
+		bindings = append(bindings, keyBinding{Keys: leaderSequenceName(binding.Keys), Description: binding.Description})
+	}
+	return bindings
+}
+
+// keymapWithLeaders returns a copy of sections whose leader section lists
+// leaders instead
+func keymapWithLeaders(sections []keySection, leaders []leaderBinding) []keySection {
+	sections = slices.Clone(sections)
+	for i := range sections {
+		if sections[i].Title == leaderSectionTitle {
+			sections[i].Bindings = leaderKeyBindings(leaders)
+		}
+	}
+	return sections
+}
+
+// withProjectLeaders adds a project's leader sequences (from .beads/tui.toml)
+// to bindings, replacing built-in ones with the same keys. A sequence must
+// send keys, and must not be a prefix of another (or have one as its prefix),
+// which would make one of them unreachable.
+func withProjectLeaders(bindings []leaderBinding, project []config.LeaderBinding) ([]leaderBinding, error) {
+	merged := append([]leaderBinding(nil), bindings...)
+	for _, extra := range project {
+		if extra.Keys == "" || extra.Sends == "" {
+			return nil, fmt.Errorf("leader sequence %q needs both keys and sends", extra.Keys)
+		}
+		binding := leaderBinding{Keys: extra.Keys, Description: extra.Description, Sends: extra.Sends}
+		if binding.Description == "" {
+			binding.Description = "Send " + extra.Sends
+		}
+		replaced := false
+		for i := range merged {
+			if merged[i].Keys == binding.Keys {
+				merged[i] = binding
+				replaced = true
+			}
+		}
+		if !replaced {
+			merged = append(merged, binding)
+		}
+	}
+
+	var errs []error
+	for i, a := range merged {
+		for j, b := range merged {
+			if i != j && strings.HasPrefix(b.Keys, a.Keys) {
+				errs = append(errs, fmt.Errorf("leader sequence %s shadows %s", leaderSequenceName(a.Keys), leaderSequenceName(b.Keys)))
+			}
+		}
+	}
+	if len(errs) > 0 {
+		return nil, errors.Join(errs...)
+	}
+	return merged, nil
+}
+
+// useLeaderBindings replaces the leader sequences, keeping the keymap's
+// leader section in step
+func useLeaderBindings(bindings []leaderBinding) {
+	leaderBindings = bindings
+	keymap = keymapWithLeaders(keymap, bindings)
+}
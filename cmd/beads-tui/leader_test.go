@@ -0,0 +1,120 @@
+package main
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/andy/beads-tui/internal/config"
+)
+
+func TestLeaderLookup(t *testing.T) {
+	binding, choices := leaderLookup(leaderBindings, "fl")
+	if binding == nil || binding.Action != leaderFilterLabel {
+		t.Fatalf("expected Space f l to filter by label, got %+v", binding)
+	}
+	if choices != nil {
+		t.Errorf("expected no choices for a complete sequence, got %v", choices)
+	}
+
+	// After the leader: Space Space first, then the groups in key order
+	_, choices = leaderLookup(leaderBindings, "")
+	if len(choices) == 0 || choices[0].Key != " " || choices[0].Group {
+		t.Fatalf("expected Space Space first, got %+v", choices)
+	}
+	for _, choice := range choices[1:] {
+		if !choice.Group || choice.Description == "" {
+			t.Errorf("expected %q to be a named group, got %+v", choice.Key, choice)
+		}
+	}
+
+	_, choices = leaderLookup(leaderBindings, "i")
+	found := false
+	for _, choice := range choices {
+		if choice.Key == "c" {
+			found = choice.Description == "Close issue" && !choice.Group
+		}
+	}
+	if !found {
+		t.Errorf("expected Space i to offer c (close issue), got %+v", choices)
+	}
+
+	if binding, choices := leaderLookup(leaderBindings, "zz"); binding != nil || len(choices) != 0 {
+		t.Errorf("expected an unknown sequence to match nothing, got %+v %+v", binding, choices)
+	}
+}
+
+// Every sequence must be reachable: none may be a prefix of another, and
+// each must either replay keys or name an action
+func TestLeaderBindingsConflictFree(t *testing.T) {
+	for i, a := range leaderBindings {
+		if (a.Sends == "") == (a.Action == "") {
+			t.Errorf("%q must set exactly one of Sends and Action", a.Keys)
+		}
+		for j, b := range leaderBindings {
+			if i != j && strings.HasPrefix(b.Keys, a.Keys) {
+				t.Errorf("%q shadows %q", a.Keys, b.Keys)
+			}
+		}
+	}
+}
+
+func TestLeaderSequenceName(t *testing.T) {
+	if got := leaderSequenceName("fl"); got != "Space f l" {
+		t.Errorf("unexpected name %q", got)
+	}
+	if got := leaderSequenceName(" "); got != "Space Space" {
+		t.Errorf("unexpected name %q", got)
+	}
+}
+
+func TestWithProjectLeaders(t *testing.T) {
+	merged, err := withProjectLeaders(leaderBindings, []config.LeaderBinding{
+		{Keys: "ic", Description: "Close with a reason", Sends: "x"},
+		{Keys: "rn", Sends: "gd"},
+	})
+	if err != nil {
+		t.Fatalf("withProjectLeaders() failed: %v", err)
+	}
+	if len(merged) != len(leaderBindings)+1 {
+		t.Errorf("expected one binding added, got %d (was %d)", len(merged), len(leaderBindings))
+	}
+	if binding, _ := leaderLookup(merged, "ic"); binding == nil || binding.Description != "Close with a reason" {
+		t.Errorf("expected Space i c to be replaced, got %+v", binding)
+	}
+	if binding, _ := leaderLookup(merged, "rn"); binding == nil || binding.Sends != "gd" || binding.Description != "Send gd" {
+		t.Errorf("expected Space r n to send gd, got %+v", binding)
+	}
+	if _, choices := leaderLookup(merged, ""); len(choices) == 0 || choices[len(choices)-1].Description == "" {
+		t.Errorf("expected the new r group to be named, got %+v", choices)
+	}
+	if binding, _ := leaderLookup(leaderBindings, "rn"); binding != nil {
+		t.Error("withProjectLeaders must not change the bindings it was given")
+	}
+
+	for _, bad := range [][]config.LeaderBinding{
+		{{Keys: "i", Sends: "x"}},   // Shadows every Space i sequence
+		{{Keys: "icx", Sends: "x"}}, // Shadowed by Space i c
+		{{Keys: "zz", Description: "Nothing"}},
+	} {
+		if _, err := withProjectLeaders(leaderBindings, bad); err == nil {
+			t.Errorf("expected an error for %+v", bad)
+		}
+	}
+}
+
+func TestUseLeaderBindingsHelp(t *testing.T) {
+	savedBindings, savedKeymap := leaderBindings, keymap
+	t.Cleanup(func() { leaderBindings, keymap = savedBindings, savedKeymap })
+
+	merged, err := withProjectLeaders(leaderBindings, []config.LeaderBinding{{Keys: "rn", Description: "Release notes", Sends: "gd"}})
+	if err != nil {
+		t.Fatal(err)
+	}
+	useLeaderBindings(merged)
+	if help := renderKeymapHelp(keymap); !strings.Contains(help, "Space r n") || !strings.Contains(help, "Release notes") {
+		t.Error("expected the help screen to list the project's leader sequence")
+	}
+	if strings.Contains(renderKeymapText(savedKeymap), "Release notes") {
+		t.Error("useLeaderBindings must not change the keymap it replaced")
+	}
+}
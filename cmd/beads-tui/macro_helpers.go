@@ -0,0 +1,146 @@
+package main
+
+import (
+	"fmt"
+	"slices"
+
+	"github.com/andy/beads-tui/internal/formatting"
+	"github.com/gdamore/tcell/v2"
+)
+
+// macroKeyLimit stops a replay after this many keys, which only a macro
+// that replays itself gets to
+const macroKeyLimit = 5000
+
+// isMacroRegister reports whether r names a macro register (Qa..Qz, @a..@z)
+func isMacroRegister(r rune) bool {
+	return r >= 'a' && r <= 'z'
+}
+
+// macroRecorder records keys into registers (Q<register> ... Q) and plays
+// them back (@<register>), for the session only. Keys are recorded as
+// they reach the application, dialogs included; played keys go to the main
+// key handler one at a time (see playMacroKey in main) and aren't recorded
+// again. Only used on the UI goroutine.
+type macroRecorder struct {
+	registers map[rune][]*tcell.EventKey
+
+	recording rune // Register being recorded into, 0 when not recording
+	keys      []*tcell.EventKey
+
+	playing rune              // Register being played, 0 when not playing
+	queue   []*tcell.EventKey // Keys still to play
+	played  int               // Keys played since the replay started
+	last    rune              // Last register played, for @@
+}
+
+// newMacroRecorder returns a recorder with empty registers
+func newMacroRecorder() *macroRecorder {
+	return &macroRecorder{registers: make(map[rune][]*tcell.EventKey)}
+}
+
+// Recording returns the register being recorded into, or 0
+func (m *macroRecorder) Recording() rune {
+	return m.recording
+}
+
+// StartRecording starts recording into register, replacing what it held
+// once the recording stops
+func (m *macroRecorder) StartRecording(register rune) {
+	m.recording = register
+	m.keys = nil
+}
+
+// Record adds a key typed while recording
+func (m *macroRecorder) Record(event *tcell.EventKey) {
+	if m.recording == 0 {
+		return
+	}
+	m.keys = append(m.keys, tcell.NewEventKey(event.Key(), event.Rune(), event.Modifiers()))
+}
+
+// StopRecording saves the keys recorded, without the last one (the Q that
+// stopped the recording), and returns the register and how many keys it
+// now holds
+func (m *macroRecorder) StopRecording() (register rune, keys int) {
+	register = m.recording
+	if len(m.keys) > 0 {
+		m.keys = m.keys[:len(m.keys)-1]
+	}
+	m.registers[register] = m.keys
+	m.recording, m.keys = 0, nil
+	return register, len(m.registers[register])
+}
+
+// Play queues the keys in register to play next, ahead of any left from
+// the macro playing now (so a macro can replay another). "@" replays the
+// last register played. Returns the register played and false if it's
+// empty.
+func (m *macroRecorder) Play(register rune) (rune, bool) {
+	if register == '@' {
+		register = m.last
+	}
+	keys := m.registers[register]
+	if len(keys) == 0 {
+		return register, false
+	}
+	if m.playing == 0 {
+		m.playing, m.played = register, 0
+	}
+	m.last = register
+	m.queue = append(slices.Clone(keys), m.queue...)
+	return register, true
+}
+
+// Playing returns the register being played, or 0
+func (m *macroRecorder) Playing() rune {
+	return m.playing
+}
+
+// Next returns the next key to play. ok is false when the replay is done,
+// or was stopped for running past macroKeyLimit (overrun).
+func (m *macroRecorder) Next() (event *tcell.EventKey, ok, overrun bool) {
+	if len(m.queue) == 0 || m.played >= macroKeyLimit {
+		overrun = len(m.queue) > 0
+		m.StopPlaying()
+		return nil, false, overrun
+	}
+	event = m.queue[0]
+	m.queue = m.queue[1:]
+	m.played++
+	return tcell.NewEventKey(event.Key(), event.Rune(), event.Modifiers()), true, false
+}
+
+// StopPlaying drops the keys left to play
+func (m *macroRecorder) StopPlaying() {
+	m.playing, m.queue = 0, nil
+}
+
+// Registers returns the registers holding keys, in order
+func (m *macroRecorder) Registers() []rune {
+	var registers []rune
+	for register, keys := range m.registers {
+		if len(keys) > 0 {
+			registers = append(registers, register)
+		}
+	}
+	slices.Sort(registers)
+	return registers
+}
+
+// modeMarkers returns the status bar markers for the modes that are on:
+// the macro being recorded (0 for none), dry run, and demo mode, in that
+// order, each with a leading space
+func modeMarkers(recording rune, dryRun, demo bool) string {
+	text := ""
+	if recording != 0 {
+		text += fmt.Sprintf(" [%s::b]recording @%c[-::-]", formatting.GetErrorColor(), recording)
+	}
+	if dryRun {
+		text += fmt.Sprintf(" [%s::b][DRY RUN][-::-]", formatting.GetWarningColor())
+	}
+	if demo {
+		text += fmt.Sprintf(" [%s::b][DEMO[][-::-]", formatting.GetAccentColor())
+	}
+	return text
+}
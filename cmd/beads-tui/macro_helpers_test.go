@@ -0,0 +1,143 @@
+package main
+
+import (
+	"slices"
+	"strings"
+	"testing"
+
+	"github.com/gdamore/tcell/v2"
+)
+
+// typeKeys records keys as if typed
+func typeKeys(m *macroRecorder, keys string) {
+	for _, r := range keys {
+		m.Record(tcell.NewEventKey(tcell.KeyRune, r, tcell.ModNone))
+	}
+}
+
+// playAll drains the replay, returning the runes played
+func playAll(t *testing.T, m *macroRecorder) string {
+	t.Helper()
+	var played []rune
+	for {
+		event, ok, overrun := m.Next()
+		if overrun {
+			t.Fatalf("unexpected overrun after %q", string(played))
+		}
+		if !ok {
+			return string(played)
+		}
+		played = append(played, event.Rune())
+	}
+}
+
+func TestMacroRecorderRecordAndPlay(t *testing.T) {
+	m := newMacroRecorder()
+	typeKeys(m, "2j") // Not recording yet
+	m.StartRecording('a')
+	typeKeys(m, "2Ltriaged")
+	m.Record(tcell.NewEventKey(tcell.KeyEnter, 0, tcell.ModNone))
+	typeKeys(m, "jQ")
+	if m.Recording() != 'a' {
+		t.Fatalf("Recording = %q, want 'a'", m.Recording())
+	}
+
+	register, keys := m.StopRecording()
+	if register != 'a' || keys != 11 {
+		t.Errorf("StopRecording = %q, %d, want 'a', 11 (without the final Q)", register, keys)
+	}
+	if m.Recording() != 0 {
+		t.Error("expected recording to stop")
+	}
+
+	if _, ok := m.Play('a'); !ok {
+		t.Fatal("expected register a to play")
+	}
+	if m.Playing() != 'a' {
+		t.Errorf("Playing = %q, want 'a'", m.Playing())
+	}
+	if got := playAll(t, m); got != "2Ltriaged\x00j" {
+		t.Errorf("played %q", got)
+	}
+	if m.Playing() != 0 {
+		t.Error("expected the replay to end")
+	}
+
+	// @@ replays the last register
+	if register, ok := m.Play('@'); !ok || register != 'a' {
+		t.Errorf("Play('@') = %q, %v, want 'a', true", register, ok)
+	}
+	m.StopPlaying()
+	if _, ok, _ := m.Next(); ok {
+		t.Error("expected nothing left to play after StopPlaying")
+	}
+}
+
+func TestMacroRecorderEmptyRegister(t *testing.T) {
+	m := newMacroRecorder()
+	if _, ok := m.Play('b'); ok {
+		t.Error("expected an empty register not to play")
+	}
+	if register, ok := m.Play('@'); ok || register != 0 {
+		t.Errorf("Play('@') before any replay = %q, %v", register, ok)
+	}
+
+	// Recording nothing but the Q leaves the register empty
+	m.StartRecording('b')
+	typeKeys(m, "Q")
+	if _, keys := m.StopRecording(); keys != 0 {
+		t.Errorf("expected an empty register, got %d keys", keys)
+	}
+	if registers := m.Registers(); len(registers) != 0 {
+		t.Errorf("Registers = %q, want none", string(registers))
+	}
+}
+
+func TestMacroRecorderNestedAndRunaway(t *testing.T) {
+	m := newMacroRecorder()
+	m.StartRecording('b')
+	typeKeys(m, "xyQ")
+	m.StopRecording()
+	m.StartRecording('a')
+	typeKeys(m, "1Q")
+	m.StopRecording()
+	if registers := m.Registers(); !slices.Equal(registers, []rune{'a', 'b'}) {
+		t.Errorf("Registers = %q, want ab", string(registers))
+	}
+
+	// A macro replaying another plays it before its own remaining keys
+	m.Play('a')
+	m.Next()
+	m.Play('b')
+	if got := playAll(t, m); got != "xy" {
+		t.Errorf("played %q, want xy", got)
+	}
+
+	// One that keeps replaying itself is stopped at the limit
+	m.Play('a')
+	for played := 0; ; played++ {
+		_, ok, overrun := m.Next()
+		if !ok {
+			if !overrun || played != macroKeyLimit {
+				t.Errorf("stopped after %d keys (overrun %v), want %d with an overrun", played, overrun, macroKeyLimit)
+			}
+			break
+		}
+		m.Play('a')
+	}
+}
+
+func TestModeMarkers(t *testing.T) {
+	if got := modeMarkers(0, false, false); got != "" {
+		t.Errorf("expected no markers, got %q", got)
+	}
+	// Recording while in dry run shows both
+	got := modeMarkers('q', true, false)
+	rec, dry := strings.Index(got, "recording @q"), strings.Index(got, "[DRY RUN]")
+	if rec < 0 || dry < rec {
+		t.Errorf("expected the recording marker then [DRY RUN], got %q", got)
+	}
+	if got := modeMarkers('a', true, true); !strings.Contains(got, "recording @a") || !strings.Contains(got, "[DRY RUN]") || !strings.Contains(got, "[DEMO[]") {
+		t.Errorf("expected all three markers, got %q", got)
+	}
+}
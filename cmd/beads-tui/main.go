@@ -11,19 +11,24 @@ import (
 	"os/exec"
 	"os/signal"
 	"path/filepath"
+	"slices"
+	"strings"
 	"sync"
 	"syscall"
 	"time"
 
 	"github.com/andy/beads-tui/internal/app"
 	"github.com/andy/beads-tui/internal/config"
+	"github.com/andy/beads-tui/internal/demo"
 	"github.com/andy/beads-tui/internal/formatting"
+	"github.com/andy/beads-tui/internal/git"
 	"github.com/andy/beads-tui/internal/parser"
 	"github.com/andy/beads-tui/internal/state"
 	"github.com/andy/beads-tui/internal/storage"
 	"github.com/andy/beads-tui/internal/theme"
 	_ "github.com/andy/beads-tui/internal/theme" // Import to register themes
 	"github.com/andy/beads-tui/internal/ui"
+	"github.com/andy/beads-tui/internal/ui/notify"
 	"github.com/andy/beads-tui/internal/watcher"
 	"github.com/atotto/clipboard"
 	"github.com/gdamore/tcell/v2"
@@ -31,8 +36,13 @@ import (
 )
 
 const (
-	// statusMessageDuration is how long temporary status bar messages are shown.
-	statusMessageDuration = 2 * time.Second
+	// keySequenceTimeout is how long a status shortcut (s), section jump
+	// (]] and [[), or macro key (Q and @) waits for its second key.
+	keySequenceTimeout = 2 * time.Second
+
+	// macroPollInterval is how often a macro being replayed checks whether
+	// the bd command its last key started, and the refresh after it, are done.
+	macroPollInterval = 50 * time.Millisecond
 
 	// refreshDelay is the delay before auto-refreshing after an update command.
 	refreshDelay = 500 * time.Millisecond
@@ -45,15 +55,47 @@ const (
 
 	// watcherDebounce is the file watcher debounce interval.
 	watcherDebounce = 200 * time.Millisecond
+
+	// maxIssueCommits is how many commits mentioning an issue the details show.
+	maxIssueCommits = 20
 )
 
 func main() {
+	// Subcommands that don't need a database
+	if len(os.Args) > 1 && os.Args[1] == "themes" {
+		os.Exit(runThemesCommand(os.Args[2:], os.Stdout, os.Stderr))
+	}
+	if len(os.Args) > 1 && os.Args[1] == "keys" {
+		os.Exit(runKeysCommand(os.Args[2:], os.Stdout, os.Stderr))
+	}
+	if len(os.Args) > 1 && os.Args[1] == "serve" {
+		os.Exit(runServeCommand(os.Args[2:], os.Stdout, os.Stderr))
+	}
+	if len(os.Args) > 1 && os.Args[1] == "report" {
+		os.Exit(runReportCommand(os.Args[2:], os.Stdout, os.Stderr))
+	}
+
 	// Parse command line flags
 	debugMode := flag.Bool("debug", false, "Enable debug logging to file")
 	themeName := flag.String("theme", "", "Color theme (default, gruvbox-dark, etc)")
-	viewMode := flag.String("view", "list", "Initial view mode (list or tree)")
-	issueID := flag.String("issue", "", "Show only this issue (e.g., tui-abc)")
+	viewMode := flag.String("view", "", "Initial view mode (list or tree, default: last used)")
+	startFilter := flag.String("filter", "", "Quick filter to start with (e.g., \"p1 bug #ui\"; see the f dialog for the syntax)")
+	issueID := flag.String("issue", "", "Follow one issue's details, live-updating (e.g., tui-abc; q quits)")
+	showHome := flag.Bool("home", false, "Start on the workspace summary screen (also: \"show_home\" in config)")
+	projectPath := flag.String("path", "", "Open the beads project at this directory (or its .beads directory) instead of the current one")
+	dbFile := flag.String("db", "", "Open this beads database file directly")
+	directWrite := flag.Bool("direct-write", false, "Write status, priority, label, and comment changes straight to the database instead of running bd")
+	dryRun := flag.Bool("dry-run", false, "Show each bd command that would change something and ask before running it (Space v D toggles)")
+	demoMode := flag.Bool("demo", false, "Try beads-tui on generated sample issues instead of a beads project (regenerated each run)")
+	pprofAddr := flag.String("pprof", "", "Serve net/http/pprof at this address, e.g. localhost:6060 (hidden; for profiling)")
+	flag.Usage = func() {
+		printUsage(flag.CommandLine.Output(), os.Args[0], flag.CommandLine)
+	}
 	flag.Parse()
+	if *viewMode != "" && *viewMode != config.ViewModeList && *viewMode != config.ViewModeTree {
+		fmt.Fprintf(os.Stderr, "Error: unknown view %q (want list or tree)\n", *viewMode)
+		os.Exit(1)
+	}
 
 	// Load user config (includes theme preference)
 	cfg, err := config.Load()
@@ -62,31 +104,6 @@ func main() {
 		cfg = config.DefaultConfig()
 	}
 
-	// Theme priority order: CLI flag > env var > config file > default
-	// Start with theme from config file
-	if cfg.Theme != "" {
-		if err := theme.SetCurrent(cfg.Theme); err != nil {
-			fmt.Fprintf(os.Stderr, "Warning: %v, using gruvbox-dark theme\n", err)
-			_ = theme.SetCurrent("gruvbox-dark")
-		}
-	} else {
-		_ = theme.SetCurrent("gruvbox-dark")
-	}
-
-	// Override with environment variable if set
-	if envTheme := os.Getenv("BEADS_THEME"); envTheme != "" && *themeName == "" {
-		if err := theme.SetCurrent(envTheme); err != nil {
-			fmt.Fprintf(os.Stderr, "Warning: %v, keeping current theme\n", err)
-		}
-	}
-
-	// Override with CLI flag if specified (highest priority)
-	if *themeName != "" {
-		if err := theme.SetCurrent(*themeName); err != nil {
-			fmt.Fprintf(os.Stderr, "Warning: %v, keeping current theme\n", err)
-		}
-	}
-
 	// Set up logging
 	var logFile *os.File
 	if *debugMode {
@@ -114,55 +131,270 @@ func main() {
 		log.SetFlags(0)
 	}
 
-	log.Printf("Finding .beads directory")
-	// Find .beads directory
-	beadsDir, err := app.FindBeadsDir()
-	if err != nil {
-		log.Printf("ERROR: Failed to find .beads directory: %v", err)
-		fmt.Fprintf(os.Stderr, "Error: %v\n", err)
-		os.Exit(1)
+	if *pprofAddr != "" {
+		if err := startPprof(*pprofAddr); err != nil {
+			fmt.Fprintf(os.Stderr, "Error: %v\n", err)
+			os.Exit(1)
+		}
 	}
-	log.Printf("Found .beads directory: %s", beadsDir)
 
-	// Warn if bd CLI is not available (issue updates won't work)
-	if _, err := exec.LookPath("bd"); err != nil {
-		fmt.Fprintf(os.Stderr, "Warning: 'bd' command not found in PATH. Issue updates will not work.\n")
-		fmt.Fprintf(os.Stderr, "Install beads or add 'bd' to your PATH to enable editing.\n\n")
+	// Find .beads directory: --db or --path if given, otherwise from the cwd
+	var beadsDir, dbPath string
+	switch {
+	case *projectPath != "" && *dbFile != "":
+		fmt.Fprintln(os.Stderr, "Error: use either --path or --db, not both")
+		os.Exit(2)
+	case *demoMode && (*projectPath != "" || *dbFile != ""):
+		fmt.Fprintln(os.Stderr, "Error: --demo doesn't open a project; drop --path and --db")
+		os.Exit(2)
+	case *demoMode:
+		// Generated issues, kept in memory (see the issue source below).
+		// Simple edits change them; anything needing bd explains it's off.
+		beadsDir = demoBeadsDir
+		bdDisabled = errDemoBd
+	case *dbFile != "" || *projectPath != "":
+		target := *dbFile
+		if target == "" {
+			target = *projectPath
+		}
+		log.Printf("Resolving beads project from %s", target)
+		beadsDir, dbPath, err = app.ResolveBeadsPath(target)
+		if err != nil {
+			log.Printf("ERROR: Failed to resolve %s: %v", target, err)
+			fmt.Fprintf(os.Stderr, "Error: %v\n", err)
+			os.Exit(1)
+		}
+		// Point bd at the same database rather than whatever is in the cwd
+		bdDatabase = dbPath
+	default:
+		log.Printf("Finding .beads directory")
+		beadsDir, err = app.FindBeadsDir()
+		if cwd, cwdErr := os.Getwd(); err != nil && cwdErr == nil && isTerminal(os.Stdin) {
+			// No project here yet; the database check below offers to set
+			// one up
+			log.Printf("No .beads directory found (%v), offering setup in %s", err, cwd)
+			beadsDir, err = filepath.Join(cwd, ".beads"), nil
+		}
+		if err != nil {
+			log.Printf("ERROR: Failed to find .beads directory: %v", err)
+			fmt.Fprintf(os.Stderr, "Error: %v\n", err)
+			fmt.Fprintf(os.Stderr, "Run beads-tui inside a beads project, or pass --path <dir> or --db <file>.\n")
+			os.Exit(1)
+		}
+		dbPath = filepath.Join(beadsDir, "beads.db")
+	}
+	log.Printf("Found .beads directory: %s (database %s)", beadsDir, dbPath)
+
+	// Project defaults committed with the repo (.beads/tui.toml) override the
+	// global config; globalCfg keeps the user's own settings for saving
+	globalCfg := *cfg
+	projectCfg := &config.ProjectConfig{} // The demo has no project to read one from
+	if !*demoMode {
+		if projectCfg, err = config.LoadProjectConfig(beadsDir); err != nil {
+			fmt.Fprintf(os.Stderr, "Warning: %v, ignoring it\n", err)
+			projectCfg = &config.ProjectConfig{}
+		}
+	}
+	projectCfg.Apply(cfg)
+	if bindings, err := withProjectLeaders(leaderBindings, projectCfg.Leader); err != nil {
+		fmt.Fprintf(os.Stderr, "Warning: ignoring the leader sequences in %s: %v\n", config.ProjectConfigPath(beadsDir), err)
+	} else {
+		useLeaderBindings(bindings)
+	}
+
+	// Themes in ~/.config/beads-tui/themes can be picked like the built-in ones
+	if err := theme.LoadUserThemes(); err != nil {
+		fmt.Fprintf(os.Stderr, "Warning: %v\n", err)
 	}
 
-	dbPath := filepath.Join(beadsDir, "beads.db")
+	// Color depth: "color_mode" in the config, or detected from the terminal
+	colorMode := theme.DetectColorMode(os.Getenv)
+	if mode, ok := theme.ParseColorMode(cfg.ColorMode); !ok {
+		fmt.Fprintf(os.Stderr, "Warning: unknown color_mode %q, detecting it\n", cfg.ColorMode)
+	} else if mode != theme.ColorModeAuto {
+		colorMode = mode
+		if err := theme.ConfigureTerminal(mode, os.Setenv); err != nil {
+			log.Printf("Warning: failed to set color mode %s: %v", mode, err)
+		}
+	}
+	log.Printf("Color mode: %s", colorMode)
 
-	// Check if database file exists
-	if _, err := os.Stat(dbPath); os.IsNotExist(err) {
-		fmt.Fprintf(os.Stderr, "Error: %s not found\n", dbPath)
-		fmt.Fprintf(os.Stderr, "Have you initialized beads? Run: bd init\n")
-		os.Exit(1)
+	// Glyphs for status, type, and tree branches: "icon_set" in the config
+	if err := formatting.SetIconSet(cfg.IconSet); err != nil {
+		fmt.Fprintf(os.Stderr, "Warning: %v, using %s\n", err, formatting.IconSetUnicode)
 	}
+	defaultTheme := theme.DefaultTheme(colorMode)
 
-	// Open SQLite database in read-only mode
-	sqliteReader, err := storage.NewSQLiteReader(dbPath)
-	if err != nil {
-		if errors.Is(err, storage.ErrDatabaseCorrupted) {
-			fmt.Fprintln(os.Stderr, "")
-			fmt.Fprintln(os.Stderr, "Error: Database is corrupted!")
-			fmt.Fprintln(os.Stderr, "")
-			fmt.Fprintln(os.Stderr, "The beads database has been damaged. Run 'bd doctor --fix' to recover from backup.")
-			fmt.Fprintln(os.Stderr, "")
+	// Theme priority order: CLI flag > env var > project config > global config > default
+	// Start with theme from config file (or the project config)
+	if cfg.Theme != "" {
+		if err := theme.SetCurrent(cfg.Theme); err != nil {
+			fmt.Fprintf(os.Stderr, "Warning: %v, using %s theme\n", err, defaultTheme)
+			_ = theme.SetCurrent(defaultTheme)
+		}
+	} else {
+		_ = theme.SetCurrent(defaultTheme)
+	}
+
+	// Override with environment variable if set
+	if envTheme := os.Getenv("BEADS_THEME"); envTheme != "" && *themeName == "" {
+		if err := theme.SetCurrent(envTheme); err != nil {
+			fmt.Fprintf(os.Stderr, "Warning: %v, keeping current theme\n", err)
+		}
+	}
+
+	// Override with CLI flag if specified (highest priority)
+	if *themeName != "" {
+		if err := theme.SetCurrent(*themeName); err != nil {
+			fmt.Fprintf(os.Stderr, "Warning: %v, keeping current theme\n", err)
+		}
+	}
+
+	// Use configured bd executable if set
+	if cfg.BdPath != "" {
+		bdCommand = cfg.BdPath
+	}
+	if cfg.BdTimeoutSeconds > 0 {
+		bdTimeout = time.Duration(cfg.BdTimeoutSeconds) * time.Second
+	}
+
+	// Warn if bd CLI is not available (issue updates won't work)
+	_, bdMissing := exec.LookPath(bdCommand)
+	if bdMissing != nil && !*demoMode {
+		if *directWrite {
+			fmt.Fprintf(os.Stderr, "Warning: '%s' command not found. Only status, priority, label, and comment changes will work.\n\n", bdCommand)
+		} else {
+			fmt.Fprintf(os.Stderr, "Warning: '%s' command not found. Issue updates will not work.\n", bdCommand)
+			fmt.Fprintf(os.Stderr, "Install beads, add 'bd' to your PATH, or set \"bd_path\" in ~/.beads-tui/config.json.\n")
+			fmt.Fprintf(os.Stderr, "Or run with --direct-write to make simple edits without bd.\n\n")
+		}
+	}
+
+	// Without a database, offer to set one up with bd init (in a terminal
+	// and with bd installed); otherwise say how to
+	if _, err := os.Stat(dbPath); os.IsNotExist(err) && !*demoMode {
+		if bdMissing != nil || !isTerminal(os.Stdin) || !isTerminal(os.Stdout) {
+			fmt.Fprintf(os.Stderr, "Error: %s not found\n", dbPath)
+			fmt.Fprintf(os.Stderr, "Have you initialized beads? Run: bd init\n")
 			os.Exit(1)
 		}
-		fmt.Fprintf(os.Stderr, "Error opening database: %v\n", err)
-		os.Exit(1)
+		if err := runOnboarding(filepath.Dir(beadsDir), dbPath); err != nil {
+			if errors.Is(err, errSetupQuit) {
+				fmt.Fprintln(os.Stderr, "No beads project set up. Run bd init (or beads-tui again) when you're ready.")
+			} else {
+				fmt.Fprintf(os.Stderr, "Error: %v\n", err)
+			}
+			os.Exit(1)
+		}
+	}
+
+	// Issues come from the database, opened read-only, or in demo mode from
+	// memory, where the simple edits --direct-write makes also go
+	var source storage.IssueSource
+	if *demoMode {
+		log.Printf("Generating the demo project")
+		demoSource := demo.NewSource(demo.Generate(time.Now(), currentUser()), currentUser())
+		source, directWriter = demoSource, demoSource
+	} else {
+		sqliteReader, err := storage.NewSQLiteReader(dbPath)
+		if err != nil {
+			if errors.Is(err, storage.ErrDatabaseCorrupted) {
+				fmt.Fprintln(os.Stderr, "")
+				fmt.Fprintln(os.Stderr, "Error: Database is corrupted!")
+				fmt.Fprintln(os.Stderr, "")
+				fmt.Fprintln(os.Stderr, "The beads database has been damaged. Run 'bd doctor --fix' to recover from backup.")
+				fmt.Fprintln(os.Stderr, "")
+				os.Exit(1)
+			}
+			fmt.Fprintf(os.Stderr, "Error opening database: %v\n", err)
+			os.Exit(1)
+		}
+		source = sqliteReader
+
+		if *directWrite {
+			writer, err := storage.NewSQLiteWriter(dbPath, currentUser())
+			if err != nil {
+				fmt.Fprintf(os.Stderr, "Error opening database for direct writes: %v\n", err)
+				os.Exit(1)
+			}
+			directWriter = writer
+		}
+	}
+	defer source.Close()
+	if directWriter != nil {
+		defer directWriter.Close()
 	}
-	defer sqliteReader.Close()
 
-	// Initialize state
+	// Initialize state. Comments aren't loaded with the issues: the detail
+	// panel reads them on demand and search queries them in the database.
 	appState := state.New()
+	appState.SetCommentSearcher(commentSearcher(source))
+	commentCache := storage.NewCommentCache(source)
+
+	// Git repository the project lives in, for branches named after issues
+	// (B) and the commits that mention them in the detail panel
+	var gitRepo *git.Repo
+	var commitCache *git.CommitCache
+	if *demoMode {
+		log.Printf("Git integration disabled in demo mode")
+	} else if repo, err := git.Open(filepath.Dir(beadsDir)); err != nil {
+		log.Printf("Git integration disabled: %v", err)
+	} else {
+		gitRepo = repo
+		commitCache = git.NewCommitCache(repo, maxIssueCommits)
+	}
+
+	// syncGitBranch notes the checked-out branch, so the issue it's named
+	// after can be marked in the list, and drops the cached commits, which
+	// may have changed along with it
+	syncGitBranch := func() {
+		if gitRepo == nil {
+			return
+		}
+		branch, err := gitRepo.CurrentBranch()
+		if err != nil {
+			log.Printf("GIT: Failed to read the current branch: %v", err)
+		}
+		appState.SetCurrentBranch(branch)
+		commitCache.Clear()
+	}
+
+	// Load per-project view preferences (falls back to global config)
+	projectState, err := config.LoadProjectState(beadsDir)
+	if err != nil {
+		log.Printf("Warning: failed to load project state: %v", err)
+		projectState = &config.ProjectState{}
+	}
 
-	// Set initial view mode from command line
-	if *viewMode == "tree" {
+	// Set initial view mode: command line flag > project preference > global preference
+	initialViewMode := cfg.ViewMode
+	if projectState.ViewMode != "" {
+		initialViewMode = projectState.ViewMode
+	}
+	if *viewMode != "" {
+		initialViewMode = *viewMode
+	}
+	if initialViewMode == config.ViewModeTree {
 		appState.SetViewMode(state.ViewTree)
 	}
 
+	// Set initial sort mode: project preference > global preference
+	initialSortMode := cfg.SortMode
+	if projectState.SortMode != "" {
+		initialSortMode = projectState.SortMode
+	}
+	if mode, ok := state.ParseSortMode(initialSortMode); ok {
+		appState.SetSortMode(mode)
+	}
+	appState.SetWatched(projectState.Watched)
+	appState.SetAgingDays(agingDaysFromConfig(cfg.PriorityAgingDays))
+
+	// Refreshes from the watcher, r, and actions all go through one
+	// coordinator, which coalesces them and runs one load at a time (started
+	// once refreshIssues exists)
+	refresher := app.NewRefresher()
+	defer refresher.Stop()
+
 	// Create TUI application
 	app := tview.NewApplication()
 
@@ -178,8 +410,7 @@ func main() {
 		SetDynamicColors(true)
 
 	// Issue list
-	issueList := tview.NewList().
-		ShowSecondaryText(false).
+	issueList := ui.NewVirtualList().
 		SetSelectedBackgroundColor(currentTheme.SelectionBg()).
 		SetSelectedTextColor(currentTheme.SelectionFg())
 	issueList.SetBorder(true).SetTitle("Issues")
@@ -192,42 +423,74 @@ func main() {
 	var searchMode bool
 	var searchQuery string
 	var searchMatches []int
+	var searchMatchFields [][]state.SearchField
 	var currentSearchIndex int
 
 	// Two-character shortcut state
-	var lastKeyWasS bool // For status shortcuts (So, Si, Sb, Sc)
+	var lastKeyWasS bool    // For status shortcuts (So, Si, Sb, Sc)
+	var lastKeyWasD bool    // For dD (discard)
+	var pendingBracket rune // ']' or '[' awaiting its second key (]] and [[ jump between sections)
+	var pendingMacro rune   // 'Q' or '@' awaiting its register (Qa records, @a replays)
+
+	// Keyboard macros, for the session
+	macros := newMacroRecorder()
 
 	// ESC to quit state (double-press within 1 second)
 	var lastEscapeTime time.Time
 
-	// Mouse mode state (default: enabled)
-	var mouseEnabled = true
+	// Mouse mode state (default: enabled, restored from config)
+	var mouseEnabled = cfg.MouseEnabled
 
 	// Panel focus state (true = detail panel, false = issue list)
 	var detailPanelFocused bool
 
-	// Show closed issues in list view (default: false)
-	var showClosedIssues bool
+	// Show closed issues in list view (default: false, restored from config)
+	var showClosedIssues = cfg.ShowClosedIssues
 
-	// Layout orientation: true = vertical, false = horizontal (default)
-	var verticalLayout bool
+	// Layout orientation: true = vertical, false = horizontal (default, restored from config)
+	var verticalLayout = cfg.Layout == config.LayoutVertical
 
-	// Detail pane visibility (default: true)
-	var detailPaneVisible = true
+	// Detail pane visibility (default: true, restored from config)
+	var detailPaneVisible = cfg.ShowDetailPane
 
 	// Show issue ID prefix (default: true)
 	var showPrefix = true
 
+	// Issue list columns (restored from config; Space v o toggles them)
+	var listColumns = listColumnsFromConfig(cfg.ListColumns)
+
+	// List view sections: by status (default), assignee, or label (P, restored from config)
+	var listGrouping, _ = ui.ParseGrouping(cfg.GroupBy)
+
+	// Issue shown full screen by --issue (follow mode), kept up to date by
+	// refreshes. The list is hidden but still selects it, so issue actions
+	// apply to it.
+	var followIssueID string
+	var refreshFollowedIssue func()
+
+	// Issue marked with gm in tree view, waiting for a new parent
+	var movingIssueID string
+
+	// Dry-run mode (--dry-run, Space v D): bd commands are previewed and
+	// only run once approved
+	dryRunEnabled := *dryRun
+
+	// Whether the database watcher is delivering live updates (shown in the
+	// status bar; set from the watcher's state callback)
+	liveState := watcher.StateStopped
+
 	// Track currently displayed issue in detail panel (for clipboard copy)
 	var currentDetailIssue *parser.Issue
+	var detailComments []*parser.Comment // Comments shown in the detail panel
+	var jumpToIssue func(issueID string) // Set once the issue list is built
+
+	// Issues shown in the detail panel, for g; and g, (back and forward).
+	// historyJump marks the issue about to be shown as a jump; historyMoving
+	// keeps going back and forward from being recorded.
+	history := newIssueHistory()
+	var historyJump, historyMoving bool
 
 	// Helper functions for themed messages
-	successMsg := func(msg string) string {
-		return fmt.Sprintf("[%s]%s[-]", formatting.GetSuccessColor(), msg)
-	}
-	errorMsg := func(msg string) string {
-		return fmt.Sprintf("[%s]%s[-]", formatting.GetErrorColor(), msg)
-	}
 	_ = func(msg string) string { // emphasisMsg - reserved for future use
 		return fmt.Sprintf("[%s]%s[-]", formatting.GetEmphasisColor(), msg)
 	}
@@ -258,8 +521,19 @@ func main() {
 		return fmt.Sprintf("Issues [%s]%s (t:%s)", mode, posStr, toggle)
 	}
 
+	// Loads issues narrowed by the filters once the database is large
+	sqlFilters := newSQLFilter(cfg.SQLFilterThreshold)
+
 	// Helper function to generate status bar text
 	getStatusBarText := func() string {
+		if followIssueID != "" {
+			return fmt.Sprintf("[%s]Following %s[-] [%s]q quit · j/k scroll · ]/[ pick comment · c comment · e edit · x close · r refresh[-]",
+				formatting.GetEmphasisColor(), followIssueID, formatting.GetMutedColor())
+		}
+		if movingIssueID != "" {
+			return fmt.Sprintf("[%s]Moving %s[-] [%s]select its new parent and press gm · Esc cancels[-]",
+				formatting.GetEmphasisColor(), movingIssueID, formatting.GetMutedColor())
+		}
 		mouseStr := "OFF"
 		if mouseEnabled {
 			mouseStr = "ON"
@@ -270,14 +544,14 @@ func main() {
 		}
 
 		// Count visible issues after filtering
-		visibleCount := len(appState.GetReadyIssues()) + len(appState.GetBlockedIssues()) + len(appState.GetInProgressIssues())
-		if showClosedIssues {
-			visibleCount += len(appState.GetClosedIssues())
-		}
+		visibleCount := appState.Snapshot().Visible(showClosedIssues)
 
 		filterText := ""
 		if appState.HasActiveFilters() {
 			filterText = fmt.Sprintf(" [Filters: %s]", appState.GetActiveFilters())
+			if sqlFilters.active() {
+				filterText = fmt.Sprintf(" [Filters (in SQL): %s]", appState.GetActiveFilters())
+			}
 		}
 
 		closedText := ""
@@ -290,14 +564,46 @@ func main() {
 			layoutStr = "Vertical"
 		}
 
+		sortText := ""
+		if appState.GetViewMode() == state.ViewList {
+			sortText = fmt.Sprintf(" [Sort: %s]", appState.GetSortMode())
+			if listGrouping != ui.GroupByStatus {
+				sortText += fmt.Sprintf(" [By %s]", listGrouping)
+			}
+		}
+
+		modeText := modeMarkers(macros.Recording(), dryRunEnabled, *demoMode)
+
+		liveText := fmt.Sprintf(" [%s]●[-]", formatting.GetSuccessColor())
+		switch liveState {
+		case watcher.StateDegraded:
+			liveText = fmt.Sprintf(" [%s]◐ reconnecting[-]", formatting.GetWarningColor())
+		case watcher.StateStopped:
+			liveText = fmt.Sprintf(" [%s]○ no live updates (r refreshes)[-]", formatting.GetErrorColor())
+		}
+
 		emphasisColor := formatting.GetEmphasisColor()
-		return fmt.Sprintf("[%s]Beads TUI[-] - %s (%d issues)%s%s [%s] [Mouse: %s] [Focus: %s] [? help | v layout]",
-			emphasisColor, beadsDir, visibleCount, filterText, closedText, layoutStr, mouseStr, focusStr)
+		return fmt.Sprintf("[%s]Beads TUI[-] - %s (%d issues)%s%s%s%s%s [%s] [Mouse: %s] [Focus: %s] [? help | v layout]",
+			emphasisColor, beadsDir, visibleCount, liveText, modeText, filterText, closedText, sortText, layoutStr, mouseStr, focusStr)
 	}
 
+	// Sections of the list as last populated (]] and [[ jump between them;
+	// none in tree view)
+	var listSections []ui.Section
+
 	// Helper function to populate issue list from state
 	populateIssueList := func() {
-		ui.PopulateIssueList(issueList, appState, showClosedIssues, showPrefix, indexToIssue)
+		// Issues loaded for other filters are reloaded for these; the
+		// refresh populates the list again once they're in
+		if sqlFilters.stale(appState.FilterSpec()) {
+			refresher.Refresh("")
+		}
+		listSections = ui.PopulateIssueList(issueList, appState, ui.ListOptions{
+			ShowClosedIssues: showClosedIssues,
+			ShowPrefix:       showPrefix,
+			Columns:          listColumns,
+			Grouping:         listGrouping,
+		}, indexToIssue)
 	}
 
 	// safeQueueUpdateDraw wraps app.QueueUpdateDraw with timeout protection
@@ -317,58 +623,187 @@ func main() {
 		}
 	}
 
-	// showTemporaryStatus displays a message in the status bar that auto-clears
-	// after the given duration, reverting to the default status bar text.
-	showTemporaryStatus := func(msg string, duration time.Duration) {
-		statusBar.SetText(msg)
-		time.AfterFunc(duration, func() {
+	// Success and error messages go through the notification center so they
+	// queue instead of overwriting each other. Redraw puts the current
+	// notification (or the normal status bar text) back after a prompt.
+	notifier := notify.New(safeQueueUpdateDraw, func(text string) {
+		statusBar.SetText(text)
+	}, func() {
+		statusBar.SetText(getStatusBarText())
+	})
+	for name, seconds := range cfg.NotificationSeconds {
+		if level, ok := notify.ParseLevel(name); ok && seconds > 0 {
+			notifier.SetDuration(level, time.Duration(seconds*float64(time.Second)))
+		}
+	}
+
+	// Hook commands from the config run after the bd commands that trigger
+	// them; not in demo mode, where the issues are made up
+	if !*demoMode {
+		issueHooks = newHookRunner(cfg.Hooks, filepath.Dir(beadsDir), func(event string, err error) {
 			safeQueueUpdateDraw(func() {
-				statusBar.SetText(getStatusBarText())
+				notifier.Warn(fmt.Sprintf("The %s hook failed: %v", event, err))
 			})
 		})
 	}
 
-	// Mutex to serialize refresh operations
-	var refreshMutex sync.Mutex
+	// Runs bd commands on worker goroutines with a status bar spinner
+	runner := newBdRunner(statusBar, notifier, safeQueueUpdateDraw)
+	runner.SetDryRun(dryRunEnabled)
+
+	// The screen is captured on draw so alerts can ring the terminal bell
+	var screen tcell.Screen
+	app.SetAfterDrawFunc(func(s tcell.Screen) {
+		screen = s
+	})
+
+	// alertUser rings the bell and flashes the status bar (bell_alerts config)
+	// so the terminal gets attention even in a background pane
+	alertUser := func(alerts []string) {
+		log.Printf("ALERT: %s", strings.Join(alerts, "; "))
+		if screen != nil {
+			if err := screen.Beep(); err != nil {
+				log.Printf("ALERT: Failed to ring bell: %v", err)
+			}
+		}
+
+		msg := alerts[0]
+		if len(alerts) > 1 {
+			msg += fmt.Sprintf(" (+%d more)", len(alerts)-1)
+		}
+		notifier.Warn(fmt.Sprintf("[::b]🔔 %s[::-]", tview.Escape(msg)))
+
+		// Flash by inverting the status bar background a few times
+		flashColor := tcell.GetColor(formatting.GetErrorColor())
+		for i := 0; i < 6; i++ {
+			on := i%2 == 0
+			time.AfterFunc(time.Duration(i)*250*time.Millisecond, func() {
+				safeQueueUpdateDraw(func() {
+					if on {
+						statusBar.SetBackgroundColor(flashColor)
+					} else {
+						statusBar.SetBackgroundColor(tview.Styles.PrimitiveBackgroundColor)
+					}
+				})
+			})
+		}
+	}
+
+	// Issues deleted with dD, remembered per project so references to them
+	// that show up later (stale deps, mentions, re-imports) can be flagged
+	discardLog, err := config.LoadDiscardLog(beadsDir)
+	if err != nil {
+		log.Printf("Warning: failed to load discard log: %v", err)
+		discardLog = &config.DiscardLog{}
+	}
+	var discardMutex sync.Mutex
+	var lastDangling string // Last warning shown, so each is only shown once
+
+	// recordDiscard adds a deleted issue to the discard log
+	recordDiscard := func(issue *parser.Issue) {
+		discardMutex.Lock()
+		defer discardMutex.Unlock()
+		discardLog.Discarded = append(discardLog.Discarded, config.DiscardedIssue{ID: issue.ID, Title: issue.Title, DiscardedAt: time.Now()})
+		if err := config.SaveDiscardLog(beadsDir, discardLog); err != nil {
+			log.Printf("Warning: failed to save discard log: %v", err)
+		}
+	}
+
+	// Time logged with w, kept per project since bd doesn't track time
+	worklog, err := config.LoadWorklog(beadsDir)
+	if err != nil {
+		log.Printf("Warning: failed to load worklog: %v", err)
+		worklog = &config.Worklog{}
+	}
+	appState.SetLoggedMinutes(worklog.Totals())
+
+	// Watched issues as of the last refresh, to report what changed in the
+	// next one (only touched by refreshIssues, which the refresher runs one
+	// at a time)
+	var watchBaseline map[string]watchSnapshot
+
+	// Changes found by the last refresh that changed anything (gc lists them)
+	var lastChanges []issueChange
+
+	// The issues as the last few refreshes loaded them (gv compares them)
+	snapshots := newSnapshotHistory(snapshotHistoryLimit)
+
+	// requestRefresh asks the refresher to reload the issues right away,
+	// keeping the given issue selected (or the current selection)
+	requestRefresh := func(preserveIssueID ...string) {
+		issueID := ""
+		if len(preserveIssueID) > 0 {
+			issueID = preserveIssueID[0]
+		}
+		refresher.Refresh(issueID)
+	}
 
-	// Refresh timer for single-flight pattern (prevent timer pile-up)
-	var refreshTimer *time.Timer
-	var refreshTimerMutex sync.Mutex
+	// A canceled command may have changed some issues before bd was stopped
+	runner.SetOnCancel(func() {
+		requestRefresh()
+	})
 
-	// Forward declare refreshIssues for use in scheduleRefresh
-	var refreshIssues func(...string)
+	// Forward declare reportError (set once dialog helpers exist) for refresh failures
+	var reportError func(summary string, err error)
 
-	// scheduleRefresh schedules a delayed refresh, cancelling any pending refresh
-	// This prevents timer pile-up when user performs rapid actions
+	// scheduleRefresh asks for a refresh once bd has had time to finish
+	// writing; another action before then pushes it back, so rapid actions
+	// are one refresh
 	scheduleRefresh := func(issueID string) {
-		refreshTimerMutex.Lock()
-		defer refreshTimerMutex.Unlock()
+		refresher.Schedule(issueID, refreshDelay)
+		log.Printf("SCHEDULE: Refresh scheduled in %v for issue: %s", refreshDelay, issueID)
+	}
 
-		// Cancel existing timer if present
-		if refreshTimer != nil {
-			refreshTimer.Stop()
+	// loadBlockedReasons reads why issues are blocked from their "BLOCKED:"
+	// comments, which aren't loaded with the issues
+	loadBlockedReasons := func(ctx context.Context) {
+		comments, err := source.LatestCommentsWithPrefix(ctx, blockedReasonPrefix)
+		if err != nil {
+			log.Printf("Warning: failed to load blocked reasons: %v", err)
+			return
 		}
+		appState.SetBlockedReasons(blockedReasons(comments))
+	}
 
-		// Schedule new refresh
-		refreshTimer = time.AfterFunc(refreshDelay, func() {
-			log.Printf("SCHEDULE: Delayed refresh starting for issue: %s", issueID)
-			refreshIssues(issueID)
-		})
-		log.Printf("SCHEDULE: Refresh scheduled in 500ms for issue: %s", issueID)
+	// loadIssues reads the issues, narrowed in the query by the priority,
+	// type, status, and label filters when the database holds more than
+	// sql_filter_threshold of them. It also reports whether that narrowing
+	// changed since the last load, when the issues can't be compared with
+	// the ones before.
+	loadIssues := func(ctx context.Context) ([]*parser.Issue, bool, error) {
+		count := 0
+		if sqlFilters.enabled() {
+			var err error
+			if count, err = source.CountIssues(ctx); err != nil {
+				return nil, false, err
+			}
+		}
+		spec := sqlFilters.spec(appState.FilterSpec(), count)
+		var issues []*parser.Issue
+		var err error
+		if spec.IsEmpty() {
+			issues, err = source.LoadIssues(ctx)
+		} else {
+			log.Printf("Loading the issues matching %+v from %d in the database", spec, count)
+			issues, err = source.LoadIssuesFiltered(ctx, spec)
+		}
+		if err != nil {
+			return nil, false, err
+		}
+		return issues, sqlFilters.setLoaded(spec), nil
 	}
 
-	// Function to load and display issues (for async updates after app starts)
+	// Function to load and display issues (for async updates after app
+	// starts). The refresher calls it, never two at once.
 	// preserveIssueID: if provided, attempt to restore selection to this issue after refresh
-	refreshIssues = func(preserveIssueID ...string) {
-		// Serialize refreshes to prevent concurrent access
-		refreshMutex.Lock()
-		defer refreshMutex.Unlock()
-
-		log.Printf("REFRESH: Starting issue refresh (mutex acquired)")
+	refreshIssues := func(preserveIssueID ...string) {
+		log.Printf("REFRESH: Starting issue refresh")
 
 		// Show "Refreshing..." in status bar
 		safeQueueUpdateDraw(func() {
-			statusBar.SetText("[yellow]⟳ Refreshing...[-]")
+			if !notifier.Showing() {
+				statusBar.SetText("[yellow]⟳ Refreshing...[-]")
+			}
 		})
 
 		var targetIssueID string
@@ -389,38 +824,118 @@ func main() {
 		defer cancel()
 
 		log.Printf("REFRESH: Loading issues from SQLite (timeout=5s)")
-		issues, err := sqliteReader.LoadIssues(ctx)
+		loadStart := time.Now()
+		issues, specChanged, err := loadIssues(ctx)
 		if err != nil {
 			log.Printf("REFRESH ERROR: Failed to load issues: %v", err)
-			// Show error in status bar with helpful message for corruption
-			var errText string
+			// Show error overlay with remedies (e.g. 'bd doctor --fix' for corruption)
+			summary := "Error loading issues"
 			if errors.Is(err, storage.ErrDatabaseCorrupted) {
-				errText = "Database corrupted! Run 'bd doctor --fix' to recover."
-			} else {
-				errText = fmt.Sprintf("Error loading issues: %v", err)
+				summary = "Database corrupted"
 			}
 			safeQueueUpdateDraw(func() {
-				statusBar.SetText(errorMsg(errText))
+				if reportError != nil {
+					reportError(summary, err)
+				}
 			})
 			return
 		}
-		log.Printf("REFRESH: Loaded %d issues from database", len(issues))
+		log.Printf("REFRESH: Loaded %d issues from database in %v", len(issues), time.Since(loadStart))
+		snapshots.Record(issues, time.Now())
+
+		// Look for new P0s and assignments before the old issues are replaced;
+		// an explicitly preserved issue was just changed from this TUI
+		var ownChange string
+		if len(preserveIssueID) > 0 {
+			ownChange = preserveIssueID[0]
+		}
+		// When the filters picked the issues, only those loaded both times
+		// can be compared, and none if the filters changed
+		before := appState.GetAllIssues()
+		watched := appState.WatchedIDs()
+		if specChanged {
+			before, watched = nil, nil
+		} else if sqlFilters.active() {
+			before = stillLoaded(before, issues)
+			watched = nil
+			for _, issue := range issues {
+				if appState.IsWatched(issue.ID) {
+					watched = append(watched, issue.ID)
+				}
+			}
+		}
+		var alerts []string
+		if cfg.BellAlerts && !specChanged {
+			alerts = arrivalAlerts(before, issues, currentUser(), ownChange)
+		}
+
+		// Summarize what changed since the last refresh
+		var changes []issueChange
+		if !specChanged {
+			changes = refreshChanges(before, issues, ownChange)
+		}
+
+		// Compare watched issues (status, priority, comment count) with the
+		// last refresh
+		var watchMessages []string
+		if commentCounts, err := source.CountComments(ctx); err != nil {
+			log.Printf("REFRESH: Skipping watch list check, failed to count comments: %v", err)
+		} else {
+			snapshots := watchSnapshots(issues, commentCounts)
+			var changed []string
+			changed, watchMessages = watchChanges(watchBaseline, snapshots, watched, ownChange)
+			watchBaseline = snapshots
+			appState.MarkUnseenChanges(changed)
+		}
+
+		// Look for references to discarded issues
+		discardMutex.Lock()
+		dangling := danglingReferences(issues, discardLog.Discarded)
+		discardMutex.Unlock()
+		var danglingMsg string
+		if len(dangling) > 0 {
+			danglingMsg = dangling[0]
+			if len(dangling) > 1 {
+				danglingMsg += fmt.Sprintf(" (+%d more)", len(dangling)-1)
+			}
+		}
 
-		// Update state
+		// Update state, noting dependency cycles that weren't there before
+		inCycleBefore := make(map[string]bool)
+		for _, cycle := range appState.Cycles() {
+			for _, id := range cycle.IssueIDs() {
+				inCycleBefore[id] = true
+			}
+		}
+		syncGitBranch()
+		stateStart := time.Now()
 		appState.LoadIssues(issues)
-		log.Printf("REFRESH: Updated app state")
+		loadBlockedReasons(ctx)
+		log.Printf("REFRESH: Updated app state in %v", time.Since(stateStart))
+		var cycleMsg string
+		for _, cycle := range appState.Cycles() {
+			if ids := cycle.IssueIDs(); !inCycleBefore[ids[0]] {
+				cycleMsg = fmt.Sprintf("⟲ New dependency cycle: %s → %s [%s](gl to list)[-]",
+					strings.Join(ids, " → "), ids[0], formatting.GetMutedColor())
+				break
+			}
+		}
 
 		// Update UI on main thread
 		log.Printf("REFRESH: Queueing UI update")
 		safeQueueUpdateDraw(func() {
 			log.Printf("REFRESH: UI update executing")
 			// Update status bar
-			statusBar.SetText(getStatusBarText())
+			notifier.Redraw()
 
+			populateStart := time.Now()
 			populateIssueList()
+			log.Printf("REFRESH: Populated issue list in %v", time.Since(populateStart))
 
 			// Restore selection if requested
-			if targetIssueID != "" {
+			if followIssueID != "" {
+				refreshFollowedIssue()
+			} else if targetIssueID != "" {
 				log.Printf("REFRESH: Searching for issue %s to restore selection", targetIssueID)
 				for idx, issue := range indexToIssue {
 					if issue.ID == targetIssueID {
@@ -431,14 +946,59 @@ func main() {
 				}
 			}
 
+			if len(alerts) > 0 {
+				alertUser(alerts)
+			} else if len(watchMessages) > 0 {
+				log.Printf("REFRESH: Watched issues changed: %s", strings.Join(watchMessages, "; "))
+				msg := "Watched " + watchMessages[0]
+				if len(watchMessages) > 1 {
+					msg += fmt.Sprintf(" (+%d more)", len(watchMessages)-1)
+				}
+				notifier.Warn(tview.Escape(msg))
+			} else if cycleMsg != "" {
+				log.Printf("REFRESH: %s", cycleMsg)
+				notifier.Warn(cycleMsg)
+			} else if danglingMsg != "" && danglingMsg != lastDangling {
+				log.Printf("REFRESH: Dangling references: %s", strings.Join(dangling, "; "))
+				notifier.Warn(tview.Escape(danglingMsg))
+			}
+			lastDangling = danglingMsg
+
+			// Queued behind any warning above
+			if summary := summarizeChanges(changes); summary != "" {
+				log.Printf("REFRESH: Changes: %s", summary)
+				lastChanges = changes
+				notifier.Info(fmt.Sprintf("⟳ %s [%s](gc to list)[-]", summary, formatting.GetMutedColor()))
+			}
+
 			log.Printf("REFRESH: UI update complete")
 		})
 		log.Printf("REFRESH: Issue refresh complete")
 	}
+	refresher.Start(func(issueID string) {
+		if issueID == "" {
+			refreshIssues()
+		} else {
+			refreshIssues(issueID)
+		}
+	})
+
+	// Start with the filter from the command line or the project, before
+	// the initial load so a large database is read narrowed by it. Not when
+	// following, where it could hide the followed issue.
+	if *issueID == "" {
+		if *startFilter != "" {
+			appState.ApplyFilterQuery(*startFilter)
+		} else if projectState.Filter != "" {
+			appState.ApplyFilterQuery(projectState.Filter)
+		} else if projectCfg.Filter != "" {
+			appState.ApplyFilterQuery(projectCfg.Filter)
+		}
+	}
 
 	// Initial load (before app starts, no QueueUpdateDraw)
 	ctx, cancel := context.WithTimeout(context.Background(), dbLoadTimeout)
-	issues, err := sqliteReader.LoadIssues(ctx)
+	issues, _, err := loadIssues(ctx)
 	cancel()
 	if err != nil {
 		if errors.Is(err, storage.ErrDatabaseCorrupted) {
@@ -452,7 +1012,15 @@ func main() {
 		fmt.Fprintf(os.Stderr, "Error loading issues: %v\n", err)
 		os.Exit(1)
 	}
+	syncGitBranch()
 	appState.LoadIssues(issues)
+	snapshots.Record(issues, time.Now())
+	loadBlockedReasons(context.Background())
+	if commentCounts, err := source.CountComments(context.Background()); err != nil {
+		log.Printf("Warning: failed to count comments for the watch list: %v", err)
+	} else {
+		watchBaseline = watchSnapshots(issues, commentCounts)
+	}
 
 	// Load collapse state from disk (persisted between sessions)
 	collapseState, err := config.LoadCollapseState(beadsDir)
@@ -475,41 +1043,149 @@ func main() {
 		}
 	}
 
-	// Filter by issue ID if specified
-	if *issueID != "" {
-		filtered := make([]*parser.Issue, 0)
-		for _, issue := range issues {
-			if issue.ID == *issueID {
-				filtered = append(filtered, issue)
-				break
+	// Helper function to select an issue by ID in the list (returns false if not visible)
+	selectIssue := func(issueID string) bool {
+		for idx, iss := range indexToIssue {
+			if iss.ID == issueID {
+				issueList.SetCurrentItem(idx)
+				return true
 			}
 		}
-		if len(filtered) == 0 {
-			fmt.Fprintf(os.Stderr, "Error: Issue %s not found\n", *issueID)
-			os.Exit(1)
-		}
-		appState.LoadIssues(filtered)
+		return false
 	}
 
-	statusBar.SetText(getStatusBarText())
-	populateIssueList()
+	// Helper function to select the first issue of a list section (sections are
+	// list view only; the tree has none)
+	jumpToSection := func(target int) {
+		if appState.GetViewMode() == state.ViewTree {
+			notifier.Info("Section jumps work in list view")
+			return
+		}
+		if target < 0 || target >= len(listSections) {
+			notifier.Warn(fmt.Sprintf("No section %d (%d shown)", target+1, len(listSections)))
+			return
+		}
+		issueList.SetCurrentItem(firstSectionRow(listSections[target]))
+	}
 
-	// Set up filesystem watcher on the database
-	log.Printf("Setting up file watcher on: %s", dbPath)
-	fileWatcher, err := watcher.New(dbPath, watcherDebounce, func() {
-		log.Printf("WATCHER: File change detected, triggering refresh")
-		refreshIssues()
-	})
-	if err != nil {
-		log.Printf("WATCHER ERROR: Failed to create watcher: %v", err)
-		fmt.Fprintf(os.Stderr, "Warning: failed to set up database watcher: %v\n", err)
-		fmt.Fprintf(os.Stderr, "Live updates will not work. Press 'r' to manually refresh.\n")
-	} else {
-		if err := fileWatcher.Start(); err != nil {
-			log.Printf("WATCHER ERROR: Failed to start watcher: %v", err)
-			fmt.Fprintf(os.Stderr, "Warning: failed to start database watcher: %v\n", err)
-		} else {
-			log.Printf("WATCHER: File watcher started successfully")
+	// Helper function for ]] (offset 1) and [[ (offset -1)
+	jumpSection := func(offset int) {
+		if appState.GetViewMode() == state.ViewTree {
+			notifier.Info("Section jumps work in list view")
+			return
+		}
+		target, ok := sectionJump(listSections, issueList.GetCurrentItem(), offset)
+		if !ok {
+			if offset > 0 {
+				notifier.Info("No next section")
+			} else {
+				notifier.Info("No previous section")
+			}
+			return
+		}
+		jumpToSection(target)
+	}
+
+	// Helper function to fold or unfold a tree node, persisting the change and keeping it selected
+	setTreeCollapsed := func(issueID string, collapsed bool) {
+		if !appState.SetCollapsed(issueID, collapsed) {
+			return
+		}
+		saveCollapseState()
+		populateIssueList()
+		selectIssue(issueID)
+		if collapsed {
+			notifier.Success(fmt.Sprintf("Collapsed %s", issueID))
+		} else {
+			notifier.Success(fmt.Sprintf("Expanded %s", issueID))
+		}
+	}
+
+	// Helper function to save layout and view preferences, globally and for this project (called on toggle and exit)
+	savePreferences := func() {
+		// Follow mode overrides the display settings, and the demo is for
+		// trying things out; keep the saved ones
+		if followIssueID != "" || *demoMode {
+			return
+		}
+		cfg.Layout = config.LayoutHorizontal
+		if verticalLayout {
+			cfg.Layout = config.LayoutVertical
+		}
+		cfg.ShowDetailPane = detailPaneVisible
+		cfg.ShowClosedIssues = showClosedIssues
+		cfg.MouseEnabled = mouseEnabled
+		cfg.GroupBy = listGrouping.String()
+		cfg.ViewMode = config.ViewModeList
+		if appState.GetViewMode() == state.ViewTree {
+			cfg.ViewMode = config.ViewModeTree
+		}
+		if err := config.Save(projectCfg.Unapply(cfg, &globalCfg)); err != nil {
+			log.Printf("Warning: failed to save preferences: %v", err)
+		}
+
+		// View mode is also remembered per project
+		cfg.SortMode = appState.GetSortMode().String()
+		projectState.ViewMode = cfg.ViewMode
+		projectState.SortMode = cfg.SortMode
+		if err := config.SaveProjectState(beadsDir, projectState); err != nil {
+			log.Printf("Warning: failed to save project state: %v", err)
+		}
+	}
+
+	// Follow one issue if specified, in list view with closed issues shown
+	// so the list can always select it
+	if *issueID != "" {
+		issue, err := findIssueByID(issues, *issueID)
+		if err != nil {
+			fmt.Fprintf(os.Stderr, "Error: %v\n", err)
+			os.Exit(1)
+		}
+		followIssueID = issue.ID
+		appState.SetViewMode(state.ViewList)
+		showClosedIssues = true
+	}
+
+	notifier.Redraw()
+	populateIssueList()
+
+	// Set up filesystem watcher on the database. The demo's issues only
+	// change from the TUI, which refreshes after each change anyway.
+	if *demoMode {
+		liveState = watcher.StateRunning
+	} else if fileWatcher, err := watcher.New(dbPath, watcherDebounce, func() {
+		log.Printf("WATCHER: File change detected, triggering refresh")
+		requestRefresh()
+	}); err != nil {
+		log.Printf("WATCHER ERROR: Failed to create watcher: %v", err)
+		fmt.Fprintf(os.Stderr, "Warning: failed to set up database watcher: %v\n", err)
+		fmt.Fprintf(os.Stderr, "Live updates will not work. Press 'r' to manually refresh.\n")
+	} else {
+		if err := fileWatcher.Start(); err != nil {
+			log.Printf("WATCHER ERROR: Failed to start watcher: %v", err)
+			fmt.Fprintf(os.Stderr, "Warning: failed to start database watcher: %v\n", err)
+		} else {
+			log.Printf("WATCHER: File watcher started successfully")
+			liveState = watcher.StateRunning
+			// The status bar shows the watcher's health; a restart or giving up
+			// is also announced
+			fileWatcher.SetOnStateChange(func(state watcher.State) {
+				safeQueueUpdateDraw(func() {
+					previous := liveState
+					liveState = state
+					log.Printf("WATCHER: state %s -> %s", previous, state)
+					switch {
+					case state == watcher.StateDegraded:
+						notifier.Warn("Live updates interrupted, reconnecting…")
+					case state == watcher.StateStopped:
+						notifier.Error("Live updates stopped; press r to refresh")
+					case previous == watcher.StateDegraded:
+						notifier.Success("Live updates restored")
+					default:
+						notifier.Redraw()
+					}
+				})
+			})
 		}
 		defer func() {
 			log.Printf("WATCHER: Stopping file watcher")
@@ -520,11 +1196,19 @@ func main() {
 	// Detail panel
 	detailPanel := tview.NewTextView().
 		SetDynamicColors(true).
+		SetRegions(true). // Comments are regions, picked with ] and [
 		SetScrollable(true).
 		SetWrap(true)
 	detailPanel.SetBorder(true).SetTitle("Details")
 	detailPanel.SetText(fmt.Sprintf("[%s]Navigate to an issue to view details[-]", formatting.GetEmphasisColor()))
 
+	// Comment input, shown under the details while adding a comment (c with
+	// the details focused) so the issue stays in view
+	commentInput := tview.NewInputField().SetLabel("Comment: ")
+	detailPane := tview.NewFlex().
+		SetDirection(tview.FlexRow).
+		AddItem(detailPanel, 0, 1, true)
+
 	// Add mouse click handler for copying issue ID
 	detailPanel.SetMouseCapture(func(action tview.MouseAction, event *tcell.EventMouse) (tview.MouseAction, *tcell.EventMouse) {
 		if action == tview.MouseLeftClick && currentDetailIssue != nil {
@@ -543,10 +1227,10 @@ func main() {
 				err := clipboard.WriteAll(currentDetailIssue.ID)
 				if err != nil {
 					log.Printf("CLIPBOARD ERROR: Failed to copy to clipboard: %v", err)
-					statusBar.SetText(fmt.Sprintf("[%s]Failed to copy: %v[-]", formatting.GetErrorColor(), err))
+					notifier.Error(fmt.Sprintf("Failed to copy: %v", err))
 				} else {
 					log.Printf("CLIPBOARD: Copied issue ID to clipboard: %s", currentDetailIssue.ID)
-					showTemporaryStatus(fmt.Sprintf("[%s]✓ Copied %s to clipboard[-]", formatting.GetSuccessColor(), currentDetailIssue.ID), statusMessageDuration)
+					notifier.Success(fmt.Sprintf("Copied %s to clipboard", currentDetailIssue.ID))
 				}
 			}
 		}
@@ -559,43 +1243,323 @@ func main() {
 			issueList.SetBorderColor(tcell.ColorGray)
 			issueList.SetTitle(getIssueListTitle())
 			detailPanel.SetBorderColor(tcell.ColorYellow)
-			detailPanel.SetTitle("Details [FOCUSED - Use Ctrl-d/u to scroll, ESC to return]")
+			detailPanel.SetTitle("Details [FOCUSED - Ctrl-d/u scroll, ]/[ pick comment, }/{ pick link, c comment, ESC to return]")
 			app.SetFocus(detailPanel)
 		} else {
 			issueList.SetBorderColor(tcell.ColorDefault)
 			issueList.SetTitle(getIssueListTitle())
 			detailPanel.SetBorderColor(tcell.ColorGray)
 			detailPanel.SetTitle("Details [Press Tab or Enter to focus]")
+			detailPanel.Highlight() // Drop any picked comment
 			app.SetFocus(issueList)
 		}
-		statusBar.SetText(getStatusBarText())
+		notifier.Redraw()
 	}
 	// Set initial focus state
 	updatePanelFocus()
 
+	// Forward declare issueDetailsText so commits loaded in the background
+	// can redraw the details
+	var issueDetailsText func(issue *parser.Issue) string
+
+	// gitDetails formats the issue's branch and commits, starting a git log
+	// in the background the first time the issue is shown
+	gitDetails := func(issue *parser.Issue) string {
+		if gitRepo == nil {
+			return ""
+		}
+		var branch string
+		if appState.BranchIssue() == issue.ID {
+			branch = appState.CurrentBranch()
+		}
+		commits, ok := commitCache.Cached(issue.ID)
+		if !ok {
+			// Renders while the load runs don't start another
+			issueID := issue.ID
+			commitCache.LoadAsync(issueID, func(err error) {
+				if err != nil {
+					log.Printf("GIT: Failed to find commits for %s: %v", issueID, err)
+				}
+				safeQueueUpdateDraw(func() {
+					if currentDetailIssue != nil && currentDetailIssue.ID == issueID {
+						detailPanel.SetText(issueDetailsText(currentDetailIssue))
+					}
+				})
+			})
+		}
+		return formatting.FormatGitDetails(branch, commits)
+	}
+
+	issueDetailsText = func(issue *parser.Issue) string {
+		loaded := withComments(commentCache, issue)
+		detailComments = loaded.Comments
+		dc := formatting.NewDetailContext(appState, issue, time.Now())
+		// Linked issue IDs jump to the issue, which following doesn't allow
+		if followIssueID == "" {
+			dc.Linkable = func(issueID string) bool { return appState.GetIssueByID(issueID) != nil }
+		}
+		return formatting.FormatIssueDetails(loaded, dc) + gitDetails(issue)
+	}
+
 	// Function to show issue details
 	showIssueDetails := func(issue *parser.Issue) {
 		currentDetailIssue = issue
-		details := formatting.FormatIssueDetails(issue)
-		detailPanel.SetText(details)
+		if !historyMoving {
+			history.Visit(issue.ID, historyJump)
+		}
+		if appState.ClearUnseenChange(issue.ID) {
+			issueList.Reformat() // Drop the changed marker
+		}
+		detailPanel.SetText(issueDetailsText(issue))
 		detailPanel.ScrollToBeginning()
 	}
 
+	// refreshFollowedIssue redraws the followed issue's details in place,
+	// keeping the scroll position, and selects it for the issue actions
+	refreshFollowedIssue = func() {
+		row, column := detailPanel.GetScrollOffset()
+		detailPanel.SetBorderColor(tcell.ColorYellow)
+		issue := appState.GetIssueByID(followIssueID)
+		if issue == nil {
+			currentDetailIssue = nil
+			detailPanel.SetTitle(fmt.Sprintf("Following %s [deleted]", followIssueID))
+			detailPanel.SetText(fmt.Sprintf("[%s]%s no longer exists (q to quit)[-]", formatting.GetWarningColor(), followIssueID))
+			return
+		}
+		detailPanel.SetTitle(fmt.Sprintf("Following %s", followIssueID))
+		selectIssue(followIssueID)
+		currentDetailIssue = issue
+		appState.ClearUnseenChange(issue.ID)
+		detailPanel.SetText(issueDetailsText(issue))
+		detailPanel.ScrollTo(row, column)
+	}
+	if followIssueID != "" {
+		refreshFollowedIssue()
+	}
+
+	// saveSession remembers the selected issue, filters, and detail panel
+	// scroll position for the project, to pick up there next launch
+	saveSession := func() {
+		if followIssueID != "" {
+			return
+		}
+		projectState.Selected = ""
+		if issue, ok := indexToIssue[issueList.GetCurrentItem()]; ok {
+			projectState.Selected = issue.ID
+		}
+		projectState.Filter = appState.FilterQuery()
+		projectState.DetailScroll, _ = detailPanel.GetScrollOffset()
+		if err := config.SaveProjectState(beadsDir, projectState); err != nil {
+			log.Printf("Warning: failed to save session: %v", err)
+		}
+	}
+
+	// pickedComment returns the comment highlighted in the detail panel, if any
+	pickedComment := func() *parser.Comment {
+		for _, region := range detailPanel.GetHighlights() {
+			if id, ok := formatting.CommentRegionID(region); ok {
+				for _, comment := range detailComments {
+					if comment.ID == id {
+						return comment
+					}
+				}
+			}
+		}
+		return nil
+	}
+
+	// pickComment highlights the next (offset 1) or previous (-1) comment in
+	// the detail panel, wrapping around, and scrolls to it
+	pickComment := func(offset int) {
+		if len(detailComments) == 0 {
+			notifier.Warn("No comments on this issue")
+			return
+		}
+		next := 0
+		if offset < 0 {
+			next = len(detailComments) - 1
+		}
+		if picked := pickedComment(); picked != nil {
+			for i, comment := range detailComments {
+				if comment.ID == picked.ID {
+					next = (i + offset + len(detailComments)) % len(detailComments)
+					break
+				}
+			}
+		}
+		detailPanel.Highlight(formatting.CommentRegion(detailComments[next].ID)).ScrollToHighlight()
+	}
+
+	// pickingLink is set while a link is highlighted from the keyboard, so
+	// only clicks follow links straight away
+	var pickingLink bool
+
+	// pickedLink returns the issue the link highlighted in the detail panel
+	// goes to, if any
+	pickedLink := func() (string, bool) {
+		for _, region := range detailPanel.GetHighlights() {
+			if issueID, ok := formatting.IssueRegionID(region); ok {
+				return issueID, true
+			}
+		}
+		return "", false
+	}
+
+	// pickLink highlights the next (offset 1) or previous (-1) issue link in
+	// the detail panel, wrapping around, and scrolls to it
+	pickLink := func(offset int) {
+		regions := formatting.IssueRegions(detailPanel.GetText(false))
+		if len(regions) == 0 {
+			notifier.Warn("No linked issues in the details")
+			return
+		}
+		next := 0
+		if offset < 0 {
+			next = len(regions) - 1
+		}
+		if highlights := detailPanel.GetHighlights(); len(highlights) > 0 {
+			if i := slices.Index(regions, highlights[0]); i >= 0 {
+				next = (i + offset + len(regions)) % len(regions)
+			}
+		}
+		pickingLink = true
+		detailPanel.Highlight(regions[next]).ScrollToHighlight()
+		pickingLink = false
+	}
+
+	// openExternalRef opens an issue's external reference in the browser:
+	// the reference itself if it is a URL, otherwise the external_ref_urls
+	// rule from the config that matches it
+	openExternalRef := func(issue *parser.Issue) {
+		if issue.ExternalRef == nil || strings.TrimSpace(*issue.ExternalRef) == "" {
+			notifier.Warn(fmt.Sprintf("%s has no external reference (Space i R links one)", issue.ID))
+			return
+		}
+		ref := *issue.ExternalRef
+		link, err := externalRefURL(ref, cfg.ExternalRefURLs)
+		if err != nil {
+			log.Printf("EXTERNAL REF ERROR: %v", err)
+			notifier.Error(tview.Escape(err.Error()))
+			return
+		}
+		if link == "" {
+			notifier.Warn(tview.Escape(fmt.Sprintf("No URL for %q: add a pattern to \"external_ref_urls\" in ~/.beads-tui/config.json", ref)))
+			return
+		}
+		if err := openInBrowser(link); err != nil {
+			log.Printf("EXTERNAL REF ERROR: Failed to open %s: %v", link, err)
+			notifier.Error(fmt.Sprintf("Failed to open browser: %v", err))
+			return
+		}
+		log.Printf("EXTERNAL REF: Opened %s for %s", link, issue.ID)
+		notifier.Success("Opened " + tview.Escape(link))
+	}
+
+	// Clicking the external reference in the details opens it, and clicking
+	// an issue link jumps to the issue. Either drops the highlight so the
+	// next click works again.
+	detailPanel.SetHighlightedFunc(func(added, removed, remaining []string) {
+		if slices.Contains(added, formatting.ExternalRefRegion) {
+			detailPanel.Highlight()
+			if currentDetailIssue != nil {
+				openExternalRef(currentDetailIssue)
+			}
+			return
+		}
+		if pickingLink {
+			return
+		}
+		for _, region := range added {
+			if issueID, ok := formatting.IssueRegionID(region); ok {
+				detailPanel.Highlight()
+				jumpToIssue(issueID)
+				return
+			}
+		}
+	})
+
 	// Set up change handler to auto-show details on selection change
-	issueList.SetChangedFunc(func(index int, mainText, secondaryText string, shortcut rune) {
+	issueList.SetChangedFunc(func(index int) {
 		// Check if the selected item is an issue (not a header)
-		if issue, ok := indexToIssue[index]; ok {
+		if issue, ok := indexToIssue[index]; ok && followIssueID == "" {
 			showIssueDetails(issue)
 		}
 		// Update title to reflect current position
 		issueList.SetTitle(getIssueListTitle())
 	})
 
+	// Go back to the issue (and detail scroll position) selected last time
+	if followIssueID == "" && projectState.Selected != "" && selectIssue(projectState.Selected) {
+		detailPanel.ScrollTo(projectState.DetailScroll, 0)
+	}
+
+	// applyTheme redraws the main screen in the current theme: tview's
+	// default colors (for dialogs opened from now on), the panels, and the
+	// color tags in the list, details, and status bar
+	applyTheme := func() {
+		currentTheme := theme.Current()
+		tview.Styles.PrimitiveBackgroundColor = currentTheme.AppBackground()
+		tview.Styles.PrimaryTextColor = currentTheme.AppForeground()
+		tview.Styles.ContrastBackgroundColor = currentTheme.InputFieldBackground()
+		tview.Styles.MoreContrastBackgroundColor = currentTheme.InputFieldBackground()
+		statusBar.SetTextColor(currentTheme.AppForeground()).SetBackgroundColor(currentTheme.AppBackground())
+		detailPanel.SetTextColor(currentTheme.AppForeground()).SetBackgroundColor(currentTheme.AppBackground())
+		commentInput.SetFieldBackgroundColor(currentTheme.InputFieldBackground()).
+			SetLabelColor(currentTheme.AppForeground()).
+			SetBackgroundColor(currentTheme.AppBackground())
+		issueList.SetMainTextColor(currentTheme.AppForeground()).
+			SetSelectedBackgroundColor(currentTheme.SelectionBg()).
+			SetSelectedTextColor(currentTheme.SelectionFg()).
+			SetBackgroundColor(currentTheme.AppBackground())
+
+		if followIssueID != "" {
+			refreshFollowedIssue()
+		} else {
+			row, column := detailPanel.GetScrollOffset()
+			selected, hasSelection := indexToIssue[issueList.GetCurrentItem()]
+			populateIssueList()
+			if hasSelection && selectIssue(selected.ID) {
+				detailPanel.ScrollTo(row, column)
+			}
+		}
+		notifier.Redraw()
+	}
+
+	// Reload user themes when their files change, so a theme can be edited
+	// with the TUI open to preview it
+	if themesDir, err := theme.UserThemesDir(); err == nil {
+		if _, err := os.Stat(themesDir); err == nil {
+			themeWatcher, err := watcher.New(themesDir, watcherDebounce, func() {
+				log.Printf("WATCHER: Theme file change detected, reloading user themes")
+				err := theme.LoadUserThemes() // Themes that did load are still applied
+				safeQueueUpdateDraw(func() {
+					applyTheme()
+					if err != nil {
+						notifier.Error(tview.Escape(err.Error()))
+					} else {
+						notifier.Info(fmt.Sprintf("Reloaded themes (current: %s)", theme.Current().Name()))
+					}
+				})
+			})
+			if err != nil {
+				log.Printf("WATCHER ERROR: Failed to create theme watcher: %v", err)
+			} else if err := themeWatcher.Start(); err != nil {
+				log.Printf("WATCHER ERROR: Failed to start theme watcher: %v", err)
+			} else {
+				defer func() { _ = themeWatcher.Stop() }()
+			}
+		}
+	}
+
 	// Layout builder function
 	buildLayout := func() *tview.Flex {
 		var contentFlex *tview.Flex
 
-		if !detailPaneVisible {
+		if followIssueID != "" {
+			// Follow mode: the followed issue's details only
+			contentFlex = tview.NewFlex().
+				AddItem(detailPane, 0, 1, false)
+		} else if !detailPaneVisible {
 			// Detail pane hidden: show only issue list
 			contentFlex = tview.NewFlex().
 				AddItem(issueList, 0, 1, true)
@@ -604,12 +1568,12 @@ func main() {
 			contentFlex = tview.NewFlex().
 				SetDirection(tview.FlexRow).
 				AddItem(issueList, 0, 40, !detailPanelFocused).
-				AddItem(detailPanel, 0, 60, detailPanelFocused)
+				AddItem(detailPane, 0, 60, detailPanelFocused)
 		} else {
 			// Horizontal: list on left (1 part), details on right (2 parts)
 			contentFlex = tview.NewFlex().
 				AddItem(issueList, 0, 1, !detailPanelFocused).
-				AddItem(detailPanel, 0, 2, detailPanelFocused)
+				AddItem(detailPane, 0, 2, detailPanelFocused)
 		}
 
 		return tview.NewFlex().
@@ -637,8 +1601,10 @@ func main() {
 			shutdownOnce.Do(func() {
 				log.Printf("SIGNAL: Received signal %v, initiating graceful shutdown", sig)
 
-				// Save collapse state before exit
+				// Save collapse state and preferences before exit
 				saveCollapseState()
+				savePreferences()
+				saveSession()
 
 				// Stop the TUI application
 				app.Stop()
@@ -651,34 +1617,45 @@ func main() {
 		}
 	}()
 
-	// Helper function to perform search
+	// Helper function to show the current search match in the status bar
+	showSearchPosition := func() {
+		var fieldNames []string
+		for _, field := range searchMatchFields[currentSearchIndex] {
+			fieldNames = append(fieldNames, string(field))
+		}
+		statusBar.SetText(fmt.Sprintf("[%s]Search:[-] %s [%d/%d matches, in %s] [Press n/N for next/prev, ESC to exit search]",
+			formatting.GetEmphasisColor(), searchQuery, currentSearchIndex+1, len(searchMatches), strings.Join(fieldNames, ", ")))
+	}
+
+	// Helper function to perform search across all issue fields
 	performSearch := func(query string) {
 		searchMatches = nil
+		searchMatchFields = nil
 		currentSearchIndex = -1
 
 		if query == "" {
 			return
 		}
 
-		// Search through all items in the list
-		for i := 0; i < issueList.GetItemCount(); i++ {
-			mainText, _ := issueList.GetItemText(i)
-			// Simple case-insensitive substring search
-			if len(mainText) > 0 && formatting.ContainsCaseInsensitive(mainText, query) {
-				searchMatches = append(searchMatches, i)
+		// Map ranked results onto visible list rows (filtered or collapsed issues are skipped)
+		rowByID := make(map[string]int, len(indexToIssue))
+		for i, issue := range indexToIssue {
+			rowByID[issue.ID] = i
+		}
+		for _, result := range appState.Search(query) {
+			if row, ok := rowByID[result.Issue.ID]; ok {
+				searchMatches = append(searchMatches, row)
+				searchMatchFields = append(searchMatchFields, result.Fields)
 			}
 		}
 
-		// Jump to first match if any
-		emphasisColor := formatting.GetEmphasisColor()
-		errorColor := formatting.GetErrorColor()
+		// Jump to best match if any
 		if len(searchMatches) > 0 {
 			currentSearchIndex = 0
 			issueList.SetCurrentItem(searchMatches[0])
-			statusBar.SetText(fmt.Sprintf("[%s]Search:[-] %s [%d/%d matches] [Press n/N for next/prev, ESC to exit search]",
-				emphasisColor, query, 1, len(searchMatches)))
+			showSearchPosition()
 		} else {
-			statusBar.SetText(fmt.Sprintf("[%s]Search:[-] %s [No matches]", errorColor, query))
+			statusBar.SetText(fmt.Sprintf("[%s]Search:[-] %s [No matches]", formatting.GetErrorColor(), query))
 		}
 	}
 
@@ -689,8 +1666,7 @@ func main() {
 		}
 		currentSearchIndex = (currentSearchIndex + 1) % len(searchMatches)
 		issueList.SetCurrentItem(searchMatches[currentSearchIndex])
-		statusBar.SetText(fmt.Sprintf("[%s]Search:[-] %s [%d/%d matches] [Press n/N for next/prev, ESC to exit search]",
-			formatting.GetEmphasisColor(), searchQuery, currentSearchIndex+1, len(searchMatches)))
+		showSearchPosition()
 	}
 
 	// Helper function for previous search result
@@ -703,8 +1679,7 @@ func main() {
 			currentSearchIndex = len(searchMatches) - 1
 		}
 		issueList.SetCurrentItem(searchMatches[currentSearchIndex])
-		statusBar.SetText(fmt.Sprintf("[%s]Search:[-] %s [%d/%d matches] [Press n/N for next/prev, ESC to exit search]",
-			formatting.GetEmphasisColor(), searchQuery, currentSearchIndex+1, len(searchMatches)))
+		showSearchPosition()
 	}
 
 	// Helper function to show comment dialog
@@ -716,30 +1691,451 @@ func main() {
 		IndexToIssue:    &indexToIssue,
 		StatusBar:       statusBar,
 		AppState:        appState,
-		RefreshIssues:   refreshIssues,
+		RefreshIssues:   requestRefresh,
 		ScheduleRefresh: scheduleRefresh,
+		Runner:          runner,
+		Undo:            &undoStack{},
+		DB:              source,
+		Comments:        commentCache,
+		Snapshots:       snapshots,
+		Drafts:          newDraftStore(beadsDir),
+		Git:             gitRepo,
+		BranchTemplate:  cfg.BranchTemplate,
+		StandupWindow:   time.Duration(cfg.StandupHours) * time.Hour,
+		ExternalRefURLs: cfg.ExternalRefURLs,
+		Notify:          notifier,
+		Confirm: &confirmPolicy{
+			Enabled: cfg.ConfirmDestructive,
+			Skip:    cfg.SkipConfirm,
+			Save: func(skip map[string]bool) {
+				cfg.SkipConfirm = skip
+				if err := config.Save(projectCfg.Unapply(cfg, &globalCfg)); err != nil {
+					log.Printf("Warning: failed to save confirmations: %v", err)
+				}
+			},
+		},
 	}
+	reportError = dialogHelpers.ShowErrorOverlay
+	runner.SetPreview(dialogHelpers.ShowCommandPreview)
+
+	// Find out what the installed bd supports in the background; until then
+	// every action is tried
+	go func() {
+		caps := detectBdCapabilities()
+		safeQueueUpdateDraw(func() {
+			dialogHelpers.Bd = caps
+		})
+	}()
 
 	// Helper function to show comment dialog
 	showCommentDialog := func() {
 		dialogHelpers.ShowCommentDialog()
 	}
 
+	// Helper functions to add a comment from the one-line input under the
+	// details. Enter posts it and redraws the details in place; Esc closes
+	// the input, keeping the text as the comment dialog's draft.
+	var commentIssueID string
+	closeInlineComment := func() {
+		commentIssueID = ""
+		commentInput.SetDisabled(false)
+		detailPane.RemoveItem(commentInput)
+		app.SetFocus(detailPanel)
+	}
+	postInlineComment := func() {
+		issueID := commentIssueID
+		commentText := strings.TrimSpace(commentInput.GetText())
+		if commentText == "" {
+			notifier.Error("Comment cannot be empty")
+			return
+		}
+		log.Printf("BD COMMAND: Adding comment: bd comment %s %q", issueID, commentText)
+		commentInput.SetDisabled(true)
+		var comment *parser.Comment
+		runner.Run("Adding comment to "+issueID, func(ctx context.Context) error {
+			var err error
+			comment, err = execBdJSONComment(ctx, "comment", issueID, commentText)
+			return err
+		}, func(err error) {
+			commentInput.SetDisabled(false)
+			if err != nil {
+				log.Printf("BD COMMAND ERROR: Comment failed: %v", err)
+				dialogHelpers.ShowErrorOverlay("Error adding comment", err)
+				return
+			}
+			log.Printf("BD COMMAND: Comment added successfully: ID %d", comment.ID)
+			dialogHelpers.Drafts.Delete(draftKey("comment", issueID))
+			commentCache.Forget(issueID)
+			closeInlineComment()
+			if currentDetailIssue != nil && currentDetailIssue.ID == issueID {
+				row, column := detailPanel.GetScrollOffset()
+				detailPanel.SetText(issueDetailsText(currentDetailIssue))
+				detailPanel.ScrollTo(row, column)
+			}
+			notifier.Success("Comment added to " + issueID)
+			scheduleRefresh(issueID)
+		})
+	}
+	commentInput.SetChangedFunc(func(text string) {
+		if commentIssueID != "" {
+			dialogHelpers.Drafts.Save(draftKey("comment", commentIssueID), map[string]string{"comment": text}, nil)
+		}
+	})
+	commentInput.SetDoneFunc(func(key tcell.Key) {
+		switch key {
+		case tcell.KeyEnter:
+			postInlineComment()
+		case tcell.KeyEscape:
+			closeInlineComment()
+		}
+	})
+	showInlineComment := func() {
+		if currentDetailIssue == nil {
+			notifier.Error("No issue selected")
+			return
+		}
+		draft, _ := dialogHelpers.Drafts.Load(draftKey("comment", currentDetailIssue.ID))
+		text, fits := inlineCommentDraft(draft.Fields["comment"])
+		if !fits {
+			// Editing a draft of several lines on one would lose them
+			showCommentDialog()
+			return
+		}
+		commentIssueID = currentDetailIssue.ID
+		detailPane.RemoveItem(commentInput)
+		detailPane.AddItem(commentInput, 1, 0, true)
+		commentInput.SetText(text)
+		app.SetFocus(commentInput)
+	}
+
 	// Helper function to show rename dialog
 	showRenameDialog := func() {
 		dialogHelpers.ShowRenameDialog()
 	}
 
 	// Helper function to show quick filter (keyboard-friendly)
-	showQuickFilter := func() {
-		dialogHelpers.ShowQuickFilter()
-		statusBar.SetText(getStatusBarText())
+	showQuickFilter := func(initial string) {
+		dialogHelpers.ShowQuickFilter(initial, func() {
+			notifier.Redraw()
+			populateIssueList()
+		})
+	}
+
+	// pageDown moves the selection down a screen, wrapping to the top
+	pageDown := func() {
+		_, _, _, height := issueList.GetInnerRect()
+		currentItem := issueList.GetCurrentItem()
+		maxItem := issueList.GetItemCount() - 1
+		newItem := currentItem + height
+		if newItem > maxItem {
+			// Wrap to top
+			newItem = 0
+		}
+		issueList.SetCurrentItem(newItem)
+	}
+
+	// Leader sequences (Space, then mnemonic keys) with a which-key popup
+	// listing what can come next
+	var leaderActive bool
+	var leaderPrefix string
+	var handleKey func(event *tcell.EventKey) *tcell.EventKey // The main key handler, for replaying keys
+	leaderView := tview.NewTextView().SetDynamicColors(true)
+	leaderView.SetBorder(true).SetTitleAlign(tview.AlignLeft)
+
+	showLeaderPopup := func(choices []leaderChoice) {
+		leaderView.SetTitle(" " + leaderSequenceName(leaderPrefix) + " ")
+		leaderView.SetText(renderLeaderPopup(choices, formatting.GetAccentColor(), formatting.GetEmphasisColor()))
+		// Bottom right, above the status bar
+		popup := tview.NewFlex().SetDirection(tview.FlexRow).
+			AddItem(nil, 0, 1, false).
+			AddItem(tview.NewFlex().
+				AddItem(nil, 0, 1, false).
+				AddItem(leaderView, 40, 0, false).
+				AddItem(nil, 1, 0, false), len(choices)+2, 0, false).
+			AddItem(nil, 1, 0, false)
+		pages.RemovePage("leader")
+		pages.AddPage("leader", popup, true, true)
+		app.SetFocus(issueList)
+	}
+	endLeader := func() {
+		leaderActive = false
+		leaderPrefix = ""
+		pages.RemovePage("leader")
+		app.SetFocus(issueList)
+	}
+
+	// jumpToIssue selects an issue in the list, showing closed issues first if
+	// needed. Issues hidden by filters or folding still get their details shown.
+	jumpToIssue = func(issueID string) {
+		historyJump = true
+		defer func() { historyJump = false }()
+		issue := appState.GetIssueByID(issueID)
+		if issue == nil {
+			notifier.Error(fmt.Sprintf("%s not found", issueID))
+			return
+		}
+		if issue.Status == parser.StatusClosed && !showClosedIssues {
+			showClosedIssues = true
+			savePreferences()
+			notifier.Redraw()
+			populateIssueList()
+		}
+		if !selectIssue(issueID) {
+			showIssueDetails(issue)
+			notifier.Warn(fmt.Sprintf("%s is hidden by the current filters or tree folding", issueID))
+		}
+	}
+
+	// moveInHistory goes back (or forward) to the issue shown before (or
+	// after) the current one
+	moveInHistory := func(back bool) {
+		move, direction := history.Forward, "newer"
+		if back {
+			move, direction = history.Back, "older"
+		}
+		issueID, ok := move()
+		if !ok {
+			notifier.Info(fmt.Sprintf("No %s issue in the history", direction))
+			return
+		}
+		historyMoving = true
+		defer func() { historyMoving = false }()
+		jumpToIssue(issueID)
+	}
+
+	// Helper function to review the discussion queue; Enter jumps to the issue
+	showDiscussionQueue := func() {
+		dialogHelpers.ShowDiscussionQueue(jumpToIssue)
+	}
+
+	// Helper function to open the fuzzy issue finder
+	showGotoIssue := func() {
+		dialogHelpers.ShowGotoIssue(jumpToIssue)
+	}
+
+	// logWork saves a worklog entry and updates the totals shown in details
+	logWork := func(entry config.WorklogEntry) error {
+		worklog.Entries = append(worklog.Entries, entry)
+		if err := config.SaveWorklog(beadsDir, worklog); err != nil {
+			worklog.Entries = worklog.Entries[:len(worklog.Entries)-1]
+			return err
+		}
+		appState.SetLoggedMinutes(worklog.Totals())
+		if issue, ok := indexToIssue[issueList.GetCurrentItem()]; ok {
+			showIssueDetails(issue)
+		}
+		return nil
+	}
+
+	// toggleWatch adds the selected issue to the watch list or removes it.
+	// Changes to watched issues are reported after each refresh.
+	toggleWatch := func() {
+		issue, ok := indexToIssue[issueList.GetCurrentItem()]
+		if !ok {
+			notifier.Error("No issue selected")
+			return
+		}
+		watching := appState.ToggleWatched(issue.ID)
+		projectState.Watched = appState.WatchedIDs()
+		if err := config.SaveProjectState(beadsDir, projectState); err != nil {
+			log.Printf("Warning: failed to save watch list: %v", err)
+		}
+		issueList.Reformat()
+		if watching {
+			notifier.Success(fmt.Sprintf("Watching [%s]%s[-] for status, priority, and comment changes", formatting.GetAccentColor(), issue.ID))
+		} else {
+			notifier.Success(fmt.Sprintf("Stopped watching [%s]%s[-]", formatting.GetAccentColor(), issue.ID))
+		}
+	}
+
+	// showCycles lists the dependency cycles; Enter on a link selects the
+	// issue that has the dependency and opens its dependency dialog
+	showCycles := func() {
+		dialogHelpers.ShowDependencyCycles(appState.Cycles(), func(issueID string) {
+			jumpToIssue(issueID)
+			if issue, ok := indexToIssue[issueList.GetCurrentItem()]; ok && issue.ID == issueID {
+				dialogHelpers.ShowDependencyDialog()
+			}
+		})
+	}
+
+	// changePickedComment opens edit (or delete) for the highlighted comment,
+	// if the user wrote it
+	changePickedComment := func(remove bool) {
+		comment := pickedComment()
+		if comment == nil || currentDetailIssue == nil {
+			notifier.Warn("Pick a comment with ] or [ first")
+			return
+		}
+		if !ownComment(comment, currentUser()) {
+			notifier.Warn(fmt.Sprintf("Only your own comments can be changed (this one is by %s)", tview.Escape(comment.Author)))
+			return
+		}
+		if remove {
+			dialogHelpers.ConfirmDeleteComment(currentDetailIssue.ID, comment)
+		} else {
+			dialogHelpers.ShowEditCommentDialog(currentDetailIssue.ID, comment)
+		}
+	}
+
+	// togglePrefix shows or hides the issue ID prefix in the list
+	togglePrefix := func() {
+		showPrefix = !showPrefix
 		populateIssueList()
+		if showPrefix {
+			notifier.Success("Prefix: shown")
+		} else {
+			notifier.Success("Prefix: hidden")
+		}
+	}
+
+	// chooseColumns shows or hides issue list columns and saves the choice
+	chooseColumns := func() {
+		dialogHelpers.ShowColumnsDialog(listColumns, func(columns []ui.Column) {
+			listColumns = columns
+			cfg.ListColumns = listColumnsToConfig(columns)
+			if err := config.Save(projectCfg.Unapply(cfg, &globalCfg)); err != nil {
+				log.Printf("Warning: failed to save list columns: %v", err)
+			}
+			populateIssueList()
+			notifier.Success("List columns updated")
+		})
+	}
+
+	// sendKey replays a key through the main handler, passing it on to the
+	// pages (as tview does with a typed key) if the handler doesn't take it,
+	// so the input captures of the focused dialog see it too
+	sendKey := func(event *tcell.EventKey) {
+		if event := handleKey(event); event != nil {
+			pages.InputHandler()(event, func(p tview.Primitive) { app.SetFocus(p) })
+		}
 	}
 
-	// Helper function to show stats dashboard
+	// sendKeys replays keys through the main handler
+	sendKeys := func(keys string) {
+		for _, r := range keys {
+			sendKey(tcell.NewEventKey(tcell.KeyRune, r, tcell.ModNone))
+		}
+	}
+
+	// playMacroKey plays the next key of the macro being replayed, then
+	// queues itself for the one after, so each key's dialogs open and its
+	// redraws happen before the next. It waits for a running bd command
+	// (the runner takes one at a time) and the refresh after it (which
+	// would move the selection back to the changed issue), and stops when
+	// a command fails.
+	var playMacroKey func()
+	var macroWaited bool
+	playMacroKey = func() {
+		register := macros.Playing()
+		if register == 0 {
+			return
+		}
+		if runner.Busy() || !refresher.Idle() {
+			macroWaited = true
+			time.AfterFunc(macroPollInterval, func() { safeQueueUpdateDraw(playMacroKey) })
+			return
+		}
+		if macroWaited {
+			// The refresh's list update is queued ahead of this; let it run
+			macroWaited = false
+			go app.QueueUpdateDraw(playMacroKey)
+			return
+		}
+		if front, _ := pages.GetFrontPage(); front == "error_overlay" {
+			macros.StopPlaying()
+			notifier.Warn(fmt.Sprintf("Stopped replaying @%c: a command failed", register))
+			return
+		}
+		event, ok, overrun := macros.Next()
+		if !ok {
+			if overrun {
+				notifier.Warn(fmt.Sprintf("Stopped replaying @%c after %d keys (does it replay itself?)", register, macroKeyLimit))
+			} else {
+				notifier.Success(fmt.Sprintf("Replayed @%c", register))
+			}
+			return
+		}
+		sendKey(event)
+		go app.QueueUpdateDraw(playMacroKey)
+	}
+
+	// runLeaderBinding replays the binding's keys through the main handler,
+	// or runs its named action
+	runLeaderBinding := func(binding *leaderBinding) {
+		sendKeys(binding.Sends)
+		switch binding.Action {
+		case leaderPageDown:
+			pageDown()
+		case leaderFilterLabel:
+			showQuickFilter("#")
+		case leaderFilterAssignee:
+			showQuickFilter("@")
+		case leaderFilterMine:
+			user := currentUser()
+			if user == "" {
+				notifier.Error("Set $BD_ACTOR or $USER to filter to your issues")
+				return
+			}
+			appState.ApplyFilterQuery("@" + user)
+			notifier.Redraw()
+			populateIssueList()
+		case leaderFilterClear:
+			appState.ClearAllFilters()
+			notifier.Redraw()
+			populateIssueList()
+		case leaderGotoIssue:
+			showGotoIssue()
+		case leaderTogglePrefix:
+			togglePrefix()
+		case leaderDuplicate:
+			dialogHelpers.ShowDuplicateDialog()
+		case leaderCopyBranch:
+			dialogHelpers.CopyBranchName()
+		case leaderExternalRef:
+			dialogHelpers.ShowExternalRefDialog()
+		case leaderColumns:
+			chooseColumns()
+		case leaderDryRun:
+			dryRunEnabled = !dryRunEnabled
+			runner.SetDryRun(dryRunEnabled)
+			if dryRunEnabled {
+				notifier.Warn("Dry run on: bd commands are shown for approval before they run")
+			} else {
+				notifier.Info("Dry run off: bd commands run right away")
+			}
+		}
+	}
+
+	// handleLeaderKey continues a leader sequence; Esc or an unbound key ends it
+	handleLeaderKey := func(event *tcell.EventKey) *tcell.EventKey {
+		if event.Key() != tcell.KeyRune {
+			endLeader()
+			return nil
+		}
+		leaderPrefix += string(event.Rune())
+		binding, choices := leaderLookup(leaderBindings, leaderPrefix)
+		switch {
+		case binding != nil:
+			endLeader()
+			runLeaderBinding(binding)
+		case len(choices) == 0:
+			sequence := leaderSequenceName(leaderPrefix)
+			endLeader()
+			notifier.Error(fmt.Sprintf("%s is not bound", tview.Escape(sequence)))
+		default:
+			showLeaderPopup(choices)
+		}
+		return nil
+	}
+
+	// Helper function to show stats dashboard; grid cells filter the list
 	showStatsOverlay := func() {
-		dialogHelpers.ShowStatsOverlay()
+		dialogHelpers.ShowStatsOverlay(func(query string) {
+			appState.ApplyFilterQuery(query)
+			notifier.Redraw()
+			populateIssueList()
+		})
 	}
 
 	// Helper function to show help screen
@@ -747,6 +2143,22 @@ func main() {
 		dialogHelpers.ShowHelpScreen()
 	}
 
+	// Helper function to show the workspace summary; Enter jumps into the list
+	showHomeScreen := func() {
+		dialogHelpers.ShowHomeScreen(func(target homeTarget) {
+			appState.ApplyFilterQuery(target.Query)
+			if target.ShowClosed && !showClosedIssues {
+				showClosedIssues = true
+				savePreferences()
+			}
+			notifier.Redraw()
+			populateIssueList()
+			if target.IssueID != "" && !selectIssue(target.IssueID) {
+				notifier.Error(fmt.Sprintf("%s is hidden (collapsed in tree view)", target.IssueID))
+			}
+		})
+	}
+
 	// Helper function to manage dependencies
 	showDependencyDialog := func() {
 		dialogHelpers.ShowDependencyDialog()
@@ -772,30 +2184,235 @@ func main() {
 		dialogHelpers.ShowEditForm()
 	}
 
+	// showIssueMenu opens the context menu for the selected issue with its
+	// corner at x, y. The list takes focus so the actions work on the issue.
+	showIssueMenu := func(x, y int) {
+		issue, ok := indexToIssue[issueList.GetCurrentItem()]
+		if !ok {
+			return
+		}
+		if detailPanelFocused {
+			detailPanelFocused = false
+			updatePanelFocus()
+		}
+		ui.NewContextMenu(app, pages, "issue_menu", x, y, issueMenuItems(issue, sendKeys)).
+			SetReturnFocus(issueList).
+			Show()
+	}
+
+	// Right-clicking a row opens its context menu; double-clicking edits it
+	issueList.SetRightClickedFunc(func(index, x, y int) {
+		showIssueMenu(x+1, y+1)
+	})
+	issueList.SetDoubleClickedFunc(func(index int) {
+		if _, ok := indexToIssue[index]; ok {
+			if detailPanelFocused {
+				detailPanelFocused = false
+				updatePanelFocus()
+			}
+			showEditForm()
+		}
+	})
+
 	// Helper function to show issue creation dialog
 	showCreateIssueDialog := func() {
 		dialogHelpers.ShowCreateIssueDialog()
 	}
 
+	// Helper function to split the current issue into child issues
+	showSplitIssueDialog := func() {
+		dialogHelpers.ShowSplitIssueDialog()
+	}
+
+	// Helper function to assign the current issue
+	showAssigneeDialog := func() {
+		dialogHelpers.ShowAssigneeDialog()
+	}
+
+	// Helper function to merge a duplicate issue into the current one
+	showMergeDialog := func() {
+		dialogHelpers.ShowMergeDialog()
+	}
+
+	// markOrMoveIssue marks the issue to be moved (gm), or, with one already
+	// marked, makes that one a child of this issue: its old parent-child
+	// dependencies are swapped for one on the new parent
+	markOrMoveIssue := func(target *parser.Issue) {
+		if movingIssueID == "" || movingIssueID == target.ID {
+			if movingIssueID == target.ID {
+				movingIssueID = ""
+				notifier.Info(fmt.Sprintf("Stopped moving %s", target.ID))
+				return
+			}
+			movingIssueID = target.ID
+			notifier.Redraw()
+			return
+		}
+
+		issue := appState.GetIssueByID(movingIssueID)
+		if issue == nil {
+			movingIssueID = ""
+			notifier.Error("The issue being moved no longer exists")
+			return
+		}
+		if appState.IsTreeDescendant(target.ID, issue.ID) {
+			notifier.Error(fmt.Sprintf("Can't move %s under %s, which is inside it", issue.ID, target.ID))
+			return
+		}
+		steps, undoSteps := planMove(issue, target.ID)
+		if len(steps) == 0 {
+			notifier.Warn(fmt.Sprintf("%s is already a child of %s", issue.ID, target.ID))
+			return
+		}
+
+		issueID, parentID := issue.ID, target.ID
+		idParent := idPrefixParent(issueID, func(id string) bool {
+			candidate := appState.GetIssueByID(id)
+			return candidate != nil && candidate.Status != parser.StatusClosed
+		})
+		completed := 0
+		started := runner.Run(fmt.Sprintf("Moving %s under %s", issueID, parentID), func(ctx context.Context) error {
+			for i, step := range steps {
+				log.Printf("BD COMMAND: Move step %d/%d (%s): bd %s", i+1, len(steps), step.Description, strings.Join(step.Args, " "))
+				if _, err := execBdJSON(ctx, step.Args...); err != nil {
+					return err
+				}
+				completed++
+			}
+			return nil
+		}, func(err error) {
+			if err != nil {
+				log.Printf("BD COMMAND ERROR: Move step failed: %v", err)
+				dialogHelpers.ShowErrorOverlay(fmt.Sprintf("Move of %s stopped at step %d/%d (%s)", issueID, completed+1, len(steps), steps[completed].Description), err)
+				if completed > 0 {
+					scheduleRefresh(issueID)
+				}
+				return
+			}
+			dialogHelpers.Undo.Push(undoEntry{Description: fmt.Sprintf("move %s under %s", issueID, parentID), IssueID: issueID, Steps: undoSteps})
+			if idParent != "" && idParent != parentID {
+				notifier.Warn(fmt.Sprintf("Moved %s under %s; it also stays under %s, which its ID nests it in", issueID, parentID, idParent))
+			} else {
+				notifier.Success(fmt.Sprintf("Moved %s under [%s]%s[-]", issueID, formatting.GetAccentColor(), parentID))
+			}
+			// Unfold the new parent so the moved issue shows up under it
+			appState.SetCollapsed(parentID, false)
+			saveCollapseState()
+			scheduleRefresh(issueID)
+		})
+		if started {
+			movingIssueID = ""
+		}
+	}
+
 	// Set up key bindings
-	app.SetInputCapture(func(event *tcell.EventKey) *tcell.EventKey {
+	handleKey = func(event *tcell.EventKey) *tcell.EventKey {
 		// Log all keyboard events in debug mode
 		log.Printf("KEY EVENT: key=%v rune=%q mod=%v searchMode=%v detailFocus=%v",
 			event.Key(), event.Rune(), event.Modifiers(), searchMode, detailPanelFocused)
 
+		// Esc (on the main screen) or Ctrl-C (anywhere) cancels a running bd
+		// command; Ctrl-C quits as usual when nothing is running
+		if runner.Busy() {
+			if front, _ := pages.GetFrontPage(); event.Key() == tcell.KeyCtrlC ||
+				(event.Key() == tcell.KeyEscape && front == "main" && !leaderActive) {
+				runner.Cancel()
+				return nil
+			}
+		}
+
+		// A leader sequence in progress takes every key (its popup is a page)
+		if leaderActive {
+			return handleLeaderKey(event)
+		}
+
 		// If a modal is showing (not on main page), let the modal handle all input
 		currentPage, _ := pages.GetFrontPage()
 		if currentPage != "main" {
 			return event
 		}
 
+		// The comment input under the details takes every key
+		if app.GetFocus() == commentInput {
+			return event
+		}
+
+		// Follow mode: scroll the details, quit, or act on the followed issue
+		if followIssueID != "" {
+			scroll := func(key tcell.Key, times int) *tcell.EventKey {
+				for i := 0; i < times; i++ {
+					detailPanel.InputHandler()(tcell.NewEventKey(key, 0, tcell.ModNone), nil)
+				}
+				return nil
+			}
+			_, _, _, height := detailPanel.GetInnerRect()
+			switch event.Key() {
+			case tcell.KeyEscape:
+				saveCollapseState()
+				app.Stop()
+				return nil
+			case tcell.KeyDown, tcell.KeyCtrlE:
+				return scroll(tcell.KeyDown, 1)
+			case tcell.KeyUp, tcell.KeyCtrlY:
+				return scroll(tcell.KeyUp, 1)
+			case tcell.KeyCtrlD:
+				return scroll(tcell.KeyDown, height/2)
+			case tcell.KeyCtrlU:
+				return scroll(tcell.KeyUp, height/2)
+			case tcell.KeyPgDn, tcell.KeyCtrlF:
+				return scroll(tcell.KeyPgDn, 1)
+			case tcell.KeyPgUp, tcell.KeyCtrlB:
+				return scroll(tcell.KeyPgUp, 1)
+			case tcell.KeyHome:
+				detailPanel.ScrollToBeginning()
+				return nil
+			case tcell.KeyEnd:
+				detailPanel.ScrollToEnd()
+				return nil
+			case tcell.KeyRune:
+				switch r := event.Rune(); {
+				case r == 'q':
+					saveCollapseState()
+					app.Stop()
+					return nil
+				case r == 'j':
+					return scroll(tcell.KeyDown, 1)
+				case r == 'k':
+					return scroll(tcell.KeyUp, 1)
+				case r == 'g':
+					detailPanel.ScrollToBeginning()
+					return nil
+				case r == 'G':
+					detailPanel.ScrollToEnd()
+					return nil
+				case r == ']':
+					pickComment(1)
+					return nil
+				case r == '[':
+					pickComment(-1)
+					return nil
+				case r == 'c' && currentDetailIssue != nil:
+					showInlineComment()
+					return nil
+				case r == 'r' || r == '?':
+					// Handled below
+				case strings.ContainsRune(followActionKeys, r) && currentDetailIssue != nil:
+					// Handled below, on the selected (followed) issue
+				default:
+					return nil
+				}
+			default:
+				return nil
+			}
+		}
+
 		// Handle search mode
 		if searchMode {
 			switch event.Key() {
 			case tcell.KeyEscape:
 				searchMode = false
 				searchQuery = ""
-				statusBar.SetText(getStatusBarText())
+				notifier.Redraw()
 				return nil
 			case tcell.KeyEnter:
 				performSearch(searchQuery)
@@ -815,6 +2432,20 @@ func main() {
 			return nil
 		}
 
+		// Ctrl-O opens the issue finder from either panel
+		if event.Key() == tcell.KeyCtrlO {
+			lastKeyWasG = false
+			showGotoIssue()
+			return nil
+		}
+
+		// Ctrl-L lists the bd commands run this session, from either panel
+		if event.Key() == tcell.KeyCtrlL {
+			lastKeyWasG = false
+			dialogHelpers.ShowCommandLog()
+			return nil
+		}
+
 		// Handle detail panel scrolling when focused
 		if detailPanelFocused {
 			switch event.Key() {
@@ -823,6 +2454,13 @@ func main() {
 				detailPanelFocused = false
 				updatePanelFocus()
 				return nil
+			case tcell.KeyEnter:
+				// Follow the picked issue link
+				if issueID, ok := pickedLink(); ok {
+					detailPanel.Highlight()
+					jumpToIssue(issueID)
+				}
+				return nil
 			case tcell.KeyEscape:
 				// Return focus to issue list (keep detail pane visible)
 				detailPanelFocused = false
@@ -874,6 +2512,32 @@ func main() {
 				// Jump to end
 				detailPanel.ScrollToEnd()
 				return nil
+			case tcell.KeyRune:
+				// Pick a comment, then edit or delete it; or pick an issue
+				// link, then follow it with Enter
+				switch event.Rune() {
+				case ']':
+					pickComment(1)
+					return nil
+				case '[':
+					pickComment(-1)
+					return nil
+				case '}':
+					pickLink(1)
+					return nil
+				case '{':
+					pickLink(-1)
+					return nil
+				case 'c':
+					showInlineComment()
+					return nil
+				case 'e':
+					changePickedComment(false)
+					return nil
+				case 'd':
+					changePickedComment(true)
+					return nil
+				}
 			}
 			// Allow other keys to pass through
 			return event
@@ -882,11 +2546,18 @@ func main() {
 		// Normal mode key bindings (issue list focused)
 		switch event.Key() {
 		case tcell.KeyEscape:
+			// Cancel a move started with gm
+			if movingIssueID != "" {
+				notifier.Info(fmt.Sprintf("Stopped moving %s", movingIssueID))
+				movingIssueID = ""
+				return nil
+			}
+
 			// Clear search matches on ESC if any exist
 			if len(searchMatches) > 0 {
 				searchMatches = nil
 				currentSearchIndex = -1
-				statusBar.SetText(getStatusBarText())
+				notifier.Redraw()
 				return nil
 			}
 
@@ -895,6 +2566,8 @@ func main() {
 			if !lastEscapeTime.IsZero() && now.Sub(lastEscapeTime) < time.Second {
 				// Second ESC within 1 second - quit
 				saveCollapseState() // Persist before exit
+				savePreferences()
+				saveSession()
 				app.Stop()
 				return nil
 			}
@@ -909,7 +2582,7 @@ func main() {
 				if time.Since(lastEscapeTime) >= time.Second {
 					lastEscapeTime = time.Time{}
 					app.QueueUpdateDraw(func() {
-						statusBar.SetText(getStatusBarText())
+						notifier.Redraw()
 					})
 				}
 			}()
@@ -925,13 +2598,14 @@ func main() {
 				if !detailPaneVisible {
 					// Show detail pane
 					detailPaneVisible = true
+					savePreferences()
 					newFlex := buildLayout()
 					pages.RemovePage("main")
 					pages.AddPage("main", newFlex, true, true)
 				}
 				detailPanelFocused = true
 				updatePanelFocus()
-				statusBar.SetText(getStatusBarText())
+				notifier.Redraw()
 				return nil
 			}
 			return event
@@ -957,22 +2631,70 @@ func main() {
 			issueList.SetCurrentItem(newItem)
 			return nil
 		case tcell.KeyRune:
-			// Handle space bar for page down with wrapping
-			if event.Rune() == ' ' {
-				_, _, _, height := issueList.GetInnerRect()
-				currentItem := issueList.GetCurrentItem()
-				maxItem := issueList.GetItemCount() - 1
-				newItem := currentItem + height
-				if newItem > maxItem {
-					// Wrap to top
-					newItem = 0
-				}
-				issueList.SetCurrentItem(newItem)
+			// Space starts a leader sequence (Space Space pages down)
+			if event.Rune() == leaderKey {
+				lastKeyWasG = false
+				lastKeyWasS = false
+				lastKeyWasD = false
+				pendingBracket = 0
+				pendingMacro = 0
+				leaderActive = true
+				_, choices := leaderLookup(leaderBindings, "")
+				showLeaderPopup(choices)
+				return nil
+			}
+			// Alt-1..Alt-9 jump to a list section (before 0-4 set priority)
+			if event.Modifiers()&tcell.ModAlt != 0 && event.Rune() >= '1' && event.Rune() <= '9' {
+				jumpToSection(int(event.Rune() - '1'))
 				return nil
 			}
+
 			// Handle multi-key sequences FIRST before processing individual keys
 			// This prevents conflicts with single-key handlers
 
+			// Handle Q<register> (record a macro) and @<register> (replay one)
+			if pendingMacro != 0 {
+				first := pendingMacro
+				pendingMacro = 0
+				notifier.Redraw()
+				register := event.Rune()
+				switch {
+				case first == 'Q' && isMacroRegister(register):
+					macros.StartRecording(register)
+					notifier.Redraw()
+				case first == '@' && (isMacroRegister(register) || register == '@'):
+					if macros.Playing() != 0 {
+						// Replayed from a macro: the keys go next in line
+						macros.Play(register)
+						return nil
+					}
+					if played, ok := macros.Play(register); !ok {
+						if played == 0 {
+							notifier.Warn("No macro replayed yet (@@ repeats the last one)")
+						} else {
+							notifier.Warn(fmt.Sprintf("Register %c is empty (Q%c records into it)", played, played))
+						}
+						return nil
+					}
+					go app.QueueUpdateDraw(playMacroKey)
+				}
+				return nil
+			}
+
+			// Handle ]] and [[ (next / previous section); a mismatched pair does nothing
+			if pendingBracket != 0 {
+				first := pendingBracket
+				pendingBracket = 0
+				notifier.Redraw()
+				switch {
+				case event.Rune() == first && first == ']':
+					jumpSection(1)
+				case event.Rune() == first:
+					jumpSection(-1)
+				}
+				return nil
+			}
+
 			// Handle status shortcuts (S + second char)
 			if lastKeyWasS {
 				var newStatus string
@@ -982,42 +2704,180 @@ func main() {
 				case 'i':
 					newStatus = "in_progress"
 				case 'b':
-					newStatus = "blocked"
+					// Blocking asks why (the reason is kept as a comment)
+					lastKeyWasS = false
+					dialogHelpers.ShowBlockIssueDialog()
+					return nil
 				case 'c':
 					newStatus = "closed"
 				default:
 					// Invalid second key, reset and fall through
 					lastKeyWasS = false
-					statusBar.SetText(getStatusBarText())
+					notifier.Redraw()
 					return nil
 				}
 
 				// Execute status update
 				if issue, ok := indexToIssue[issueList.GetCurrentItem()]; ok {
 					issueID := issue.ID
-					log.Printf("BD COMMAND: Executing status update (S%c): bd update %s --status %s", event.Rune(), issueID, newStatus)
-					updatedIssue, err := execBdJSONIssue("update", issueID, "--status", string(newStatus))
-					if err != nil {
-						statusBar.SetText(errorMsg(fmt.Sprintf("Error updating status: %v", err)))
+					undo := undoStatus(issue, parser.Status(newStatus))
+					key := event.Rune()
+					setStatus := func() {
+						log.Printf("BD COMMAND: Executing status update (S%c): bd update %s --status %s", key, issueID, newStatus)
+						var updatedIssue *parser.Issue
+						runner.Run(fmt.Sprintf("Setting %s to %s", issueID, newStatus), func(ctx context.Context) error {
+							var err error
+							updatedIssue, err = execBdJSONIssue(ctx, "update", issueID, "--status", string(newStatus))
+							return err
+						}, func(err error) {
+							if err != nil {
+								dialogHelpers.ShowErrorOverlay("Error updating status", err)
+								return
+							}
+							dialogHelpers.Undo.Push(undo)
+							notifier.Success(fmt.Sprintf("Set %s to %s", updatedIssue.ID, updatedIssue.Status))
+							scheduleRefresh(issueID)
+						})
+					}
+					// Closing skips the close dialog's reason, so it asks first
+					if newStatus == "closed" && issue.Status != parser.StatusClosed {
+						dialogHelpers.ConfirmAction(confirmClose, "Close Issue",
+							fmt.Sprintf("Close %s without a reason?\n\n%s", issueID, tview.Escape(issue.Title)), "Close", setStatus)
 					} else {
-						statusBar.SetText(successMsg(fmt.Sprintf("✓ Set %s to %s", updatedIssue.ID, updatedIssue.Status)))
-						scheduleRefresh(issueID)
+						setStatus()
 					}
 				}
 				lastKeyWasS = false
 				return nil
 			}
 
+			// Handle dD (discard issue); d on its own does nothing
+			if lastKeyWasD {
+				lastKeyWasD = false
+				notifier.Redraw()
+				if event.Rune() == 'D' {
+					dialogHelpers.ShowDiscardDialog(recordDiscard)
+				}
+				return nil
+			}
+
+			// Handle "gh" (go home) before 'h' folds a tree node
+			if lastKeyWasG && event.Rune() == 'h' {
+				lastKeyWasG = false
+				showHomeScreen()
+				return nil
+			}
+			if lastKeyWasG && event.Rune() == 'd' {
+				lastKeyWasG = false
+				showDiscussionQueue()
+				return nil
+			}
+			if lastKeyWasG && event.Rune() == 'i' {
+				lastKeyWasG = false
+				dialogHelpers.ShowInspector()
+				return nil
+			}
+			if lastKeyWasG && event.Rune() == 'n' {
+				lastKeyWasG = false
+				dialogHelpers.ShowNotifications()
+				return nil
+			}
+			if lastKeyWasG && event.Rune() == 'c' {
+				lastKeyWasG = false
+				dialogHelpers.ShowRefreshChanges(lastChanges, jumpToIssue)
+				return nil
+			}
+			if lastKeyWasG && event.Rune() == 'v' {
+				lastKeyWasG = false
+				dialogHelpers.ShowSnapshotDiff()
+				return nil
+			}
+			if lastKeyWasG && event.Rune() == 'l' {
+				lastKeyWasG = false
+				showCycles()
+				return nil
+			}
+			if lastKeyWasG && event.Rune() == 'a' {
+				lastKeyWasG = false
+				dialogHelpers.ShowAgingReport(jumpToIssue)
+				return nil
+			}
+			if lastKeyWasG && event.Rune() == '#' {
+				lastKeyWasG = false
+				dialogHelpers.ShowLabelBrowser(func() {
+					notifier.Redraw()
+					populateIssueList()
+				})
+				return nil
+			}
+			if lastKeyWasG && event.Rune() == 'u' {
+				lastKeyWasG = false
+				dialogHelpers.ShowStandup(jumpToIssue)
+				return nil
+			}
+			if lastKeyWasG && (event.Rune() == ';' || event.Rune() == ',') {
+				lastKeyWasG = false
+				moveInHistory(event.Rune() == ';')
+				return nil
+			}
+			if lastKeyWasG && event.Rune() == 'C' {
+				lastKeyWasG = false
+				dialogHelpers.ShowArchive(jumpToIssue)
+				return nil
+			}
+			if lastKeyWasG && event.Rune() == 'r' {
+				lastKeyWasG = false
+				dialogHelpers.ShowRecentIssues(history.Recent(historyLimit), jumpToIssue)
+				return nil
+			}
+			if lastKeyWasG && event.Rune() == 'm' {
+				lastKeyWasG = false
+				if appState.GetViewMode() != state.ViewTree {
+					notifier.Info("Moving issues works in tree view (t)")
+					return nil
+				}
+				if issue, ok := indexToIssue[issueList.GetCurrentItem()]; ok {
+					markOrMoveIssue(issue)
+				}
+				return nil
+			}
+			if lastKeyWasG && event.Rune() == 'x' {
+				lastKeyWasG = false
+				if issue, ok := indexToIssue[issueList.GetCurrentItem()]; ok {
+					openExternalRef(issue)
+				}
+				return nil
+			}
+			// g1-g9: jump to the Nth child in tree view, unfolding the node first
+			if lastKeyWasG && event.Rune() >= '1' && event.Rune() <= '9' {
+				lastKeyWasG = false
+				if appState.GetViewMode() == state.ViewTree {
+					if issue, ok := indexToIssue[issueList.GetCurrentItem()]; ok {
+						n := int(event.Rune() - '0')
+						childID := appState.TreeChild(issue.ID, n)
+						if childID == "" {
+							notifier.Warn(fmt.Sprintf("%s has no child #%d", issue.ID, n))
+							return nil
+						}
+						setTreeCollapsed(issue.ID, false)
+						selectIssue(childID)
+					}
+				}
+				return nil
+			}
+
 			// Normal single-key handling
 			switch event.Rune() {
 			case 'q':
 				saveCollapseState() // Persist before exit
+				savePreferences()
+				saveSession()
 				app.Stop()
 				return nil
 			case 'r':
-				// Manual refresh - run in goroutine to avoid blocking UI
-				statusBar.SetText(fmt.Sprintf("[%s]Refreshing...[-]", formatting.GetEmphasisColor()))
-				go refreshIssues()
+				// Manual refresh, joined with any already on its way
+				notifier.Info("Refreshing...")
+				requestRefresh()
 				return nil
 			case 'j':
 				// Down - simulate down arrow
@@ -1056,8 +2916,9 @@ func main() {
 			case 't':
 				// Toggle view mode
 				appState.ToggleViewMode()
+				savePreferences()
 				issueList.SetTitle(getIssueListTitle())
-				statusBar.SetText(getStatusBarText())
+				notifier.Redraw()
 				populateIssueList()
 				return nil
 			case 'o':
@@ -1065,23 +2926,46 @@ func main() {
 				if appState.GetViewMode() == state.ViewTree {
 					if issue, ok := indexToIssue[issueList.GetCurrentItem()]; ok {
 						if appState.HasChildren(issue.ID) {
-							isCollapsed := appState.ToggleCollapse(issue.ID)
-							saveCollapseState() // Persist to disk
-							populateIssueList()
-							// Restore selection after repopulating
-							for idx, iss := range indexToIssue {
-								if iss.ID == issue.ID {
-									issueList.SetCurrentItem(idx)
-									break
-								}
-							}
-							if isCollapsed {
-								showTemporaryStatus(successMsg(fmt.Sprintf("✓ Collapsed %s", issue.ID)), statusMessageDuration)
-							} else {
-								showTemporaryStatus(successMsg(fmt.Sprintf("✓ Expanded %s", issue.ID)), statusMessageDuration)
-							}
+							setTreeCollapsed(issue.ID, !appState.IsCollapsed(issue.ID))
+						} else {
+							notifier.Error("No children to collapse")
+						}
+					}
+					return nil
+				}
+				// In list view, cycle the sort order, keeping the selection
+				var selectedID string
+				if issue, ok := indexToIssue[issueList.GetCurrentItem()]; ok {
+					selectedID = issue.ID
+				}
+				appState.CycleSortMode()
+				savePreferences()
+				populateIssueList()
+				if selectedID != "" {
+					selectIssue(selectedID)
+				}
+				notifier.Redraw()
+				return nil
+			case 'h':
+				// Collapse selected node, or jump to its parent if it's a leaf or already collapsed
+				if appState.GetViewMode() == state.ViewTree {
+					if issue, ok := indexToIssue[issueList.GetCurrentItem()]; ok {
+						if appState.HasChildren(issue.ID) && !appState.IsCollapsed(issue.ID) {
+							setTreeCollapsed(issue.ID, true)
+						} else if parentID := appState.TreeParent(issue.ID); parentID != "" {
+							selectIssue(parentID)
+						}
+					}
+				}
+				return nil
+			case 'l':
+				// Expand selected node, or step to its first child if already expanded
+				if appState.GetViewMode() == state.ViewTree {
+					if issue, ok := indexToIssue[issueList.GetCurrentItem()]; ok && appState.HasChildren(issue.ID) {
+						if appState.IsCollapsed(issue.ID) {
+							setTreeCollapsed(issue.ID, false)
 						} else {
-							showTemporaryStatus(errorMsg("No children to collapse"), statusMessageDuration)
+							issueList.SetCurrentItem(issueList.GetCurrentItem() + 1)
 						}
 					}
 				}
@@ -1093,9 +2977,9 @@ func main() {
 					saveCollapseState()
 					populateIssueList()
 					if count > 0 {
-						showTemporaryStatus(successMsg(fmt.Sprintf("✓ Expanded %d nodes", count)), statusMessageDuration)
+						notifier.Success(fmt.Sprintf("Expanded %d nodes", count))
 					} else {
-						showTemporaryStatus(successMsg("✓ All nodes already expanded"), statusMessageDuration)
+						notifier.Success("All nodes already expanded")
 					}
 				}
 				return nil
@@ -1106,41 +2990,74 @@ func main() {
 					saveCollapseState()
 					populateIssueList()
 					if count > 0 {
-						showTemporaryStatus(successMsg(fmt.Sprintf("✓ Collapsed %d nodes", count)), statusMessageDuration)
+						notifier.Success(fmt.Sprintf("Collapsed %d nodes", count))
 					} else {
-						showTemporaryStatus(successMsg("✓ All nodes already collapsed"), statusMessageDuration)
+						notifier.Success("All nodes already collapsed")
 					}
 				}
 				return nil
 			case 'v':
 				// Toggle layout orientation (horizontal/vertical)
 				verticalLayout = !verticalLayout
+				savePreferences()
 				newFlex := buildLayout()
 				pages.RemovePage("main")
 				pages.AddPage("main", newFlex, true, true)
 				app.SetRoot(pages, true)
-				statusBar.SetText(getStatusBarText())
+				notifier.Redraw()
 				return nil
 			case 'C':
 				// Toggle showing closed issues
 				showClosedIssues = !showClosedIssues
-				statusBar.SetText(getStatusBarText())
+				savePreferences()
+				notifier.Redraw()
+				populateIssueList()
+				return nil
+			case 'P':
+				// Section the list by status, assignee, or label, keeping the selection
+				listGrouping = listGrouping.Next()
+				savePreferences()
+				notifier.Redraw()
+				selected, ok := indexToIssue[issueList.GetCurrentItem()]
 				populateIssueList()
+				if ok {
+					selectIssue(selected.ID)
+				}
+				if appState.GetViewMode() == state.ViewTree {
+					notifier.Info("Grouping applies to list view (t)")
+				}
 				return nil
 			case 'm':
 				// Toggle mouse mode
 				mouseEnabled = !mouseEnabled
 				app.EnableMouse(mouseEnabled)
-				statusBar.SetText(getStatusBarText())
+				savePreferences()
+				notifier.Redraw()
 				return nil
 			case 'p':
-				// Toggle issue ID prefix display
-				showPrefix = !showPrefix
-				populateIssueList()
-				if showPrefix {
-					showTemporaryStatus(successMsg("Prefix: shown"), statusMessageDuration)
-				} else {
-					showTemporaryStatus(successMsg("Prefix: hidden"), statusMessageDuration)
+				// Jump to the parent node in tree view; toggle the ID prefix in list view
+				if appState.GetViewMode() == state.ViewTree {
+					if issue, ok := indexToIssue[issueList.GetCurrentItem()]; ok {
+						if parentID := appState.TreeParent(issue.ID); parentID != "" {
+							selectIssue(parentID)
+						}
+					}
+					return nil
+				}
+				togglePrefix()
+				return nil
+			case '}', '{':
+				// Next/previous sibling in tree view
+				if appState.GetViewMode() == state.ViewTree {
+					offset := 1
+					if event.Rune() == '{' {
+						offset = -1
+					}
+					if issue, ok := indexToIssue[issueList.GetCurrentItem()]; ok {
+						if siblingID := appState.TreeSibling(issue.ID, offset); siblingID != "" {
+							selectIssue(siblingID)
+						}
+					}
 				}
 				return nil
 			case 'a':
@@ -1151,6 +3068,18 @@ func main() {
 				// Edit issue fields
 				showEditForm()
 				return nil
+			case '.':
+				// Open the context menu under the selected row
+				x, y, visible := issueList.ItemPosition(issueList.GetCurrentItem())
+				if !visible {
+					x, y, _, _ = issueList.GetInnerRect()
+				}
+				showIssueMenu(x+4, y+1)
+				return nil
+			case 'E':
+				// Split issue into child issues
+				showSplitIssueDialog()
+				return nil
 			case 'D':
 				// Open dependency management dialog
 				showDependencyDialog()
@@ -1159,16 +3088,24 @@ func main() {
 				// Open label management dialog
 				showLabelDialog()
 				return nil
+			case 'A':
+				// Assign issue
+				showAssigneeDialog()
+				return nil
+			case 'M':
+				// Merge a duplicate into the selected issue
+				showMergeDialog()
+				return nil
 			case 'y':
 				// Yank (copy) issue ID to clipboard
 				if issue, ok := indexToIssue[issueList.GetCurrentItem()]; ok {
 					err := clipboard.WriteAll(issue.ID)
 					if err != nil {
 						log.Printf("CLIPBOARD ERROR: Failed to copy to clipboard: %v", err)
-						statusBar.SetText(fmt.Sprintf("[%s]Failed to copy: %v[-]", formatting.GetErrorColor(), err))
+						notifier.Error(fmt.Sprintf("Failed to copy: %v", err))
 					} else {
 						log.Printf("CLIPBOARD: Copied issue ID to clipboard: %s", issue.ID)
-						showTemporaryStatus(successMsg(fmt.Sprintf("✓ Copied %s to clipboard", issue.ID)), statusMessageDuration)
+						notifier.Success(fmt.Sprintf("Copied %s to clipboard", issue.ID))
 					}
 				}
 				return nil
@@ -1179,31 +3116,58 @@ func main() {
 					err := clipboard.WriteAll(text)
 					if err != nil {
 						log.Printf("CLIPBOARD ERROR: Failed to copy to clipboard: %v", err)
-						statusBar.SetText(fmt.Sprintf("[%s]Failed to copy: %v[-]", formatting.GetErrorColor(), err))
+						notifier.Error(fmt.Sprintf("Failed to copy: %v", err))
 					} else {
 						log.Printf("CLIPBOARD: Copied issue ID with title to clipboard: %s", text)
-						showTemporaryStatus(successMsg(fmt.Sprintf("✓ Copied '%s' to clipboard", text)), statusMessageDuration)
+						notifier.Success(fmt.Sprintf("Copied '%s' to clipboard", text))
 					}
 				}
 				return nil
-			case 'B':
-				// Copy git branch name to clipboard
+			case 'K':
+				// Yank (copy) the whole issue as Markdown, comments included
 				if issue, ok := indexToIssue[issueList.GetCurrentItem()]; ok {
-					branchName := issue.ID // Simple format: just the issue ID
-					err := clipboard.WriteAll(branchName)
-					if err != nil {
-						log.Printf("CLIPBOARD ERROR: Failed to copy branch name: %v", err)
-						statusBar.SetText(fmt.Sprintf("[%s]Failed to copy: %v[-]", formatting.GetErrorColor(), err))
+					text := formatting.FormatIssueMarkdown(withComments(commentCache, issue), appState.GetIssueByID)
+					if err := clipboard.WriteAll(text); err != nil {
+						log.Printf("CLIPBOARD ERROR: Failed to copy issue as Markdown: %v", err)
+						notifier.Error(fmt.Sprintf("Failed to copy: %v", err))
 					} else {
-						log.Printf("CLIPBOARD: Copied branch name to clipboard: %s", branchName)
-						showTemporaryStatus(successMsg(fmt.Sprintf("✓ Copied branch name '%s' to clipboard", branchName)), statusMessageDuration)
+						log.Printf("CLIPBOARD: Copied %s as Markdown (%d bytes)", issue.ID, len(text))
+						notifier.Success(fmt.Sprintf("Copied %s as Markdown to clipboard", issue.ID))
 					}
 				}
 				return nil
+			case 'B':
+				// Check out (or copy) the git branch for the selected issue
+				dialogHelpers.ShowBranchDialog()
+				return nil
+			case 'u':
+				// Undo the most recent mutation
+				dialogHelpers.UndoLastAction()
+				return nil
+			case 'F':
+				// Flag/unflag the selected issue for discussion
+				dialogHelpers.ToggleDiscussion()
+				return nil
+			case 'H':
+				// Show the selected issue's history
+				dialogHelpers.ShowTimeline()
+				return nil
 			case 'R':
 				// Rename issue (edit title)
 				showRenameDialog()
 				return nil
+			case 'i':
+				// Rename in place on the list row
+				dialogHelpers.ShowInlineRename()
+				return nil
+			case 'I':
+				// Add child issues in place below the selected row, unfolding
+				// it in tree view so the new children show up under it
+				if issue, ok := indexToIssue[issueList.GetCurrentItem()]; ok && appState.GetViewMode() == state.ViewTree {
+					setTreeCollapsed(issue.ID, false)
+				}
+				dialogHelpers.ShowQuickAddChild()
+				return nil
 			case 'x':
 				// Close issue with optional reason
 				showCloseIssueDialog()
@@ -1216,31 +3180,74 @@ func main() {
 				// Show help screen
 				showHelpScreen()
 				return nil
+			case 'T':
+				// Cycle to the next theme, redrawing in it
+				name := nextThemeName(theme.List(), theme.Current().Name())
+				if err := theme.SetCurrent(name); err != nil {
+					notifier.Error(tview.Escape(err.Error()))
+					return nil
+				}
+				applyTheme()
+				notifier.Info(fmt.Sprintf("Theme: %s", name))
+				return nil
 			case 'f':
 				// Show quick filter
-				showQuickFilter()
+				showQuickFilter("")
 				return nil
 			case 'S':
 				// Show stats dashboard
 				showStatsOverlay()
 				return nil
+			case 'W':
+				// Write the listed issues to a CSV, JSON, or Markdown file
+				dialogHelpers.ShowExportDialog()
+				return nil
+			case 'w':
+				// Log time spent on the selected issue
+				dialogHelpers.ShowWorklogDialog(logWork)
+				return nil
+			case 'U':
+				// Set or clear the due date
+				dialogHelpers.ShowDueDateDialog()
+				return nil
+			case 'V':
+				// Watch or unwatch the selected issue
+				toggleWatch()
+				return nil
 			case '0', '1', '2', '3', '4':
 				// Quick priority change
 				if issue, ok := indexToIssue[issueList.GetCurrentItem()]; ok {
 					priority := int(event.Rune() - '0')
 					issueID := issue.ID // Capture issue ID before refresh
 					// Update priority via bd command with --json
-					log.Printf("BD COMMAND: Executing priority update: bd update %s --priority %d", issueID, priority)
-					updatedIssue, err := execBdJSONIssue("update", issueID, "--priority", fmt.Sprintf("%d", priority))
-					if err != nil {
-						log.Printf("BD COMMAND ERROR: Priority update failed: %v", err)
-						statusBar.SetText(errorMsg(fmt.Sprintf("Error updating priority: %v", err)))
+					undo := undoPriority(issue, priority)
+					setPriority := func() {
+						log.Printf("BD COMMAND: Executing priority update: bd update %s --priority %d", issueID, priority)
+						var updatedIssue *parser.Issue
+						runner.Run(fmt.Sprintf("Setting %s to P%d", issueID, priority), func(ctx context.Context) error {
+							var err error
+							updatedIssue, err = execBdJSONIssue(ctx, "update", issueID, "--priority", fmt.Sprintf("%d", priority))
+							return err
+						}, func(err error) {
+							if err != nil {
+								log.Printf("BD COMMAND ERROR: Priority update failed: %v", err)
+								dialogHelpers.ShowErrorOverlay("Error updating priority", err)
+								return
+							}
+							log.Printf("BD COMMAND: Priority update successful for %s -> P%d", updatedIssue.ID, updatedIssue.Priority)
+							dialogHelpers.Undo.Push(undo)
+							notifier.Success(fmt.Sprintf("Set %s to P%d", updatedIssue.ID, updatedIssue.Priority))
+							// Refresh issues after a short delay, preserving selection
+							log.Printf("BD COMMAND: Scheduling refresh in 500ms")
+							scheduleRefresh(issueID)
+						})
+					}
+					// A stray 0 escalates the issue to critical, so it asks first
+					if priority == 0 && issue.Priority != 0 {
+						dialogHelpers.ConfirmAction(confirmP0, "Raise to P0",
+							fmt.Sprintf("Raise %s from P%d to P0 (critical)?\n\n%s", issueID, issue.Priority, tview.Escape(issue.Title)), "Raise", setPriority)
 					} else {
-						log.Printf("BD COMMAND: Priority update successful for %s -> P%d", updatedIssue.ID, updatedIssue.Priority)
-						statusBar.SetText(successMsg(fmt.Sprintf("✓ Set %s to P%d", updatedIssue.ID, updatedIssue.Priority)))
-						// Refresh issues after a short delay, preserving selection
-						log.Printf("BD COMMAND: Scheduling refresh in 500ms")
-						scheduleRefresh(issueID)
+						setPriority()
 					}
 				}
 				return nil
@@ -1249,11 +3256,28 @@ func main() {
 				lastKeyWasS = true
 				statusBar.SetText(fmt.Sprintf("[%s]Status shortcut: o/i/b/c[-]", formatting.GetEmphasisColor()))
 				// Reset after 2 seconds if no second key
-				time.AfterFunc(statusMessageDuration, func() {
+				time.AfterFunc(keySequenceTimeout, func() {
 					safeQueueUpdateDraw(func() {
 						if lastKeyWasS {
 							lastKeyWasS = false
-							statusBar.SetText(getStatusBarText())
+							notifier.Redraw()
+						}
+					})
+				})
+				return nil
+			case ']', '[':
+				// Initiate section jump sequence (]] or [[)
+				pendingBracket = event.Rune()
+				hint := "]: next section"
+				if pendingBracket == '[' {
+					hint = "[: previous section"
+				}
+				statusBar.SetText(fmt.Sprintf("[%s]%s[-]", formatting.GetEmphasisColor(), tview.Escape(hint)))
+				time.AfterFunc(keySequenceTimeout, func() {
+					safeQueueUpdateDraw(func() {
+						if pendingBracket != 0 {
+							pendingBracket = 0
+							notifier.Redraw()
 						}
 					})
 				})
@@ -1262,15 +3286,68 @@ func main() {
 				// Add comment to issue
 				showCommentDialog()
 				return nil
+			case 'Q', '@':
+				// Q stops a recording; otherwise wait for the register
+				if event.Rune() == 'Q' && macros.Recording() != 0 {
+					register, keys := macros.StopRecording()
+					notifier.Success(fmt.Sprintf("Recorded %d keys into @%c", keys, register))
+					return nil
+				}
+				pendingMacro = event.Rune()
+				hint := "Q: record a macro into register a-z (Q again stops)"
+				if pendingMacro == '@' {
+					hint = "@: replay the macro in register a-z, @ for the last one"
+					if registers := macros.Registers(); len(registers) > 0 {
+						hint += " (recorded: " + string(registers) + ")"
+					}
+				}
+				statusBar.SetText(fmt.Sprintf("[%s]%s[-]", formatting.GetEmphasisColor(), tview.Escape(hint)))
+				time.AfterFunc(keySequenceTimeout, func() {
+					safeQueueUpdateDraw(func() {
+						if pendingMacro != 0 {
+							pendingMacro = 0
+							notifier.Redraw()
+						}
+					})
+				})
+				return nil
+			case 'd':
+				// Initiate discard sequence (dD)
+				lastKeyWasD = true
+				statusBar.SetText(fmt.Sprintf("[%s]D: discard issue[-]", formatting.GetEmphasisColor()))
+				// Reset after 2 seconds if no second key
+				time.AfterFunc(keySequenceTimeout, func() {
+					safeQueueUpdateDraw(func() {
+						if lastKeyWasD {
+							lastKeyWasD = false
+							notifier.Redraw()
+						}
+					})
+				})
+				return nil
 			default:
 				// Reset all multi-key flags if any other key is pressed
 				lastKeyWasG = false
 				lastKeyWasS = false
+				lastKeyWasD = false
 			}
 		default:
 			lastKeyWasG = false
+			lastKeyWasS = false
+			lastKeyWasD = false
 		}
 		return event
+	}
+	app.SetInputCapture(func(event *tcell.EventKey) *tcell.EventKey {
+		// Esc stops a macro being replayed; keys typed while recording one
+		// are kept, whatever they go to
+		if macros.Playing() != 0 && event.Key() == tcell.KeyEscape {
+			macros.StopPlaying()
+			notifier.Warn("Stopped replaying the macro")
+			return nil
+		}
+		macros.Record(event)
+		return handleKey(event)
 	})
 
 	// Run application
@@ -1281,6 +3358,9 @@ func main() {
 	// Set root and ensure issue list has focus initially
 	app.SetRoot(pages, true)
 	app.SetFocus(issueList)
+	if *showHome || (cfg.ShowHome && *issueID == "") {
+		showHomeScreen()
+	}
 
 	if err := app.Run(); err != nil {
 		log.Printf("APP ERROR: Application crashed: %v", err)
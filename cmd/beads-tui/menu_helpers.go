@@ -0,0 +1,56 @@
+package main
+
+import (
+	"fmt"
+
+	"github.com/andy/beads-tui/internal/parser"
+	"github.com/andy/beads-tui/internal/ui"
+)
+
+// issueMenuItems lists the actions in an issue's context menu (right click,
+// or . in the list). Like the leader bindings, each replays the single-key
+// shortcut through send so the menu stays in step with the keys; the
+// issue's current status and priority are left out of their submenus.
+func issueMenuItems(issue *parser.Issue, send func(keys string)) []ui.MenuItem {
+	sends := func(keys string) func() {
+		return func() { send(keys) }
+	}
+
+	var statuses []ui.MenuItem
+	for _, status := range []struct {
+		status parser.Status
+		key    rune
+	}{
+		{parser.StatusOpen, 'o'},
+		{parser.StatusInProgress, 'i'},
+		{parser.StatusBlocked, 'b'},
+		{parser.StatusClosed, 'c'},
+	} {
+		if status.status != issue.Status {
+			statuses = append(statuses, ui.MenuItem{Label: string(status.status), Shortcut: status.key, Action: sends("s" + string(status.key))})
+		}
+	}
+
+	var priorities []ui.MenuItem
+	for priority, name := range []string{"critical", "high", "normal", "low", "lowest"} {
+		if priority != issue.Priority {
+			key := rune('0' + priority)
+			priorities = append(priorities, ui.MenuItem{Label: fmt.Sprintf("P%d %s", priority, name), Shortcut: key, Action: sends(string(key))})
+		}
+	}
+
+	closeItem := ui.MenuItem{Label: "Close…", Shortcut: 'x', Action: sends("x")}
+	if issue.Status == parser.StatusClosed {
+		closeItem = ui.MenuItem{Label: "Reopen…", Shortcut: 'X', Action: sends("X")}
+	}
+
+	return []ui.MenuItem{
+		{Label: "Edit…", Shortcut: 'e', Action: sends("e")},
+		{Label: "Set status", Shortcut: 's', Items: statuses},
+		{Label: "Set priority", Shortcut: 'p', Items: priorities},
+		closeItem,
+		{Label: "Labels…", Shortcut: 'L', Action: sends("L")},
+		{Label: "Comment…", Shortcut: 'c', Action: sends("c")},
+		{Label: "Copy ID", Shortcut: 'y', Action: sends("y")},
+	}
+}
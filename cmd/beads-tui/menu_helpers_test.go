@@ -0,0 +1,61 @@
+package main
+
+import (
+	"testing"
+
+	"github.com/andy/beads-tui/internal/parser"
+	"github.com/andy/beads-tui/internal/ui"
+)
+
+// menuItem finds the item labelled label, failing the test if it's missing
+func menuItem(t *testing.T, items []ui.MenuItem, label string) ui.MenuItem {
+	t.Helper()
+	for _, item := range items {
+		if item.Label == label {
+			return item
+		}
+	}
+	t.Fatalf("expected a %q item in %+v", label, items)
+	return ui.MenuItem{}
+}
+
+func TestIssueMenuItems(t *testing.T) {
+	var sent []string
+	send := func(keys string) { sent = append(sent, keys) }
+	issue := &parser.Issue{ID: "tui-a", Status: parser.StatusInProgress, Priority: 2}
+	items := issueMenuItems(issue, send)
+
+	statuses := menuItem(t, items, "Set status").Items
+	if len(statuses) != 3 {
+		t.Fatalf("expected the three other statuses, got %+v", statuses)
+	}
+	for _, item := range statuses {
+		if item.Label == string(parser.StatusInProgress) {
+			t.Errorf("expected the current status to be left out, got %+v", statuses)
+		}
+	}
+	menuItem(t, statuses, "blocked").Action()
+
+	priorities := menuItem(t, items, "Set priority").Items
+	if len(priorities) != 4 {
+		t.Fatalf("expected the four other priorities, got %+v", priorities)
+	}
+	menuItem(t, priorities, "P0 critical").Action()
+
+	menuItem(t, items, "Close…").Action()
+	menuItem(t, items, "Edit…").Action()
+	want := []string{"sb", "0", "x", "e"}
+	if len(sent) != len(want) {
+		t.Fatalf("expected keys %v, got %v", want, sent)
+	}
+	for i := range want {
+		if sent[i] != want[i] {
+			t.Errorf("expected keys %v, got %v", want, sent)
+			break
+		}
+	}
+
+	// A closed issue is reopened instead
+	issue.Status = parser.StatusClosed
+	menuItem(t, issueMenuItems(issue, send), "Reopen…")
+}
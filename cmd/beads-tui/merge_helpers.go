@@ -0,0 +1,130 @@
+package main
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/andy/beads-tui/internal/parser"
+)
+
+// bdStep is a single bd invocation in a multi-command operation
+type bdStep struct {
+	Description string   // Human-readable summary for logs and status messages
+	Args        []string // Arguments passed to bd (without --json)
+}
+
+// mergedText appends the duplicate's text to the survivor's under a cross-reference header.
+// Returns the survivor text unchanged if the duplicate has nothing to add.
+func mergedText(survivorText, duplicateText, duplicateID string) string {
+	duplicateText = strings.TrimSpace(duplicateText)
+	if duplicateText == "" {
+		return survivorText
+	}
+	header := fmt.Sprintf("Merged from %s:", duplicateID)
+	if strings.TrimSpace(survivorText) == "" {
+		return header + "\n" + duplicateText
+	}
+	return strings.TrimRight(survivorText, "\n") + "\n\n---\n" + header + "\n" + duplicateText
+}
+
+// commentReferences summarizes the duplicate's comments so they remain findable after the merge
+func commentReferences(duplicate *parser.Issue) string {
+	if len(duplicate.Comments) == 0 {
+		return ""
+	}
+	var sb strings.Builder
+	sb.WriteString(fmt.Sprintf("Comments on %s:\n", duplicate.ID))
+	for _, comment := range duplicate.Comments {
+		firstLine := strings.SplitN(strings.TrimSpace(comment.Text), "\n", 2)[0]
+		sb.WriteString(fmt.Sprintf("- %s (%s): %s\n", comment.Author, comment.CreatedAt.Format("2006-01-02"), firstLine))
+	}
+	return strings.TrimRight(sb.String(), "\n")
+}
+
+// hasDependency reports whether issue already has a dependency of depType on targetID
+func hasDependency(issue *parser.Issue, targetID string, depType parser.DependencyType) bool {
+	for _, dep := range issue.Dependencies {
+		if dep.DependsOnID == targetID && dep.Type == depType {
+			return true
+		}
+	}
+	return false
+}
+
+// planMerge builds the ordered bd commands that merge duplicate into survivor:
+//  1. Append the duplicate's description, notes, and comment references to the survivor
+//  2. Move the duplicate's outbound dependencies onto the survivor
+//  3. Re-point issues that depend on the duplicate to depend on the survivor
+//  4. Close the duplicate with a cross-reference
+//
+// Dependencies between the two issues themselves are dropped rather than
+// turned into self-references.
+func planMerge(survivor, duplicate *parser.Issue, allIssues []*parser.Issue) []bdStep {
+	var steps []bdStep
+
+	// 1. Combine content
+	description := mergedText(survivor.Description, duplicate.Description, duplicate.ID)
+	notes := mergedText(survivor.Notes, duplicate.Notes, duplicate.ID)
+	if refs := commentReferences(duplicate); refs != "" {
+		notes = mergedText(notes, refs, duplicate.ID)
+	}
+	updateArgs := []string{"update", survivor.ID}
+	if description != survivor.Description {
+		updateArgs = append(updateArgs, "--description", description)
+	}
+	if notes != survivor.Notes {
+		updateArgs = append(updateArgs, "--notes", notes)
+	}
+	if len(updateArgs) > 2 {
+		steps = append(steps, bdStep{
+			Description: fmt.Sprintf("combine content into %s", survivor.ID),
+			Args:        updateArgs,
+		})
+	}
+
+	// 2. Outbound dependencies of the duplicate move to the survivor
+	for _, dep := range duplicate.Dependencies {
+		if dep.DependsOnID != survivor.ID && !hasDependency(survivor, dep.DependsOnID, dep.Type) {
+			steps = append(steps, bdStep{
+				Description: fmt.Sprintf("%s %s %s", survivor.ID, depTypeToPhrase(dep.Type), dep.DependsOnID),
+				Args:        []string{"dep", "add", survivor.ID, dep.DependsOnID, "--type", string(dep.Type)},
+			})
+		}
+		steps = append(steps, bdStep{
+			Description: fmt.Sprintf("detach %s from %s", duplicate.ID, dep.DependsOnID),
+			Args:        []string{"dep", "remove", duplicate.ID, dep.DependsOnID, "--type", string(dep.Type)},
+		})
+	}
+
+	// 3. Dependents of the duplicate are re-pointed at the survivor
+	for _, issue := range allIssues {
+		if issue.ID == duplicate.ID {
+			continue
+		}
+		for _, dep := range issue.Dependencies {
+			if dep.DependsOnID != duplicate.ID {
+				continue
+			}
+			if issue.ID != survivor.ID && !hasDependency(issue, survivor.ID, dep.Type) {
+				steps = append(steps, bdStep{
+					Description: fmt.Sprintf("%s %s %s", issue.ID, depTypeToPhrase(dep.Type), survivor.ID),
+					Args:        []string{"dep", "add", issue.ID, survivor.ID, "--type", string(dep.Type)},
+				})
+			}
+			steps = append(steps, bdStep{
+				Description: fmt.Sprintf("detach %s from %s", issue.ID, duplicate.ID),
+				Args:        []string{"dep", "remove", issue.ID, duplicate.ID, "--type", string(dep.Type)},
+			})
+		}
+	}
+
+	// 4. Close the duplicate with a cross-reference
+	if duplicate.Status != parser.StatusClosed {
+		steps = append(steps, bdStep{
+			Description: fmt.Sprintf("close %s", duplicate.ID),
+			Args:        []string{"close", duplicate.ID, "--reason", fmt.Sprintf("Duplicate of %s (merged)", survivor.ID)},
+		})
+	}
+
+	return steps
+}
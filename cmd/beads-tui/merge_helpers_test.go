@@ -0,0 +1,102 @@
+package main
+
+import (
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/andy/beads-tui/internal/parser"
+)
+
+func TestMergedText(t *testing.T) {
+	if got := mergedText("keep", "", "tui-2"); got != "keep" {
+		t.Errorf("expected unchanged survivor text, got %q", got)
+	}
+	if got := mergedText("", "dup", "tui-2"); got != "Merged from tui-2:\ndup" {
+		t.Errorf("unexpected merge into empty text: %q", got)
+	}
+	got := mergedText("keep\n", "dup", "tui-2")
+	if got != "keep\n\n---\nMerged from tui-2:\ndup" {
+		t.Errorf("unexpected merged text: %q", got)
+	}
+}
+
+func TestPlanMerge(t *testing.T) {
+	survivor := &parser.Issue{
+		ID:          "tui-1",
+		Title:       "Survivor",
+		Description: "Original",
+		Status:      parser.StatusOpen,
+		Dependencies: []*parser.Dependency{
+			{DependsOnID: "tui-epic", Type: parser.DepParentChild},
+		},
+	}
+	duplicate := &parser.Issue{
+		ID:          "tui-2",
+		Title:       "Duplicate",
+		Description: "Extra detail",
+		Status:      parser.StatusOpen,
+		Dependencies: []*parser.Dependency{
+			{DependsOnID: "tui-epic", Type: parser.DepParentChild}, // survivor already has this
+			{DependsOnID: "tui-3", Type: parser.DepBlocks},
+			{DependsOnID: "tui-1", Type: parser.DepRelated}, // link between the pair
+		},
+		Comments: []*parser.Comment{
+			{Author: "alice", Text: "Repro steps\nmore", CreatedAt: time.Date(2025, 1, 2, 0, 0, 0, 0, time.UTC)},
+		},
+	}
+	dependent := &parser.Issue{
+		ID:     "tui-4",
+		Status: parser.StatusOpen,
+		Dependencies: []*parser.Dependency{
+			{DependsOnID: "tui-2", Type: parser.DepBlocks},
+		},
+	}
+	all := []*parser.Issue{survivor, duplicate, dependent}
+
+	steps := planMerge(survivor, duplicate, all)
+
+	var cmds []string
+	for _, step := range steps {
+		cmds = append(cmds, strings.Join(step.Args, " "))
+	}
+	joined := strings.Join(cmds, "\n")
+
+	expected := []string{
+		"dep add tui-1 tui-3 --type blocks",
+		"dep remove tui-2 tui-epic --type parent-child",
+		"dep remove tui-2 tui-3 --type blocks",
+		"dep remove tui-2 tui-1 --type related",
+		"dep add tui-4 tui-1 --type blocks",
+		"dep remove tui-4 tui-2 --type blocks",
+		"close tui-2 --reason Duplicate of tui-1 (merged)",
+	}
+	for _, want := range expected {
+		if !strings.Contains(joined, want) {
+			t.Errorf("expected plan to contain %q, got:\n%s", want, joined)
+		}
+	}
+	if strings.Contains(joined, "dep add tui-1 tui-epic") {
+		t.Error("did not expect duplicate parent-child dependency to be re-added")
+	}
+	if strings.Contains(joined, "dep add tui-1 tui-1") {
+		t.Error("did not expect a self-referencing dependency")
+	}
+
+	// First step combines content, including comment references
+	first := steps[0].Args
+	if first[0] != "update" || first[1] != "tui-1" {
+		t.Fatalf("expected first step to update survivor, got %v", first)
+	}
+	if !strings.Contains(strings.Join(first, " "), "Extra detail") {
+		t.Error("expected duplicate description in merged content")
+	}
+	if !strings.Contains(strings.Join(first, " "), "alice (2025-01-02): Repro steps") {
+		t.Error("expected comment reference in merged notes")
+	}
+
+	// Last step closes the duplicate
+	if last := steps[len(steps)-1].Args; last[0] != "close" || last[1] != "tui-2" {
+		t.Errorf("expected last step to close duplicate, got %v", last)
+	}
+}
@@ -0,0 +1,55 @@
+package main
+
+import (
+	"fmt"
+
+	"github.com/andy/beads-tui/internal/parser"
+)
+
+// planMove builds the bd commands that make issue a child of newParentID in
+// place of its current parents: a parent-child dependency on the new parent
+// is added first, then the one on each other issue is removed, so a step
+// that fails never leaves the issue without a parent. The undo steps put
+// the old parents back in the same order: they're added, then the new one
+// is removed.
+func planMove(issue *parser.Issue, newParentID string) (steps, undo []bdStep) {
+	add := func(parentID string) bdStep {
+		return bdStep{
+			Description: fmt.Sprintf("make %s a child of %s", issue.ID, parentID),
+			Args:        []string{"dep", "add", issue.ID, parentID, "--type", string(parser.DepParentChild)},
+		}
+	}
+	remove := func(parentID string) bdStep {
+		return bdStep{
+			Description: fmt.Sprintf("detach %s from %s", issue.ID, parentID),
+			Args:        []string{"dep", "remove", issue.ID, parentID, "--type", string(parser.DepParentChild)},
+		}
+	}
+
+	added := !hasDependency(issue, newParentID, parser.DepParentChild)
+	if added {
+		steps = append(steps, add(newParentID))
+	}
+	for _, dep := range issue.Dependencies {
+		if dep.Type == parser.DepParentChild && dep.DependsOnID != newParentID {
+			steps = append(steps, remove(dep.DependsOnID))
+			undo = append(undo, add(dep.DependsOnID))
+		}
+	}
+	if added {
+		undo = append(undo, remove(newParentID))
+	}
+	return steps, undo
+}
+
+// idPrefixParent returns the issue that issueID's own ID nests it under in
+// the tree (tui-a for tui-a.1, checking the longest prefix first), or "" if
+// there is none. Dependency changes can't move an issue out from under it.
+func idPrefixParent(issueID string, exists func(issueID string) bool) string {
+	for i := len(issueID) - 1; i > 0; i-- {
+		if issueID[i] == '.' && exists(issueID[:i]) {
+			return issueID[:i]
+		}
+	}
+	return ""
+}
@@ -0,0 +1,61 @@
+package main
+
+import (
+	"reflect"
+	"strings"
+	"testing"
+
+	"github.com/andy/beads-tui/internal/parser"
+)
+
+// stepArgs joins each step's arguments, for comparing plans
+func stepArgs(steps []bdStep) []string {
+	var args []string
+	for _, step := range steps {
+		args = append(args, strings.Join(step.Args, " "))
+	}
+	return args
+}
+
+func TestPlanMove(t *testing.T) {
+	issue := &parser.Issue{ID: "tui-c", Dependencies: []*parser.Dependency{
+		{IssueID: "tui-c", DependsOnID: "tui-a", Type: parser.DepParentChild},
+		{IssueID: "tui-c", DependsOnID: "tui-x", Type: parser.DepBlocks},
+	}}
+
+	steps, undo := planMove(issue, "tui-b")
+	// The new parent is added before the old one goes, and back again on undo
+	want := []string{
+		"dep add tui-c tui-b --type parent-child",
+		"dep remove tui-c tui-a --type parent-child",
+	}
+	if got := stepArgs(steps); !reflect.DeepEqual(got, want) {
+		t.Errorf("expected steps %q, got %q", want, got)
+	}
+	wantUndo := []string{
+		"dep add tui-c tui-a --type parent-child",
+		"dep remove tui-c tui-b --type parent-child",
+	}
+	if got := stepArgs(undo); !reflect.DeepEqual(got, wantUndo) {
+		t.Errorf("expected undo steps %q, got %q", wantUndo, got)
+	}
+
+	// Moving under the current parent changes nothing
+	if steps, undo := planMove(issue, "tui-a"); len(steps) != 0 || len(undo) != 0 {
+		t.Errorf("expected no steps, got %q and %q", stepArgs(steps), stepArgs(undo))
+	}
+}
+
+func TestIDPrefixParent(t *testing.T) {
+	exists := func(issueID string) bool { return issueID == "tui-a" || issueID == "tui-a.1" }
+	for issueID, want := range map[string]string{
+		"tui-a.1.2": "tui-a.1",
+		"tui-a.2":   "tui-a",
+		"tui-a":     "",
+		"tui-b.1":   "",
+	} {
+		if got := idPrefixParent(issueID, exists); got != want {
+			t.Errorf("idPrefixParent(%q) = %q, expected %q", issueID, got, want)
+		}
+	}
+}
@@ -0,0 +1,120 @@
+package main
+
+import (
+	"context"
+	"fmt"
+	"log"
+	"os"
+
+	"github.com/andy/beads-tui/internal/formatting"
+	"github.com/andy/beads-tui/internal/theme"
+	"github.com/andy/beads-tui/internal/ui"
+	"github.com/gdamore/tcell/v2"
+	"github.com/rivo/tview"
+)
+
+// runOnboarding is the first-run wizard, shown instead of an error when
+// there's no beads database at dbPath. It asks for an issue prefix, runs bd
+// init in projectDir, optionally creates a sample issue, and then shows the
+// basic keys. Returns errSetupQuit if the user leaves without setting up.
+func runOnboarding(projectDir, dbPath string) error {
+	setupApp := tview.NewApplication()
+	pages := tview.NewPages()
+
+	currentTheme := theme.Current()
+	tview.Styles.PrimitiveBackgroundColor = currentTheme.AppBackground()
+	tview.Styles.PrimaryTextColor = currentTheme.AppForeground()
+	tview.Styles.ContrastBackgroundColor = currentTheme.InputFieldBackground()
+	tview.Styles.MoreContrastBackgroundColor = currentTheme.InputFieldBackground()
+
+	result := errSetupQuit
+	prefix := defaultIssuePrefix(projectDir)
+	sample := true
+	running := false
+
+	dialog := ui.NewDialog(setupApp, pages, "setup", "Set Up Beads")
+	form := dialog.Form
+	form.AddTextView("", fmt.Sprintf("No beads database in [%s]%s[-] yet.\nSet one up with bd init? Issue IDs will start with the prefix below.",
+		formatting.GetAccentColor(), tview.Escape(projectDir)), 0, 3, true, false)
+	form.AddInputField("Issue prefix", prefix, 20, nil, func(text string) {
+		prefix = text
+	})
+	form.AddCheckbox("Create a sample issue", sample, func(checked bool) {
+		sample = checked
+	})
+	status := tview.NewTextView().SetDynamicColors(true).
+		SetText(fmt.Sprintf("[%s]Runs: bd init --prefix <prefix> --quiet[-]", formatting.GetMutedColor()))
+
+	// Once set up, the basic keys, then on to the issue list
+	showKeys := func(message string) {
+		keys := tview.NewTextView().SetDynamicColors(true).
+			SetText(fmt.Sprintf("[%s]%s[-]\n\n%s[%s]Press Enter to open the issue list.[-]",
+				formatting.GetSuccessColor(), message, renderKeymapHelp(keymap[:1]), formatting.GetMutedColor()))
+		keys.SetBorder(true).SetTitle(" Welcome to beads-tui ").SetTitleAlign(tview.AlignCenter)
+		keys.SetInputCapture(func(event *tcell.EventKey) *tcell.EventKey {
+			if event.Key() == tcell.KeyEnter || event.Key() == tcell.KeyEscape {
+				setupApp.Stop()
+				return nil
+			}
+			return event
+		})
+		pages.AddPage("keys", ui.CenterModal(keys, 2, 2), true, true)
+		setupApp.SetFocus(keys)
+	}
+
+	initialize := func() {
+		if running {
+			return
+		}
+		if err := checkIssuePrefix(prefix); err != nil {
+			status.SetText(fmt.Sprintf("[%s]%s[-]", formatting.GetErrorColor(), tview.Escape(err.Error())))
+			return
+		}
+		running = true
+		status.SetText(fmt.Sprintf("[%s]Running bd init --prefix %s…[-]", formatting.GetWarningColor(), prefix))
+		chosenPrefix, createSample := prefix, sample
+		go func() {
+			ctx := context.Background()
+			log.Printf("SETUP: Running bd init --prefix %s in %s", chosenPrefix, projectDir)
+			err := initBeads(ctx, projectDir, chosenPrefix)
+			if err == nil {
+				if _, statErr := os.Stat(dbPath); statErr != nil {
+					err = fmt.Errorf("bd init finished, but %s wasn't created", dbPath)
+				}
+			}
+			message := "Beads is set up with issue prefix " + chosenPrefix + "."
+			if err == nil && createSample {
+				issue, sampleErr := execBdJSONIssue(ctx, sampleIssueArgs(dbPath)...)
+				if sampleErr != nil {
+					log.Printf("SETUP: Failed to create the sample issue: %v", sampleErr)
+					message += " (The sample issue couldn't be created.)"
+				} else {
+					message += " Try things out on " + issue.ID + "."
+				}
+			}
+			setupApp.QueueUpdateDraw(func() {
+				running = false
+				if err != nil {
+					log.Printf("SETUP: %v", err)
+					status.SetText(fmt.Sprintf("[%s]%s[-]", formatting.GetErrorColor(), tview.Escape(err.Error())))
+					return
+				}
+				result = nil
+				dialog.Close()
+				showKeys(message)
+			})
+		}()
+	}
+
+	dialog.SetPrimary("Initialize", initialize).
+		SetCancel("Quit", setupApp.Stop).
+		SetSubmitOnEnter(true).
+		SetFooter(status, 2).
+		SetFixedSize(76, 16)
+	dialog.Show()
+
+	if err := setupApp.SetRoot(pages, true).Run(); err != nil {
+		return err
+	}
+	return result
+}
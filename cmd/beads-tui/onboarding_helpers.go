@@ -0,0 +1,86 @@
+package main
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"os"
+	"os/exec"
+	"path/filepath"
+	"regexp"
+	"strings"
+)
+
+// errSetupQuit is returned by runOnboarding when the user leaves without
+// setting up a project
+var errSetupQuit = errors.New("beads setup canceled")
+
+// issuePrefixPattern matches the issue prefixes the setup wizard accepts:
+// letters, digits, and inner hyphens
+var issuePrefixPattern = regexp.MustCompile(`^[a-z0-9]+(-[a-z0-9]+)*$`)
+
+// defaultIssuePrefix suggests an issue prefix for a project from its
+// directory name, as bd init would ("My App" becomes "my-app")
+func defaultIssuePrefix(dir string) string {
+	var sb strings.Builder
+	for _, r := range strings.ToLower(filepath.Base(dir)) {
+		switch {
+		case r >= 'a' && r <= 'z', r >= '0' && r <= '9':
+			sb.WriteRune(r)
+		case sb.Len() > 0 && !strings.HasSuffix(sb.String(), "-"):
+			sb.WriteRune('-')
+		}
+	}
+	prefix := strings.TrimSuffix(sb.String(), "-")
+	if prefix == "" {
+		return "bd"
+	}
+	return prefix
+}
+
+// checkIssuePrefix explains what's wrong with a prefix typed in the setup
+// wizard, or returns nil
+func checkIssuePrefix(prefix string) error {
+	if prefix == "" {
+		return errors.New("enter an issue prefix")
+	}
+	if !issuePrefixPattern.MatchString(prefix) {
+		return fmt.Errorf("%q: use lowercase letters, digits, and hyphens", prefix)
+	}
+	return nil
+}
+
+// isTerminal reports whether f is an interactive terminal, where the setup
+// wizard can run
+func isTerminal(f *os.File) bool {
+	info, err := f.Stat()
+	return err == nil && info.Mode()&os.ModeCharDevice != 0
+}
+
+// initBeads runs bd init in dir with the given issue prefix. It runs in dir
+// (bd init sets up the project it's started in), so unlike other bd
+// commands it doesn't go through runBd.
+func initBeads(ctx context.Context, dir, prefix string) error {
+	ctx, cancel := context.WithTimeout(ctx, bdTimeout)
+	defer cancel()
+	cmd := exec.CommandContext(ctx, bdCommand, "init", "--prefix", prefix, "--quiet")
+	cmd.Dir = dir
+	if output, err := cmd.CombinedOutput(); err != nil {
+		if message := strings.TrimSpace(string(output)); message != "" {
+			return fmt.Errorf("bd init failed: %s", message)
+		}
+		return fmt.Errorf("bd init failed: %w", err)
+	}
+	return nil
+}
+
+// sampleIssueArgs is the bd create command for the sample issue the setup
+// wizard offers, in the database at dbPath
+func sampleIssueArgs(dbPath string) []string {
+	args := []string{"create", "Try out beads-tui", "-p", "2", "-t", "task",
+		"--description", "A sample issue to practice on: press e to edit it, c to comment, si to start it, and x to close it. Press ? for every key."}
+	if bdDatabase == "" {
+		args = append(args, "--db", dbPath)
+	}
+	return args
+}
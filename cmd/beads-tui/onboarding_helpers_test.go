@@ -0,0 +1,48 @@
+package main
+
+import (
+	"slices"
+	"testing"
+)
+
+func TestDefaultIssuePrefix(t *testing.T) {
+	for dir, want := range map[string]string{
+		"/home/me/beads-tui":  "beads-tui",
+		"/home/me/My App":     "my-app",
+		"/home/me/__api_v2__": "api-v2",
+		"/home/me/日本":         "bd",
+	} {
+		if got := defaultIssuePrefix(dir); got != want {
+			t.Errorf("defaultIssuePrefix(%q) = %q, want %q", dir, got, want)
+		}
+		if err := checkIssuePrefix(defaultIssuePrefix(dir)); err != nil {
+			t.Errorf("expected the prefix suggested for %q to be accepted, got %v", dir, err)
+		}
+	}
+}
+
+func TestCheckIssuePrefix(t *testing.T) {
+	for _, prefix := range []string{"tui", "my-app", "v2"} {
+		if err := checkIssuePrefix(prefix); err != nil {
+			t.Errorf("expected %q to be accepted, got %v", prefix, err)
+		}
+	}
+	for _, prefix := range []string{"", "My App", "-tui", "tui-", "a--b", "tui_x"} {
+		if err := checkIssuePrefix(prefix); err == nil {
+			t.Errorf("expected %q to be rejected", prefix)
+		}
+	}
+}
+
+func TestSampleIssueArgs(t *testing.T) {
+	args := sampleIssueArgs("/p/.beads/beads.db")
+	if args[0] != "create" || !slices.Contains(args, "/p/.beads/beads.db") {
+		t.Errorf("expected a create in the new database, got %q", args)
+	}
+
+	bdDatabase = "/p/.beads/beads.db"
+	defer func() { bdDatabase = "" }()
+	if slices.Contains(sampleIssueArgs("/p/.beads/beads.db"), "--db") {
+		t.Error("expected --db to be left to bdArgs when a database was chosen")
+	}
+}
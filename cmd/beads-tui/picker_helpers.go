@@ -0,0 +1,61 @@
+package main
+
+import (
+	"strings"
+
+	"github.com/andy/beads-tui/internal/parser"
+	"github.com/andy/beads-tui/internal/state"
+)
+
+// issuePickerLimit caps the suggestions listed under an issue picker field
+const issuePickerLimit = 10
+
+// issuePickerEntry formats an issue as a picker suggestion: its ID, then its
+// title
+func issuePickerEntry(issue *parser.Issue) string {
+	entry := issue.ID + "  " + issue.Title
+	if issue.Status == parser.StatusClosed {
+		entry += " (closed)"
+	}
+	return entry
+}
+
+// issuePickerID returns the issue ID a picker suggestion starts with
+func issuePickerID(entry string) string {
+	id, _, _ := strings.Cut(strings.TrimSpace(entry), " ")
+	return id
+}
+
+// issuePickerSuggestions returns up to limit fuzzy matches as picker
+// entries, best first, leaving out the issues exclude reports
+func issuePickerSuggestions(matches []state.FuzzyMatch, exclude func(issueID string) bool, limit int) []string {
+	var entries []string
+	for _, match := range matches {
+		if exclude(match.Issue.ID) {
+			continue
+		}
+		entries = append(entries, issuePickerEntry(match.Issue))
+		if len(entries) == limit {
+			break
+		}
+	}
+	return entries
+}
+
+// splitIssueIDs reads text as a list of issue IDs separated by commas or
+// spaces (as typed or pasted into a picker). It returns false unless every
+// one names an issue.
+func splitIssueIDs(text string, exists func(issueID string) bool) ([]string, bool) {
+	fields := strings.FieldsFunc(text, func(r rune) bool {
+		return r == ',' || r == ' ' || r == '\t'
+	})
+	if len(fields) == 0 {
+		return nil, false
+	}
+	for _, id := range fields {
+		if !exists(id) {
+			return nil, false
+		}
+	}
+	return fields, true
+}
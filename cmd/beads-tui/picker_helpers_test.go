@@ -0,0 +1,46 @@
+package main
+
+import (
+	"reflect"
+	"testing"
+
+	"github.com/andy/beads-tui/internal/parser"
+	"github.com/andy/beads-tui/internal/state"
+)
+
+func TestIssuePickerSuggestions(t *testing.T) {
+	matches := []state.FuzzyMatch{
+		{Issue: &parser.Issue{ID: "tui-a", Title: "Crash on start"}},
+		{Issue: &parser.Issue{ID: "tui-b", Title: "Crash on exit", Status: parser.StatusClosed}},
+		{Issue: &parser.Issue{ID: "tui-c", Title: "Crash on resize"}},
+	}
+	exclude := func(issueID string) bool { return issueID == "tui-a" }
+
+	got := issuePickerSuggestions(matches, exclude, 1)
+	want := []string{"tui-b  Crash on exit (closed)"}
+	if !reflect.DeepEqual(got, want) {
+		t.Errorf("expected %q, got %q", want, got)
+	}
+	if id := issuePickerID(got[0]); id != "tui-b" {
+		t.Errorf("expected the entry to start with tui-b, got %q", id)
+	}
+	if got := issuePickerSuggestions(matches, exclude, issuePickerLimit); len(got) != 2 {
+		t.Errorf("expected the two issues not excluded, got %q", got)
+	}
+}
+
+func TestSplitIssueIDs(t *testing.T) {
+	exists := func(issueID string) bool { return issueID == "tui-a" || issueID == "tui-b" }
+
+	if ids, ok := splitIssueIDs("tui-a, tui-b", exists); !ok || !reflect.DeepEqual(ids, []string{"tui-a", "tui-b"}) {
+		t.Errorf("expected both IDs, got %q (%v)", ids, ok)
+	}
+	if ids, ok := splitIssueIDs("tui-a", exists); !ok || len(ids) != 1 {
+		t.Errorf("expected one ID, got %q (%v)", ids, ok)
+	}
+	for _, text := range []string{"", "crash", "tui-a crash"} {
+		if ids, ok := splitIssueIDs(text, exists); ok {
+			t.Errorf("expected %q not to be a list of IDs, got %q", text, ids)
+		}
+	}
+}
@@ -0,0 +1,49 @@
+package main
+
+import (
+	"flag"
+	"fmt"
+	"io"
+	"log"
+	"net"
+	"net/http"
+	_ "net/http/pprof" // Registers /debug/pprof/ on http.DefaultServeMux
+)
+
+// hiddenFlags are developer flags left out of --help
+var hiddenFlags = map[string]bool{"pprof": true}
+
+// printUsage lists a flag set's flags like flag.PrintDefaults, minus the
+// hidden ones
+func printUsage(out io.Writer, name string, flags *flag.FlagSet) {
+	visible := flag.NewFlagSet(name, flag.ContinueOnError)
+	visible.SetOutput(out)
+	flags.VisitAll(func(f *flag.Flag) {
+		if !hiddenFlags[f.Name] {
+			visible.Var(f.Value, f.Name, f.Usage)
+		}
+	})
+	fmt.Fprintf(out, "Usage of %s:\n", name)
+	visible.PrintDefaults()
+}
+
+// startPprof serves net/http/pprof at addr (e.g. localhost:6060), so the
+// refresh path can be profiled in a running TUI:
+//
+//	go tool pprof http://localhost:6060/debug/pprof/profile?seconds=30
+//
+// The listener is opened before returning, so a bad address is reported
+// before the TUI takes over the terminal.
+func startPprof(addr string) error {
+	listener, err := net.Listen("tcp", addr)
+	if err != nil {
+		return fmt.Errorf("pprof: %w", err)
+	}
+	log.Printf("PPROF: Serving /debug/pprof/ on %s", listener.Addr())
+	go func() {
+		if err := http.Serve(listener, nil); err != nil {
+			log.Printf("PPROF: Server stopped: %v", err)
+		}
+	}()
+	return nil
+}
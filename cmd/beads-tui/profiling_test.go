@@ -0,0 +1,29 @@
+package main
+
+import (
+	"bytes"
+	"flag"
+	"strings"
+	"testing"
+)
+
+func TestPrintUsageHidesDeveloperFlags(t *testing.T) {
+	flags := flag.NewFlagSet("beads-tui", flag.ContinueOnError)
+	flags.Bool("debug", false, "Enable debug logging to file")
+	flags.String("pprof", "", "Serve net/http/pprof at this address")
+
+	var out bytes.Buffer
+	printUsage(&out, "beads-tui", flags)
+	if !strings.Contains(out.String(), "-debug") {
+		t.Errorf("Expected -debug in the usage, got:\n%s", out.String())
+	}
+	if strings.Contains(out.String(), "pprof") {
+		t.Errorf("Expected -pprof to be hidden, got:\n%s", out.String())
+	}
+}
+
+func TestStartPprofBadAddress(t *testing.T) {
+	if err := startPprof("not an address"); err == nil {
+		t.Error("Expected an error for a bad address")
+	}
+}
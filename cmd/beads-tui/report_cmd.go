@@ -0,0 +1,222 @@
+package main
+
+import (
+	"context"
+	"encoding/json"
+	"flag"
+	"fmt"
+	"io"
+	"log"
+	"path/filepath"
+	"strings"
+	"time"
+
+	"github.com/andy/beads-tui/internal/parser"
+	"github.com/andy/beads-tui/internal/state"
+	"github.com/andy/beads-tui/internal/storage"
+)
+
+// statusReport is the ready/in-progress/blocked breakdown printed by
+// `beads-tui report`
+type statusReport struct {
+	Project     string        `json:"project"`
+	Filter      string        `json:"filter,omitempty"`
+	GeneratedAt time.Time     `json:"generated_at"`
+	InProgress  []reportIssue `json:"in_progress"`
+	Ready       []reportIssue `json:"ready"`
+	Blocked     []reportIssue `json:"blocked"`
+}
+
+// reportIssue is one issue in a statusReport
+type reportIssue struct {
+	ID        string   `json:"id"`
+	Title     string   `json:"title"`
+	Status    string   `json:"status"`
+	Priority  int      `json:"priority"`
+	Type      string   `json:"type"`
+	Assignee  string   `json:"assignee,omitempty"`
+	BlockedBy []string `json:"blocked_by,omitempty"` // Unclosed issues it waits on directly
+}
+
+// runReportCommand implements `beads-tui report [--format text|markdown|json]
+// [--filter query] [--path dir | --db file]`, printing the issue breakdown
+// without starting the TUI. Returns the process exit code.
+func runReportCommand(args []string, stdout, stderr io.Writer) int {
+	fs := flag.NewFlagSet("report", flag.ContinueOnError)
+	fs.SetOutput(stderr)
+	format := fs.String("format", "text", "Output format: text, markdown, or json")
+	filter := fs.String("filter", "", `Quick filter query, as typed after f in the TUI (e.g. "p0,p1 bug")`)
+	projectPath := fs.String("path", "", "Project directory or .beads directory (default: search up from the current directory)")
+	dbFile := fs.String("db", "", "Beads database file to report on")
+	fs.Usage = func() {
+		fmt.Fprintln(stderr, "Usage: beads-tui report [--format text|markdown|json] [--filter query] [--path dir | --db file]")
+		fmt.Fprintln(stderr, "")
+		fmt.Fprintln(stderr, "Prints the in-progress, ready, and blocked issues, as grouped in the")
+		fmt.Fprintln(stderr, "issue list, for standup scripts and dashboards.")
+		fs.PrintDefaults()
+	}
+	if err := fs.Parse(args); err != nil {
+		return 2
+	}
+	switch *format {
+	case "text", "txt", "markdown", "md", "json":
+	default:
+		fmt.Fprintf(stderr, "Error: unknown format %q (use text, markdown, or json)\n", *format)
+		return 2
+	}
+	if *projectPath != "" && *dbFile != "" {
+		fmt.Fprintln(stderr, "Error: use either --path or --db, not both")
+		return 2
+	}
+
+	// Keep library logging off the report
+	log.SetOutput(io.Discard)
+
+	beadsDir, dbPath, err := findProjectDatabase(*projectPath, *dbFile)
+	if err != nil {
+		fmt.Fprintf(stderr, "Error: %v\n", err)
+		return 1
+	}
+	reader, err := storage.NewSQLiteReader(dbPath)
+	if err != nil {
+		fmt.Fprintf(stderr, "Error opening database: %v\n", err)
+		return 1
+	}
+	defer reader.Close()
+
+	ctx, cancel := context.WithTimeout(context.Background(), dbLoadTimeout)
+	defer cancel()
+	issues, err := reader.LoadIssues(ctx)
+	if err != nil {
+		fmt.Fprintf(stderr, "Error loading issues: %v\n", err)
+		return 1
+	}
+
+	appState := state.New()
+	appState.LoadIssues(issues)
+	appState.ApplyFilterQuery(*filter)
+	report := buildStatusReport(appState, filepath.Base(filepath.Dir(beadsDir)), *filter, time.Now())
+
+	switch *format {
+	case "markdown", "md":
+		fmt.Fprint(stdout, renderReportMarkdown(report))
+	case "json":
+		encoder := json.NewEncoder(stdout)
+		encoder.SetIndent("", "  ")
+		if err := encoder.Encode(report); err != nil {
+			fmt.Fprintf(stderr, "Error: %v\n", err)
+			return 1
+		}
+	default:
+		fmt.Fprint(stdout, renderReportText(report))
+	}
+	return 0
+}
+
+// buildStatusReport collects the filtered in-progress, ready, and blocked
+// issues from state, in list order
+func buildStatusReport(appState *state.State, project, filter string, now time.Time) statusReport {
+	collect := func(issues []*parser.Issue) []reportIssue {
+		items := make([]reportIssue, 0, len(issues))
+		for _, issue := range issues {
+			item := reportIssue{
+				ID:       issue.ID,
+				Title:    issue.Title,
+				Status:   string(issue.Status),
+				Priority: issue.Priority,
+				Type:     string(issue.IssueType),
+				Assignee: issue.Assignee,
+			}
+			for _, blocker := range appState.TransitiveBlockers(issue.ID) {
+				if blocker.Depth == 1 {
+					item.BlockedBy = append(item.BlockedBy, blocker.Issue.ID)
+				}
+			}
+			items = append(items, item)
+		}
+		return items
+	}
+	return statusReport{
+		Project:     project,
+		Filter:      strings.TrimSpace(filter),
+		GeneratedAt: now,
+		InProgress:  collect(appState.GetInProgressIssues()),
+		Ready:       collect(appState.GetReadyIssues()),
+		Blocked:     collect(appState.GetBlockedIssues()),
+	}
+}
+
+// reportSection is one group of issues in the printed report
+type reportSection struct {
+	Title  string
+	Issues []reportIssue
+}
+
+// reportSections lists the report's groups in the order they are printed
+func reportSections(report statusReport) []reportSection {
+	return []reportSection{
+		{"In Progress", report.InProgress},
+		{"Ready", report.Ready},
+		{"Blocked", report.Blocked},
+	}
+}
+
+// reportSummary is the one-line count of each section
+func reportSummary(report statusReport) string {
+	summary := fmt.Sprintf("%d in progress, %d ready, %d blocked", len(report.InProgress), len(report.Ready), len(report.Blocked))
+	if report.Filter != "" {
+		summary += fmt.Sprintf(" (filter: %s)", report.Filter)
+	}
+	return summary
+}
+
+// renderReportText renders the report as plain text, one aligned line per
+// issue
+func renderReportText(report statusReport) string {
+	var sb strings.Builder
+	fmt.Fprintf(&sb, "%s: %s\n", report.Project, reportSummary(report))
+	for _, section := range reportSections(report) {
+		if len(section.Issues) == 0 {
+			continue
+		}
+		idWidth := 0
+		for _, issue := range section.Issues {
+			idWidth = max(idWidth, len(issue.ID))
+		}
+		fmt.Fprintf(&sb, "\n%s (%d)\n", strings.ToUpper(section.Title), len(section.Issues))
+		for _, issue := range section.Issues {
+			fmt.Fprintf(&sb, "  %-*s  P%d  %-7s  %s", idWidth, issue.ID, issue.Priority, issue.Type, issue.Title)
+			sb.WriteString(reportExtras(issue))
+			sb.WriteString("\n")
+		}
+	}
+	return sb.String()
+}
+
+// renderReportMarkdown renders the report as Markdown, a list per section
+func renderReportMarkdown(report statusReport) string {
+	var sb strings.Builder
+	fmt.Fprintf(&sb, "# %s status\n\n%s\n", report.Project, reportSummary(report))
+	for _, section := range reportSections(report) {
+		if len(section.Issues) == 0 {
+			continue
+		}
+		fmt.Fprintf(&sb, "\n## %s (%d)\n\n", section.Title, len(section.Issues))
+		for _, issue := range section.Issues {
+			fmt.Fprintf(&sb, "- **%s** P%d %s: %s%s\n", issue.ID, issue.Priority, issue.Type, issue.Title, reportExtras(issue))
+		}
+	}
+	return sb.String()
+}
+
+// reportExtras notes an issue's assignee and blockers after its title
+func reportExtras(issue reportIssue) string {
+	extras := ""
+	if issue.Assignee != "" {
+		extras += " @" + issue.Assignee
+	}
+	if len(issue.BlockedBy) > 0 {
+		extras += " (blocked by " + strings.Join(issue.BlockedBy, ", ") + ")"
+	}
+	return extras
+}
@@ -0,0 +1,90 @@
+package main
+
+import (
+	"bytes"
+	"encoding/json"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/andy/beads-tui/internal/parser"
+	"github.com/andy/beads-tui/internal/state"
+)
+
+func reportTestState() *state.State {
+	appState := state.New()
+	appState.LoadIssues([]*parser.Issue{
+		{ID: "tui-1", Title: "Fix crash", Status: parser.StatusInProgress, Priority: 0, IssueType: parser.TypeBug, Assignee: "ada"},
+		{ID: "tui-2", Title: "Add export", Status: parser.StatusOpen, Priority: 2, IssueType: parser.TypeFeature},
+		{ID: "tui-3", Title: "Write docs", Status: parser.StatusOpen, Priority: 1, IssueType: parser.TypeTask,
+			Dependencies: []*parser.Dependency{{IssueID: "tui-3", DependsOnID: "tui-2", Type: parser.DepBlocks}}},
+		{ID: "tui-4", Title: "Old", Status: parser.StatusClosed, Priority: 1, IssueType: parser.TypeBug},
+	})
+	return appState
+}
+
+func TestBuildStatusReport(t *testing.T) {
+	now := time.Date(2026, 3, 1, 9, 0, 0, 0, time.UTC)
+	report := buildStatusReport(reportTestState(), "demo", "", now)
+
+	if len(report.InProgress) != 1 || len(report.Ready) != 1 || len(report.Blocked) != 1 {
+		t.Fatalf("unexpected breakdown: %+v", report)
+	}
+	if got := report.Blocked[0]; got.ID != "tui-3" || len(got.BlockedBy) != 1 || got.BlockedBy[0] != "tui-2" {
+		t.Errorf("blocked issue = %+v, want tui-3 blocked by tui-2", got)
+	}
+
+	// The quick filter narrows every section
+	filtered := reportTestState()
+	filtered.ApplyFilterQuery("p0,p1 bug")
+	report = buildStatusReport(filtered, "demo", " p0,p1 bug ", now)
+	if len(report.InProgress) != 1 || len(report.Ready) != 0 || len(report.Blocked) != 0 || report.Filter != "p0,p1 bug" {
+		t.Errorf("filtered report = %+v", report)
+	}
+}
+
+func TestRenderReport(t *testing.T) {
+	report := buildStatusReport(reportTestState(), "demo", "", time.Now())
+
+	text := renderReportText(report)
+	for _, want := range []string{
+		"demo: 1 in progress, 1 ready, 1 blocked\n",
+		"\nIN PROGRESS (1)\n  tui-1  P0  bug      Fix crash @ada\n",
+		"  tui-3  P1  task     Write docs (blocked by tui-2)\n",
+	} {
+		if !strings.Contains(text, want) {
+			t.Errorf("text report missing %q:\n%s", want, text)
+		}
+	}
+
+	markdown := renderReportMarkdown(report)
+	for _, want := range []string{"# demo status\n", "\n## Ready (1)\n\n- **tui-2** P2 feature: Add export\n"} {
+		if !strings.Contains(markdown, want) {
+			t.Errorf("markdown report missing %q:\n%s", want, markdown)
+		}
+	}
+
+	var buf bytes.Buffer
+	if err := json.NewEncoder(&buf).Encode(report); err != nil {
+		t.Fatal(err)
+	}
+	var decoded map[string]any
+	if err := json.Unmarshal(buf.Bytes(), &decoded); err != nil {
+		t.Fatal(err)
+	}
+	for _, key := range []string{"project", "generated_at", "in_progress", "ready", "blocked"} {
+		if _, ok := decoded[key]; !ok {
+			t.Errorf("JSON report missing %q: %s", key, buf.String())
+		}
+	}
+}
+
+func TestRunReportCommand_BadFormat(t *testing.T) {
+	var stdout, stderr bytes.Buffer
+	if code := runReportCommand([]string{"--format", "xml"}, &stdout, &stderr); code != 2 {
+		t.Errorf("expected exit code 2, got %d", code)
+	}
+	if !strings.Contains(stderr.String(), "unknown format") {
+		t.Errorf("expected an unknown format error, got %q", stderr.String())
+	}
+}
@@ -0,0 +1,41 @@
+package main
+
+import "github.com/andy/beads-tui/internal/ui"
+
+// sectionAt returns the index of the section the row is in, or -1 if the row
+// is above the first section (e.g. the filter banner)
+func sectionAt(sections []ui.Section, row int) int {
+	current := -1
+	for i, section := range sections {
+		if section.Row > row {
+			break
+		}
+		current = i
+	}
+	return current
+}
+
+// sectionJump picks the section ]] (offset 1) or [[ (offset -1) moves to from
+// the selected row. Like vim's [[, moving back from below a section's first
+// issue goes to that section's start first. ok is false at either end.
+func sectionJump(sections []ui.Section, row, offset int) (target int, ok bool) {
+	current := sectionAt(sections, row)
+	switch {
+	case offset > 0:
+		target = current + 1
+	case current >= 0 && row > firstSectionRow(sections[current]):
+		target = current
+	default:
+		target = current - 1
+	}
+	if target < 0 || target >= len(sections) {
+		return 0, false
+	}
+	return target, true
+}
+
+// firstSectionRow is the row of a section's first issue, just below its
+// header
+func firstSectionRow(section ui.Section) int {
+	return section.Row + 1
+}
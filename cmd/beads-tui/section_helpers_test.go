@@ -0,0 +1,45 @@
+package main
+
+import (
+	"testing"
+
+	"github.com/andy/beads-tui/internal/ui"
+)
+
+func TestSectionJump(t *testing.T) {
+	// A filter banner on row 0, then three sections of 3, 2, and 4 issues
+	sections := []ui.Section{
+		{Title: "IN PROGRESS", Row: 1, Shown: 3},
+		{Title: "READY", Row: 5, Shown: 2},
+		{Title: "BLOCKED", Row: 8, Shown: 4},
+	}
+
+	tests := []struct {
+		name   string
+		row    int
+		offset int
+		want   int
+		wantOK bool
+	}{
+		{"next from the banner", 0, 1, 0, true},
+		{"next from a section's first issue", 2, 1, 1, true},
+		{"next from inside a section", 6, 1, 2, true},
+		{"next from the last section", 10, 1, 0, false},
+		{"back from inside a section goes to its start", 11, -1, 2, true},
+		{"back from a section's first issue", 9, -1, 1, true},
+		{"back from the first section's first issue", 2, -1, 0, false},
+		{"back from the banner", 0, -1, 0, false},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, ok := sectionJump(sections, tt.row, tt.offset)
+			if got != tt.want || ok != tt.wantOK {
+				t.Errorf("sectionJump(row %d, %d) = %d, %v; want %d, %v", tt.row, tt.offset, got, ok, tt.want, tt.wantOK)
+			}
+		})
+	}
+
+	if _, ok := sectionJump(nil, 0, 1); ok {
+		t.Error("expected no jump without sections (tree view)")
+	}
+}
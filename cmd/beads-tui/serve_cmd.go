@@ -0,0 +1,262 @@
+package main
+
+import (
+	"context"
+	"errors"
+	"flag"
+	"fmt"
+	"html"
+	"io"
+	"log"
+	"net/http"
+	"net/url"
+	"path/filepath"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/andy/beads-tui/internal/app"
+	"github.com/andy/beads-tui/internal/formatting"
+	"github.com/andy/beads-tui/internal/parser"
+	"github.com/andy/beads-tui/internal/state"
+	"github.com/andy/beads-tui/internal/storage"
+	"github.com/andy/beads-tui/internal/ui"
+	"github.com/rivo/tview"
+)
+
+// serveRefreshSeconds is how often the served pages reload themselves
+const serveRefreshSeconds = 30
+
+// issueSource loads what the web view shows, fresh for every request
+type issueSource struct {
+	LoadIssues   func(ctx context.Context) ([]*parser.Issue, error)
+	LoadComments func(ctx context.Context, issueID string) ([]*parser.Comment, error)
+}
+
+// runServeCommand implements `beads-tui serve [--addr host:port] [--path dir
+// | --db file]`, serving a read-only web page of the issue list and details
+// for teammates without beads-tui. Returns the process exit code.
+func runServeCommand(args []string, stdout, stderr io.Writer) int {
+	fs := flag.NewFlagSet("serve", flag.ContinueOnError)
+	fs.SetOutput(stderr)
+	addr := fs.String("addr", "127.0.0.1:8080", "Address to listen on (use :8080 to accept other machines)")
+	projectPath := fs.String("path", "", "Project directory or .beads directory (default: search up from the current directory)")
+	dbFile := fs.String("db", "", "Beads database file to serve")
+	fs.Usage = func() {
+		fmt.Fprintln(stderr, "Usage: beads-tui serve [--addr host:port] [--path dir | --db file]")
+		fmt.Fprintln(stderr, "")
+		fmt.Fprintln(stderr, "Serves a read-only web page of the issue list and issue details,")
+		fmt.Fprintln(stderr, "read from the database on every request.")
+		fs.PrintDefaults()
+	}
+	if err := fs.Parse(args); err != nil {
+		return 2
+	}
+
+	// Keep library logging (storage reconnects etc.) off the terminal
+	log.SetOutput(io.Discard)
+
+	if *projectPath != "" && *dbFile != "" {
+		fmt.Fprintln(stderr, "Error: use either --path or --db, not both")
+		return 2
+	}
+	beadsDir, dbPath, err := findProjectDatabase(*projectPath, *dbFile)
+	if err != nil {
+		fmt.Fprintf(stderr, "Error: %v\n", err)
+		return 1
+	}
+
+	reader, err := storage.NewSQLiteReader(dbPath)
+	if err != nil {
+		fmt.Fprintf(stderr, "Error opening database: %v\n", err)
+		return 1
+	}
+	defer reader.Close()
+
+	project := filepath.Base(filepath.Dir(beadsDir))
+	handler := newServeHandler(issueSource{
+		LoadIssues:   reader.LoadIssues,
+		LoadComments: reader.LoadComments,
+	}, project, stderr)
+	server := &http.Server{Addr: *addr, Handler: handler, ReadHeaderTimeout: 10 * time.Second}
+
+	fmt.Fprintf(stdout, "Serving %s read-only at http://%s/ (Ctrl-C to stop)\n", project, *addr)
+	if err := server.ListenAndServe(); err != nil && !errors.Is(err, http.ErrServerClosed) {
+		fmt.Fprintf(stderr, "Error: %v\n", err)
+		return 1
+	}
+	return 0
+}
+
+// findProjectDatabase finds the .beads directory and database for the
+// subcommands' --path and --db flags (at most one set), searching up from
+// the current directory when neither is
+func findProjectDatabase(projectPath, dbFile string) (beadsDir, dbPath string, err error) {
+	target := dbFile
+	if target == "" {
+		target = projectPath
+	}
+	if target != "" {
+		return app.ResolveBeadsPath(target)
+	}
+	if beadsDir, err = app.FindBeadsDir(); err != nil {
+		return "", "", err
+	}
+	return beadsDir, filepath.Join(beadsDir, "beads.db"), nil
+}
+
+// newServeHandler serves the issue list at / (?view=tree for the dependency
+// tree, ?closed=1 to include closed issues) and each issue's details at
+// /issue/<id>, as the TUI formats them with the colors stripped. Errors are
+// reported to errOut.
+func newServeHandler(source issueSource, project string, errOut io.Writer) http.Handler {
+	var mu sync.Mutex // One database read at a time
+
+	loadState := func(r *http.Request) (*state.State, error) {
+		mu.Lock()
+		defer mu.Unlock()
+		ctx, cancel := context.WithTimeout(r.Context(), dbLoadTimeout)
+		defer cancel()
+		issues, err := source.LoadIssues(ctx)
+		if err != nil {
+			return nil, err
+		}
+		appState := state.New()
+		if r.URL.Query().Get("view") == "tree" {
+			appState.SetViewMode(state.ViewTree)
+		}
+		appState.LoadIssues(issues)
+		return appState, nil
+	}
+	fail := func(w http.ResponseWriter, err error) {
+		fmt.Fprintf(errOut, "serve: %v\n", err)
+		http.Error(w, "Failed to read the beads database: "+err.Error(), http.StatusInternalServerError)
+	}
+
+	mux := http.NewServeMux()
+	mux.HandleFunc("GET /{$}", func(w http.ResponseWriter, r *http.Request) {
+		appState, err := loadState(r)
+		if err != nil {
+			fail(w, err)
+			return
+		}
+		showClosed := r.URL.Query().Get("closed") == "1"
+		indexToIssue := make(map[int]*parser.Issue)
+		rows, _ := ui.BuildIssueListRows(appState, ui.ListOptions{ShowClosedIssues: showClosed, ShowPrefix: true}, indexToIssue)
+
+		var body strings.Builder
+		body.WriteString(serveNav(r.URL.Query()))
+		body.WriteString("<pre>")
+		for i, row := range rows {
+			text := row.Text
+			if row.Format != nil {
+				text = row.Format()
+			}
+			text = html.EscapeString(stripMarkup(text))
+			if issue, ok := indexToIssue[i]; ok {
+				text = fmt.Sprintf(`<a href="%s">%s</a>`, serveLink("/issue/"+url.PathEscape(issue.ID), r.URL.Query()), text)
+			}
+			body.WriteString(text + "\n")
+		}
+		body.WriteString("</pre>")
+		writeServePage(w, project, body.String())
+	})
+	mux.HandleFunc("GET /issue/{id}", func(w http.ResponseWriter, r *http.Request) {
+		appState, err := loadState(r)
+		if err != nil {
+			fail(w, err)
+			return
+		}
+		issue := appState.GetIssueByID(r.PathValue("id"))
+		if issue == nil {
+			http.NotFound(w, r)
+			return
+		}
+
+		ctx, cancel := context.WithTimeout(r.Context(), commentLoadTimeout)
+		defer cancel()
+		loaded := *issue
+		mu.Lock()
+		comments, err := source.LoadComments(ctx, issue.ID)
+		mu.Unlock()
+		if err != nil {
+			fmt.Fprintf(errOut, "serve: comments for %s: %v\n", issue.ID, err)
+		} else {
+			loaded.Comments = comments
+		}
+		details := formatting.FormatIssueDetails(&loaded, formatting.NewDetailContext(appState, issue, time.Now()))
+
+		body := fmt.Sprintf(`<p><a href="%s">← All issues</a></p><pre>%s</pre>`,
+			serveLink("/", r.URL.Query()), html.EscapeString(stripMarkup(details)))
+		writeServePage(w, issue.ID+" "+issue.Title+" · "+project, body)
+	})
+	return mux
+}
+
+// serveQuery keeps the list view options (view, closed) from a request's
+// query, for links that should show the list the same way
+func serveQuery(query url.Values) url.Values {
+	kept := url.Values{}
+	for _, key := range []string{"view", "closed"} {
+		if value := query.Get(key); value != "" {
+			kept.Set(key, value)
+		}
+	}
+	return kept
+}
+
+// serveLink returns path with the request's list view options
+func serveLink(path string, query url.Values) string {
+	if kept := serveQuery(query).Encode(); kept != "" {
+		return path + "?" + kept
+	}
+	return path
+}
+
+// serveNav links the list view options, keeping the others as they are
+func serveNav(query url.Values) string {
+	link := func(label, key, value string) string {
+		if query.Get(key) == value {
+			return "<b>" + label + "</b>"
+		}
+		q := serveQuery(query)
+		if value == "" {
+			q.Del(key)
+		} else {
+			q.Set(key, value)
+		}
+		return fmt.Sprintf(`<a href="%s">%s</a>`, serveLink("/", q), label)
+	}
+	return "<p>" + link("List", "view", "") + " · " + link("Tree", "view", "tree") +
+		" | " + link("Open", "closed", "") + " · " + link("With closed", "closed", "1") + "</p>"
+}
+
+// writeServePage writes a served page that reloads itself
+func writeServePage(w http.ResponseWriter, title, body string) {
+	w.Header().Set("Content-Type", "text/html; charset=utf-8")
+	fmt.Fprintf(w, `<!DOCTYPE html>
+<html>
+<head>
+<meta charset="utf-8">
+<meta http-equiv="refresh" content="%d">
+<title>%s</title>
+<style>
+body { font-family: ui-monospace, monospace; margin: 1em 2em; }
+pre { white-space: pre-wrap; }
+a { color: inherit; text-decoration: none; }
+pre a:hover { text-decoration: underline; }
+</style>
+</head>
+<body>
+%s
+<p><small>Read-only view from beads-tui, reloads every %d seconds</small></p>
+</body>
+</html>
+`, serveRefreshSeconds, html.EscapeString(title), body, serveRefreshSeconds)
+}
+
+// stripMarkup removes tview color and region tags, leaving the text a
+// TextView would show
+func stripMarkup(text string) string {
+	return tview.NewTextView().SetDynamicColors(true).SetRegions(true).SetText(text).GetText(true)
+}
@@ -0,0 +1,98 @@
+package main
+
+import (
+	"bytes"
+	"context"
+	"errors"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/andy/beads-tui/internal/parser"
+)
+
+func serveTestSource() issueSource {
+	now := time.Date(2026, 3, 1, 12, 0, 0, 0, time.UTC)
+	closedAt := now
+	return issueSource{
+		LoadIssues: func(ctx context.Context) ([]*parser.Issue, error) {
+			return []*parser.Issue{
+				{ID: "tui-1", Title: "Ship <the> thing", Status: parser.StatusOpen, Priority: 1, IssueType: parser.TypeFeature, CreatedAt: now, UpdatedAt: now},
+				{ID: "tui-2", Title: "Old work", Status: parser.StatusClosed, Priority: 2, IssueType: parser.TypeTask, CreatedAt: now, UpdatedAt: now, ClosedAt: &closedAt},
+			}, nil
+		},
+		LoadComments: func(ctx context.Context, issueID string) ([]*parser.Comment, error) {
+			return []*parser.Comment{{ID: 1, IssueID: issueID, Author: "ada", Text: "Looks good", CreatedAt: now}}, nil
+		},
+	}
+}
+
+func serveGet(t *testing.T, handler http.Handler, method, target string) (int, string) {
+	t.Helper()
+	rec := httptest.NewRecorder()
+	handler.ServeHTTP(rec, httptest.NewRequest(method, target, nil))
+	body, _ := io.ReadAll(rec.Body)
+	return rec.Code, string(body)
+}
+
+func TestServeHandler(t *testing.T) {
+	handler := newServeHandler(serveTestSource(), "demo", io.Discard)
+
+	code, body := serveGet(t, handler, http.MethodGet, "/")
+	if code != http.StatusOK {
+		t.Fatalf("GET / = %d", code)
+	}
+	for _, want := range []string{`<a href="/issue/tui-1">`, "Ship &lt;the&gt; thing", "READY (1)"} {
+		if !strings.Contains(body, want) {
+			t.Errorf("list page missing %q:\n%s", want, body)
+		}
+	}
+	if strings.Contains(body, "Old work") || strings.Contains(body, "[::b]") {
+		t.Errorf("list page should hide closed issues and strip markup:\n%s", body)
+	}
+
+	_, body = serveGet(t, handler, http.MethodGet, "/?closed=1&evil=%22%3E")
+	if !strings.Contains(body, "Old work") || !strings.Contains(body, `href="/issue/tui-2?closed=1"`) {
+		t.Errorf("expected closed issues linked with the view options kept:\n%s", body)
+	}
+	if strings.Contains(body, `">`+"\"") || strings.Contains(body, "evil") {
+		t.Errorf("unknown query parameters should be dropped from links:\n%s", body)
+	}
+
+	code, body = serveGet(t, handler, http.MethodGet, "/issue/tui-1?closed=1")
+	if code != http.StatusOK {
+		t.Fatalf("GET /issue/tui-1 = %d", code)
+	}
+	for _, want := range []string{"tui-1", "Looks good", `href="/?closed=1"`} {
+		if !strings.Contains(body, want) {
+			t.Errorf("detail page missing %q:\n%s", want, body)
+		}
+	}
+
+	if code, _ := serveGet(t, handler, http.MethodGet, "/issue/tui-9"); code != http.StatusNotFound {
+		t.Errorf("GET unknown issue = %d, want 404", code)
+	}
+	if code, _ := serveGet(t, handler, http.MethodPost, "/"); code != http.StatusMethodNotAllowed {
+		t.Errorf("POST / = %d, want 405", code)
+	}
+}
+
+func TestServeHandlerLoadError(t *testing.T) {
+	var errOut bytes.Buffer
+	handler := newServeHandler(issueSource{
+		LoadIssues: func(ctx context.Context) ([]*parser.Issue, error) {
+			return nil, errors.New("database is locked")
+		},
+	}, "demo", &errOut)
+
+	code, body := serveGet(t, handler, http.MethodGet, "/")
+	if code != http.StatusInternalServerError || !strings.Contains(body, "database is locked") {
+		t.Errorf("GET / with a failing database = %d %q", code, body)
+	}
+	if !strings.Contains(errOut.String(), "database is locked") {
+		t.Errorf("expected the error reported, got %q", errOut.String())
+	}
+}
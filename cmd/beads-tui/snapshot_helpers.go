@@ -0,0 +1,265 @@
+package main
+
+import (
+	"fmt"
+	"slices"
+	"sort"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/andy/beads-tui/internal/formatting"
+	"github.com/andy/beads-tui/internal/parser"
+)
+
+// snapshotHistoryLimit is how many refreshes' worth of issues are kept for
+// the snapshot diff (gv)
+const snapshotHistoryLimit = 10
+
+// issueSnapshot is the issues as one refresh loaded them
+type issueSnapshot struct {
+	Taken  time.Time
+	Issues map[string]*parser.Issue
+}
+
+// snapshotHistory keeps the issues loaded by the last few refreshes, so gv
+// can show how one changed between them. An issue unchanged since the
+// previous snapshot shares its copy, so memory grows with the changes
+// rather than the issue count. Safe for concurrent use: the refresher
+// records while the UI reads.
+type snapshotHistory struct {
+	limit int
+
+	mu        sync.Mutex
+	snapshots []issueSnapshot // Oldest first
+}
+
+// newSnapshotHistory keeps the last limit snapshots
+func newSnapshotHistory(limit int) *snapshotHistory {
+	return &snapshotHistory{limit: limit}
+}
+
+// Record adds the issues a refresh loaded, taken at the given time,
+// dropping the oldest snapshot once there are more than the limit
+func (h *snapshotHistory) Record(issues []*parser.Issue, taken time.Time) {
+	h.mu.Lock()
+	defer h.mu.Unlock()
+
+	var previous map[string]*parser.Issue
+	if len(h.snapshots) > 0 {
+		previous = h.snapshots[len(h.snapshots)-1].Issues
+	}
+	snapshot := issueSnapshot{Taken: taken, Issues: make(map[string]*parser.Issue, len(issues))}
+	for _, issue := range issues {
+		if old := previous[issue.ID]; old != nil && old.UpdatedAt.Equal(issue.UpdatedAt) && len(issueFieldChanges(old, issue)) == 0 {
+			snapshot.Issues[issue.ID] = old
+			continue
+		}
+		// Copied, so later changes to the loaded issue don't rewrite history
+		issueCopy := *issue
+		issueCopy.Labels = slices.Clone(issue.Labels)
+		issueCopy.Dependencies = slices.Clone(issue.Dependencies)
+		snapshot.Issues[issue.ID] = &issueCopy
+	}
+	h.snapshots = append(h.snapshots, snapshot)
+	if len(h.snapshots) > h.limit {
+		h.snapshots = slices.Delete(h.snapshots, 0, len(h.snapshots)-h.limit)
+	}
+}
+
+// Span returns how many snapshots are kept and when the oldest was taken
+func (h *snapshotHistory) Span() (count int, oldest time.Time) {
+	h.mu.Lock()
+	defer h.mu.Unlock()
+	if len(h.snapshots) == 0 {
+		return 0, time.Time{}
+	}
+	return len(h.snapshots), h.snapshots[0].Taken
+}
+
+// issueRevision is how an issue changed between two snapshots: it was seen
+// one way at Since and another at At
+type issueRevision struct {
+	Since   time.Time
+	At      time.Time
+	Changes []fieldChange
+}
+
+// Revisions returns how the issue changed across the kept snapshots, newest
+// first. Snapshots the issue is missing from (filtered out in the query, or
+// deleted) are skipped over.
+func (h *snapshotHistory) Revisions(issueID string) []issueRevision {
+	h.mu.Lock()
+	defer h.mu.Unlock()
+
+	var revisions []issueRevision
+	var last *parser.Issue
+	var lastTaken time.Time
+	for _, snapshot := range h.snapshots {
+		issue := snapshot.Issues[issueID]
+		if issue == nil {
+			continue
+		}
+		if last != nil && issue != last {
+			changes := issueFieldChanges(last, issue)
+			if len(changes) == 0 {
+				changes = []fieldChange{{Field: "Updated", Old: formatSnapshotTime(last.UpdatedAt), New: formatSnapshotTime(issue.UpdatedAt)}}
+			}
+			revisions = append(revisions, issueRevision{Since: lastTaken, At: snapshot.Taken, Changes: changes})
+		}
+		last, lastTaken = issue, snapshot.Taken
+	}
+	slices.Reverse(revisions)
+	return revisions
+}
+
+// fieldChange is one field of an issue that changed between two snapshots.
+// Text marks the multi-line fields, shown as the lines removed and added.
+type fieldChange struct {
+	Field string
+	Old   string
+	New   string
+	Text  bool
+}
+
+// issueFieldChanges lists the fields that differ between two versions of an
+// issue, in the order the details panel shows them. updated_at isn't one:
+// it changes with everything else.
+func issueFieldChanges(old, issue *parser.Issue) []fieldChange {
+	var changes []fieldChange
+	add := func(field, before, after string, text bool) {
+		if before != after {
+			changes = append(changes, fieldChange{Field: field, Old: before, New: after, Text: text})
+		}
+	}
+	add("Title", old.Title, issue.Title, false)
+	add("Status", string(old.Status), string(issue.Status), false)
+	add("Priority", fmt.Sprintf("P%d", old.Priority), fmt.Sprintf("P%d", issue.Priority), false)
+	add("Type", string(old.IssueType), string(issue.IssueType), false)
+	add("Assignee", old.Assignee, issue.Assignee, false)
+	add("Estimate", snapshotEstimate(old.EstimatedMinutes), snapshotEstimate(issue.EstimatedMinutes), false)
+	add("Due", snapshotDate(old.DueDate), snapshotDate(issue.DueDate), false)
+	add("External ref", snapshotString(old.ExternalRef), snapshotString(issue.ExternalRef), false)
+	add("Labels", snapshotLabels(old.Labels), snapshotLabels(issue.Labels), false)
+	add("Dependencies", snapshotDependencies(old.Dependencies), snapshotDependencies(issue.Dependencies), false)
+	add("Description", old.Description, issue.Description, true)
+	add("Design", old.Design, issue.Design, true)
+	add("Acceptance", old.AcceptanceCriteria, issue.AcceptanceCriteria, true)
+	add("Notes", old.Notes, issue.Notes, true)
+	return changes
+}
+
+// lineChanges returns the lines of a text field removed and added between
+// two versions, in the order they appear. Lines are matched by content
+// (each occurrence once), not by position, so moved lines don't show.
+func lineChanges(old, updated string) (removed, added []string) {
+	split := func(text string) []string {
+		if text == "" {
+			return nil
+		}
+		return strings.Split(text, "\n")
+	}
+	oldLines, newLines := split(old), split(updated)
+
+	remaining := make(map[string]int, len(newLines))
+	for _, line := range newLines {
+		remaining[line]++
+	}
+	for _, line := range oldLines {
+		if remaining[line] > 0 {
+			remaining[line]--
+		} else {
+			removed = append(removed, line)
+		}
+	}
+	remaining = make(map[string]int, len(oldLines))
+	for _, line := range oldLines {
+		remaining[line]++
+	}
+	for _, line := range newLines {
+		if remaining[line] > 0 {
+			remaining[line]--
+		} else {
+			added = append(added, line)
+		}
+	}
+	return removed, added
+}
+
+// revisionAuthors guesses who made a revision: the actors of the audit
+// trail events between its two snapshots, or failing that (older bd
+// versions have no events) the authors of comments made then
+func revisionAuthors(revision issueRevision, events []*parser.Event, comments []*parser.Comment) []string {
+	var authors []string
+	within := func(t time.Time) bool {
+		return t.After(revision.Since) && !t.After(revision.At)
+	}
+	for _, event := range events {
+		if within(event.CreatedAt) && event.Actor != "" && !slices.Contains(authors, event.Actor) {
+			authors = append(authors, event.Actor)
+		}
+	}
+	if len(authors) > 0 {
+		return authors
+	}
+	for _, comment := range comments {
+		if within(comment.CreatedAt) && comment.Author != "" && !slices.Contains(authors, comment.Author) {
+			authors = append(authors, comment.Author)
+		}
+	}
+	return authors
+}
+
+// formatSnapshotTime formats when a snapshot was taken: the time today,
+// with the date before that
+func formatSnapshotTime(t time.Time) string {
+	if t.IsZero() {
+		return ""
+	}
+	local := t.Local()
+	if local.Format(time.DateOnly) == time.Now().Format(time.DateOnly) {
+		return local.Format("15:04:05")
+	}
+	return local.Format("Jan 2 15:04")
+}
+
+// snapshotEstimate renders an estimate for comparison ("" for none)
+func snapshotEstimate(minutes *int) string {
+	if minutes == nil {
+		return ""
+	}
+	return formatting.FormatMinutes(*minutes)
+}
+
+// snapshotDate renders a due date for comparison ("" for none)
+func snapshotDate(t *time.Time) string {
+	if t == nil {
+		return ""
+	}
+	return t.Format("2006-01-02")
+}
+
+// snapshotString renders an optional string for comparison
+func snapshotString(s *string) string {
+	if s == nil {
+		return ""
+	}
+	return *s
+}
+
+// snapshotLabels renders labels for comparison, in any order
+func snapshotLabels(labels []string) string {
+	sorted := slices.Clone(labels)
+	sort.Strings(sorted)
+	return strings.Join(sorted, ", ")
+}
+
+// snapshotDependencies renders dependencies for comparison, in any order
+func snapshotDependencies(deps []*parser.Dependency) string {
+	parts := make([]string, 0, len(deps))
+	for _, dep := range deps {
+		parts = append(parts, fmt.Sprintf("%s %s", dep.Type, dep.DependsOnID))
+	}
+	sort.Strings(parts)
+	return strings.Join(parts, ", ")
+}
@@ -0,0 +1,112 @@
+package main
+
+import (
+	"slices"
+	"testing"
+	"time"
+
+	"github.com/andy/beads-tui/internal/parser"
+)
+
+func TestSnapshotHistoryRevisions(t *testing.T) {
+	base := time.Date(2026, 1, 1, 9, 0, 0, 0, time.UTC)
+	history := newSnapshotHistory(3)
+
+	issue := &parser.Issue{ID: "tui-1", Title: "Login", Status: parser.StatusOpen, Priority: 2, UpdatedAt: base}
+	other := &parser.Issue{ID: "tui-2", Title: "Other", UpdatedAt: base}
+	history.Record([]*parser.Issue{issue, other}, base)
+
+	// The refresh loads new copies; editing the loaded one mustn't change
+	// what was recorded
+	started := *issue
+	started.Status, started.Priority, started.Labels, started.UpdatedAt = parser.StatusInProgress, 1, []string{"ui"}, base.Add(time.Minute)
+	history.Record([]*parser.Issue{&started, other}, base.Add(2*time.Minute))
+	started.Title = "Changed after recording"
+
+	// Filtered out of one refresh, then back with a new description
+	history.Record([]*parser.Issue{other}, base.Add(4*time.Minute))
+	described := &parser.Issue{ID: "tui-1", Title: "Login", Status: parser.StatusInProgress, Priority: 1, Labels: []string{"ui"},
+		Description: "Steps\nMore", UpdatedAt: base.Add(5 * time.Minute)}
+	history.Record([]*parser.Issue{described, other}, base.Add(6*time.Minute))
+
+	// Only the last three snapshots are kept: the first is gone, so the
+	// status change is too
+	if count, oldest := history.Span(); count != 3 || !oldest.Equal(base.Add(2*time.Minute)) {
+		t.Errorf("Span = %d, %v, want 3 since the second snapshot", count, oldest)
+	}
+	revisions := history.Revisions("tui-1")
+	if len(revisions) != 1 {
+		t.Fatalf("expected 1 revision, got %+v", revisions)
+	}
+	revision := revisions[0]
+	if !revision.Since.Equal(base.Add(2*time.Minute)) || !revision.At.Equal(base.Add(6*time.Minute)) {
+		t.Errorf("revision spans %v to %v, want the second to the fourth snapshot", revision.Since, revision.At)
+	}
+	if len(revision.Changes) != 1 || revision.Changes[0].Field != "Description" || !revision.Changes[0].Text {
+		t.Errorf("expected only the description to change (the title edit came after recording), got %+v", revision.Changes)
+	}
+	if got := history.Revisions("tui-2"); len(got) != 0 {
+		t.Errorf("expected no revisions of an unchanged issue, got %+v", got)
+	}
+}
+
+func TestSnapshotHistoryUpdatedOnly(t *testing.T) {
+	base := time.Date(2026, 1, 1, 9, 0, 0, 0, time.UTC)
+	history := newSnapshotHistory(snapshotHistoryLimit)
+	history.Record([]*parser.Issue{{ID: "tui-1", UpdatedAt: base}}, base)
+	history.Record([]*parser.Issue{{ID: "tui-1", UpdatedAt: base.Add(time.Hour)}}, base.Add(time.Hour))
+
+	revisions := history.Revisions("tui-1")
+	if len(revisions) != 1 || len(revisions[0].Changes) != 1 || revisions[0].Changes[0].Field != "Updated" {
+		t.Errorf("expected a bare update to show as one, got %+v", revisions)
+	}
+}
+
+func TestIssueFieldChanges(t *testing.T) {
+	estimate := 90
+	due := time.Date(2026, 2, 1, 0, 0, 0, 0, time.UTC)
+	old := &parser.Issue{Title: "A", Status: parser.StatusOpen, Priority: 2, Labels: []string{"b", "a"},
+		Dependencies: []*parser.Dependency{{DependsOnID: "tui-9", Type: parser.DepBlocks}}}
+	issue := &parser.Issue{Title: "A", Status: parser.StatusOpen, Priority: 2, Labels: []string{"a", "b"},
+		Assignee: "alice", EstimatedMinutes: &estimate, DueDate: &due, Notes: "n"}
+
+	var fields []string
+	for _, change := range issueFieldChanges(old, issue) {
+		fields = append(fields, change.Field)
+	}
+	// Labels in another order are the same labels
+	want := []string{"Assignee", "Estimate", "Due", "Dependencies", "Notes"}
+	if !slices.Equal(fields, want) {
+		t.Errorf("changed fields = %v, want %v", fields, want)
+	}
+}
+
+func TestLineChanges(t *testing.T) {
+	removed, added := lineChanges("one\ntwo\ntwo\nthree", "two\nthree\nfour")
+	if !slices.Equal(removed, []string{"one", "two"}) || !slices.Equal(added, []string{"four"}) {
+		t.Errorf("lineChanges = %q, %q", removed, added)
+	}
+	if removed, added := lineChanges("", "new"); len(removed) != 0 || !slices.Equal(added, []string{"new"}) {
+		t.Errorf("lineChanges from empty = %q, %q", removed, added)
+	}
+}
+
+func TestRevisionAuthors(t *testing.T) {
+	base := time.Date(2026, 1, 1, 9, 0, 0, 0, time.UTC)
+	revision := issueRevision{Since: base, At: base.Add(time.Hour)}
+	comments := []*parser.Comment{{Author: "carol", CreatedAt: base.Add(10 * time.Minute)}}
+
+	events := []*parser.Event{
+		{Actor: "alice", CreatedAt: base},                    // Before the window
+		{Actor: "bob", CreatedAt: base.Add(time.Minute)},     // In it
+		{Actor: "bob", CreatedAt: base.Add(2 * time.Minute)}, // Counted once
+		{Actor: "agent", CreatedAt: base.Add(time.Hour)},     // At its end
+		{Actor: "dave", CreatedAt: base.Add(2 * time.Hour)},  // After it
+	}
+	if got := revisionAuthors(revision, events, comments); !slices.Equal(got, []string{"bob", "agent"}) {
+		t.Errorf("revisionAuthors = %v, want [bob agent]", got)
+	}
+	if got := revisionAuthors(revision, nil, comments); !slices.Equal(got, []string{"carol"}) {
+		t.Errorf("revisionAuthors without events = %v, want the commenter", got)
+	}
+}
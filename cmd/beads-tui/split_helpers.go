@@ -0,0 +1,154 @@
+package main
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/andy/beads-tui/internal/parser"
+)
+
+// splitChildSpec describes one child issue entered in the split dialog
+type splitChildSpec struct {
+	Title     string
+	Priority  int
+	IssueType string
+}
+
+// Limits on how many children a single split may produce
+const (
+	minSplitChildren = 2
+	maxSplitChildren = 5
+)
+
+// parseSplitLines parses the split dialog text into child specs.
+// Each non-empty line is one child. Optional bracketed tags anywhere on the
+// line override priority ([p0]-[p4]) and type ([bug], [task], ...), e.g.:
+//
+//	[p1][bug] Fix crash on empty list
+//	Write migration guide [chore]
+//
+// Lines without tags inherit defaultPriority and defaultType.
+func parseSplitLines(text string, defaultPriority int, defaultType string) ([]splitChildSpec, error) {
+	var specs []splitChildSpec
+
+	for lineNum, line := range strings.Split(text, "\n") {
+		line = strings.TrimSpace(line)
+		if line == "" {
+			continue
+		}
+
+		spec := splitChildSpec{Priority: defaultPriority, IssueType: defaultType}
+		var titleParts []string
+
+		rest := line
+		for rest != "" {
+			open := strings.Index(rest, "[")
+			if open < 0 {
+				titleParts = append(titleParts, rest)
+				break
+			}
+			closeIdx := strings.Index(rest[open:], "]")
+			if closeIdx < 0 {
+				titleParts = append(titleParts, rest)
+				break
+			}
+			closeIdx += open
+
+			tag := strings.ToLower(strings.TrimSpace(rest[open+1 : closeIdx]))
+			if applySplitTag(&spec, tag) {
+				titleParts = append(titleParts, rest[:open])
+			} else {
+				// Unknown tag - keep it as part of the title
+				titleParts = append(titleParts, rest[:closeIdx+1])
+			}
+			rest = rest[closeIdx+1:]
+		}
+
+		spec.Title = strings.Join(strings.Fields(strings.Join(titleParts, " ")), " ")
+		if spec.Title == "" {
+			return nil, fmt.Errorf("line %d has no title", lineNum+1)
+		}
+		specs = append(specs, spec)
+	}
+
+	if len(specs) < minSplitChildren || len(specs) > maxSplitChildren {
+		return nil, fmt.Errorf("enter %d-%d child issues (one per line), got %d", minSplitChildren, maxSplitChildren, len(specs))
+	}
+
+	return specs, nil
+}
+
+// applySplitTag applies a recognized [tag] to the spec and reports whether it was recognized
+func applySplitTag(spec *splitChildSpec, tag string) bool {
+	if len(tag) == 2 && tag[0] == 'p' && tag[1] >= '0' && tag[1] <= '4' {
+		spec.Priority = int(tag[1] - '0')
+		return true
+	}
+	switch parser.IssueType(tag) {
+	case parser.TypeBug, parser.TypeFeature, parser.TypeTask, parser.TypeEpic, parser.TypeChore:
+		spec.IssueType = tag
+		return true
+	}
+	return false
+}
+
+// splitStopWords are ignored when matching parent sections to child titles
+var splitStopWords = map[string]bool{
+	"the": true, "and": true, "for": true, "with": true, "from": true,
+	"into": true, "that": true, "this": true, "add": true, "issue": true,
+	"should": true, "when": true, "then": true, "have": true, "make": true,
+}
+
+// splitKeywords returns the significant lowercase words of a title
+func splitKeywords(title string) []string {
+	var keywords []string
+	for _, word := range strings.FieldsFunc(strings.ToLower(title), func(r rune) bool {
+		return !(r >= 'a' && r <= 'z' || r >= '0' && r <= '9' || r == '_')
+	}) {
+		if len(word) >= 3 && !splitStopWords[word] {
+			keywords = append(keywords, word)
+		}
+	}
+	return keywords
+}
+
+// relevantParentSections returns the paragraphs of the parent's description,
+// design, and acceptance criteria that mention any keyword from the child title.
+// Paragraphs are separated by blank lines; a markdown heading keeps the
+// paragraph that follows it together.
+func relevantParentSections(parent *parser.Issue, childTitle string) []string {
+	keywords := splitKeywords(childTitle)
+	if len(keywords) == 0 {
+		return nil
+	}
+
+	var sections []string
+	for _, field := range []string{parent.Description, parent.Design, parent.AcceptanceCriteria} {
+		for _, paragraph := range strings.Split(field, "\n\n") {
+			paragraph = strings.TrimSpace(paragraph)
+			if paragraph == "" {
+				continue
+			}
+			lower := strings.ToLower(paragraph)
+			for _, kw := range keywords {
+				if strings.Contains(lower, kw) {
+					sections = append(sections, paragraph)
+					break
+				}
+			}
+		}
+	}
+	return sections
+}
+
+// buildSplitChildDescription builds the description for a child created by a split
+func buildSplitChildDescription(parent *parser.Issue, childTitle string, copySections bool) string {
+	desc := fmt.Sprintf("Split from %s: %s", parent.ID, parent.Title)
+	if !copySections {
+		return desc
+	}
+	if sections := relevantParentSections(parent, childTitle); len(sections) > 0 {
+		desc += "\n\n" + strings.Join(sections, "\n\n")
+	}
+	return desc
+}
@@ -0,0 +1,88 @@
+package main
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/andy/beads-tui/internal/parser"
+)
+
+func TestParseSplitLines(t *testing.T) {
+	text := `[p1][bug] Fix crash on empty list
+
+Write migration guide [chore]
+Plain child`
+
+	specs, err := parseSplitLines(text, 2, "task")
+	if err != nil {
+		t.Fatalf("parseSplitLines failed: %v", err)
+	}
+	if len(specs) != 3 {
+		t.Fatalf("expected 3 specs, got %d", len(specs))
+	}
+
+	if specs[0].Title != "Fix crash on empty list" || specs[0].Priority != 1 || specs[0].IssueType != "bug" {
+		t.Errorf("unexpected first spec: %+v", specs[0])
+	}
+	if specs[1].Title != "Write migration guide" || specs[1].Priority != 2 || specs[1].IssueType != "chore" {
+		t.Errorf("unexpected second spec: %+v", specs[1])
+	}
+	if specs[2].Title != "Plain child" || specs[2].Priority != 2 || specs[2].IssueType != "task" {
+		t.Errorf("unexpected third spec: %+v", specs[2])
+	}
+}
+
+func TestParseSplitLines_UnknownTagKeptInTitle(t *testing.T) {
+	specs, err := parseSplitLines("Support [WIP] drafts [p3]\nSecond", 2, "task")
+	if err != nil {
+		t.Fatalf("parseSplitLines failed: %v", err)
+	}
+	if specs[0].Title != "Support [WIP] drafts" {
+		t.Errorf("expected unknown tag kept in title, got %q", specs[0].Title)
+	}
+	if specs[0].Priority != 3 {
+		t.Errorf("expected priority 3, got %d", specs[0].Priority)
+	}
+}
+
+func TestParseSplitLines_CountLimits(t *testing.T) {
+	if _, err := parseSplitLines("only one", 2, "task"); err == nil {
+		t.Error("expected error for a single child")
+	}
+	if _, err := parseSplitLines("a\nb\nc\nd\ne\nf", 2, "task"); err == nil {
+		t.Error("expected error for six children")
+	}
+	if _, err := parseSplitLines("[p1]\nsecond", 2, "task"); err == nil {
+		t.Error("expected error for a line with only tags")
+	}
+}
+
+func TestBuildSplitChildDescription(t *testing.T) {
+	parent := &parser.Issue{
+		ID:    "tui-abc",
+		Title: "Big feature",
+		Description: `Parser needs to handle streaming input.
+
+Renderer should support colors.`,
+		AcceptanceCriteria: "Streaming parser passes the benchmark.",
+	}
+
+	desc := buildSplitChildDescription(parent, "Streaming parser", true)
+	if !strings.HasPrefix(desc, "Split from tui-abc: Big feature") {
+		t.Errorf("expected reference to parent, got %q", desc)
+	}
+	if !strings.Contains(desc, "handle streaming input") {
+		t.Error("expected matching description paragraph to be copied")
+	}
+	if !strings.Contains(desc, "passes the benchmark") {
+		t.Error("expected matching acceptance criteria to be copied")
+	}
+	if strings.Contains(desc, "Renderer") {
+		t.Error("did not expect unrelated paragraph to be copied")
+	}
+
+	plain := buildSplitChildDescription(parent, "Streaming parser", false)
+	if plain != "Split from tui-abc: Big feature" {
+		t.Errorf("expected only the reference when copying is disabled, got %q", plain)
+	}
+}
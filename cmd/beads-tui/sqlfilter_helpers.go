@@ -0,0 +1,96 @@
+package main
+
+import (
+	"sync"
+
+	"github.com/andy/beads-tui/internal/parser"
+	"github.com/andy/beads-tui/internal/storage"
+)
+
+// defaultSQLFilterThreshold is the issue count above which the priority,
+// type, status, and label filters are applied in the database query, unless
+// the config sets sql_filter_threshold
+const defaultSQLFilterThreshold = 5000
+
+// sqlFilter decides when issues are loaded narrowed by the list filters
+// (storage.LoadIssuesFiltered) and remembers what the loaded issues were
+// read with, so a filter change can reload them. Safe for concurrent use:
+// the refresher loads while the UI changes filters.
+type sqlFilter struct {
+	threshold int // Issue count above which to filter in SQL; negative never does
+
+	mu     sync.Mutex
+	large  bool               // The database was over the threshold at the last load
+	loaded storage.FilterSpec // What the loaded issues were read with (empty for all)
+}
+
+// newSQLFilter returns a sqlFilter for the configured threshold (0 for the
+// default, negative to always load everything)
+func newSQLFilter(configured int) *sqlFilter {
+	if configured == 0 {
+		configured = defaultSQLFilterThreshold
+	}
+	return &sqlFilter{threshold: configured}
+}
+
+// enabled reports whether the issue count matters, i.e. whether the
+// database has to be counted before loading
+func (f *sqlFilter) enabled() bool {
+	return f.threshold >= 0
+}
+
+// spec returns what to load issues with given the active filters and how
+// many issues the database holds: the filters once it's over the
+// threshold, otherwise nothing (everything is loaded)
+func (f *sqlFilter) spec(filters storage.FilterSpec, count int) storage.FilterSpec {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+	f.large = f.enabled() && count > f.threshold
+	if !f.large {
+		return storage.FilterSpec{}
+	}
+	return filters
+}
+
+// setLoaded records what the issues just loaded were read with, and
+// reports whether that differs from the previous load (when the two sets
+// of issues can't be compared)
+func (f *sqlFilter) setLoaded(spec storage.FilterSpec) bool {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+	changed := !spec.Equal(f.loaded)
+	f.loaded = spec
+	return changed
+}
+
+// stale reports whether the issues need reloading for the active filters:
+// the database is large and they were read with different ones
+func (f *sqlFilter) stale(filters storage.FilterSpec) bool {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+	return f.large && !filters.Equal(f.loaded)
+}
+
+// active reports whether the loaded issues were narrowed by the filters
+func (f *sqlFilter) active() bool {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+	return !f.loaded.IsEmpty()
+}
+
+// stillLoaded returns the issues in before that are also in after. When
+// the filters decide what's loaded, an issue missing after a refresh has
+// most likely just stopped matching them rather than been deleted.
+func stillLoaded(before, after []*parser.Issue) []*parser.Issue {
+	loaded := make(map[string]bool, len(after))
+	for _, issue := range after {
+		loaded[issue.ID] = true
+	}
+	var kept []*parser.Issue
+	for _, issue := range before {
+		if loaded[issue.ID] {
+			kept = append(kept, issue)
+		}
+	}
+	return kept
+}
@@ -0,0 +1,48 @@
+package main
+
+import (
+	"reflect"
+	"testing"
+
+	"github.com/andy/beads-tui/internal/parser"
+	"github.com/andy/beads-tui/internal/storage"
+)
+
+func TestSQLFilter(t *testing.T) {
+	filters := storage.FilterSpec{Priorities: []int{0}}
+	f := newSQLFilter(0)
+
+	// Small databases are loaded whole, whatever the filters
+	if spec := f.spec(filters, defaultSQLFilterThreshold); !spec.IsEmpty() {
+		t.Errorf("expected an empty spec at the threshold, got %+v", spec)
+	}
+	if f.setLoaded(storage.FilterSpec{}) || f.stale(filters) || f.active() {
+		t.Error("expected nothing to change for a small database")
+	}
+
+	// Large ones are loaded filtered, and reloaded when the filters change
+	spec := f.spec(filters, defaultSQLFilterThreshold+1)
+	if !spec.Equal(filters) {
+		t.Errorf("expected the filters over the threshold, got %+v", spec)
+	}
+	if !f.setLoaded(spec) || !f.active() {
+		t.Error("expected the filtered load to be recorded as a change")
+	}
+	if f.stale(filters) {
+		t.Error("expected issues loaded with the same filters not to be stale")
+	}
+	if !f.stale(storage.FilterSpec{}) {
+		t.Error("expected clearing the filters to need a reload")
+	}
+
+	if spec := newSQLFilter(-1).spec(filters, 1_000_000); !spec.IsEmpty() {
+		t.Errorf("expected a negative threshold to turn SQL filtering off, got %+v", spec)
+	}
+}
+
+func TestStillLoaded(t *testing.T) {
+	a, b, c := &parser.Issue{ID: "tui-a"}, &parser.Issue{ID: "tui-b"}, &parser.Issue{ID: "tui-c"}
+	if got := stillLoaded([]*parser.Issue{a, b}, []*parser.Issue{b, c}); !reflect.DeepEqual(got, []*parser.Issue{b}) {
+		t.Errorf("expected only tui-b, got %v", got)
+	}
+}
@@ -0,0 +1,86 @@
+package main
+
+import (
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/andy/beads-tui/internal/parser"
+	"github.com/andy/beads-tui/internal/standup"
+)
+
+// defaultStandupWindow is how far back the standup overlay (gu) looks unless
+// the config sets standup_hours
+const defaultStandupWindow = 24 * time.Hour
+
+// standupWindows lists the windows Tab cycles through in the standup
+// overlay: the configured one, then three days (Monday standups) and a week
+func standupWindows(configured time.Duration) []time.Duration {
+	if configured <= 0 {
+		configured = defaultStandupWindow
+	}
+	windows := []time.Duration{configured}
+	for _, window := range []time.Duration{3 * 24 * time.Hour, 7 * 24 * time.Hour} {
+		if window > configured {
+			windows = append(windows, window)
+		}
+	}
+	return windows
+}
+
+// formatStandupWindow describes a window for the overlay title: "24h",
+// "3 days"
+func formatStandupWindow(window time.Duration) string {
+	if window >= 48*time.Hour && window%(24*time.Hour) == 0 {
+		return fmt.Sprintf("%d days", window/(24*time.Hour))
+	}
+	return fmt.Sprintf("%dh", int(window.Hours()))
+}
+
+// standupCategory is one list in an assignee's standup group
+type standupCategory struct {
+	Label  string
+	Issues []*parser.Issue
+	Stale  bool // Show when each issue was last updated
+}
+
+// standupCategories returns a group's lists in display order
+func standupCategories(group standup.Group) []standupCategory {
+	return []standupCategory{
+		{Label: "Closed", Issues: group.Closed},
+		{Label: "Started", Issues: group.Started},
+		{Label: "New", Issues: group.Created},
+		{Label: "Stale", Issues: group.Stale, Stale: true},
+	}
+}
+
+// standupMarkdown renders the summary for pasting into a standup thread or
+// notes, one heading per assignee
+func standupMarkdown(summary standup.Summary) string {
+	var sb strings.Builder
+	sb.WriteString(fmt.Sprintf("# Standup - since %s\n\n", summary.Since.Format("Mon Jan 2 15:04")))
+	if len(summary.Groups) == 0 {
+		sb.WriteString("No activity.\n")
+		return sb.String()
+	}
+	for i, group := range summary.Groups {
+		if i > 0 {
+			sb.WriteString("\n")
+		}
+		sb.WriteString(fmt.Sprintf("## %s\n", group.Assignee))
+		for _, category := range standupCategories(group) {
+			if len(category.Issues) == 0 {
+				continue
+			}
+			sb.WriteString(fmt.Sprintf("\n**%s**\n", category.Label))
+			for _, issue := range category.Issues {
+				line := fmt.Sprintf("- %s [P%d] %s", issue.ID, issue.Priority, issue.Title)
+				if category.Stale {
+					line += fmt.Sprintf(" (no update since %s)", issue.UpdatedAt.Format("Jan 2"))
+				}
+				sb.WriteString(line + "\n")
+			}
+		}
+	}
+	return sb.String()
+}
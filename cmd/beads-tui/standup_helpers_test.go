@@ -0,0 +1,80 @@
+package main
+
+import (
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/andy/beads-tui/internal/parser"
+	"github.com/andy/beads-tui/internal/standup"
+)
+
+func TestStandupWindows(t *testing.T) {
+	tests := []struct {
+		configured time.Duration
+		want       []time.Duration
+	}{
+		{0, []time.Duration{24 * time.Hour, 72 * time.Hour, 168 * time.Hour}},
+		{12 * time.Hour, []time.Duration{12 * time.Hour, 72 * time.Hour, 168 * time.Hour}},
+		{96 * time.Hour, []time.Duration{96 * time.Hour, 168 * time.Hour}},
+		{30 * 24 * time.Hour, []time.Duration{30 * 24 * time.Hour}},
+	}
+	for _, tt := range tests {
+		got := standupWindows(tt.configured)
+		if len(got) != len(tt.want) {
+			t.Errorf("standupWindows(%v) = %v, want %v", tt.configured, got, tt.want)
+			continue
+		}
+		for i := range got {
+			if got[i] != tt.want[i] {
+				t.Errorf("standupWindows(%v) = %v, want %v", tt.configured, got, tt.want)
+				break
+			}
+		}
+	}
+}
+
+func TestFormatStandupWindow(t *testing.T) {
+	tests := map[time.Duration]string{
+		24 * time.Hour:  "24h",
+		12 * time.Hour:  "12h",
+		72 * time.Hour:  "3 days",
+		168 * time.Hour: "7 days",
+		36 * time.Hour:  "36h",
+	}
+	for window, want := range tests {
+		if got := formatStandupWindow(window); got != want {
+			t.Errorf("formatStandupWindow(%v) = %q, want %q", window, got, want)
+		}
+	}
+}
+
+func TestStandupMarkdown(t *testing.T) {
+	since := time.Date(2026, 3, 2, 9, 0, 0, 0, time.UTC)
+	if got := standupMarkdown(standup.Summary{Since: since}); !strings.Contains(got, "No activity.") {
+		t.Errorf("expected no-activity note, got %q", got)
+	}
+
+	summary := standup.Summary{
+		Since: since,
+		Groups: []standup.Group{{
+			Assignee: "ada",
+			Closed:   []*parser.Issue{{ID: "tui-1", Title: "Fix login", Priority: 1}},
+			Stale:    []*parser.Issue{{ID: "tui-2", Title: "Refactor", Priority: 2, UpdatedAt: since.Add(-5 * 24 * time.Hour)}},
+		}},
+	}
+	got := standupMarkdown(summary)
+	for _, want := range []string{
+		"# Standup - since Mon Mar 2 09:00",
+		"## ada",
+		"**Closed**\n- tui-1 [P1] Fix login",
+		"**Stale**\n- tui-2 [P2] Refactor (no update since Feb 25)",
+	} {
+		if !strings.Contains(got, want) {
+			t.Errorf("expected %q in:\n%s", want, got)
+		}
+	}
+	if strings.Contains(got, "**Started**") {
+		t.Errorf("empty categories should be left out:\n%s", got)
+	}
+}
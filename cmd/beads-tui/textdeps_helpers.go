@@ -0,0 +1,97 @@
+package main
+
+import (
+	"fmt"
+	"regexp"
+	"strings"
+
+	"github.com/andy/beads-tui/internal/parser"
+)
+
+// textDependency is a relationship declared in an issue's description that
+// isn't yet a bd dependency
+type textDependency struct {
+	IssueID     string // Issue that gets the dependency
+	DependsOnID string
+	Type        parser.DependencyType
+	Line        string // Description line that declared it
+}
+
+// Args returns the bd command that materializes the dependency
+func (d textDependency) Args() []string {
+	return []string{"dep", "add", d.IssueID, d.DependsOnID, "--type", string(d.Type)}
+}
+
+// String describes the dependency, e.g. "tui-1 blocked by tui-2"
+func (d textDependency) String() string {
+	return fmt.Sprintf("%s %s %s", d.IssueID, depTypeToPhrase(d.Type), d.DependsOnID)
+}
+
+var (
+	// textIssueIDPattern matches candidate issue IDs such as tui-abc or tui-abc.1;
+	// candidates are only used if an issue with that ID exists
+	textIssueIDPattern = regexp.MustCompile(`[A-Za-z][A-Za-z0-9_-]*-[A-Za-z0-9]+(?:\.[0-9]+)*`)
+
+	// textDependsOnPattern matches "Depends on: a, b" style lines
+	textDependsOnPattern = regexp.MustCompile(`(?i)^\s*(?:[-*+]\s+)?(?:depends on|blocked by|requires)\b\s*:?\s*(.*)$`)
+
+	// textChecklistPattern matches Markdown task list items ("- [ ] ...", "* [x] ...")
+	textChecklistPattern = regexp.MustCompile(`^\s*[-*+]\s+\[[ xX]\]\s+(.*)$`)
+)
+
+// parseTextDependencies finds relationships declared in issue's description
+// that bd doesn't know about yet:
+//
+//	Depends on: tui-abc, tui-def    issue is blocked by each listed issue
+//	- [ ] tui-ghi Write the docs    tui-ghi becomes a child of issue
+//
+// "Blocked by:" and "Requires:" work like "Depends on:". IDs must belong to
+// existing issues (looked up with lookup). Dependencies bd already has, self
+// references, and checklist issues that already have a parent are skipped.
+func parseTextDependencies(issue *parser.Issue, lookup func(id string) *parser.Issue) []textDependency {
+	var found []textDependency
+	seen := make(map[string]bool)
+
+	add := func(dep textDependency) {
+		key := dep.IssueID + " " + dep.DependsOnID + " " + string(dep.Type)
+		if seen[key] {
+			return
+		}
+		seen[key] = true
+		found = append(found, dep)
+	}
+
+	for _, line := range strings.Split(issue.Description, "\n") {
+		if match := textDependsOnPattern.FindStringSubmatch(line); match != nil {
+			for _, id := range textIssueIDPattern.FindAllString(match[1], -1) {
+				target := lookup(id)
+				if target == nil || target.ID == issue.ID || hasDependency(issue, target.ID, parser.DepBlocks) {
+					continue
+				}
+				add(textDependency{IssueID: issue.ID, DependsOnID: target.ID, Type: parser.DepBlocks, Line: strings.TrimSpace(line)})
+			}
+			continue
+		}
+
+		if match := textChecklistPattern.FindStringSubmatch(line); match != nil {
+			for _, id := range textIssueIDPattern.FindAllString(match[1], -1) {
+				child := lookup(id)
+				if child == nil || child.ID == issue.ID || hasParent(child) {
+					continue
+				}
+				add(textDependency{IssueID: child.ID, DependsOnID: issue.ID, Type: parser.DepParentChild, Line: strings.TrimSpace(line)})
+			}
+		}
+	}
+	return found
+}
+
+// hasParent reports whether issue already has a parent-child dependency
+func hasParent(issue *parser.Issue) bool {
+	for _, dep := range issue.Dependencies {
+		if dep.Type == parser.DepParentChild {
+			return true
+		}
+	}
+	return false
+}
@@ -0,0 +1,58 @@
+package main
+
+import (
+	"reflect"
+	"testing"
+
+	"github.com/andy/beads-tui/internal/parser"
+)
+
+func TestParseTextDependencies(t *testing.T) {
+	issues := map[string]*parser.Issue{
+		"tui-1": {ID: "tui-1"},
+		"tui-2": {ID: "tui-2"},
+		"tui-3": {ID: "tui-3"},
+		"tui-4": {ID: "tui-4", Dependencies: []*parser.Dependency{{IssueID: "tui-4", DependsOnID: "tui-9", Type: parser.DepParentChild}}},
+		"tui-5": {ID: "tui-5"},
+	}
+	issue := &parser.Issue{
+		ID: "tui-epic",
+		Description: "Intro mentions tui-5 in passing.\n" +
+			"Depends on: tui-1, tui-2 and tui-missing\n" +
+			"blocked by tui-3\n" +
+			"## Tasks\n" +
+			"- [ ] tui-5 Write docs\n" +
+			"- [x] tui-4 already parented elsewhere\n" +
+			"* [ ] tui-epic self reference\n" +
+			"- [ ] no id here\n",
+		Dependencies: []*parser.Dependency{{IssueID: "tui-epic", DependsOnID: "tui-3", Type: parser.DepBlocks}},
+	}
+	issues["tui-epic"] = issue
+
+	got := parseTextDependencies(issue, func(id string) *parser.Issue { return issues[id] })
+	var descriptions []string
+	for _, dep := range got {
+		descriptions = append(descriptions, dep.String())
+	}
+	want := []string{
+		"tui-epic blocked by tui-1",
+		"tui-epic blocked by tui-2",
+		"tui-5 child of tui-epic",
+	}
+	if !reflect.DeepEqual(descriptions, want) {
+		t.Errorf("expected %v, got %v", want, descriptions)
+	}
+
+	if args := got[2].Args(); !reflect.DeepEqual(args, []string{"dep", "add", "tui-5", "tui-epic", "--type", "parent-child"}) {
+		t.Errorf("unexpected args %v", args)
+	}
+}
+
+func TestParseTextDependenciesDeduplicates(t *testing.T) {
+	issues := map[string]*parser.Issue{"tui-1": {ID: "tui-1"}}
+	issue := &parser.Issue{ID: "tui-2", Description: "Depends on: tui-1\nRequires: tui-1"}
+	got := parseTextDependencies(issue, func(id string) *parser.Issue { return issues[id] })
+	if len(got) != 1 {
+		t.Errorf("expected one dependency, got %v", got)
+	}
+}
@@ -0,0 +1,219 @@
+package main
+
+import (
+	"flag"
+	"fmt"
+	"io"
+	"os"
+	"strings"
+
+	"github.com/andy/beads-tui/internal/formatting"
+	"github.com/andy/beads-tui/internal/parser"
+	"github.com/andy/beads-tui/internal/theme"
+	"github.com/gdamore/tcell/v2"
+)
+
+const ansiReset = "\x1b[0m"
+
+// nextThemeName returns the theme after current in names (sorted, as
+// theme.List returns them), wrapping around to the first
+func nextThemeName(names []string, current string) string {
+	for i, name := range names {
+		if name == current {
+			return names[(i+1)%len(names)]
+		}
+	}
+	if len(names) == 0 {
+		return current
+	}
+	return names[0]
+}
+
+// runThemesCommand implements `beads-tui themes [--preview] [name...]`.
+// Without --preview it lists the available themes; with it, it renders a
+// static sample screen for each theme (or just the named ones) straight to
+// the terminal. Returns the process exit code.
+func runThemesCommand(args []string, stdout, stderr io.Writer) int {
+	fs := flag.NewFlagSet("themes", flag.ContinueOnError)
+	fs.SetOutput(stderr)
+	preview := fs.Bool("preview", false, "Render a sample screen for each theme")
+	fs.Usage = func() {
+		fmt.Fprintln(stderr, "Usage: beads-tui themes [--preview] [theme...]")
+		fmt.Fprintln(stderr, "")
+		fmt.Fprintln(stderr, "Lists available color themes. With --preview, renders a sample screen")
+		fmt.Fprintln(stderr, "for each theme (or only the named themes) to compare them and check")
+		fmt.Fprintln(stderr, "your terminal's color support.")
+		fs.PrintDefaults()
+	}
+	if err := fs.Parse(args); err != nil {
+		return 2
+	}
+
+	if err := theme.LoadUserThemes(); err != nil {
+		fmt.Fprintf(stderr, "Warning: %v\n", err)
+	}
+
+	names := fs.Args()
+	if len(names) == 0 {
+		names = theme.List()
+	}
+	var themes []theme.Theme
+	for _, name := range names {
+		t := theme.Get(name)
+		if t == nil {
+			fmt.Fprintf(stderr, "Error: theme not found: %s\n", name)
+			fmt.Fprintf(stderr, "Available themes: %s\n", strings.Join(theme.List(), ", "))
+			return 2
+		}
+		themes = append(themes, t)
+	}
+
+	if !*preview {
+		for _, t := range themes {
+			fmt.Fprintln(stdout, t.Name())
+		}
+		return 0
+	}
+
+	fmt.Fprintf(stdout, "TERM=%s COLORTERM=%s\n", os.Getenv("TERM"), os.Getenv("COLORTERM"))
+	fmt.Fprintln(stdout, "Previews use 24-bit color; if the gradient below shows bands or the")
+	fmt.Fprintln(stdout, "themes look alike, your terminal may not support truecolor.")
+	fmt.Fprintln(stdout, gradientStrip())
+	for _, t := range themes {
+		fmt.Fprintln(stdout)
+		writeThemePreview(stdout, t)
+	}
+	return 0
+}
+
+// writeThemePreview renders a sample screen in t's colors: status bar, list
+// rows for each status and priority, a selected row, dependency phrases,
+// message colors, and a dialog with an input field and buttons
+func writeThemePreview(w io.Writer, t theme.Theme) {
+	bg := ansiBg(t.AppBackground())
+	fg := ansiFg(t.AppForeground())
+	priorities := t.PriorityColors()
+
+	// Each line starts with the app colors and clears to end of line so the
+	// background fills the terminal width
+	line := func(parts ...string) {
+		fmt.Fprint(w, bg+fg+strings.Join(parts, "")+bg+fg+"\x1b[K"+ansiReset+"\n")
+	}
+	color := func(c, text string) string {
+		return ansiFgName(c) + text + fg
+	}
+	border := func(c tcell.Color, text string) string {
+		return ansiFg(c) + text + fg
+	}
+
+	line(border(t.BorderFocused(), "━━ "), color(t.Emphasis(), t.Name()), border(t.BorderFocused(), " ━━━━━━━━━━━━━━━━━━━━━━━━━━━━━━━━━━━━━━━━━━━━━━━━━━━"))
+	line(color(t.Emphasis(), "Issues:"), " ",
+		color(t.StatusOpen(), "2 ready"), "  ",
+		color(t.StatusInProgress(), "1 in progress"), "  ",
+		color(t.StatusBlocked(), "1 blocked"), "  ",
+		color(t.StatusClosed(), "1 closed"), "  ",
+		color(t.Muted(), "[? help]"))
+	line(border(t.BorderFocused(), "┌ Issues ─────────────────────────────────────────────┐"))
+
+	icons := formatting.IconsFor(t)
+	rows := []struct {
+		icon     string
+		color    string
+		kind     parser.IssueType
+		id       string
+		priority int
+		title    string
+	}{
+		{icons.Ready, t.StatusOpen(), parser.TypeBug, "tui-a1", 0, "Crash when database is locked"},
+		{icons.InProgress, t.StatusInProgress(), parser.TypeFeature, "tui-b2", 1, "Board view"},
+		{icons.Ready, t.StatusOpen(), parser.TypeTask, "tui-c3", 2, "Document quick filter syntax"},
+		{icons.Blocked, t.StatusBlocked(), parser.TypeEpic, "tui-d4", 3, "Plugin system"},
+		{icons.Closed, t.StatusClosed(), parser.TypeChore, "tui-e5", 4, "Update dependencies"},
+	}
+	for i, row := range rows {
+		text := fmt.Sprintf("%s %s %s [P%d] %s", row.icon, icons.TypeIcon(row.kind), row.id, row.priority, row.title)
+		if i == 2 {
+			// Selected row
+			line(border(t.BorderFocused(), "│ "), ansiBg(t.SelectionBg())+ansiFg(t.SelectionFg())+text+bg+fg)
+			continue
+		}
+		line(border(t.BorderFocused(), "│ "),
+			color(row.color, row.icon), " ", icons.TypeIcon(row.kind), " ",
+			color(t.Accent(), row.id), " ",
+			color(priorities[row.priority], fmt.Sprintf("[P%d]", row.priority)), " ",
+			row.title)
+	}
+	line(border(t.BorderFocused(), "└─────────────────────────────────────────────────────┘"))
+
+	line(border(t.BorderNormal(), "  Dependencies: "),
+		color(t.DepBlocks(), "blocked by tui-a1"), "  ",
+		color(t.DepParentChild(), "child of tui-d4"), "  ",
+		color(t.DepRelated(), "related to tui-b2"), "  ",
+		color(t.DepDiscoveredFrom(), "discovered from tui-c3"))
+	line("  Messages:     ",
+		color(t.Success(), "✓ success"), "  ",
+		color(t.Error(), "✗ error"), "  ",
+		color(t.Warning(), "⚠ warning"), "  ",
+		color(t.Info(), "ℹ info"), "  ",
+		color(t.Muted(), "muted"), "  ",
+		color(t.Accent(), "accent"))
+
+	field := ansiBg(t.InputFieldBackground()) + " Fix login timeout       " + bg + fg
+	button := func(label string) string {
+		return ansiBg(t.SelectionBg()) + ansiFg(t.SelectionFg()) + " " + label + " " + bg + fg
+	}
+	line(border(t.BorderNormal(), "  ┌ Rename Issue ───────────────────────────────┐"))
+	line(border(t.BorderNormal(), "  │ "), color(t.Emphasis(), "Title "), field)
+	line(border(t.BorderNormal(), "  │ "), button("Save (Ctrl-S)"), " ", button("Cancel (Esc)"))
+	line(border(t.BorderNormal(), "  └─────────────────────────────────────────────┘"))
+}
+
+// gradientStrip renders a red-to-blue strip for checking 24-bit color support
+func gradientStrip() string {
+	var sb strings.Builder
+	const steps = 48
+	for i := 0; i < steps; i++ {
+		r := int32(255 * (steps - 1 - i) / (steps - 1))
+		b := int32(255 * i / (steps - 1))
+		sb.WriteString(ansiBg(tcell.NewRGBColor(r, 64, b)) + " ")
+	}
+	return sb.String() + ansiReset
+}
+
+// ansiFgName returns the 24-bit foreground escape for a tview color name or
+// "#rrggbb" value, or "" if the color is not recognized
+func ansiFgName(name string) string {
+	return ansiFg(tcell.GetColor(name))
+}
+
+// ansiFg returns the foreground escape for c: 24-bit for RGB colors, the
+// terminal's own palette entry for palette colors, and "" for the default
+// color
+func ansiFg(c tcell.Color) string {
+	return ansiColor(c, 38, 30, 90)
+}
+
+// ansiBg returns the background escape for c, like ansiFg
+func ansiBg(c tcell.Color) string {
+	return ansiColor(c, 48, 40, 100)
+}
+
+// ansiColor formats c as an SGR escape: extended is 38 or 48, and normal and
+// bright are the codes of the first 8 and the next 8 ANSI colors
+func ansiColor(c tcell.Color, extended, normal, bright int) string {
+	if c.Valid() && !c.IsRGB() {
+		switch index := int(c - tcell.ColorValid); {
+		case index < 8:
+			return fmt.Sprintf("\x1b[%dm", normal+index)
+		case index < 16:
+			return fmt.Sprintf("\x1b[%dm", bright+index-8)
+		default:
+			return fmt.Sprintf("\x1b[%d;5;%dm", extended, index)
+		}
+	}
+	r, g, b := c.RGB()
+	if r < 0 {
+		return ""
+	}
+	return fmt.Sprintf("\x1b[%d;2;%d;%d;%dm", extended, r, g, b)
+}
@@ -0,0 +1,95 @@
+package main
+
+import (
+	"bytes"
+	"strings"
+	"testing"
+
+	"github.com/andy/beads-tui/internal/theme"
+	"github.com/gdamore/tcell/v2"
+)
+
+func TestRunThemesCommand_List(t *testing.T) {
+	var stdout, stderr bytes.Buffer
+	if code := runThemesCommand(nil, &stdout, &stderr); code != 0 {
+		t.Fatalf("expected exit code 0, got %d (stderr: %s)", code, stderr.String())
+	}
+	got := strings.Fields(stdout.String())
+	if len(got) != len(theme.List()) {
+		t.Errorf("expected %d theme names, got %v", len(theme.List()), got)
+	}
+}
+
+func TestRunThemesCommand_Preview(t *testing.T) {
+	var stdout, stderr bytes.Buffer
+	if code := runThemesCommand([]string{"--preview", "nord"}, &stdout, &stderr); code != 0 {
+		t.Fatalf("expected exit code 0, got %d (stderr: %s)", code, stderr.String())
+	}
+	out := stdout.String()
+
+	nord := theme.Get("nord")
+	for _, want := range []string{
+		"nord",
+		"in progress",
+		"blocked by tui-a1",
+		"Save (Ctrl-S)",
+		ansiBg(nord.AppBackground()),
+		ansiFgName(nord.StatusOpen()),
+		ansiBg(nord.SelectionBg()),
+	} {
+		if !strings.Contains(out, want) {
+			t.Errorf("expected preview to contain %q", want)
+		}
+	}
+	if strings.Contains(out, "dracula") {
+		t.Error("expected only the named theme to be previewed")
+	}
+}
+
+func TestRunThemesCommand_UnknownTheme(t *testing.T) {
+	var stdout, stderr bytes.Buffer
+	if code := runThemesCommand([]string{"--preview", "no-such-theme"}, &stdout, &stderr); code != 2 {
+		t.Errorf("expected exit code 2, got %d", code)
+	}
+	if !strings.Contains(stderr.String(), "theme not found: no-such-theme") {
+		t.Errorf("unexpected stderr: %s", stderr.String())
+	}
+}
+
+func TestAnsiColors(t *testing.T) {
+	tests := []struct {
+		color  tcell.Color
+		fg, bg string
+	}{
+		{tcell.NewHexColor(0x102030), "\x1b[38;2;16;32;48m", "\x1b[48;2;16;32;48m"},
+		{tcell.ColorMaroon, "\x1b[31m", "\x1b[41m"},
+		{tcell.ColorRed, "\x1b[91m", "\x1b[101m"},
+		{tcell.PaletteColor(200), "\x1b[38;5;200m", "\x1b[48;5;200m"},
+		{tcell.ColorDefault, "", ""},
+	}
+	for _, tt := range tests {
+		if got := ansiFg(tt.color); got != tt.fg {
+			t.Errorf("ansiFg(%v) = %q, want %q", tt.color, got, tt.fg)
+		}
+		if got := ansiBg(tt.color); got != tt.bg {
+			t.Errorf("ansiBg(%v) = %q, want %q", tt.color, got, tt.bg)
+		}
+	}
+}
+
+func TestNextThemeName(t *testing.T) {
+	names := []string{"default", "dracula", "gruvbox-dark"}
+	tests := []struct{ current, want string }{
+		{"default", "dracula"},
+		{"gruvbox-dark", "default"}, // Wraps around
+		{"removed", "default"},
+	}
+	for _, tt := range tests {
+		if got := nextThemeName(names, tt.current); got != tt.want {
+			t.Errorf("nextThemeName(%q) = %q, want %q", tt.current, got, tt.want)
+		}
+	}
+	if got := nextThemeName(nil, "default"); got != "default" {
+		t.Errorf("expected the current theme without others, got %q", got)
+	}
+}
@@ -0,0 +1,230 @@
+package main
+
+import (
+	"context"
+	"fmt"
+	"log"
+	"strconv"
+	"strings"
+
+	"github.com/andy/beads-tui/internal/parser"
+)
+
+// undoLimit caps how many recent mutations can be undone
+const undoLimit = 20
+
+// undoEntry records how to revert one mutation: the bd commands that restore
+// the previous values, run in order
+type undoEntry struct {
+	Description string // What the original action did, e.g. "set tui-1 to P0"
+	IssueID     string // Issue to select after undoing
+	Steps       []bdStep
+}
+
+// undoStack holds the most recent mutations, newest last. It is only touched
+// from the UI goroutine (key handlers and bdRunner done callbacks), so it
+// needs no locking.
+type undoStack struct {
+	entries []undoEntry
+}
+
+// Push records an entry, dropping the oldest once undoLimit is reached
+func (s *undoStack) Push(entry undoEntry) {
+	if len(entry.Steps) == 0 {
+		return
+	}
+	s.entries = append(s.entries, entry)
+	if len(s.entries) > undoLimit {
+		s.entries = s.entries[len(s.entries)-undoLimit:]
+	}
+}
+
+// Pop removes and returns the newest entry
+func (s *undoStack) Pop() (undoEntry, bool) {
+	if len(s.entries) == 0 {
+		return undoEntry{}, false
+	}
+	entry := s.entries[len(s.entries)-1]
+	s.entries = s.entries[:len(s.entries)-1]
+	return entry, true
+}
+
+// Len returns the number of entries that can be undone
+func (s *undoStack) Len() int {
+	return len(s.entries)
+}
+
+// UndoLastAction reverts the most recent mutation by running its inverse bd
+// commands. The entry is consumed even if a command fails, since a partial
+// undo can't safely be replayed.
+func (h *DialogHelpers) UndoLastAction() {
+	entry, ok := h.Undo.Pop()
+	if !ok {
+		h.Notify.Warn("Nothing to undo")
+		return
+	}
+
+	completed := 0
+	started := h.Runner.Run("Undoing "+entry.Description, func(ctx context.Context) error {
+		for i, step := range entry.Steps {
+			log.Printf("BD COMMAND: Undo step %d/%d (%s): bd %s", i+1, len(entry.Steps), step.Description, strings.Join(step.Args, " "))
+			if _, err := execBdJSON(ctx, step.Args...); err != nil {
+				return err
+			}
+			completed++
+		}
+		return nil
+	}, func(err error) {
+		if err != nil {
+			log.Printf("BD COMMAND ERROR: Undo failed: %v", err)
+			h.ShowErrorOverlay(fmt.Sprintf("Undo of %q failed at step %d/%d (%s)", entry.Description, completed+1, len(entry.Steps), entry.Steps[completed].Description), err)
+			if completed > 0 {
+				h.ScheduleRefresh(entry.IssueID)
+			}
+			return
+		}
+		log.Printf("BD COMMAND: Undid %q", entry.Description)
+		if h.Comments != nil {
+			h.Comments.Forget(entry.IssueID) // Comment edits don't touch updated_at
+		}
+		h.Notify.Success(fmt.Sprintf("Undid: %s (%d more)", entry.Description, h.Undo.Len()))
+		h.ScheduleRefresh(entry.IssueID)
+	})
+	if !started {
+		// Busy: keep the entry so the user can retry
+		h.Undo.Push(entry)
+	}
+}
+
+// undoStatus reverts a status change made with bd update --status
+func undoStatus(issue *parser.Issue, newStatus parser.Status) undoEntry {
+	return undoEntry{
+		Description: fmt.Sprintf("set %s to %s", issue.ID, newStatus),
+		IssueID:     issue.ID,
+		Steps: []bdStep{{
+			Description: fmt.Sprintf("restore %s status to %s", issue.ID, issue.Status),
+			Args:        []string{"update", issue.ID, "--status", string(issue.Status)},
+		}},
+	}
+}
+
+// undoPriority reverts a priority change
+func undoPriority(issue *parser.Issue, newPriority int) undoEntry {
+	return undoEntry{
+		Description: fmt.Sprintf("set %s to P%d", issue.ID, newPriority),
+		IssueID:     issue.ID,
+		Steps: []bdStep{{
+			Description: fmt.Sprintf("restore %s to P%d", issue.ID, issue.Priority),
+			Args:        []string{"update", issue.ID, "--priority", strconv.Itoa(issue.Priority)},
+		}},
+	}
+}
+
+// undoClose reverts bd close: the issue is reopened, then put back in its
+// previous status if that wasn't open
+func undoClose(issue *parser.Issue) undoEntry {
+	steps := []bdStep{{
+		Description: fmt.Sprintf("reopen %s", issue.ID),
+		Args:        []string{"reopen", issue.ID, "--reason", "Undo close"},
+	}}
+	if issue.Status != parser.StatusOpen {
+		steps = append(steps, bdStep{
+			Description: fmt.Sprintf("restore %s status to %s", issue.ID, issue.Status),
+			Args:        []string{"update", issue.ID, "--status", string(issue.Status)},
+		})
+	}
+	return undoEntry{Description: "close " + issue.ID, IssueID: issue.ID, Steps: steps}
+}
+
+// undoReopen reverts bd reopen by closing the issue again
+func undoReopen(issue *parser.Issue) undoEntry {
+	return undoEntry{
+		Description: "reopen " + issue.ID,
+		IssueID:     issue.ID,
+		Steps: []bdStep{{
+			Description: fmt.Sprintf("close %s", issue.ID),
+			Args:        []string{"close", issue.ID, "--reason", "Undo reopen"},
+		}},
+	}
+}
+
+// undoLabel reverts adding (or removing) a label
+func undoLabel(issueID, label string, added bool) undoEntry {
+	if added {
+		return undoEntry{
+			Description: fmt.Sprintf("add label %q to %s", label, issueID),
+			IssueID:     issueID,
+			Steps:       []bdStep{{Description: fmt.Sprintf("remove label %q", label), Args: []string{"label", "remove", issueID, label}}},
+		}
+	}
+	return undoEntry{
+		Description: fmt.Sprintf("remove label %q from %s", label, issueID),
+		IssueID:     issueID,
+		Steps:       []bdStep{{Description: fmt.Sprintf("add label %q", label), Args: []string{"label", "add", issueID, label}}},
+	}
+}
+
+// undoDependency reverts adding (or removing) a dependency
+func undoDependency(issueID, targetID string, depType parser.DependencyType, added bool) undoEntry {
+	phrase := fmt.Sprintf("%s %s %s", issueID, depTypeToPhrase(depType), targetID)
+	if added {
+		return undoEntry{
+			Description: "add dependency " + phrase,
+			IssueID:     issueID,
+			Steps:       []bdStep{{Description: "remove dependency " + phrase, Args: []string{"dep", "remove", issueID, targetID, "--type", string(depType)}}},
+		}
+	}
+	return undoEntry{
+		Description: "remove dependency " + phrase,
+		IssueID:     issueID,
+		Steps:       []bdStep{{Description: "add dependency " + phrase, Args: []string{"dep", "add", issueID, targetID, "--type", string(depType)}}},
+	}
+}
+
+// undoCommentEdit reverts editing a comment, restoring its text from
+// comment, which must hold the text before the edit
+func undoCommentEdit(issueID string, comment *parser.Comment) undoEntry {
+	commentID := strconv.FormatInt(comment.ID, 10)
+	return undoEntry{
+		Description: fmt.Sprintf("edit comment %s on %s", commentID, issueID),
+		IssueID:     issueID,
+		Steps:       []bdStep{{Description: "restore comment " + commentID, Args: []string{"comment", "edit", commentID, comment.Text}}},
+	}
+}
+
+// undoFields reverts a bd update that set the given flags (e.g. "--title"),
+// restoring each one from issue, which must hold the values before the update.
+// Flags whose value didn't change are left out.
+func undoFields(description string, issue *parser.Issue, updateArgs []string) undoEntry {
+	previous := map[string]string{
+		"--title":        issue.Title,
+		"--description":  issue.Description,
+		"--design":       issue.Design,
+		"--acceptance":   issue.AcceptanceCriteria,
+		"--notes":        issue.Notes,
+		"--priority":     strconv.Itoa(issue.Priority),
+		"--type":         string(issue.IssueType),
+		"--assignee":     issue.Assignee,
+		"--status":       string(issue.Status),
+		"--due":          formatDueInput(issue.DueDate),
+		"--external-ref": externalRef(issue),
+	}
+
+	args := []string{"update", issue.ID}
+	for i := 0; i+1 < len(updateArgs); i++ {
+		old, known := previous[updateArgs[i]]
+		if !known {
+			continue
+		}
+		if updateArgs[i+1] != old {
+			args = append(args, updateArgs[i], old)
+		}
+		i++
+	}
+
+	entry := undoEntry{Description: description, IssueID: issue.ID}
+	if len(args) > 2 {
+		entry.Steps = []bdStep{{Description: "restore " + issue.ID, Args: args}}
+	}
+	return entry
+}
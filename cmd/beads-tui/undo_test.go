@@ -0,0 +1,99 @@
+package main
+
+import (
+	"reflect"
+	"testing"
+
+	"github.com/andy/beads-tui/internal/parser"
+)
+
+func TestUndoStack(t *testing.T) {
+	var stack undoStack
+	if _, ok := stack.Pop(); ok {
+		t.Fatal("expected empty stack")
+	}
+
+	// Entries without steps (nothing changed) are not recorded
+	stack.Push(undoEntry{Description: "noop"})
+	if stack.Len() != 0 {
+		t.Fatalf("expected no-op entry to be skipped, got %d entries", stack.Len())
+	}
+
+	for i := 0; i < undoLimit+5; i++ {
+		stack.Push(undoPriority(&parser.Issue{ID: "tui-1", Priority: i % 5}, 0))
+	}
+	if stack.Len() != undoLimit {
+		t.Fatalf("expected stack capped at %d, got %d", undoLimit, stack.Len())
+	}
+
+	entry, ok := stack.Pop()
+	if !ok {
+		t.Fatal("expected an entry")
+	}
+	want := []string{"update", "tui-1", "--priority", "4"} // newest push had priority 24 % 5
+	if !reflect.DeepEqual(entry.Steps[0].Args, want) {
+		t.Errorf("expected newest entry %v, got %v", want, entry.Steps[0].Args)
+	}
+}
+
+func TestUndoClose(t *testing.T) {
+	open := undoClose(&parser.Issue{ID: "tui-1", Status: parser.StatusOpen})
+	if len(open.Steps) != 1 || open.Steps[0].Args[0] != "reopen" {
+		t.Errorf("expected a single reopen step, got %+v", open.Steps)
+	}
+
+	inProgress := undoClose(&parser.Issue{ID: "tui-1", Status: parser.StatusInProgress})
+	if len(inProgress.Steps) != 2 {
+		t.Fatalf("expected reopen plus status restore, got %+v", inProgress.Steps)
+	}
+	want := []string{"update", "tui-1", "--status", "in_progress"}
+	if !reflect.DeepEqual(inProgress.Steps[1].Args, want) {
+		t.Errorf("expected %v, got %v", want, inProgress.Steps[1].Args)
+	}
+}
+
+func TestUndoFields(t *testing.T) {
+	issue := &parser.Issue{ID: "tui-1", Title: "Old title", Description: "Same", Priority: 2, IssueType: parser.TypeTask}
+
+	entry := undoFields("edit tui-1", issue, []string{
+		"--title", "New title",
+		"--description", "Same",
+		"--priority", "1",
+		"--type", "task",
+	})
+	want := []string{"update", "tui-1", "--title", "Old title", "--priority", "2"}
+	if len(entry.Steps) != 1 || !reflect.DeepEqual(entry.Steps[0].Args, want) {
+		t.Errorf("expected only changed fields restored %v, got %+v", want, entry.Steps)
+	}
+
+	ref := "PROJ-1"
+	issue.ExternalRef = &ref
+	linked := undoFields("link tui-1", issue, []string{"--external-ref", "PROJ-2"})
+	if want := []string{"update", "tui-1", "--external-ref", "PROJ-1"}; len(linked.Steps) != 1 || !reflect.DeepEqual(linked.Steps[0].Args, want) {
+		t.Errorf("expected the external ref restored %v, got %+v", want, linked.Steps)
+	}
+
+	unchanged := undoFields("rename tui-1", issue, []string{"--title", "Old title"})
+	if len(unchanged.Steps) != 0 {
+		t.Errorf("expected no steps when nothing changed, got %+v", unchanged.Steps)
+	}
+}
+
+func TestUndoLabelAndDependency(t *testing.T) {
+	added := undoLabel("tui-1", "ui", true)
+	if want := []string{"label", "remove", "tui-1", "ui"}; !reflect.DeepEqual(added.Steps[0].Args, want) {
+		t.Errorf("expected %v, got %v", want, added.Steps[0].Args)
+	}
+	removed := undoDependency("tui-1", "tui-2", parser.DepBlocks, false)
+	if want := []string{"dep", "add", "tui-1", "tui-2", "--type", "blocks"}; !reflect.DeepEqual(removed.Steps[0].Args, want) {
+		t.Errorf("expected %v, got %v", want, removed.Steps[0].Args)
+	}
+}
+
+func TestUndoCommentEdit(t *testing.T) {
+	entry := undoCommentEdit("tui-1", &parser.Comment{ID: 42, IssueID: "tui-1", Text: "original text"})
+	want := []string{"comment", "edit", "42", "original text"}
+	if entry.IssueID != "tui-1" || len(entry.Steps) != 1 || !reflect.DeepEqual(entry.Steps[0].Args, want) {
+		t.Errorf("expected one step %v on tui-1, got %+v", want, entry)
+	}
+}
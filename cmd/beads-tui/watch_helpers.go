@@ -0,0 +1,66 @@
+package main
+
+import (
+	"fmt"
+	"sort"
+	"strings"
+
+	"github.com/andy/beads-tui/internal/parser"
+)
+
+// watchSnapshot is the part of an issue whose changes are reported for
+// watched issues
+type watchSnapshot struct {
+	Status   parser.Status
+	Priority int
+	Comments int
+}
+
+// watchSnapshots captures every issue for comparison after the next refresh
+func watchSnapshots(issues []*parser.Issue, commentCounts map[string]int) map[string]watchSnapshot {
+	snapshots := make(map[string]watchSnapshot, len(issues))
+	for _, issue := range issues {
+		snapshots[issue.ID] = watchSnapshot{Status: issue.Status, Priority: issue.Priority, Comments: commentCounts[issue.ID]}
+	}
+	return snapshots
+}
+
+// watchChanges describes how the watched issues changed between two
+// refreshes, one message per issue in ID order, and returns the IDs of the
+// changed issues. Issues missing from before (not loaded yet) are skipped,
+// as is skip (an issue just changed from this TUI).
+func watchChanges(before, after map[string]watchSnapshot, watched []string, skip string) (changed []string, messages []string) {
+	sorted := append([]string(nil), watched...)
+	sort.Strings(sorted)
+
+	for _, id := range sorted {
+		old, known := before[id]
+		if !known || id == skip {
+			continue
+		}
+		current, exists := after[id]
+		if !exists {
+			changed = append(changed, id)
+			messages = append(messages, id+" was deleted")
+			continue
+		}
+
+		var parts []string
+		if current.Status != old.Status {
+			parts = append(parts, fmt.Sprintf("%s → %s", old.Status, current.Status))
+		}
+		if current.Priority != old.Priority {
+			parts = append(parts, fmt.Sprintf("P%d → P%d", old.Priority, current.Priority))
+		}
+		if added := current.Comments - old.Comments; added == 1 {
+			parts = append(parts, "1 new comment")
+		} else if added > 1 {
+			parts = append(parts, fmt.Sprintf("%d new comments", added))
+		}
+		if len(parts) > 0 {
+			changed = append(changed, id)
+			messages = append(messages, id+": "+strings.Join(parts, ", "))
+		}
+	}
+	return changed, messages
+}
@@ -0,0 +1,42 @@
+package main
+
+import (
+	"reflect"
+	"testing"
+
+	"github.com/andy/beads-tui/internal/parser"
+)
+
+func TestWatchChanges(t *testing.T) {
+	before := watchSnapshots([]*parser.Issue{
+		{ID: "tui-1", Status: parser.StatusOpen, Priority: 2},
+		{ID: "tui-2", Status: parser.StatusOpen, Priority: 2},
+		{ID: "tui-3", Status: parser.StatusOpen, Priority: 2},
+		{ID: "tui-4", Status: parser.StatusOpen, Priority: 2},
+		{ID: "tui-5", Status: parser.StatusOpen, Priority: 2},
+	}, map[string]int{"tui-2": 1})
+	after := watchSnapshots([]*parser.Issue{
+		{ID: "tui-1", Status: parser.StatusClosed, Priority: 1},     // watched, changed
+		{ID: "tui-2", Status: parser.StatusOpen, Priority: 2},       // watched, commented on
+		{ID: "tui-3", Status: parser.StatusInProgress, Priority: 2}, // not watched
+		{ID: "tui-4", Status: parser.StatusBlocked, Priority: 2},    // changed from this TUI
+		{ID: "tui-6", Status: parser.StatusOpen, Priority: 2},       // new, so no baseline
+	}, map[string]int{"tui-2": 3})
+
+	changed, messages := watchChanges(before, after, []string{"tui-6", "tui-5", "tui-4", "tui-2", "tui-1"}, "tui-4")
+	if want := []string{"tui-1", "tui-2", "tui-5"}; !reflect.DeepEqual(changed, want) {
+		t.Errorf("changed = %v, want %v", changed, want)
+	}
+	want := []string{
+		"tui-1: open → closed, P2 → P1",
+		"tui-2: 2 new comments",
+		"tui-5 was deleted",
+	}
+	if !reflect.DeepEqual(messages, want) {
+		t.Errorf("messages = %q, want %q", messages, want)
+	}
+
+	if changed, _ := watchChanges(after, after, []string{"tui-1"}, ""); len(changed) != 0 {
+		t.Errorf("expected no changes between identical snapshots, got %v", changed)
+	}
+}
@@ -0,0 +1,41 @@
+package main
+
+import (
+	"fmt"
+	"strconv"
+	"strings"
+	"time"
+)
+
+// maxWorkMinutes bounds a single worklog entry (a typo like "80h" for "8h"
+// is far more likely than a week-long block of work)
+const maxWorkMinutes = 24 * 60
+
+// parseWorkDuration parses the time entered in the worklog dialog into
+// minutes. It accepts Go-style durations ("1h30m", "45m", "1.5h"), a bare
+// number of minutes ("90"), and "h"/"m" parts separated by spaces ("1h 30m").
+func parseWorkDuration(text string) (int, error) {
+	text = strings.ToLower(strings.ReplaceAll(strings.TrimSpace(text), " ", ""))
+	if text == "" {
+		return 0, fmt.Errorf("enter the time spent, e.g. 45m or 1h30m")
+	}
+
+	var minutes int
+	if n, err := strconv.Atoi(text); err == nil {
+		minutes = n
+	} else {
+		d, err := time.ParseDuration(text)
+		if err != nil {
+			return 0, fmt.Errorf("can't read %q as a duration; use e.g. 45m, 1h30m, or 1.5h", text)
+		}
+		minutes = int(d.Round(time.Minute) / time.Minute)
+	}
+
+	if minutes <= 0 {
+		return 0, fmt.Errorf("time spent must be at least a minute")
+	}
+	if minutes > maxWorkMinutes {
+		return 0, fmt.Errorf("%dh is more than a day; log long stretches one day at a time", minutes/60)
+	}
+	return minutes, nil
+}
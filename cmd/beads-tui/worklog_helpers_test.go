@@ -0,0 +1,36 @@
+package main
+
+import "testing"
+
+func TestParseWorkDuration(t *testing.T) {
+	tests := []struct {
+		text string
+		want int
+	}{
+		{"45m", 45},
+		{"90", 90},
+		{"1h30m", 90},
+		{"1h 30m", 90},
+		{"1.5h", 90},
+		{" 2H ", 120},
+		{"20s", 0}, // rounds to nothing
+	}
+	for _, tt := range tests {
+		got, err := parseWorkDuration(tt.text)
+		if tt.want == 0 {
+			if err == nil {
+				t.Errorf("parseWorkDuration(%q) = %d, expected an error", tt.text, got)
+			}
+			continue
+		}
+		if err != nil || got != tt.want {
+			t.Errorf("parseWorkDuration(%q) = %d, %v; want %d", tt.text, got, err, tt.want)
+		}
+	}
+
+	for _, text := range []string{"", "soon", "-1h", "30h"} {
+		if _, err := parseWorkDuration(text); err == nil {
+			t.Errorf("parseWorkDuration(%q) expected an error", text)
+		}
+	}
+}
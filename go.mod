@@ -3,22 +3,22 @@ module github.com/andy/beads-tui
 go 1.24.0
 
 require (
+	github.com/BurntSushi/toml v1.5.0
+	github.com/atotto/clipboard v0.1.4
 	github.com/fsnotify/fsnotify v1.9.0
 	github.com/gdamore/tcell/v2 v2.8.1
+	github.com/mattn/go-runewidth v0.0.16
+	github.com/ncruces/go-sqlite3 v0.30.1
 	github.com/rivo/tview v0.42.0
+	golang.org/x/term v0.28.0
 )
 
 require (
-	github.com/BurntSushi/toml v1.5.0 // indirect
-	github.com/atotto/clipboard v0.1.4 // indirect
 	github.com/gdamore/encoding v1.0.1 // indirect
 	github.com/lucasb-eyer/go-colorful v1.2.0 // indirect
-	github.com/mattn/go-runewidth v0.0.16 // indirect
-	github.com/ncruces/go-sqlite3 v0.30.1 // indirect
 	github.com/ncruces/julianday v1.0.0 // indirect
 	github.com/rivo/uniseg v0.4.7 // indirect
 	github.com/tetratelabs/wazero v1.10.0 // indirect
 	golang.org/x/sys v0.38.0 // indirect
-	golang.org/x/term v0.28.0 // indirect
 	golang.org/x/text v0.30.0 // indirect
 )
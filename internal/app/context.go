@@ -23,7 +23,7 @@ type AppContext struct {
 	// TUI components
 	App         *tview.Application
 	StatusBar   *tview.TextView
-	IssueList   *tview.List
+	IssueList   *ui.VirtualList
 	DetailPanel *tview.TextView
 	Pages       *tview.Pages
 
@@ -39,6 +39,7 @@ type AppContext struct {
 	DetailPanelFocused bool
 	ShowClosedIssues   bool
 	ShowPrefix         bool
+	Columns            []ui.Column // Issue list columns (nil for ui.DefaultColumns)
 	MouseEnabled       bool
 
 	// Vim navigation state
@@ -126,8 +127,11 @@ func (ctx *AppContext) PopulateIssueList() {
 	ui.PopulateIssueList(
 		ctx.IssueList,
 		ctx.State,
-		ctx.ShowClosedIssues,
-		ctx.ShowPrefix,
+		ui.ListOptions{
+			ShowClosedIssues: ctx.ShowClosedIssues,
+			ShowPrefix:       ctx.ShowPrefix,
+			Columns:          ctx.Columns,
+		},
 		ctx.IndexToIssue,
 	)
 }
@@ -135,7 +139,7 @@ func (ctx *AppContext) PopulateIssueList() {
 // ShowIssueDetails formats and displays the details for the given issue
 func (ctx *AppContext) ShowIssueDetails(issue *parser.Issue) {
 	ctx.CurrentDetailIssue = issue
-	details := formatting.FormatIssueDetails(issue)
+	details := formatting.FormatIssueDetails(issue, formatting.NewDetailContext(ctx.State, issue, time.Now()))
 	ctx.DetailPanel.SetText(details)
 	ctx.DetailPanel.ScrollToBeginning()
 }
@@ -13,6 +13,17 @@ func FindBeadsDir() (string, error) {
 	if err != nil {
 		return "", err
 	}
+	return FindBeadsDirFrom(dir)
+}
+
+// FindBeadsDirFrom searches for a .beads directory starting from dir and
+// walking up the directory tree
+func FindBeadsDirFrom(dir string) (string, error) {
+	dir, err := filepath.Abs(dir)
+	if err != nil {
+		return "", err
+	}
+	start := dir
 
 	for {
 		beadsDir := filepath.Join(dir, ".beads")
@@ -27,5 +38,33 @@ func FindBeadsDir() (string, error) {
 		dir = parent
 	}
 
-	return "", fmt.Errorf(".beads directory not found")
+	return "", fmt.Errorf(".beads directory not found in %s or any parent directory", start)
+}
+
+// ResolveBeadsPath finds the .beads directory for a path given on the
+// command line. path may be the .beads directory itself, a project
+// directory (searched upwards like the working directory), or a database
+// file, in which case its directory is used as the .beads directory.
+// It returns the .beads directory and the database file inside it.
+func ResolveBeadsPath(path string) (beadsDir, dbPath string, err error) {
+	path, err = filepath.Abs(path)
+	if err != nil {
+		return "", "", err
+	}
+	info, err := os.Stat(path)
+	if err != nil {
+		return "", "", fmt.Errorf("cannot open %s: %w", path, err)
+	}
+
+	if !info.IsDir() {
+		return filepath.Dir(path), path, nil
+	}
+	if filepath.Base(path) == ".beads" {
+		return path, filepath.Join(path, "beads.db"), nil
+	}
+	beadsDir, err = FindBeadsDirFrom(path)
+	if err != nil {
+		return "", "", err
+	}
+	return beadsDir, filepath.Join(beadsDir, "beads.db"), nil
 }
@@ -0,0 +1,57 @@
+package app
+
+import (
+	"os"
+	"path/filepath"
+	"testing"
+)
+
+func TestResolveBeadsPath(t *testing.T) {
+	root := t.TempDir()
+	beadsDir := filepath.Join(root, ".beads")
+	nested := filepath.Join(root, "src", "pkg")
+	for _, dir := range []string{beadsDir, nested} {
+		if err := os.MkdirAll(dir, 0755); err != nil {
+			t.Fatal(err)
+		}
+	}
+	dbPath := filepath.Join(beadsDir, "beads.db")
+	if err := os.WriteFile(dbPath, nil, 0644); err != nil {
+		t.Fatal(err)
+	}
+	otherDB := filepath.Join(root, "backup.db")
+	if err := os.WriteFile(otherDB, nil, 0644); err != nil {
+		t.Fatal(err)
+	}
+
+	tests := []struct {
+		name         string
+		path         string
+		wantBeadsDir string
+		wantDB       string
+	}{
+		{"project directory", root, beadsDir, dbPath},
+		{"subdirectory", nested, beadsDir, dbPath},
+		{".beads directory", beadsDir, beadsDir, dbPath},
+		{"database file", dbPath, beadsDir, dbPath},
+		{"other database file", otherDB, root, otherDB},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			gotDir, gotDB, err := ResolveBeadsPath(tt.path)
+			if err != nil {
+				t.Fatalf("ResolveBeadsPath(%s) failed: %v", tt.path, err)
+			}
+			if gotDir != tt.wantBeadsDir || gotDB != tt.wantDB {
+				t.Errorf("ResolveBeadsPath(%s) = %s, %s; want %s, %s", tt.path, gotDir, gotDB, tt.wantBeadsDir, tt.wantDB)
+			}
+		})
+	}
+
+	if _, _, err := ResolveBeadsPath(filepath.Join(root, "missing")); err == nil {
+		t.Error("expected an error for a missing path")
+	}
+	if _, _, err := ResolveBeadsPath(t.TempDir()); err == nil {
+		t.Error("expected an error for a directory without .beads")
+	}
+}
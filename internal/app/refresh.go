@@ -0,0 +1,169 @@
+package app
+
+import (
+	"sync"
+	"time"
+)
+
+// Refresher coordinates issue refreshes. The watcher, a manual refresh, and
+// the refresh scheduled after each action can all ask for one at once; the
+// requests are coalesced, and loads run one at a time on a goroutine of
+// their own. A request made while a load is running is served by one more
+// load after it.
+type Refresher struct {
+	requests chan refreshRequest
+	stopCh   chan struct{}
+
+	mu     sync.Mutex
+	queued int  // Requests sent but not yet taken by the loop
+	active bool // A load is pending or running
+}
+
+// refreshRequest asks for a refresh after delay (0 for right away), keeping
+// issueID selected ("" for whatever is selected when the load runs)
+type refreshRequest struct {
+	issueID string
+	delay   time.Duration
+}
+
+// NewRefresher creates a refresh coordinator. Requests are held until Start.
+func NewRefresher() *Refresher {
+	return &Refresher{
+		requests: make(chan refreshRequest, 16),
+		stopCh:   make(chan struct{}),
+	}
+}
+
+// Start runs load for each coalesced refresh. It's passed the issue to keep
+// selected: the latest one asked for since the previous load, or "".
+func (r *Refresher) Start(load func(issueID string)) {
+	go r.loop(load)
+}
+
+// Stop ends the coordinator: no new load starts, and later requests are
+// dropped. A load already running isn't waited for.
+func (r *Refresher) Stop() {
+	close(r.stopCh)
+}
+
+// Refresh asks for a refresh as soon as possible, keeping issueID selected
+// (or "" for the current selection)
+func (r *Refresher) Refresh(issueID string) {
+	r.request(refreshRequest{issueID: issueID})
+}
+
+// Schedule asks for a refresh after delay, giving bd time to finish writing.
+// Another scheduled request before then pushes the refresh back, so a burst
+// of actions is one refresh; a Refresh in the meantime runs it right away.
+func (r *Refresher) Schedule(issueID string, delay time.Duration) {
+	r.request(refreshRequest{issueID: issueID, delay: delay})
+}
+
+// Idle reports whether no refresh is asked for, waiting, or running
+func (r *Refresher) Idle() bool {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	return r.queued == 0 && !r.active
+}
+
+func (r *Refresher) request(req refreshRequest) {
+	if r.stopped() {
+		return
+	}
+	r.mu.Lock()
+	r.queued++
+	r.mu.Unlock()
+	select {
+	case r.requests <- req:
+	case <-r.stopCh:
+		r.mu.Lock()
+		r.queued--
+		r.mu.Unlock()
+	}
+}
+
+// stopped reports whether Stop was called
+func (r *Refresher) stopped() bool {
+	select {
+	case <-r.stopCh:
+		return true
+	default:
+		return false
+	}
+}
+
+// loop collects requests and starts loads. pending means a load is wanted at
+// due; urgent means a Refresh asked for it, so scheduled requests don't push
+// it back.
+func (r *Refresher) loop(load func(issueID string)) {
+	var (
+		pending bool
+		urgent  bool
+		due     time.Time
+		target  string
+		loading bool
+	)
+	loaded := make(chan struct{}, 1)
+	timer := time.NewTimer(time.Hour)
+	timer.Stop()
+	defer timer.Stop()
+
+	// startIfDue starts the pending load once it's due and the previous one
+	// finished, or sets the timer for when it will be due
+	startIfDue := func() {
+		if !pending || loading || r.stopped() {
+			return
+		}
+		if wait := time.Until(due); wait > 0 {
+			timer.Reset(wait)
+			return
+		}
+		issueID := target
+		pending, urgent, target, loading = false, false, "", true
+		go func() {
+			load(issueID)
+			loaded <- struct{}{}
+		}()
+	}
+
+	// setActive publishes whether a load is pending or running, having
+	// taken received requests off the queued count
+	setActive := func(received int) {
+		r.mu.Lock()
+		r.queued -= received
+		r.active = pending || loading
+		r.mu.Unlock()
+	}
+
+	for {
+		select {
+		case req := <-r.requests:
+			if req.issueID != "" {
+				target = req.issueID
+			}
+			switch {
+			case req.delay <= 0:
+				urgent = true
+				due = time.Now()
+			case !urgent:
+				due = time.Now().Add(req.delay)
+			}
+			pending = true
+			timer.Stop()
+			startIfDue()
+			setActive(1)
+
+		case <-timer.C:
+			startIfDue()
+			setActive(0)
+
+		case <-loaded:
+			loading = false
+			startIfDue()
+			setActive(0)
+
+		case <-r.stopCh:
+			return
+		}
+	}
+}
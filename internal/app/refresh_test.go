@@ -0,0 +1,162 @@
+package app
+
+import (
+	"sync"
+	"testing"
+	"time"
+)
+
+// recordingLoad records the issue ID of each load; each load takes hold to
+// finish
+type recordingLoad struct {
+	mu      sync.Mutex
+	ids     []string
+	hold    time.Duration
+	started chan string
+}
+
+func newRecordingLoad(hold time.Duration) *recordingLoad {
+	return &recordingLoad{hold: hold, started: make(chan string, 10)}
+}
+
+func (l *recordingLoad) load(issueID string) {
+	l.mu.Lock()
+	l.ids = append(l.ids, issueID)
+	l.mu.Unlock()
+	l.started <- issueID
+	time.Sleep(l.hold)
+}
+
+func (l *recordingLoad) loads() []string {
+	l.mu.Lock()
+	defer l.mu.Unlock()
+	return append([]string(nil), l.ids...)
+}
+
+func waitStarted(t *testing.T, l *recordingLoad) string {
+	t.Helper()
+	select {
+	case id := <-l.started:
+		return id
+	case <-time.After(time.Second):
+		t.Fatal("Load didn't start")
+		return ""
+	}
+}
+
+func TestRefresherCoalescesWhileLoading(t *testing.T) {
+	// Requests made during a load are served by one more load, keeping the
+	// latest issue asked for
+	l := newRecordingLoad(100 * time.Millisecond)
+	r := NewRefresher()
+	r.Start(l.load)
+	defer r.Stop()
+
+	r.Refresh("")
+	waitStarted(t, l)
+	r.Refresh("tui-1")
+	r.Refresh("")
+	r.Refresh("tui-2")
+	r.Refresh("")
+
+	if id := waitStarted(t, l); id != "tui-2" {
+		t.Errorf("Expected the follow-up load to keep tui-2, got %q", id)
+	}
+	time.Sleep(200 * time.Millisecond)
+	if got := l.loads(); len(got) != 2 {
+		t.Errorf("Expected 2 loads, got %d: %q", len(got), got)
+	}
+}
+
+func TestRefresherScheduleIsPushedBack(t *testing.T) {
+	l := newRecordingLoad(0)
+	r := NewRefresher()
+	r.Start(l.load)
+	defer r.Stop()
+
+	start := time.Now()
+	r.Schedule("tui-1", 100*time.Millisecond)
+	time.Sleep(50 * time.Millisecond)
+	r.Schedule("tui-2", 100*time.Millisecond)
+
+	if id := waitStarted(t, l); id != "tui-2" {
+		t.Errorf("Expected the scheduled load to keep tui-2, got %q", id)
+	}
+	if elapsed := time.Since(start); elapsed < 140*time.Millisecond {
+		t.Errorf("Expected the second request to push the load back, ran after %v", elapsed)
+	}
+	time.Sleep(150 * time.Millisecond)
+	if got := l.loads(); len(got) != 1 {
+		t.Errorf("Expected 1 load, got %d: %q", len(got), got)
+	}
+}
+
+func TestRefresherRefreshRunsScheduledNow(t *testing.T) {
+	// A Refresh doesn't wait for a scheduled refresh, and takes its issue
+	l := newRecordingLoad(0)
+	r := NewRefresher()
+	r.Start(l.load)
+	defer r.Stop()
+
+	start := time.Now()
+	r.Schedule("tui-1", time.Second)
+	r.Refresh("")
+
+	if id := waitStarted(t, l); id != "tui-1" {
+		t.Errorf("Expected the load to keep tui-1, got %q", id)
+	}
+	if elapsed := time.Since(start); elapsed > 500*time.Millisecond {
+		t.Errorf("Expected Refresh to load right away, took %v", elapsed)
+	}
+	r.Schedule("", 10*time.Millisecond)
+	waitStarted(t, l)
+	if got := l.loads(); len(got) != 2 {
+		t.Errorf("Expected 2 loads, got %d: %q", len(got), got)
+	}
+}
+
+func TestRefresherStop(t *testing.T) {
+	l := newRecordingLoad(0)
+	r := NewRefresher()
+	r.Start(l.load)
+
+	r.Schedule("tui-1", 50*time.Millisecond)
+	r.Stop()
+	r.Refresh("tui-2") // Dropped, and doesn't block
+
+	time.Sleep(100 * time.Millisecond)
+	if got := l.loads(); len(got) != 0 {
+		t.Errorf("Expected no loads after Stop, got %q", got)
+	}
+}
+
+func TestRefresherIdle(t *testing.T) {
+	l := newRecordingLoad(50 * time.Millisecond)
+	r := NewRefresher()
+	if !r.Idle() {
+		t.Error("Expected a new refresher to be idle")
+	}
+
+	// Held until Start, and waiting out its delay after that
+	r.Schedule("tui-1", 50*time.Millisecond)
+	if r.Idle() {
+		t.Error("Expected a requested refresh to be pending before Start")
+	}
+	r.Start(l.load)
+	defer r.Stop()
+	if r.Idle() {
+		t.Error("Expected a scheduled refresh to be pending")
+	}
+	waitStarted(t, l)
+	if r.Idle() {
+		t.Error("Expected a running load not to be idle")
+	}
+
+	deadline := time.Now().Add(time.Second)
+	for !r.Idle() {
+		if time.Now().After(deadline) {
+			t.Fatal("Refresher didn't become idle after the load")
+		}
+		time.Sleep(10 * time.Millisecond)
+	}
+}
@@ -0,0 +1,105 @@
+package config
+
+import (
+	"encoding/json"
+	"fmt"
+	"os"
+	"path/filepath"
+	"slices"
+	"time"
+)
+
+// BdCapabilities records what an installed bd supports, as read from its
+// --help output. It's cached so bd is only probed again when its version
+// changes.
+type BdCapabilities struct {
+	BdPath   string              `json:"bd_path"`           // bd executable that was probed
+	Version  string              `json:"version"`           // First line of bd --version
+	Flags    map[string][]string `json:"flags"`             // Command ("update", "comment edit") -> flags it accepts
+	Missing  []string            `json:"missing,omitempty"` // Commands bd doesn't have
+	ProbedAt time.Time           `json:"probed_at"`
+}
+
+// Probed reports whether command's help was read
+func (c *BdCapabilities) Probed(command string) bool {
+	if c == nil {
+		return false
+	}
+	_, ok := c.Flags[command]
+	return ok || slices.Contains(c.Missing, command)
+}
+
+// Supports reports whether bd has command and accepts flag with it ("" for
+// the command alone). Anything that wasn't probed is assumed to work, so a
+// nil BdCapabilities supports everything.
+func (c *BdCapabilities) Supports(command, flag string) bool {
+	if c == nil {
+		return true
+	}
+	if slices.Contains(c.Missing, command) {
+		return false
+	}
+	flags, ok := c.Flags[command]
+	if !ok || flag == "" {
+		return true
+	}
+	return slices.Contains(flags, flag)
+}
+
+// BdCapabilitiesPath returns the path of the cached bd capabilities
+func BdCapabilitiesPath() (string, error) {
+	homeDir, err := os.UserHomeDir()
+	if err != nil {
+		return "", fmt.Errorf("failed to get home directory: %w", err)
+	}
+
+	configDir := filepath.Join(homeDir, ".beads-tui")
+	if err := os.MkdirAll(configDir, 0755); err != nil {
+		return "", fmt.Errorf("failed to create config directory: %w", err)
+	}
+
+	return filepath.Join(configDir, "bd-capabilities.json"), nil
+}
+
+// LoadBdCapabilities reads the cached bd capabilities. Returns nil if bd
+// hasn't been probed yet.
+func LoadBdCapabilities() (*BdCapabilities, error) {
+	path, err := BdCapabilitiesPath()
+	if err != nil {
+		return nil, err
+	}
+
+	data, err := os.ReadFile(path)
+	if os.IsNotExist(err) {
+		return nil, nil
+	}
+	if err != nil {
+		return nil, fmt.Errorf("failed to read bd capabilities: %w", err)
+	}
+
+	var caps BdCapabilities
+	if err := json.Unmarshal(data, &caps); err != nil {
+		return nil, fmt.Errorf("failed to parse bd capabilities: %w", err)
+	}
+
+	return &caps, nil
+}
+
+// SaveBdCapabilities caches the bd capabilities
+func SaveBdCapabilities(caps *BdCapabilities) error {
+	path, err := BdCapabilitiesPath()
+	if err != nil {
+		return err
+	}
+
+	data, err := json.MarshalIndent(caps, "", "  ")
+	if err != nil {
+		return fmt.Errorf("failed to serialize bd capabilities: %w", err)
+	}
+
+	if err := os.WriteFile(path, data, 0644); err != nil {
+		return fmt.Errorf("failed to write bd capabilities: %w", err)
+	}
+
+	return nil
+}
@@ -0,0 +1,79 @@
+package config
+
+import (
+	"os"
+	"testing"
+	"time"
+)
+
+func TestBdCapabilitiesSupports(t *testing.T) {
+	caps := &BdCapabilities{
+		Flags: map[string][]string{
+			"update":       {"--priority", "--status"},
+			"comment edit": nil,
+		},
+		Missing: []string{"delete"},
+	}
+
+	tests := []struct {
+		command, flag string
+		want          bool
+	}{
+		{"update", "--priority", true},
+		{"update", "--due", false},
+		{"update", "", true},
+		{"comment edit", "", true},
+		{"delete", "", false},
+		{"delete", "--force", false},
+		{"create", "--parent", true}, // Not probed
+	}
+	for _, tt := range tests {
+		if got := caps.Supports(tt.command, tt.flag); got != tt.want {
+			t.Errorf("Supports(%q, %q) = %v, want %v", tt.command, tt.flag, got, tt.want)
+		}
+	}
+
+	var unknown *BdCapabilities
+	if !unknown.Supports("delete", "--force") {
+		t.Error("nil capabilities should support everything")
+	}
+
+	if !caps.Probed("delete") || !caps.Probed("comment edit") || caps.Probed("create") {
+		t.Error("Probed should cover the flags and missing commands only")
+	}
+}
+
+func TestLoadSaveBdCapabilities(t *testing.T) {
+	tmpDir := t.TempDir()
+	oldHome := os.Getenv("HOME")
+	os.Setenv("HOME", tmpDir)
+	defer os.Setenv("HOME", oldHome)
+
+	caps, err := LoadBdCapabilities()
+	if err != nil {
+		t.Fatalf("LoadBdCapabilities() failed: %v", err)
+	}
+	if caps != nil {
+		t.Fatalf("expected no cached capabilities, got %+v", caps)
+	}
+
+	saved := &BdCapabilities{
+		BdPath:   "bd",
+		Version:  "bd version 0.20.1",
+		Flags:    map[string][]string{"update": {"--due"}},
+		Missing:  []string{"delete"},
+		ProbedAt: time.Date(2026, 5, 1, 12, 0, 0, 0, time.UTC),
+	}
+	if err := SaveBdCapabilities(saved); err != nil {
+		t.Fatalf("SaveBdCapabilities() failed: %v", err)
+	}
+
+	loaded, err := LoadBdCapabilities()
+	if err != nil {
+		t.Fatalf("LoadBdCapabilities() failed: %v", err)
+	}
+	if loaded.Version != saved.Version || !loaded.Supports("update", "--due") || loaded.Supports("delete", "") ||
+		!loaded.ProbedAt.Equal(saved.ProbedAt) {
+		t.Errorf("capabilities not round-tripped: %+v", loaded)
+	}
+}
@@ -7,23 +7,204 @@ import (
 	"fmt"
 	"os"
 	"path/filepath"
+	"time"
 )
 
 // Config holds persistent user configuration
 type Config struct {
-	Theme string `json:"theme"` // Current theme name
+	Theme  string `json:"theme"`             // Current theme name
+	BdPath string `json:"bd_path,omitempty"` // bd executable (default: "bd" from PATH)
+
+	// BdTimeoutSeconds is how long one bd command may run before it's
+	// stopped (default 10). Esc or Ctrl-C cancels a running command sooner.
+	BdTimeoutSeconds int `json:"bd_timeout_seconds,omitempty"`
+
+	// ColorMode is the terminal's color depth: "truecolor", "256", "16", or
+	// "auto" (the default) to detect it from COLORTERM and TERM. With 16
+	// colors and no theme set, the "terminal" theme is used.
+	ColorMode string `json:"color_mode,omitempty"`
+
+	// IconSet is the glyphs used for status and type icons and tree
+	// branches: "unicode" (the default), "ascii" for terminals or fonts that
+	// draw ◆, ⬤, or └── badly, or "nerd" for a Nerd Font
+	IconSet string `json:"icon_set,omitempty"`
+
+	// UI layout and view preferences, restored at startup
+	Layout           string `json:"layout"`              // "horizontal" or "vertical"
+	ShowDetailPane   bool   `json:"show_detail_pane"`    // Detail pane visibility
+	ShowClosedIssues bool   `json:"show_closed_issues"`  // Show closed issues in list view
+	MouseEnabled     bool   `json:"mouse_enabled"`       // Mouse mode
+	ViewMode         string `json:"view_mode"`           // "list" or "tree"
+	ShowHome         bool   `json:"show_home"`           // Open the workspace summary screen at startup
+	SortMode         string `json:"sort_mode,omitempty"` // List ordering: "created", "priority", "updated", "id", "title", "estimate"
+	GroupBy          string `json:"group_by,omitempty"`  // List view sections: "status", "assignee", or "label"
+
+	// ListColumns lays out the issue list rows, in order. Names are icon, id,
+	// priority, type, assignee, estimate, age, labels, and title; a width pads
+	// or truncates the column (Space v o shows and hides them)
+	ListColumns []ListColumn `json:"list_columns,omitempty"`
+
+	// BellAlerts rings the terminal bell and flashes the status bar when a
+	// refresh brings a new P0 or an issue newly assigned to you
+	BellAlerts bool `json:"bell_alerts"`
+
+	// ConfirmDestructive asks before actions that are easy to trigger by
+	// accident: closing an issue with sc and raising one to P0 with 0
+	ConfirmDestructive bool `json:"confirm_destructive"`
+
+	// SkipConfirm lists the prompts turned off with "Don't ask again", by
+	// action ("close", "p0")
+	SkipConfirm map[string]bool `json:"skip_confirm,omitempty"`
+
+	// BranchTemplate names the git branch B creates for an issue, e.g.
+	// "{{id}}-{{slug(title)}}" (the default) or "{{type}}/{{id}}"
+	BranchTemplate string `json:"branch_template,omitempty"`
+
+	// ExternalRefURLs turns external references that aren't URLs into links
+	// (gx); the first rule whose pattern matches a reference is used
+	ExternalRefURLs []ExternalRefURL `json:"external_ref_urls,omitempty"`
+
+	// PriorityAgingDays overrides how many days an unclosed issue of each
+	// priority can stay open before it's flagged for escalation, keyed "P0"
+	// to "P4" (0 turns the check off for that priority); see
+	// state.DefaultAgingDays
+	PriorityAgingDays map[string]int `json:"priority_aging_days,omitempty"`
+
+	// StandupHours is how far back the standup summary (gu) looks (default 24)
+	StandupHours int `json:"standup_hours,omitempty"`
+
+	// SQLFilterThreshold is how many issues the database can hold before
+	// the priority, type, status, and label filters are applied in the
+	// query, loading only the matching issues (default 5000; -1 always
+	// loads everything)
+	SQLFilterThreshold int `json:"sql_filter_threshold,omitempty"`
+
+	// NotificationSeconds overrides how long status bar notifications stay
+	// up, keyed by level: "info", "success", "warn", "error"
+	NotificationSeconds map[string]float64 `json:"notification_seconds,omitempty"`
+
+	// Hooks are shell commands run after the TUI creates an issue, changes
+	// its status, or closes it, with the issue's JSON on stdin
+	Hooks Hooks `json:"hooks"`
+}
+
+// Hooks holds a shell command for each event, e.g.
+// {"on_issue_closed": "notify-send \"Closed $BEADS_ISSUE_ID\""}; an empty
+// one is skipped
+type Hooks struct {
+	OnIssueClosed  string `json:"on_issue_closed,omitempty"`  // After closing an issue (with on_status_change)
+	OnStatusChange string `json:"on_status_change,omitempty"` // After any status change, closing and reopening included
+	OnCreate       string `json:"on_create,omitempty"`        // After creating an issue (children and splits included)
 }
 
+// ListColumn is one column of the issue list:
+// {"name": "assignee", "width": 10}
+type ListColumn struct {
+	Name   string `json:"name" toml:"name"`
+	Width  int    `json:"width,omitempty" toml:"width"`
+	Hidden bool   `json:"hidden,omitempty" toml:"hidden"`
+}
+
+// ExternalRefURL maps external references matching a regular expression to
+// a URL template, where $1 (or ${name}) stands for the pattern's captures:
+// {"pattern": "^([A-Z]+-[0-9]+)$", "url": "https://example.atlassian.net/browse/$1"}
+type ExternalRefURL struct {
+	Pattern string `json:"pattern" toml:"pattern"`
+	URL     string `json:"url" toml:"url"`
+}
+
+// Layout orientations stored in Config.Layout
+const (
+	LayoutHorizontal = "horizontal"
+	LayoutVertical   = "vertical"
+)
+
+// View modes stored in Config.ViewMode
+const (
+	ViewModeList = "list"
+	ViewModeTree = "tree"
+)
+
 // CollapseState holds the collapse state for tree view nodes
 // Keyed by issue ID, value is true if collapsed
 type CollapseState struct {
 	CollapsedNodes map[string]bool `json:"collapsed_nodes"`
 }
 
+// ProjectState holds per-project view preferences, keyed by beads directory.
+// Fields left empty fall back to the global Config.
+type ProjectState struct {
+	ViewMode string   `json:"view_mode,omitempty"` // "list" or "tree"
+	SortMode string   `json:"sort_mode,omitempty"` // List ordering (see Config.SortMode)
+	Watched  []string `json:"watched,omitempty"`   // Issue IDs on the watch list (V)
+
+	// Where the TUI was left, restored on the next launch
+	Selected     string `json:"selected,omitempty"`      // Selected issue ID
+	Filter       string `json:"filter,omitempty"`        // Active filters, as a quick filter query
+	DetailScroll int    `json:"detail_scroll,omitempty"` // Detail panel scroll offset (lines)
+}
+
+// DiscardLog records issues deleted from the TUI (dD) for a project, so
+// references to them that turn up later can be flagged
+type DiscardLog struct {
+	Discarded []DiscardedIssue `json:"discarded"`
+}
+
+// DiscardedIssue is one entry in the DiscardLog
+type DiscardedIssue struct {
+	ID          string    `json:"id"`
+	Title       string    `json:"title"`
+	DiscardedAt time.Time `json:"discarded_at"`
+}
+
+// Worklog records time logged against issues from the TUI (w) for a project.
+// bd has no time tracking of its own, so the log lives with the other
+// per-project state.
+type Worklog struct {
+	Entries []WorklogEntry `json:"entries"`
+}
+
+// WorklogEntry is one block of time spent on an issue
+type WorklogEntry struct {
+	IssueID  string    `json:"issue_id"`
+	Minutes  int       `json:"minutes"`
+	Note     string    `json:"note,omitempty"`
+	Author   string    `json:"author,omitempty"`
+	LoggedAt time.Time `json:"logged_at"`
+}
+
+// Totals returns the minutes logged per issue ID
+func (w *Worklog) Totals() map[string]int {
+	totals := make(map[string]int)
+	for _, entry := range w.Entries {
+		totals[entry.IssueID] += entry.Minutes
+	}
+	return totals
+}
+
+// Drafts holds text typed into dialogs but never submitted (cancelled, or
+// lost to a crash) for a project, so it can be restored the next time the
+// dialog opens. Keys name the dialog and issue, e.g. "comment:tui-1".
+type Drafts struct {
+	Entries map[string]Draft `json:"entries"`
+}
+
+// Draft is the unsent text of one dialog, by field name
+type Draft struct {
+	Fields  map[string]string `json:"fields"`
+	SavedAt time.Time         `json:"saved_at"`
+}
+
 // DefaultConfig returns the default configuration
 func DefaultConfig() *Config {
 	return &Config{
-		Theme: "gruvbox-dark",
+		Theme:          "gruvbox-dark",
+		Layout:         LayoutHorizontal,
+		ShowDetailPane: true,
+		MouseEnabled:   true,
+		ViewMode:       ViewModeList,
+
+		ConfirmDestructive: true,
 	}
 }
 
@@ -59,12 +240,13 @@ func Load() (*Config, error) {
 		return nil, fmt.Errorf("failed to read config file: %w", err)
 	}
 
-	var cfg Config
-	if err := json.Unmarshal(data, &cfg); err != nil {
+	// Start from defaults so fields missing from older config files keep sensible values
+	cfg := DefaultConfig()
+	if err := json.Unmarshal(data, cfg); err != nil {
 		return nil, fmt.Errorf("failed to parse config file: %w", err)
 	}
 
-	return &cfg, nil
+	return cfg, nil
 }
 
 // Save writes the config to disk
@@ -86,9 +268,9 @@ func Save(cfg *Config) error {
 	return nil
 }
 
-// CollapseStatePath returns the path for collapse state file for a given beads directory
-// Uses a hash of the beads path to create a unique filename per project
-func CollapseStatePath(beadsDir string) (string, error) {
+// projectStatePath returns the path of a per-project state file of the given kind.
+// Uses a hash of the beads path to create a unique filename per project.
+func projectStatePath(beadsDir, kind string) (string, error) {
 	homeDir, err := os.UserHomeDir()
 	if err != nil {
 		return "", fmt.Errorf("failed to get home directory: %w", err)
@@ -103,7 +285,77 @@ func CollapseStatePath(beadsDir string) (string, error) {
 	hash := sha256.Sum256([]byte(beadsDir))
 	shortHash := hex.EncodeToString(hash[:])[:8]
 
-	return filepath.Join(configDir, fmt.Sprintf("collapse-%s.json", shortHash)), nil
+	return filepath.Join(configDir, fmt.Sprintf("%s-%s.json", kind, shortHash)), nil
+}
+
+// CollapseStatePath returns the path for collapse state file for a given beads directory
+func CollapseStatePath(beadsDir string) (string, error) {
+	return projectStatePath(beadsDir, "collapse")
+}
+
+// ProjectStatePath returns the path for the project state file for a given beads directory
+func ProjectStatePath(beadsDir string) (string, error) {
+	return projectStatePath(beadsDir, "project")
+}
+
+// DiscardLogPath returns the path for the discard log file for a given beads directory
+func DiscardLogPath(beadsDir string) (string, error) {
+	return projectStatePath(beadsDir, "discarded")
+}
+
+// WorklogPath returns the path for the worklog file for a given beads directory
+func WorklogPath(beadsDir string) (string, error) {
+	return projectStatePath(beadsDir, "worklog")
+}
+
+// DraftsPath returns the path for the dialog drafts file for a given beads directory
+func DraftsPath(beadsDir string) (string, error) {
+	return projectStatePath(beadsDir, "drafts")
+}
+
+// LoadProjectState reads the view preferences for a given beads directory.
+// Returns an empty state if none has been saved yet.
+func LoadProjectState(beadsDir string) (*ProjectState, error) {
+	path, err := ProjectStatePath(beadsDir)
+	if err != nil {
+		return nil, err
+	}
+
+	// If file doesn't exist, return empty state
+	if _, err := os.Stat(path); os.IsNotExist(err) {
+		return &ProjectState{}, nil
+	}
+
+	data, err := os.ReadFile(path)
+	if err != nil {
+		return nil, fmt.Errorf("failed to read project state file: %w", err)
+	}
+
+	var state ProjectState
+	if err := json.Unmarshal(data, &state); err != nil {
+		return nil, fmt.Errorf("failed to parse project state file: %w", err)
+	}
+
+	return &state, nil
+}
+
+// SaveProjectState writes the view preferences for a given beads directory
+func SaveProjectState(beadsDir string, state *ProjectState) error {
+	path, err := ProjectStatePath(beadsDir)
+	if err != nil {
+		return err
+	}
+
+	data, err := json.MarshalIndent(state, "", "  ")
+	if err != nil {
+		return fmt.Errorf("failed to serialize project state: %w", err)
+	}
+
+	if err := os.WriteFile(path, data, 0644); err != nil {
+		return fmt.Errorf("failed to write project state file: %w", err)
+	}
+
+	return nil
 }
 
 // LoadCollapseState reads the collapse state from disk for a given beads directory
@@ -153,3 +405,142 @@ func SaveCollapseState(beadsDir string, state *CollapseState) error {
 
 	return nil
 }
+
+// LoadDiscardLog reads the discarded issues for a given beads directory.
+// Returns an empty log if nothing has been discarded yet.
+func LoadDiscardLog(beadsDir string) (*DiscardLog, error) {
+	path, err := DiscardLogPath(beadsDir)
+	if err != nil {
+		return nil, err
+	}
+
+	// If file doesn't exist, return empty log
+	if _, err := os.Stat(path); os.IsNotExist(err) {
+		return &DiscardLog{}, nil
+	}
+
+	data, err := os.ReadFile(path)
+	if err != nil {
+		return nil, fmt.Errorf("failed to read discard log: %w", err)
+	}
+
+	var discardLog DiscardLog
+	if err := json.Unmarshal(data, &discardLog); err != nil {
+		return nil, fmt.Errorf("failed to parse discard log: %w", err)
+	}
+
+	return &discardLog, nil
+}
+
+// SaveDiscardLog writes the discarded issues for a given beads directory
+func SaveDiscardLog(beadsDir string, discardLog *DiscardLog) error {
+	path, err := DiscardLogPath(beadsDir)
+	if err != nil {
+		return err
+	}
+
+	data, err := json.MarshalIndent(discardLog, "", "  ")
+	if err != nil {
+		return fmt.Errorf("failed to serialize discard log: %w", err)
+	}
+
+	if err := os.WriteFile(path, data, 0644); err != nil {
+		return fmt.Errorf("failed to write discard log: %w", err)
+	}
+
+	return nil
+}
+
+// LoadWorklog reads the logged time for a given beads directory.
+// Returns an empty log if no time has been logged yet.
+func LoadWorklog(beadsDir string) (*Worklog, error) {
+	path, err := WorklogPath(beadsDir)
+	if err != nil {
+		return nil, err
+	}
+
+	// If file doesn't exist, return empty log
+	if _, err := os.Stat(path); os.IsNotExist(err) {
+		return &Worklog{}, nil
+	}
+
+	data, err := os.ReadFile(path)
+	if err != nil {
+		return nil, fmt.Errorf("failed to read worklog: %w", err)
+	}
+
+	var worklog Worklog
+	if err := json.Unmarshal(data, &worklog); err != nil {
+		return nil, fmt.Errorf("failed to parse worklog: %w", err)
+	}
+
+	return &worklog, nil
+}
+
+// SaveWorklog writes the logged time for a given beads directory
+func SaveWorklog(beadsDir string, worklog *Worklog) error {
+	path, err := WorklogPath(beadsDir)
+	if err != nil {
+		return err
+	}
+
+	data, err := json.MarshalIndent(worklog, "", "  ")
+	if err != nil {
+		return fmt.Errorf("failed to serialize worklog: %w", err)
+	}
+
+	if err := os.WriteFile(path, data, 0644); err != nil {
+		return fmt.Errorf("failed to write worklog: %w", err)
+	}
+
+	return nil
+}
+
+// LoadDrafts reads the unsent dialog text for a given beads directory.
+// Returns no drafts if none have been saved yet.
+func LoadDrafts(beadsDir string) (*Drafts, error) {
+	path, err := DraftsPath(beadsDir)
+	if err != nil {
+		return nil, err
+	}
+
+	// If file doesn't exist, return no drafts
+	if _, err := os.Stat(path); os.IsNotExist(err) {
+		return &Drafts{Entries: make(map[string]Draft)}, nil
+	}
+
+	data, err := os.ReadFile(path)
+	if err != nil {
+		return nil, fmt.Errorf("failed to read drafts: %w", err)
+	}
+
+	var drafts Drafts
+	if err := json.Unmarshal(data, &drafts); err != nil {
+		return nil, fmt.Errorf("failed to parse drafts: %w", err)
+	}
+
+	if drafts.Entries == nil {
+		drafts.Entries = make(map[string]Draft)
+	}
+
+	return &drafts, nil
+}
+
+// SaveDrafts writes the unsent dialog text for a given beads directory
+func SaveDrafts(beadsDir string, drafts *Drafts) error {
+	path, err := DraftsPath(beadsDir)
+	if err != nil {
+		return err
+	}
+
+	data, err := json.MarshalIndent(drafts, "", "  ")
+	if err != nil {
+		return fmt.Errorf("failed to serialize drafts: %w", err)
+	}
+
+	if err := os.WriteFile(path, data, 0644); err != nil {
+		return fmt.Errorf("failed to write drafts: %w", err)
+	}
+
+	return nil
+}
@@ -4,6 +4,7 @@ import (
 	"os"
 	"path/filepath"
 	"testing"
+	"time"
 )
 
 func TestDefaultConfig(t *testing.T) {
@@ -11,6 +12,9 @@ func TestDefaultConfig(t *testing.T) {
 	if cfg.Theme != "gruvbox-dark" {
 		t.Errorf("expected default theme to be 'gruvbox-dark', got %q", cfg.Theme)
 	}
+	if !cfg.ConfirmDestructive {
+		t.Error("expected confirmations to be on by default")
+	}
 }
 
 func TestLoadSaveConfig(t *testing.T) {
@@ -73,3 +77,202 @@ func TestConfigPath(t *testing.T) {
 		t.Error("config directory was not created")
 	}
 }
+
+func TestLoadSaveUIPreferences(t *testing.T) {
+	tmpDir := t.TempDir()
+	oldHome := os.Getenv("HOME")
+	os.Setenv("HOME", tmpDir)
+	defer os.Setenv("HOME", oldHome)
+
+	cfg := DefaultConfig()
+	cfg.Layout = LayoutVertical
+	cfg.ShowDetailPane = false
+	cfg.ShowClosedIssues = true
+	cfg.MouseEnabled = false
+	cfg.ViewMode = ViewModeTree
+	if err := Save(cfg); err != nil {
+		t.Fatalf("Save() failed: %v", err)
+	}
+
+	loaded, err := Load()
+	if err != nil {
+		t.Fatalf("Load() failed: %v", err)
+	}
+	if loaded.Layout != LayoutVertical || loaded.ShowDetailPane || !loaded.ShowClosedIssues ||
+		loaded.MouseEnabled || loaded.ViewMode != ViewModeTree {
+		t.Errorf("UI preferences not round-tripped: %+v", loaded)
+	}
+}
+
+func TestLoadOldConfigKeepsUIDefaults(t *testing.T) {
+	tmpDir := t.TempDir()
+	oldHome := os.Getenv("HOME")
+	os.Setenv("HOME", tmpDir)
+	defer os.Setenv("HOME", oldHome)
+
+	// Config written before UI preferences existed
+	path, _ := ConfigPath()
+	if err := os.WriteFile(path, []byte(`{"theme": "nord"}`), 0644); err != nil {
+		t.Fatalf("failed to write config: %v", err)
+	}
+
+	cfg, err := Load()
+	if err != nil {
+		t.Fatalf("Load() failed: %v", err)
+	}
+	if cfg.Theme != "nord" {
+		t.Errorf("expected theme 'nord', got %q", cfg.Theme)
+	}
+	if !cfg.MouseEnabled || !cfg.ShowDetailPane {
+		t.Error("expected mouse and detail pane to default to enabled")
+	}
+	if !cfg.ConfirmDestructive {
+		t.Error("expected confirmations to default to enabled")
+	}
+	if cfg.Layout != LayoutHorizontal || cfg.ViewMode != ViewModeList {
+		t.Errorf("expected default layout and view mode, got %q / %q", cfg.Layout, cfg.ViewMode)
+	}
+}
+
+func TestProjectStatePerBeadsDir(t *testing.T) {
+	tmpDir := t.TempDir()
+	oldHome := os.Getenv("HOME")
+	os.Setenv("HOME", tmpDir)
+	defer os.Setenv("HOME", oldHome)
+
+	// Unsaved project returns empty state
+	state, err := LoadProjectState("/work/alpha/.beads")
+	if err != nil {
+		t.Fatalf("LoadProjectState() failed: %v", err)
+	}
+	if state.ViewMode != "" {
+		t.Errorf("expected empty view mode, got %q", state.ViewMode)
+	}
+
+	if err := SaveProjectState("/work/alpha/.beads", &ProjectState{ViewMode: ViewModeTree, SortMode: "priority", Selected: "tui-abc", Filter: "p1 bug", DetailScroll: 12}); err != nil {
+		t.Fatalf("SaveProjectState() failed: %v", err)
+	}
+	if err := SaveProjectState("/work/beta/.beads", &ProjectState{ViewMode: ViewModeList}); err != nil {
+		t.Fatalf("SaveProjectState() failed: %v", err)
+	}
+
+	alpha, _ := LoadProjectState("/work/alpha/.beads")
+	beta, _ := LoadProjectState("/work/beta/.beads")
+	if alpha.ViewMode != ViewModeTree || beta.ViewMode != ViewModeList {
+		t.Errorf("expected per-project view modes, got alpha=%q beta=%q", alpha.ViewMode, beta.ViewMode)
+	}
+	if alpha.SortMode != "priority" || beta.SortMode != "" {
+		t.Errorf("expected per-project sort modes, got alpha=%q beta=%q", alpha.SortMode, beta.SortMode)
+	}
+	if alpha.Selected != "tui-abc" || alpha.Filter != "p1 bug" || alpha.DetailScroll != 12 || beta.Selected != "" {
+		t.Errorf("expected per-project session, got alpha=%+v beta=%+v", alpha, beta)
+	}
+
+	// Project and collapse state live in separate files for the same project
+	projectPath, _ := ProjectStatePath("/work/alpha/.beads")
+	collapsePath, _ := CollapseStatePath("/work/alpha/.beads")
+	if projectPath == collapsePath {
+		t.Error("expected distinct project and collapse state paths")
+	}
+}
+
+func TestLoadSaveDiscardLog(t *testing.T) {
+	tmpDir := t.TempDir()
+	oldHome := os.Getenv("HOME")
+	os.Setenv("HOME", tmpDir)
+	defer os.Setenv("HOME", oldHome)
+
+	// Nothing discarded yet returns an empty log
+	discardLog, err := LoadDiscardLog("/work/alpha/.beads")
+	if err != nil {
+		t.Fatalf("LoadDiscardLog() failed: %v", err)
+	}
+	if len(discardLog.Discarded) != 0 {
+		t.Errorf("expected empty log, got %v", discardLog.Discarded)
+	}
+
+	discardLog.Discarded = append(discardLog.Discarded, DiscardedIssue{ID: "tui-1", Title: "Spam", DiscardedAt: time.Date(2026, 1, 2, 3, 4, 5, 0, time.UTC)})
+	if err := SaveDiscardLog("/work/alpha/.beads", discardLog); err != nil {
+		t.Fatalf("SaveDiscardLog() failed: %v", err)
+	}
+
+	alpha, err := LoadDiscardLog("/work/alpha/.beads")
+	if err != nil {
+		t.Fatalf("LoadDiscardLog() failed: %v", err)
+	}
+	if len(alpha.Discarded) != 1 || alpha.Discarded[0].ID != "tui-1" || !alpha.Discarded[0].DiscardedAt.Equal(discardLog.Discarded[0].DiscardedAt) {
+		t.Errorf("unexpected log after round trip: %+v", alpha.Discarded)
+	}
+	if beta, _ := LoadDiscardLog("/work/beta/.beads"); len(beta.Discarded) != 0 {
+		t.Errorf("expected discard logs to be per project, got %+v", beta.Discarded)
+	}
+}
+
+func TestLoadSaveWorklog(t *testing.T) {
+	tmpDir := t.TempDir()
+	oldHome := os.Getenv("HOME")
+	os.Setenv("HOME", tmpDir)
+	defer os.Setenv("HOME", oldHome)
+
+	worklog, err := LoadWorklog("/work/alpha/.beads")
+	if err != nil {
+		t.Fatalf("LoadWorklog() failed: %v", err)
+	}
+	if len(worklog.Entries) != 0 {
+		t.Errorf("expected empty worklog, got %v", worklog.Entries)
+	}
+
+	loggedAt := time.Date(2026, 1, 2, 3, 4, 5, 0, time.UTC)
+	worklog.Entries = append(worklog.Entries,
+		WorklogEntry{IssueID: "tui-1", Minutes: 30, Note: "triage", LoggedAt: loggedAt},
+		WorklogEntry{IssueID: "tui-1", Minutes: 45, LoggedAt: loggedAt},
+		WorklogEntry{IssueID: "tui-2", Minutes: 15, LoggedAt: loggedAt})
+	if err := SaveWorklog("/work/alpha/.beads", worklog); err != nil {
+		t.Fatalf("SaveWorklog() failed: %v", err)
+	}
+
+	alpha, err := LoadWorklog("/work/alpha/.beads")
+	if err != nil {
+		t.Fatalf("LoadWorklog() failed: %v", err)
+	}
+	totals := alpha.Totals()
+	if len(alpha.Entries) != 3 || totals["tui-1"] != 75 || totals["tui-2"] != 15 {
+		t.Errorf("unexpected worklog after round trip: %+v (totals %v)", alpha.Entries, totals)
+	}
+	if beta, _ := LoadWorklog("/work/beta/.beads"); len(beta.Entries) != 0 {
+		t.Errorf("expected worklogs to be per project, got %+v", beta.Entries)
+	}
+}
+
+func TestLoadSaveDrafts(t *testing.T) {
+	tmpDir := t.TempDir()
+	oldHome := os.Getenv("HOME")
+	os.Setenv("HOME", tmpDir)
+	defer os.Setenv("HOME", oldHome)
+
+	drafts, err := LoadDrafts("/work/alpha/.beads")
+	if err != nil {
+		t.Fatalf("LoadDrafts() failed: %v", err)
+	}
+	if len(drafts.Entries) != 0 {
+		t.Errorf("expected no drafts, got %v", drafts.Entries)
+	}
+
+	savedAt := time.Date(2026, 1, 2, 3, 4, 5, 0, time.UTC)
+	drafts.Entries["comment:tui-1"] = Draft{Fields: map[string]string{"comment": "half a thought"}, SavedAt: savedAt}
+	if err := SaveDrafts("/work/alpha/.beads", drafts); err != nil {
+		t.Fatalf("SaveDrafts() failed: %v", err)
+	}
+
+	alpha, err := LoadDrafts("/work/alpha/.beads")
+	if err != nil {
+		t.Fatalf("LoadDrafts() failed: %v", err)
+	}
+	draft := alpha.Entries["comment:tui-1"]
+	if draft.Fields["comment"] != "half a thought" || !draft.SavedAt.Equal(savedAt) {
+		t.Errorf("unexpected draft after round trip: %+v", draft)
+	}
+	if beta, _ := LoadDrafts("/work/beta/.beads"); len(beta.Entries) != 0 {
+		t.Errorf("expected drafts to be per project, got %+v", beta.Entries)
+	}
+}
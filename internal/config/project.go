@@ -0,0 +1,126 @@
+package config
+
+import (
+	"fmt"
+	"os"
+	"path/filepath"
+
+	"github.com/BurntSushi/toml"
+)
+
+// ProjectConfigFile is the project's shared TUI config, in its .beads
+// directory, so a team can commit its defaults with the repo
+const ProjectConfigFile = "tui.toml"
+
+// ProjectConfig holds project defaults read from .beads/tui.toml. Fields it
+// sets override the user's global Config; command line flags and environment
+// variables still win. bd_path and hooks are deliberately left out: a
+// checked-out repo shouldn't pick the commands the TUI runs.
+type ProjectConfig struct {
+	Theme             string           `toml:"theme"`
+	ViewMode          string           `toml:"view_mode"`
+	SortMode          string           `toml:"sort_mode"`
+	GroupBy           string           `toml:"group_by"`
+	Filter            string           `toml:"filter"` // Quick filter query applied at startup
+	ListColumns       []ListColumn     `toml:"list_columns"`
+	BranchTemplate    string           `toml:"branch_template"`
+	ExternalRefURLs   []ExternalRefURL `toml:"external_ref_urls"`
+	PriorityAgingDays map[string]int   `toml:"priority_aging_days"`
+	StandupHours      int              `toml:"standup_hours"`
+
+	// Leader adds leader sequences (or replaces built-in ones with the same
+	// keys) that replay existing shortcuts
+	Leader []LeaderBinding `toml:"leader"`
+}
+
+// LeaderBinding is a project leader sequence: Space, then Keys, sends the
+// keys in Sends, e.g. {keys = "gr", description = "Release epic", sends = "gd"}
+type LeaderBinding struct {
+	Keys        string `toml:"keys"`
+	Description string `toml:"description"`
+	Sends       string `toml:"sends"`
+}
+
+// ProjectConfigPath returns the path of the project config for a beads directory
+func ProjectConfigPath(beadsDir string) string {
+	return filepath.Join(beadsDir, ProjectConfigFile)
+}
+
+// LoadProjectConfig reads .beads/tui.toml. Returns an empty config if the
+// project doesn't have one.
+func LoadProjectConfig(beadsDir string) (*ProjectConfig, error) {
+	path := ProjectConfigPath(beadsDir)
+	data, err := os.ReadFile(path)
+	if os.IsNotExist(err) {
+		return &ProjectConfig{}, nil
+	}
+	if err != nil {
+		return nil, fmt.Errorf("failed to read %s: %w", path, err)
+	}
+
+	var project ProjectConfig
+	if err := toml.Unmarshal(data, &project); err != nil {
+		return nil, fmt.Errorf("failed to parse %s: %w", path, err)
+	}
+	return &project, nil
+}
+
+// Apply overrides cfg with the fields the project config sets
+func (p *ProjectConfig) Apply(cfg *Config) {
+	p.merge(cfg, p.asConfig())
+}
+
+// Unapply returns a copy of cfg with the fields the project config sets put
+// back to their values in global, so saving it doesn't turn one project's
+// defaults into the user's own
+func (p *ProjectConfig) Unapply(cfg, global *Config) *Config {
+	restored := *cfg
+	p.merge(&restored, global)
+	return &restored
+}
+
+// asConfig returns the project's settings in a Config, for merge
+func (p *ProjectConfig) asConfig() *Config {
+	return &Config{
+		Theme:             p.Theme,
+		ViewMode:          p.ViewMode,
+		SortMode:          p.SortMode,
+		GroupBy:           p.GroupBy,
+		ListColumns:       p.ListColumns,
+		BranchTemplate:    p.BranchTemplate,
+		ExternalRefURLs:   p.ExternalRefURLs,
+		PriorityAgingDays: p.PriorityAgingDays,
+		StandupHours:      p.StandupHours,
+	}
+}
+
+// merge copies the fields the project config sets from src to dst
+func (p *ProjectConfig) merge(dst, src *Config) {
+	if p.Theme != "" {
+		dst.Theme = src.Theme
+	}
+	if p.ViewMode != "" {
+		dst.ViewMode = src.ViewMode
+	}
+	if p.SortMode != "" {
+		dst.SortMode = src.SortMode
+	}
+	if p.GroupBy != "" {
+		dst.GroupBy = src.GroupBy
+	}
+	if len(p.ListColumns) > 0 {
+		dst.ListColumns = src.ListColumns
+	}
+	if p.BranchTemplate != "" {
+		dst.BranchTemplate = src.BranchTemplate
+	}
+	if len(p.ExternalRefURLs) > 0 {
+		dst.ExternalRefURLs = src.ExternalRefURLs
+	}
+	if len(p.PriorityAgingDays) > 0 {
+		dst.PriorityAgingDays = src.PriorityAgingDays
+	}
+	if p.StandupHours > 0 {
+		dst.StandupHours = src.StandupHours
+	}
+}
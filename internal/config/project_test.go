@@ -0,0 +1,98 @@
+package config
+
+import (
+	"os"
+	"path/filepath"
+	"reflect"
+	"testing"
+)
+
+func TestLoadProjectConfig(t *testing.T) {
+	beadsDir := t.TempDir()
+
+	// No tui.toml: nothing to override
+	project, err := LoadProjectConfig(beadsDir)
+	if err != nil {
+		t.Fatalf("LoadProjectConfig() without a file failed: %v", err)
+	}
+	if !reflect.DeepEqual(project, &ProjectConfig{}) {
+		t.Errorf("expected an empty project config, got %+v", project)
+	}
+
+	data := `
+theme = "nord"
+filter = "p1 bug #ui"
+group_by = "assignee"
+
+[[list_columns]]
+name = "id"
+
+[[list_columns]]
+name = "title"
+width = 50
+
+[priority_aging_days]
+P0 = 1
+
+[[leader]]
+keys = "gr"
+description = "Release notes"
+sends = "gd"
+`
+	if err := os.WriteFile(filepath.Join(beadsDir, ProjectConfigFile), []byte(data), 0644); err != nil {
+		t.Fatal(err)
+	}
+	project, err = LoadProjectConfig(beadsDir)
+	if err != nil {
+		t.Fatalf("LoadProjectConfig() failed: %v", err)
+	}
+	if project.Theme != "nord" || project.Filter != "p1 bug #ui" || project.GroupBy != "assignee" {
+		t.Errorf("unexpected settings: %+v", project)
+	}
+	if want := []ListColumn{{Name: "id"}, {Name: "title", Width: 50}}; !reflect.DeepEqual(project.ListColumns, want) {
+		t.Errorf("ListColumns = %+v, want %+v", project.ListColumns, want)
+	}
+	if project.PriorityAgingDays["P0"] != 1 {
+		t.Errorf("PriorityAgingDays = %v", project.PriorityAgingDays)
+	}
+	if want := []LeaderBinding{{Keys: "gr", Description: "Release notes", Sends: "gd"}}; !reflect.DeepEqual(project.Leader, want) {
+		t.Errorf("Leader = %+v, want %+v", project.Leader, want)
+	}
+
+	if err := os.WriteFile(filepath.Join(beadsDir, ProjectConfigFile), []byte("theme = "), 0644); err != nil {
+		t.Fatal(err)
+	}
+	if _, err := LoadProjectConfig(beadsDir); err == nil {
+		t.Error("expected an error for invalid TOML")
+	}
+}
+
+func TestProjectConfigApplyUnapply(t *testing.T) {
+	global := DefaultConfig()
+	global.Theme = "gruvbox-dark"
+	global.GroupBy = "label"
+	global.StandupHours = 48
+
+	cfg := *global
+	project := &ProjectConfig{Theme: "nord", ListColumns: []ListColumn{{Name: "id"}}}
+	project.Apply(&cfg)
+	if cfg.Theme != "nord" || len(cfg.ListColumns) != 1 {
+		t.Errorf("expected project theme and columns, got %q %+v", cfg.Theme, cfg.ListColumns)
+	}
+	if cfg.GroupBy != "label" || cfg.StandupHours != 48 {
+		t.Errorf("expected unset fields to keep their global values, got %q %d", cfg.GroupBy, cfg.StandupHours)
+	}
+
+	// Settings changed in the TUI are saved; the project's overrides aren't
+	cfg.Layout = LayoutVertical
+	saved := project.Unapply(&cfg, global)
+	if saved.Theme != "gruvbox-dark" || saved.ListColumns != nil {
+		t.Errorf("expected global theme and columns to be saved, got %q %+v", saved.Theme, saved.ListColumns)
+	}
+	if saved.Layout != LayoutVertical {
+		t.Errorf("expected the layout change to be saved, got %q", saved.Layout)
+	}
+	if cfg.Theme != "nord" {
+		t.Error("Unapply must not change the config in use")
+	}
+}
@@ -0,0 +1,294 @@
+// Package demo generates a sample beads project for demo mode (--demo):
+// epics with children, blockers, a spread of priorities, types, statuses,
+// labels, and assignees, comments, and an audit trail, so every view has
+// something to show. The same time and user always give the same data.
+// Source serves it from memory in place of a database.
+package demo
+
+import (
+	"fmt"
+	"math/rand/v2"
+	"strings"
+	"time"
+
+	"github.com/andy/beads-tui/internal/parser"
+)
+
+// Prefix is the issue ID prefix of the demo project
+const Prefix = "demo"
+
+// Dataset is a generated project: the issues, with their labels,
+// dependencies, and comments, and the events behind their history
+type Dataset struct {
+	Issues []*parser.Issue
+	Events []*parser.Event
+}
+
+// spec describes one hand-written demo issue; the generator fills in IDs,
+// times, and history
+type spec struct {
+	key       string // Name the other specs refer to it by
+	title     string
+	issueType parser.IssueType
+	priority  int
+	status    parser.Status
+	assignee  string // "me" for the demo user
+	labels    []string
+	parent    string   // Key of the epic it belongs to
+	blockers  []string // Keys of the issues it waits on
+	related   string   // Key of an issue it relates to
+	age       int      // Days since it was created
+	estimate  int      // Minutes, 0 for none
+	due       int      // Days from now until it's due (negative is overdue), 0 for none
+	ref       string   // External reference
+	comments  []string // "author: text"
+	desc      string
+}
+
+// specs is the demo project. Children come after their epic and blockers
+// before the issues they block.
+var specs = []spec{
+	{key: "onboarding", title: "Onboarding revamp", issueType: parser.TypeEpic, priority: 1, status: parser.StatusInProgress, assignee: "alice", age: 40,
+		desc: "Get new users to their first closed issue in under ten minutes."},
+	{key: "welcome", title: "Rewrite the welcome screen copy", issueType: parser.TypeTask, priority: 2, status: parser.StatusClosed, assignee: "carol", parent: "onboarding", labels: []string{"docs", "ui"}, age: 38, estimate: 120},
+	{key: "wizard", title: "First-run setup wizard", issueType: parser.TypeFeature, priority: 1, status: parser.StatusInProgress, assignee: "me", parent: "onboarding", labels: []string{"ui"}, age: 30, estimate: 480, due: 3,
+		desc:     "Offer to run bd init when there's no database, then show the basic keys.",
+		comments: []string{"alice: Let's keep it to one screen if we can.", "me: Two: the form, then the keys. Prototype is up on the branch."}},
+	{key: "sample", title: "Sample issue for new projects", issueType: parser.TypeTask, priority: 3, status: parser.StatusOpen, parent: "onboarding", age: 30, estimate: 60},
+	{key: "analytics", title: "Get access to the analytics warehouse", issueType: parser.TypeChore, priority: 2, status: parser.StatusOpen, assignee: "bob", labels: []string{"backend"}, age: 21},
+	{key: "dropoff", title: "Track where new users drop off", issueType: parser.TypeTask, priority: 2, status: parser.StatusBlocked, assignee: "bob", parent: "onboarding", blockers: []string{"analytics"}, labels: []string{"backend"}, age: 20,
+		comments: []string{"bob: BLOCKED: waiting on analytics warehouse access"}},
+
+	{key: "search", title: "Search v2", issueType: parser.TypeEpic, priority: 2, status: parser.StatusOpen, assignee: "carol", age: 60},
+	{key: "ranking", title: "Rank search results by field", issueType: parser.TypeFeature, priority: 2, status: parser.StatusClosed, assignee: "carol", parent: "search", age: 58, estimate: 240},
+	{key: "prefixes", title: "Field prefixes in search (title:, label:)", issueType: parser.TypeFeature, priority: 2, status: parser.StatusInProgress, assignee: "carol", parent: "search", age: 25, estimate: 240,
+		comments: []string{"alice: Could comment: work too?", "carol: Yes, comments are searched in the database."}},
+	{key: "highlight", title: "Highlight matches in the details", issueType: parser.TypeFeature, priority: 3, status: parser.StatusOpen, parent: "search", related: "prefixes", labels: []string{"ui"}, age: 25},
+	{key: "bench", title: "Benchmark search on 10k issues", issueType: parser.TypeChore, priority: 3, status: parser.StatusOpen, parent: "search", age: 12, estimate: 90},
+
+	{key: "mobile", title: "Mobile beta", issueType: parser.TypeEpic, priority: 1, status: parser.StatusOpen, assignee: "me", labels: []string{"mobile"}, age: 50, due: 14},
+	{key: "pagination", title: "Paginate the sync API", issueType: parser.TypeTask, priority: 1, status: parser.StatusInProgress, assignee: "bob", parent: "mobile", labels: []string{"backend"}, age: 18, estimate: 360},
+	{key: "offline", title: "Offline sync", issueType: parser.TypeFeature, priority: 1, status: parser.StatusOpen, assignee: "me", parent: "mobile", blockers: []string{"pagination"}, labels: []string{"mobile"}, age: 45, estimate: 960},
+	{key: "rotate", title: "App crashes when the screen rotates during sync", issueType: parser.TypeBug, priority: 0, status: parser.StatusOpen, parent: "mobile", labels: []string{"mobile", "urgent"}, age: 1,
+		desc:     "Steps: start a sync, rotate the phone. The app closes without an error.",
+		comments: []string{"carol: Reproduced on Android 14 and 15."}},
+	{key: "push", title: "Push notifications for assignments", issueType: parser.TypeFeature, priority: 2, status: parser.StatusOpen, parent: "mobile", labels: []string{"mobile"}, age: 44},
+	{key: "signup", title: "Beta signup page", issueType: parser.TypeTask, priority: 2, status: parser.StatusClosed, assignee: "alice", parent: "mobile", labels: []string{"ui"}, age: 48, estimate: 180},
+
+	{key: "sso", title: "Login loops back to the SSO page", issueType: parser.TypeBug, priority: 0, status: parser.StatusInProgress, assignee: "alice", labels: []string{"backend", "urgent"}, age: 2,
+		comments: []string{"bob: Only with the new identity provider, as far as I can tell."}},
+	{key: "audit", title: "Audit API token usage", issueType: parser.TypeTask, priority: 1, status: parser.StatusOpen, assignee: "bob", labels: []string{"backend"}, age: 9, estimate: 240},
+	{key: "ratelimit", title: "Rate-limit API tokens", issueType: parser.TypeFeature, priority: 1, status: parser.StatusOpen, blockers: []string{"audit"}, labels: []string{"backend"}, age: 9},
+	{key: "flaky", title: "Flaky CI on Windows", issueType: parser.TypeBug, priority: 2, status: parser.StatusOpen, labels: []string{"discuss"}, age: 15, ref: "gh-412",
+		comments: []string{"alice: Third red build this week, always the watcher tests.", "bob: Worth talking through at standup."}},
+	{key: "dashboard", title: "Dashboard takes five seconds to load", issueType: parser.TypeBug, priority: 2, status: parser.StatusOpen, assignee: "carol", labels: []string{"ui"}, age: 130, estimate: 240, due: -2},
+	{key: "contrast", title: "Low contrast in the dark theme", issueType: parser.TypeBug, priority: 3, status: parser.StatusOpen, labels: []string{"ui"}, age: 7, estimate: 30},
+	{key: "typo", title: "Typo on the settings page", issueType: parser.TypeBug, priority: 4, status: parser.StatusOpen, labels: []string{"ui"}, age: 3, estimate: 15},
+	{key: "docs", title: "Document every config option", issueType: parser.TypeTask, priority: 3, status: parser.StatusOpen, assignee: "me", labels: []string{"docs"}, age: 33, estimate: 180},
+	{key: "legacy", title: "Remove the v1 endpoints", issueType: parser.TypeChore, priority: 3, status: parser.StatusOpen, labels: []string{"backend"}, age: 70},
+	{key: "go", title: "Upgrade to Go 1.24", issueType: parser.TypeChore, priority: 2, status: parser.StatusClosed, assignee: "bob", age: 26, estimate: 60},
+	{key: "csv", title: "Export the list to CSV", issueType: parser.TypeFeature, priority: 2, status: parser.StatusClosed, assignee: "me", age: 35, estimate: 120},
+}
+
+// archiveTitles pad out the closed issues so the archive and statistics
+// have history to show
+var archiveTitles = []string{
+	"Fix the date picker in Safari", "Cache avatar images", "Retry failed webhooks",
+	"Split the settings page into tabs", "Crash when the config file is empty",
+	"Add a health check endpoint", "Move CI to the new runners", "Trim the Docker image",
+	"Show the build number in the footer", "Keyboard shortcut for search",
+	"Fix the off-by-one in pagination", "Log slow database queries",
+	"Rename the staging bucket", "Drop the unused feature flags",
+	"Timezone bug in the weekly report", "Update the license headers",
+}
+
+// Generate builds the demo project as of now. user is the person running
+// the demo (issues assigned to "me" go to them), so "my work" has issues.
+func Generate(now time.Time, user string) *Dataset {
+	if user == "" {
+		user = "me"
+	}
+	rng := rand.New(rand.NewPCG(1, 2))
+	g := &generator{now: now, rng: rng, used: make(map[string]bool), ids: make(map[string]string), byKey: make(map[string]*parser.Issue)}
+	assignees := map[string]string{"me": user}
+
+	for _, s := range specs {
+		id := g.newID(s.parent)
+		g.ids[s.key] = id
+		created := now.Add(-time.Duration(s.age)*24*time.Hour - g.jitter())
+		issue := &parser.Issue{
+			ID:          id,
+			Title:       s.title,
+			Description: s.desc,
+			Status:      s.status,
+			Priority:    s.priority,
+			IssueType:   s.issueType,
+			Assignee:    s.assignee,
+			CreatedAt:   created,
+			UpdatedAt:   created,
+			Labels:      s.labels,
+		}
+		if name, ok := assignees[s.assignee]; ok {
+			issue.Assignee = name
+		}
+		if s.estimate > 0 {
+			estimate := s.estimate
+			issue.EstimatedMinutes = &estimate
+		}
+		if s.due != 0 {
+			due := time.Date(now.Year(), now.Month(), now.Day()+s.due, 0, 0, 0, 0, time.UTC)
+			issue.DueDate = &due
+		}
+		if s.ref != "" {
+			ref := s.ref
+			issue.ExternalRef = &ref
+		}
+		if s.parent != "" {
+			issue.Dependencies = append(issue.Dependencies, g.dependency(issue, s.parent, parser.DepParentChild))
+		}
+		for _, blocker := range s.blockers {
+			issue.Dependencies = append(issue.Dependencies, g.dependency(issue, blocker, parser.DepBlocks))
+		}
+		if s.related != "" {
+			issue.Dependencies = append(issue.Dependencies, g.dependency(issue, s.related, parser.DepRelated))
+		}
+		g.history(issue, s.age)
+		for i, comment := range s.comments {
+			author, text, _ := strings.Cut(comment, ": ")
+			if name, ok := assignees[author]; ok {
+				author = name
+			}
+			at := created.Add(time.Duration(i+1) * (now.Sub(created) / time.Duration(len(s.comments)+1)))
+			issue.Comments = append(issue.Comments, &parser.Comment{IssueID: id, Author: author, Text: text, CreatedAt: at})
+			g.event(issue, "commented", author, "", "", at)
+		}
+		g.byKey[s.key] = issue
+		g.issues = append(g.issues, issue)
+	}
+
+	// Closed issues from the last three months
+	people := []string{"alice", "bob", "carol", user}
+	types := []parser.IssueType{parser.TypeBug, parser.TypeTask, parser.TypeFeature, parser.TypeChore}
+	for i, title := range archiveTitles {
+		age := 5 + rng.IntN(85)
+		created := now.Add(-time.Duration(age)*24*time.Hour - g.jitter())
+		issue := &parser.Issue{
+			ID:        g.newID(""),
+			Title:     title,
+			Status:    parser.StatusClosed,
+			Priority:  1 + rng.IntN(4),
+			IssueType: types[i%len(types)],
+			Assignee:  people[rng.IntN(len(people))],
+			CreatedAt: created,
+			UpdatedAt: created,
+		}
+		g.history(issue, age)
+		g.issues = append(g.issues, issue)
+	}
+
+	return &Dataset{Issues: g.issues, Events: g.events}
+}
+
+// generator holds the state of one Generate call
+type generator struct {
+	now    time.Time
+	rng    *rand.Rand
+	used   map[string]bool          // IDs handed out
+	ids    map[string]string        // Spec key -> ID
+	byKey  map[string]*parser.Issue // Spec key -> issue
+	issues []*parser.Issue
+	events []*parser.Event
+	nextID int64
+}
+
+// newID returns an unused ID: a short random one, or the next dotted child
+// ID of the epic with key parent
+func (g *generator) newID(parent string) string {
+	if parent != "" {
+		for n := 1; ; n++ {
+			id := fmt.Sprintf("%s.%d", g.ids[parent], n)
+			if !g.used[id] {
+				g.used[id] = true
+				return id
+			}
+		}
+	}
+	const alphabet = "abcdefghijklmnopqrstuvwxyz0123456789"
+	for {
+		var sb strings.Builder
+		sb.WriteString(Prefix + "-")
+		for range 3 {
+			sb.WriteByte(alphabet[g.rng.IntN(len(alphabet))])
+		}
+		if id := sb.String(); !g.used[id] {
+			g.used[id] = true
+			return id
+		}
+	}
+}
+
+// jitter is a few random hours, so issues created the same day aren't
+// created at the same time
+func (g *generator) jitter() time.Duration {
+	return time.Duration(g.rng.IntN(12*60)) * time.Minute
+}
+
+// dependency links issue to the issue with key target
+func (g *generator) dependency(issue *parser.Issue, target string, depType parser.DependencyType) *parser.Dependency {
+	return &parser.Dependency{IssueID: issue.ID, DependsOnID: g.ids[target], Type: depType, CreatedAt: issue.CreatedAt, CreatedBy: "alice"}
+}
+
+// history records the issue's creation and the status changes that got it
+// to its status, and sets its updated and closed times to match. Work
+// started a while after creation and finished a while after that, the
+// most recent of it within the last day so the standup has something.
+func (g *generator) history(issue *parser.Issue, age int) {
+	actor := issue.Assignee
+	if actor == "" {
+		actor = "alice"
+	}
+	g.event(issue, "created", actor, "", "", issue.CreatedAt)
+	if issue.Status == parser.StatusOpen {
+		return
+	}
+
+	span := g.now.Sub(issue.CreatedAt)
+	started := issue.CreatedAt.Add(span / 3)
+	if age <= 2 {
+		started = g.now.Add(-time.Duration(1+g.rng.IntN(6)) * time.Hour)
+	}
+	if issue.Status == parser.StatusBlocked {
+		g.event(issue, "status_changed", actor, string(parser.StatusOpen), string(parser.StatusBlocked), started)
+		issue.UpdatedAt = started
+		return
+	}
+	g.event(issue, "status_changed", actor, string(parser.StatusOpen), string(parser.StatusInProgress), started)
+	issue.UpdatedAt = started
+	if issue.Status != parser.StatusClosed {
+		return
+	}
+
+	closed := started.Add(g.now.Sub(started) / 2)
+	if g.rng.IntN(5) == 0 {
+		closed = g.now.Add(-time.Duration(1+g.rng.IntN(20)) * time.Hour)
+	}
+	g.event(issue, "status_changed", actor, string(parser.StatusInProgress), string(parser.StatusClosed), closed)
+	issue.UpdatedAt = closed
+	issue.ClosedAt = &closed
+}
+
+// event adds an audit trail entry for issue
+func (g *generator) event(issue *parser.Issue, eventType, actor, oldValue, newValue string, at time.Time) {
+	g.nextID++
+	event := &parser.Event{ID: g.nextID, IssueID: issue.ID, EventType: eventType, Actor: actor, CreatedAt: at}
+	if oldValue != "" {
+		event.OldValue = &oldValue
+	}
+	if newValue != "" {
+		event.NewValue = &newValue
+	}
+	g.events = append(g.events, event)
+	if at.After(issue.UpdatedAt) {
+		issue.UpdatedAt = at
+	}
+}
@@ -0,0 +1,103 @@
+package demo
+
+import (
+	"reflect"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/andy/beads-tui/internal/parser"
+)
+
+var now = time.Date(2026, 3, 2, 9, 0, 0, 0, time.UTC)
+
+func TestGenerate(t *testing.T) {
+	data := Generate(now, "dana")
+
+	ids := make(map[string]*parser.Issue)
+	statuses := make(map[parser.Status]int)
+	types := make(map[parser.IssueType]int)
+	priorities := make(map[int]int)
+	mine, comments := 0, 0
+	for _, issue := range data.Issues {
+		if ids[issue.ID] != nil {
+			t.Errorf("duplicate ID %s", issue.ID)
+		}
+		ids[issue.ID] = issue
+		if !strings.HasPrefix(issue.ID, Prefix+"-") {
+			t.Errorf("ID %s lacks the %s- prefix", issue.ID, Prefix)
+		}
+		statuses[issue.Status]++
+		types[issue.IssueType]++
+		priorities[issue.Priority]++
+		if issue.Assignee == "dana" {
+			mine++
+		}
+		if issue.Assignee == "me" {
+			t.Errorf("%s is assigned to the placeholder user", issue.ID)
+		}
+		comments += len(issue.Comments)
+		if (issue.Status == parser.StatusClosed) != (issue.ClosedAt != nil) {
+			t.Errorf("%s is %s with closed_at %v", issue.ID, issue.Status, issue.ClosedAt)
+		}
+		if issue.UpdatedAt.Before(issue.CreatedAt) || issue.UpdatedAt.After(now) {
+			t.Errorf("%s updated at %v, outside %v..%v", issue.ID, issue.UpdatedAt, issue.CreatedAt, now)
+		}
+	}
+
+	for _, status := range []parser.Status{parser.StatusOpen, parser.StatusInProgress, parser.StatusBlocked, parser.StatusClosed} {
+		if statuses[status] == 0 {
+			t.Errorf("no %s issues", status)
+		}
+	}
+	for _, issueType := range []parser.IssueType{parser.TypeBug, parser.TypeFeature, parser.TypeTask, parser.TypeEpic, parser.TypeChore} {
+		if types[issueType] == 0 {
+			t.Errorf("no %s issues", issueType)
+		}
+	}
+	for p := 0; p <= 4; p++ {
+		if priorities[p] == 0 {
+			t.Errorf("no P%d issues", p)
+		}
+	}
+	if mine == 0 {
+		t.Error("no issues assigned to the user")
+	}
+	if comments == 0 {
+		t.Error("no comments")
+	}
+
+	depTypes := make(map[parser.DependencyType]int)
+	for _, issue := range data.Issues {
+		for _, dep := range issue.Dependencies {
+			depTypes[dep.Type]++
+			if dep.IssueID != issue.ID || ids[dep.DependsOnID] == nil {
+				t.Errorf("%s has a dangling dependency %+v", issue.ID, dep)
+			}
+		}
+	}
+	for _, depType := range []parser.DependencyType{parser.DepBlocks, parser.DepParentChild, parser.DepRelated} {
+		if depTypes[depType] == 0 {
+			t.Errorf("no %s dependencies", depType)
+		}
+	}
+
+	recent := 0
+	for _, event := range data.Events {
+		if ids[event.IssueID] == nil {
+			t.Errorf("event for unknown issue %s", event.IssueID)
+		}
+		if event.CreatedAt.After(now.Add(-24 * time.Hour)) {
+			recent++
+		}
+	}
+	if recent == 0 {
+		t.Error("no events in the last day for the standup")
+	}
+}
+
+func TestGenerateIsDeterministic(t *testing.T) {
+	if !reflect.DeepEqual(Generate(now, "dana"), Generate(now, "dana")) {
+		t.Error("Generate returned different data for the same time and user")
+	}
+}
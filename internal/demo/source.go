@@ -0,0 +1,381 @@
+package demo
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"slices"
+	"sort"
+	"strconv"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/andy/beads-tui/internal/parser"
+	"github.com/andy/beads-tui/internal/storage"
+)
+
+// errNoDatabase is what the raw-data inspector gets: there are no rows to show
+var errNoDatabase = errors.New("the demo keeps its issues in memory; there is no database to inspect")
+
+// Source serves a generated project from memory: the issue source and the
+// direct writer of demo mode. Nothing is written to disk, so changes last
+// until the TUI quits. Issues are handed out as copies, like a database
+// read, so a change shows up as a new issue on the next load. Safe for
+// concurrent use.
+type Source struct {
+	actor string // Recorded as the actor of changes
+
+	mu       sync.Mutex
+	issues   []*parser.Issue // In creation order, without comments
+	byID     map[string]*parser.Issue
+	comments map[string][]*parser.Comment // By issue ID, oldest first
+	events   []*parser.Event              // Oldest first
+	lastID   int64                        // Last comment or event ID handed out
+}
+
+// NewSource serves data, recording changes as made by actor
+func NewSource(data *Dataset, actor string) *Source {
+	if actor == "" {
+		actor = "beads-tui"
+	}
+	s := &Source{
+		actor:    actor,
+		byID:     make(map[string]*parser.Issue, len(data.Issues)),
+		comments: make(map[string][]*parser.Comment),
+		events:   slices.Clone(data.Events),
+	}
+	for _, event := range data.Events {
+		s.lastID = max(s.lastID, event.ID)
+	}
+	for _, issue := range data.Issues {
+		stored := copyIssue(issue)
+		stored.Comments = nil
+		s.issues = append(s.issues, stored)
+		s.byID[stored.ID] = stored
+		for _, comment := range issue.Comments {
+			s.lastID++
+			stored := *comment
+			stored.ID = s.lastID
+			s.comments[issue.ID] = append(s.comments[issue.ID], &stored)
+		}
+	}
+	return s
+}
+
+// copyIssue copies an issue and the slices a change replaces
+func copyIssue(issue *parser.Issue) *parser.Issue {
+	issueCopy := *issue
+	issueCopy.Labels = slices.Clone(issue.Labels)
+	issueCopy.Dependencies = slices.Clone(issue.Dependencies)
+	return &issueCopy
+}
+
+// LoadIssues returns every issue
+func (s *Source) LoadIssues(ctx context.Context) ([]*parser.Issue, error) {
+	return s.LoadIssuesFiltered(ctx, storage.FilterSpec{})
+}
+
+// LoadIssuesFiltered returns the issues spec matches, and their blockers
+// and parents (transitively), as SQLiteReader does
+func (s *Source) LoadIssuesFiltered(ctx context.Context, spec storage.FilterSpec) ([]*parser.Issue, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	wanted := make(map[string]bool)
+	var want func(issue *parser.Issue)
+	want = func(issue *parser.Issue) {
+		if issue == nil || wanted[issue.ID] {
+			return
+		}
+		wanted[issue.ID] = true
+		for _, dep := range issue.Dependencies {
+			if dep.Type == parser.DepBlocks || dep.Type == parser.DepParentChild {
+				want(s.byID[dep.DependsOnID])
+			}
+		}
+	}
+	for _, issue := range s.issues {
+		if spec.Matches(issue) {
+			want(issue)
+		}
+	}
+
+	var issues []*parser.Issue
+	for _, issue := range s.issues {
+		if wanted[issue.ID] {
+			issues = append(issues, copyIssue(issue))
+		}
+	}
+	return issues, nil
+}
+
+// CountIssues returns how many issues there are
+func (s *Source) CountIssues(ctx context.Context) (int, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	return len(s.issues), nil
+}
+
+// LoadClosedIssues returns a page of the closed issues in the query's
+// range, ordered by when they were closed, and how many the range holds
+func (s *Source) LoadClosedIssues(ctx context.Context, q storage.ClosedQuery) ([]*parser.Issue, int, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	var closed []*parser.Issue
+	for _, issue := range s.issues {
+		if issue.Status != parser.StatusClosed || issue.ClosedAt == nil {
+			continue
+		}
+		if (!q.From.IsZero() && issue.ClosedAt.Before(q.From)) || (!q.To.IsZero() && !issue.ClosedAt.Before(q.To)) {
+			continue
+		}
+		closed = append(closed, issue)
+	}
+	sort.SliceStable(closed, func(i, j int) bool {
+		a, b := *closed[i].ClosedAt, *closed[j].ClosedAt
+		if a.Equal(b) {
+			return closed[i].ID < closed[j].ID
+		}
+		return a.After(b) != q.OldestFirst
+	})
+
+	total := len(closed)
+	closed = closed[min(q.Offset, total):]
+	if q.Limit > 0 && len(closed) > q.Limit {
+		closed = closed[:q.Limit]
+	}
+	page := make([]*parser.Issue, len(closed))
+	for i, issue := range closed {
+		page[i] = copyIssue(issue)
+		page[i].Labels, page[i].Dependencies = nil, nil
+	}
+	return page, total, nil
+}
+
+// LoadComments returns the issue's comments, oldest first
+func (s *Source) LoadComments(ctx context.Context, issueID string) ([]*parser.Comment, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	comments := make([]*parser.Comment, 0, len(s.comments[issueID]))
+	for _, comment := range s.comments[issueID] {
+		commentCopy := *comment
+		comments = append(comments, &commentCopy)
+	}
+	return comments, nil
+}
+
+// SearchComments counts, per issue, the comments containing text
+// (case-insensitive)
+func (s *Source) SearchComments(ctx context.Context, text string) (map[string]int, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	text = strings.ToLower(text)
+	matches := make(map[string]int)
+	for issueID, comments := range s.comments {
+		for _, comment := range comments {
+			if strings.Contains(strings.ToLower(comment.Text), text) {
+				matches[issueID]++
+			}
+		}
+	}
+	return matches, nil
+}
+
+// CountComments returns the number of comments on each issue that has any
+func (s *Source) CountComments(ctx context.Context) (map[string]int, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	counts := make(map[string]int)
+	for issueID, comments := range s.comments {
+		if len(comments) > 0 {
+			counts[issueID] = len(comments)
+		}
+	}
+	return counts, nil
+}
+
+// LatestCommentsWithPrefix returns, per issue, the text of its newest
+// comment starting with prefix (case-insensitive)
+func (s *Source) LatestCommentsWithPrefix(ctx context.Context, prefix string) (map[string]string, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	latest := make(map[string]string)
+	for issueID, comments := range s.comments {
+		for _, comment := range comments {
+			if len(comment.Text) >= len(prefix) && strings.EqualFold(comment.Text[:len(prefix)], prefix) {
+				latest[issueID] = comment.Text // Later comments are newer
+			}
+		}
+	}
+	return latest, nil
+}
+
+// LoadEvents returns the issue's audit trail, oldest first
+func (s *Source) LoadEvents(ctx context.Context, issueID string) ([]*parser.Event, error) {
+	return s.eventsWhere(func(event *parser.Event) bool { return event.IssueID == issueID }), nil
+}
+
+// LoadStatusChanges returns the status_changed events at or after since,
+// oldest first
+func (s *Source) LoadStatusChanges(ctx context.Context, since time.Time) ([]*parser.Event, error) {
+	return s.eventsWhere(func(event *parser.Event) bool {
+		return event.EventType == "status_changed" && !event.CreatedAt.Before(since)
+	}), nil
+}
+
+// eventsWhere returns copies of the events match selects (never nil: the
+// demo has an audit trail)
+func (s *Source) eventsWhere(match func(event *parser.Event) bool) []*parser.Event {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	events := []*parser.Event{}
+	for _, event := range s.events {
+		if match(event) {
+			eventCopy := *event
+			events = append(events, &eventCopy)
+		}
+	}
+	return events
+}
+
+// RawIssue fails: there are no stored rows to show
+func (s *Source) RawIssue(ctx context.Context, issueID string) ([]storage.RawTable, error) {
+	return nil, errNoDatabase
+}
+
+// Path names the source for the inspector
+func (s *Source) Path() string {
+	return "demo project (in memory)"
+}
+
+// Close does nothing; the data goes when the process does
+func (s *Source) Close() error {
+	return nil
+}
+
+// UpdateStatus sets the issue's status, setting closed_at when it is closed
+// and clearing it when it is reopened
+func (s *Source) UpdateStatus(ctx context.Context, issueID string, status parser.Status, reason string) (*parser.Issue, error) {
+	if err := storage.ValidateStatus(status); err != nil {
+		return nil, err
+	}
+	return s.update(ctx, issueID, func(issue *parser.Issue, now time.Time) {
+		eventType := "status_changed"
+		switch {
+		case status == parser.StatusClosed && issue.Status != parser.StatusClosed:
+			eventType = "closed"
+			issue.ClosedAt = &now
+		case status != parser.StatusClosed && issue.Status == parser.StatusClosed:
+			eventType = "reopened"
+			issue.ClosedAt = nil
+		}
+		s.recordEvent(issueID, eventType, string(issue.Status), string(status), reason, now)
+		issue.Status = status
+	})
+}
+
+// UpdatePriority sets the issue's priority (0-4)
+func (s *Source) UpdatePriority(ctx context.Context, issueID string, priority int) (*parser.Issue, error) {
+	if err := storage.ValidatePriority(priority); err != nil {
+		return nil, err
+	}
+	return s.update(ctx, issueID, func(issue *parser.Issue, now time.Time) {
+		s.recordEvent(issueID, "updated", strconv.Itoa(issue.Priority), strconv.Itoa(priority), "priority", now)
+		issue.Priority = priority
+	})
+}
+
+// AddLabel adds label to the issue (adding an existing label is a no-op)
+func (s *Source) AddLabel(ctx context.Context, issueID, label string) (*parser.Issue, error) {
+	label = strings.TrimSpace(label)
+	if label == "" {
+		return nil, errors.New("label is empty")
+	}
+	return s.update(ctx, issueID, func(issue *parser.Issue, now time.Time) {
+		if !slices.Contains(issue.Labels, label) {
+			issue.Labels = append(issue.Labels, label)
+			slices.Sort(issue.Labels)
+		}
+		s.recordEvent(issueID, "label_added", "", "", "Added label: "+label, now)
+	})
+}
+
+// RemoveLabel removes label from the issue
+func (s *Source) RemoveLabel(ctx context.Context, issueID, label string) (*parser.Issue, error) {
+	return s.update(ctx, issueID, func(issue *parser.Issue, now time.Time) {
+		issue.Labels = slices.DeleteFunc(issue.Labels, func(l string) bool { return l == label })
+		s.recordEvent(issueID, "label_removed", "", "", "Removed label: "+label, now)
+	})
+}
+
+// AddComment adds a comment by the source's actor and returns it
+func (s *Source) AddComment(ctx context.Context, issueID, text string) (*parser.Comment, error) {
+	if strings.TrimSpace(text) == "" {
+		return nil, errors.New("comment is empty")
+	}
+	var comment parser.Comment
+	_, err := s.update(ctx, issueID, func(issue *parser.Issue, now time.Time) {
+		s.lastID++
+		comment = parser.Comment{ID: s.lastID, IssueID: issueID, Author: s.actor, Text: text, CreatedAt: now}
+		stored := comment
+		s.comments[issueID] = append(s.comments[issueID], &stored)
+		s.recordEvent(issueID, "commented", "", "", text, now)
+	})
+	if err != nil {
+		return nil, err
+	}
+	return &comment, nil
+}
+
+// update applies change to the stored issue, bumps its updated_at, and
+// returns a copy of it afterwards (nothing changes once ctx is done). The stored issue is replaced rather than
+// changed, so copies handed out earlier keep their values.
+func (s *Source) update(ctx context.Context, issueID string, change func(issue *parser.Issue, now time.Time)) (*parser.Issue, error) {
+	if err := ctx.Err(); err != nil {
+		return nil, err
+	}
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	stored, ok := s.byID[issueID]
+	if !ok {
+		return nil, fmt.Errorf("%w: %s", storage.ErrIssueNotFound, issueID)
+	}
+	issue := copyIssue(stored)
+	now := time.Now().UTC()
+	change(issue, now)
+	issue.UpdatedAt = now
+
+	s.byID[issueID] = issue
+	s.issues[slices.Index(s.issues, stored)] = issue
+	return copyIssue(issue), nil
+}
+
+// recordEvent adds an entry to the audit trail. Empty values are left nil.
+// Called with s.mu held.
+func (s *Source) recordEvent(issueID, eventType, oldValue, newValue, comment string, now time.Time) {
+	nullable := func(value string) *string {
+		if value == "" {
+			return nil
+		}
+		return &value
+	}
+	s.lastID++
+	s.events = append(s.events, &parser.Event{
+		ID:        s.lastID,
+		IssueID:   issueID,
+		EventType: eventType,
+		Actor:     s.actor,
+		OldValue:  nullable(oldValue),
+		NewValue:  nullable(newValue),
+		Comment:   nullable(comment),
+		CreatedAt: now,
+	})
+}
+
+var (
+	_ storage.IssueSource = (*Source)(nil)
+	_ storage.IssueWriter = (*Source)(nil)
+)
@@ -0,0 +1,88 @@
+package demo
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/andy/beads-tui/internal/parser"
+	"github.com/andy/beads-tui/internal/storage"
+)
+
+func TestSource(t *testing.T) {
+	ctx := context.Background()
+	data := Generate(now, "dana")
+	source := NewSource(data, "dana")
+
+	issues, err := source.LoadIssues(ctx)
+	if err != nil || len(issues) != len(data.Issues) {
+		t.Fatalf("LoadIssues = %d issues, %v, want %d", len(issues), err, len(data.Issues))
+	}
+	counts, _ := source.CountComments(ctx)
+	if len(counts) == 0 || len(issues[0].Comments) != 0 {
+		t.Errorf("expected comments to be counted but not loaded with the issues")
+	}
+
+	// A change replaces the stored issue: what was loaded before keeps its values
+	before := issues[0]
+	closed, err := source.UpdateStatus(ctx, before.ID, parser.StatusClosed, "Done")
+	if err != nil || closed.Status != parser.StatusClosed || closed.ClosedAt == nil || !closed.UpdatedAt.After(before.UpdatedAt) {
+		t.Fatalf("UpdateStatus = %+v, %v", closed, err)
+	}
+	if before.Status == parser.StatusClosed {
+		t.Error("UpdateStatus changed an issue loaded earlier")
+	}
+	events, _ := source.LoadEvents(ctx, before.ID)
+	if last := events[len(events)-1]; last.EventType != "closed" || last.Actor != "dana" || *last.Comment != "Done" {
+		t.Errorf("expected a closed event by dana, got %+v", last)
+	}
+
+	if _, err := source.AddLabel(ctx, before.ID, "triaged"); err != nil {
+		t.Fatal(err)
+	}
+	if _, err := source.AddComment(ctx, before.ID, "BLOCKED: not really"); err != nil {
+		t.Fatal(err)
+	}
+	if reasons, _ := source.LatestCommentsWithPrefix(ctx, "blocked:"); reasons[before.ID] != "BLOCKED: not really" {
+		t.Errorf("LatestCommentsWithPrefix = %v", reasons)
+	}
+	if matches, _ := source.SearchComments(ctx, "NOT REALLY"); matches[before.ID] != 1 {
+		t.Errorf("SearchComments = %v", matches)
+	}
+	if _, err := source.UpdatePriority(ctx, "demo-none", 1); err == nil {
+		t.Error("expected an unknown ID to fail")
+	}
+}
+
+func TestSourceLoadIssuesFiltered(t *testing.T) {
+	source := NewSource(Generate(now, "dana"), "dana")
+	issues, err := source.LoadIssuesFiltered(context.Background(), storage.FilterSpec{Priorities: []int{0}})
+	if err != nil {
+		t.Fatal(err)
+	}
+	// The P0s, plus the epic the crash belongs to
+	var p0, others int
+	for _, issue := range issues {
+		if issue.Priority == 0 {
+			p0++
+		} else {
+			others++
+		}
+	}
+	if p0 == 0 || others == 0 {
+		t.Errorf("expected the P0 issues and their parents, got %d P0 and %d others", p0, others)
+	}
+}
+
+func TestSourceLoadClosedIssues(t *testing.T) {
+	source := NewSource(Generate(now, "dana"), "dana")
+	page, total, err := source.LoadClosedIssues(context.Background(), storage.ClosedQuery{From: now.Add(-30 * 24 * time.Hour), Limit: 3})
+	if err != nil || len(page) == 0 || len(page) > 3 || total < len(page) {
+		t.Fatalf("LoadClosedIssues = %d of %d, %v", len(page), total, err)
+	}
+	for i := 1; i < len(page); i++ {
+		if page[i].ClosedAt.After(*page[i-1].ClosedAt) {
+			t.Errorf("expected newest first, got %v after %v", page[i].ClosedAt, page[i-1].ClosedAt)
+		}
+	}
+}
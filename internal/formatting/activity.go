@@ -0,0 +1,107 @@
+package formatting
+
+import (
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/andy/beads-tui/internal/parser"
+)
+
+// ActivityWeeks is how many recent weeks the activity sparkline covers
+const ActivityWeeks = 8
+
+// sparkBlocks are the sparkline levels, lowest to highest
+var sparkBlocks = []rune("▁▂▃▄▅▆▇█")
+
+// ActivityCounts returns the number of activity events per week for the
+// given number of weeks ending at now, oldest first. Events are the issue's
+// creation, each comment, closing, and its last update (unless that update
+// coincides with a comment or the close, which already caused it).
+func ActivityCounts(issue *parser.Issue, now time.Time, weeks int) []int {
+	counts := make([]int, weeks)
+	week := 7 * 24 * time.Hour
+	start := now.Add(-time.Duration(weeks) * week)
+
+	add := func(t time.Time) {
+		if t.IsZero() || !t.After(start) {
+			return
+		}
+		index := int(t.Sub(start) / week)
+		if index >= weeks {
+			index = weeks - 1 // Clock skew: count future events in the current week
+		}
+		counts[index]++
+	}
+
+	add(issue.CreatedAt)
+	for _, comment := range issue.Comments {
+		if comment != nil {
+			add(comment.CreatedAt)
+		}
+	}
+	if issue.ClosedAt != nil {
+		add(*issue.ClosedAt)
+	}
+
+	// updated_at is the only trace of field edits
+	if lastUpdateIsNew(issue) {
+		add(issue.UpdatedAt)
+	}
+
+	return counts
+}
+
+// Sparkline renders counts as block characters scaled to the largest count.
+// Weeks with no activity are shown as '·'.
+func Sparkline(counts []int) string {
+	maxCount := 0
+	for _, count := range counts {
+		maxCount = max(maxCount, count)
+	}
+
+	var sb strings.Builder
+	for _, count := range counts {
+		if count == 0 {
+			sb.WriteRune('·')
+			continue
+		}
+		level := (count*len(sparkBlocks) - 1) / maxCount
+		sb.WriteRune(sparkBlocks[level])
+	}
+	return sb.String()
+}
+
+// formatActivity renders the detail header's activity sparkline, or a note
+// that the issue has been dormant for the whole window
+func formatActivity(issue *parser.Issue, now time.Time) string {
+	counts := ActivityCounts(issue, now, ActivityWeeks)
+	total := 0
+	for _, count := range counts {
+		total += count
+	}
+	if total == 0 {
+		return fmt.Sprintf("[%s]no activity in %d weeks[-]", GetMutedColor(), ActivityWeeks)
+	}
+	return fmt.Sprintf("[%s]activity[-] [%s]%s[-]", GetMutedColor(), GetAccentColor(), Sparkline(counts))
+}
+
+// barEighths are the partial block characters, one to seven eighths wide
+var barEighths = []rune("▏▎▍▌▋▊▉")
+
+// Bar renders value as a horizontal bar scaled so maxValue fills width cells,
+// using partial blocks for eighth-of-a-cell resolution. Non-zero values
+// always show at least a sliver.
+func Bar(value, maxValue, width int) string {
+	if value <= 0 || maxValue <= 0 || width <= 0 {
+		return ""
+	}
+	eighths := max(value*width*8/maxValue, 1)
+	eighths = min(eighths, width*8)
+
+	bar := strings.Repeat("█", eighths/8)
+	if rest := eighths % 8; rest > 0 {
+		bar += string(barEighths[rest-1])
+	}
+	return bar
+}
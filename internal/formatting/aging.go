@@ -0,0 +1,26 @@
+package formatting
+
+import (
+	"fmt"
+
+	"github.com/andy/beads-tui/internal/parser"
+	"github.com/andy/beads-tui/internal/state"
+)
+
+// FormatAgingHint suggests escalating an issue that has been open longer
+// than its priority allows ("P2 open 50d (limit 45d) — consider P1"), or
+// returns "" when it isn't aging
+func FormatAgingHint(issue *parser.Issue, aging state.Aging) string {
+	if !aging.Aged() {
+		return ""
+	}
+	details := fmt.Sprintf("limit %dd", aging.LimitDays)
+	if aging.IdleDays > 0 && aging.IdleDays < aging.AgeDays {
+		details += fmt.Sprintf(", last update %dd ago", aging.IdleDays)
+	}
+	advice := "needs attention"
+	if aging.Suggested < issue.Priority {
+		advice = fmt.Sprintf("consider P%d", aging.Suggested)
+	}
+	return fmt.Sprintf("[%s]⏳ P%d open %dd (%s) — %s[-]", GetWarningColor(), issue.Priority, aging.AgeDays, details, advice)
+}
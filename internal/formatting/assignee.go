@@ -0,0 +1,45 @@
+package formatting
+
+import (
+	"hash/fnv"
+	"strings"
+	"unicode"
+
+	"github.com/andy/beads-tui/internal/theme"
+)
+
+// AssigneeInitials abbreviates an assignee to two letters, like an avatar:
+// "Ada Lovelace" and "ada.lovelace@example.com" give "AL", "ada" gives "AD".
+// Empty for no assignee.
+func AssigneeInitials(assignee string) string {
+	name, _, _ := strings.Cut(strings.TrimSpace(assignee), "@")
+	parts := strings.FieldsFunc(name, func(r rune) bool {
+		return !unicode.IsLetter(r) && !unicode.IsDigit(r)
+	})
+	var initials []rune
+	switch {
+	case len(parts) == 0:
+		return ""
+	case len(parts) == 1:
+		initials = []rune(parts[0])
+	default:
+		initials = []rune{[]rune(parts[0])[0], []rune(parts[len(parts)-1])[0]}
+	}
+	if len(initials) > 2 {
+		initials = initials[:2]
+	}
+	return strings.ToUpper(string(initials))
+}
+
+// GetAssigneeColor picks a theme color for an assignee, the same one every
+// time, so people are easy to tell apart in the list
+func GetAssigneeColor(assignee string) string {
+	t := theme.Current()
+	palette := []string{
+		t.Accent(), t.Info(), t.Success(), t.Warning(),
+		t.Emphasis(), t.DepParentChild(), t.DepDiscoveredFrom(), t.DepRelated(),
+	}
+	h := fnv.New32a()
+	h.Write([]byte(strings.ToLower(strings.TrimSpace(assignee))))
+	return palette[h.Sum32()%uint32(len(palette))]
+}
@@ -31,22 +31,10 @@ func GetStatusColor(status parser.Status) string {
 	}
 }
 
-// GetTypeIcon returns an emoji icon for the given issue type
+// GetTypeIcon returns the icon for the given issue type: the active theme's,
+// or else the configured icon set's
 func GetTypeIcon(issueType parser.IssueType) string {
-	switch issueType {
-	case parser.TypeBug:
-		return "🐛"
-	case parser.TypeFeature:
-		return "✨"
-	case parser.TypeTask:
-		return "📋"
-	case parser.TypeEpic:
-		return "🎯"
-	case parser.TypeChore:
-		return "🔧"
-	default:
-		return "•"
-	}
+	return Icons().TypeIcon(issueType)
 }
 
 // GetDependencyColor returns a tview color code for the given dependency type
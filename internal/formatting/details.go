@@ -2,8 +2,15 @@ package formatting
 
 import (
 	"fmt"
+	"regexp"
+	"strconv"
+	"strings"
+	"time"
 
+	"github.com/andy/beads-tui/internal/git"
 	"github.com/andy/beads-tui/internal/parser"
+	"github.com/andy/beads-tui/internal/state"
+	"github.com/rivo/tview"
 )
 
 // formatDependencyPhrase converts a dependency type to a human-readable phrase
@@ -26,8 +33,59 @@ func formatDependencyPhrase(depType parser.DependencyType) string {
 	}
 }
 
-// FormatIssueDetails formats full issue metadata for display in the detail panel
-func FormatIssueDetails(issue *parser.Issue) string {
+// FormatDependentPhrase converts a dependency type to a human-readable phrase
+// from the perspective of the issue the dependency points AT, e.g. "blocks"
+// for an issue that another issue is blocked by
+func FormatDependentPhrase(depType parser.DependencyType) string {
+	switch depType {
+	case parser.DepBlocks:
+		return "blocks"
+	case parser.DepParentChild:
+		return "parent of"
+	case parser.DepRelated:
+		return "related to"
+	case parser.DepDiscoveredFrom:
+		return "led to"
+	default:
+		return string(depType)
+	}
+}
+
+// DetailContext is what the details show about an issue beyond its own
+// fields, worked out from the other issues (see NewDetailContext)
+type DetailContext struct {
+	IDChildren    []*parser.Issue   // Children by ID convention (tui-y4h.1 for tui-y4h), which have no dependency rows of their own
+	Progress      state.Progress    // An epic's child completion (zero for other issues)
+	Aging         state.Aging       // Whether it has been open too long for its priority
+	BlockedReason string            // Why it is blocked ("" for no reason given)
+	Logged        state.TimeSpent   // Time logged on it
+	Blockers      []state.Blocker   // Everything that must close before it is ready
+	Dependents    []state.Dependent // Issues that depend on it
+
+	// Linkable reports whether an ID is an issue. Other issues' IDs, in the
+	// text and the dependency lists, become links (regions, see
+	// IssueRegion) when it does; nil leaves them as text.
+	Linkable func(issueID string) bool
+}
+
+// NewDetailContext works out the details' context for issue from s, as of
+// now. Linkable is left nil.
+func NewDetailContext(s *state.State, issue *parser.Issue, now time.Time) DetailContext {
+	progress, _ := s.EpicProgress(issue.ID)
+	return DetailContext{
+		IDChildren:    s.GetIDChildren(issue.ID),
+		Progress:      progress,
+		Aging:         s.Aging(issue, now),
+		BlockedReason: s.BlockedReason(issue.ID),
+		Logged:        s.LoggedMinutes(issue.ID),
+		Blockers:      s.TransitiveBlockers(issue.ID),
+		Dependents:    s.Dependents(issue.ID),
+	}
+}
+
+// FormatIssueDetails formats full issue metadata for display in the detail
+// panel, with what dc says about its place among the other issues
+func FormatIssueDetails(issue *parser.Issue, dc DetailContext) string {
 	var result string
 
 	// Header
@@ -39,33 +97,73 @@ func FormatIssueDetails(issue *parser.Issue) string {
 	accentColor := GetAccentColor()
 	emphasisColor := GetEmphasisColor()
 
+	// link renders an issue ID as a link, then goes back to the region it
+	// was in (resume, "" for none). Each link is its own region, numbered so
+	// the same ID can be linked twice.
+	links := 0
+	link := func(issueID, resume string) string {
+		if dc.Linkable == nil || issueID == issue.ID || !dc.Linkable(issueID) {
+			return issueID
+		}
+		links++
+		return fmt.Sprintf(`["%s"][%s::u]%s[-::-]["%s"]`, IssueRegion(links, issueID), accentColor, issueID, resume)
+	}
+	linkText := func(text, resume string) string {
+		if dc.Linkable == nil {
+			return text
+		}
+		return issueIDPattern.ReplaceAllStringFunc(text, func(issueID string) string {
+			return link(issueID, resume)
+		})
+	}
+
 	result += fmt.Sprintf("[::b]%s %s[-::-]\n", typeIcon, issue.Title)
 	result += fmt.Sprintf("[%s]ID:[-] %s [%s](click to copy)[-]  ", mutedColor, issue.ID, accentColor)
 	result += fmt.Sprintf("[%s]P%d[-]  ", priorityColor, issue.Priority)
-	result += fmt.Sprintf("[%s]%s[-]\n\n", statusColor, issue.Status)
+	result += fmt.Sprintf("[%s]%s[-]  ", statusColor, issue.Status)
+	result += formatActivity(issue, time.Now()) + "\n"
+	if hint := FormatAgingHint(issue, dc.Aging); hint != "" {
+		result += hint + "\n"
+	}
+	if dc.BlockedReason != "" {
+		result += fmt.Sprintf("[%s]%s Blocked: %s[-]\n", GetStatusColor(parser.StatusBlocked), Icons().Blocked, tview.Escape(dc.BlockedReason))
+	}
+	result += "\n"
+
+	// Progress (epics)
+	if dc.Progress.Total > 0 {
+		result += fmt.Sprintf("[%s::b]Progress:[-::-] %s [%s]closed (%d%%)[-]\n",
+			emphasisColor, FormatProgress(dc.Progress), mutedColor, dc.Progress.Percent())
+		if dc.Progress.EstimatedMinutes > 0 {
+			result += fmt.Sprintf("[%s::b]Est:[-::-] %s of %s closed [%s](%d%%, across all descendants)[-]\n",
+				emphasisColor, FormatMinutes(dc.Progress.ClosedEstimatedMinutes), FormatMinutes(dc.Progress.EstimatedMinutes),
+				mutedColor, dc.Progress.ClosedEstimatedMinutes*100/dc.Progress.EstimatedMinutes)
+		}
+		result += "\n"
+	}
 
 	// Description
 	if issue.Description != "" {
 		result += fmt.Sprintf("[%s::b]Description:[-::-]\n", emphasisColor)
-		result += issue.Description + "\n\n"
+		result += linkText(issue.Description, "") + "\n\n"
 	}
 
 	// Design notes
 	if issue.Design != "" {
 		result += fmt.Sprintf("[%s::b]Design:[-::-]\n", emphasisColor)
-		result += issue.Design + "\n\n"
+		result += linkText(issue.Design, "") + "\n\n"
 	}
 
 	// Acceptance criteria
 	if issue.AcceptanceCriteria != "" {
 		result += fmt.Sprintf("[%s::b]Acceptance Criteria:[-::-]\n", emphasisColor)
-		result += issue.AcceptanceCriteria + "\n\n"
+		result += linkText(issue.AcceptanceCriteria, "") + "\n\n"
 	}
 
 	// Notes
 	if issue.Notes != "" {
 		result += fmt.Sprintf("[%s::b]Notes:[-::-]\n", emphasisColor)
-		result += issue.Notes + "\n\n"
+		result += linkText(issue.Notes, "") + "\n\n"
 	}
 
 	// Dependencies
@@ -78,7 +176,43 @@ func FormatIssueDetails(issue *parser.Issue) string {
 			// - "parent-child" means this issue is a child OF the target
 			depPhrase := formatDependencyPhrase(dep.Type)
 			result += fmt.Sprintf("  • [%s]%s[-] %s\n",
-				GetDependencyColor(dep.Type), depPhrase, dep.DependsOnID)
+				GetDependencyColor(dep.Type), depPhrase, link(dep.DependsOnID, ""))
+		}
+		result += "\n"
+	}
+
+	// Transitive blockers
+	if len(dc.Blockers) > 0 {
+		result += fmt.Sprintf("[%s::b]Must Close First (%d):[-::-]\n", emphasisColor, len(dc.Blockers))
+		for _, blocker := range dc.Blockers {
+			result += fmt.Sprintf("  • [%s]%s[-] %s [%s]%s[-]",
+				GetStatusColor(blocker.Issue.Status), blocker.Issue.Status, link(blocker.Issue.ID, ""), mutedColor, blocker.Issue.Title)
+			if blocker.Blocks != issue.ID {
+				result += fmt.Sprintf(" [%s](blocks[-] %s[%s])[-]", mutedColor, link(blocker.Blocks, ""), mutedColor)
+			}
+			result += "\n"
+		}
+		result += "\n"
+	}
+
+	// Reverse dependencies
+	if len(dc.Dependents) > 0 {
+		result += fmt.Sprintf("[%s::b]Depended On By (%d):[-::-]\n", emphasisColor, len(dc.Dependents))
+		for _, dependent := range dc.Dependents {
+			result += fmt.Sprintf("  • [%s]%s[-] [%s]%s[-] %s [%s]%s[-]\n",
+				GetDependencyColor(dependent.Type), FormatDependentPhrase(dependent.Type),
+				GetStatusColor(dependent.Issue.Status), dependent.Issue.Status,
+				link(dependent.Issue.ID, ""), mutedColor, dependent.Issue.Title)
+		}
+		result += "\n"
+	}
+
+	// Children by ID convention
+	if len(dc.IDChildren) > 0 {
+		result += fmt.Sprintf("[%s::b]Children:[-::-]\n", emphasisColor)
+		for _, child := range dc.IDChildren {
+			result += fmt.Sprintf("  • [%s]%s[-] %s [%s]%s[-]\n",
+				GetStatusColor(child.Status), child.Status, link(child.ID, ""), mutedColor, child.Title)
 		}
 		result += "\n"
 	}
@@ -114,18 +248,130 @@ func FormatIssueDetails(issue *parser.Issue) string {
 		result += fmt.Sprintf("  Estimated: %dh %dm\n", hours, mins)
 	}
 
+	if issue.DueDate != nil {
+		result += "  Due: " + FormatDueDate(issue, time.Now()) + "\n"
+	}
+
+	if dc.Logged.Total > 0 {
+		result += "  Logged: " + formatLogged(issue, dc.Logged, mutedColor) + "\n"
+	}
+
 	if issue.ExternalRef != nil {
-		result += fmt.Sprintf("  External Ref: %s\n", *issue.ExternalRef)
+		// A region, so clicking it opens the reference like gx
+		result += fmt.Sprintf("  External Ref: [\"%s\"][::u]%s[::-][\"\"]\n", ExternalRefRegion, *issue.ExternalRef)
 	}
 
 	// Comments
 	if len(issue.Comments) > 0 {
 		result += fmt.Sprintf("\n[%s::b]Comments:[-::-]\n", emphasisColor)
 		for _, comment := range issue.Comments {
+			// Each comment is a region so it can be highlighted and picked
+			result += fmt.Sprintf(`["%s"]`, CommentRegion(comment.ID))
 			result += fmt.Sprintf("  [%s]%s[-] (%s):\n", accentColor, comment.Author, comment.CreatedAt.Format("2006-01-02 15:04"))
-			result += fmt.Sprintf("    %s\n", comment.Text)
+			result += fmt.Sprintf("    %s[\"\"]\n", linkText(comment.Text, CommentRegion(comment.ID)))
 		}
 	}
 
 	return result
 }
+
+// FormatGitDetails describes the issue's place in git, appended to the
+// issue details: the checked-out branch if it is named after the issue ("" if
+// not), and the commits whose messages mention the issue, newest first
+func FormatGitDetails(branch string, commits []git.Commit) string {
+	if branch == "" && len(commits) == 0 {
+		return ""
+	}
+	emphasisColor := GetEmphasisColor()
+	accentColor := GetAccentColor()
+	mutedColor := GetMutedColor()
+
+	result := ""
+	if branch != "" {
+		result += fmt.Sprintf("\n[%s::b]Branch:[-::-] [%s]⎇ %s[-] [%s](checked out)[-]\n", emphasisColor, accentColor, branch, mutedColor)
+	}
+	if len(commits) > 0 {
+		result += fmt.Sprintf("\n[%s::b]Commits (%d):[-::-]\n", emphasisColor, len(commits))
+		for _, commit := range commits {
+			result += fmt.Sprintf("  [%s]%s[-] [%s]%s %s[-] %s\n",
+				accentColor, commit.Hash, mutedColor, commit.Date.Format("2006-01-02"), commit.Author, commit.Subject)
+		}
+	}
+	return result
+}
+
+// ExternalRefRegion is the region ID of the external reference in the issue
+// details
+const ExternalRefRegion = "external-ref"
+
+// issueIDPattern matches text shaped like an issue ID: a prefix, a hyphen,
+// and the rest, with any dotted child numbers ("tui-y4h", "tui-y4h.1").
+// Matches only become links if they name an issue.
+var issueIDPattern = regexp.MustCompile(`\b[A-Za-z][A-Za-z0-9_]*(?:-[A-Za-z0-9_]+)+(?:\.[0-9]+)*\b`)
+
+// issueRegionPattern finds the issue link regions in formatted details
+var issueRegionPattern = regexp.MustCompile(`\["(issue-[0-9]+-[^"]+)"\]`)
+
+// IssueRegion is the region ID of the nth link to an issue in the issue
+// details
+func IssueRegion(n int, issueID string) string {
+	return fmt.Sprintf("issue-%d-%s", n, issueID)
+}
+
+// IssueRegionID returns the issue ID a region in the issue details links to,
+// or false if the region isn't an issue link
+func IssueRegionID(region string) (string, bool) {
+	rest, ok := strings.CutPrefix(region, "issue-")
+	if !ok {
+		return "", false
+	}
+	n, issueID, ok := strings.Cut(rest, "-")
+	if _, err := strconv.Atoi(n); err != nil || !ok || issueID == "" {
+		return "", false
+	}
+	return issueID, true
+}
+
+// IssueRegions returns the issue link regions in formatted details, in the
+// order they appear
+func IssueRegions(details string) []string {
+	var regions []string
+	for _, match := range issueRegionPattern.FindAllStringSubmatch(details, -1) {
+		regions = append(regions, match[1])
+	}
+	return regions
+}
+
+// CommentRegion is the region ID of a comment in the issue details
+func CommentRegion(commentID int64) string {
+	return fmt.Sprintf("comment-%d", commentID)
+}
+
+// CommentRegionID returns the comment ID of a region in the issue details,
+// or false if the region isn't a comment
+func CommentRegionID(region string) (int64, bool) {
+	rest, ok := strings.CutPrefix(region, "comment-")
+	if !ok {
+		return 0, false
+	}
+	id, err := strconv.ParseInt(rest, 10, 64)
+	return id, err == nil
+}
+
+// formatLogged describes the time logged on an issue against its estimate,
+// with an epic's own time split out from the total over its descendants
+func formatLogged(issue *parser.Issue, logged state.TimeSpent, mutedColor string) string {
+	text := FormatMinutes(logged.Total)
+	if logged.Total != logged.Own {
+		text += fmt.Sprintf(" [%s](%s on the epic itself, the rest on its children)[-]", mutedColor, FormatMinutes(logged.Own))
+	}
+	if issue.EstimatedMinutes != nil && *issue.EstimatedMinutes > 0 {
+		estimate := *issue.EstimatedMinutes
+		if logged.Total > estimate {
+			text += fmt.Sprintf(" [%s]%s over estimate[-]", GetWarningColor(), FormatMinutes(logged.Total-estimate))
+		} else {
+			text += fmt.Sprintf(" [%s](%d%% of estimate)[-]", mutedColor, logged.Total*100/estimate)
+		}
+	}
+	return text
+}
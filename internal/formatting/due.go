@@ -0,0 +1,79 @@
+package formatting
+
+import (
+	"fmt"
+	"time"
+
+	"github.com/andy/beads-tui/internal/parser"
+	"github.com/andy/beads-tui/internal/state"
+)
+
+// GetDueColor returns the color for a due status: error once overdue,
+// warning when due soon, muted otherwise
+func GetDueColor(status state.DueStatus) string {
+	switch status {
+	case state.DueOverdue:
+		return GetErrorColor()
+	case state.DueSoon:
+		return GetWarningColor()
+	default:
+		return GetMutedColor()
+	}
+}
+
+// FormatDueTag renders an unclosed issue's due date for list rows ("due
+// tomorrow", "3d overdue"), or "" when there is nothing to show
+func FormatDueTag(issue *parser.Issue, now time.Time) string {
+	status := state.DueStatusOf(issue, now)
+	if status == state.DueNone {
+		return ""
+	}
+
+	days := state.DaysUntil(*issue.DueDate, now)
+	var text string
+	switch {
+	case days < 0:
+		text = fmt.Sprintf("%dd overdue", -days)
+	case days == 0:
+		text = "due today"
+	case days == 1:
+		text = "due tomorrow"
+	default:
+		text = "due " + formatDueDay(*issue.DueDate, now)
+	}
+	return fmt.Sprintf("[%s]%s[-]", GetDueColor(status), text)
+}
+
+// FormatDueDate renders a due date with how far off it is, for the detail
+// panel ("2026-05-12 (in 2 days)")
+func FormatDueDate(issue *parser.Issue, now time.Time) string {
+	due := issue.DueDate.In(now.Location())
+	text := due.Format("2006-01-02")
+	if issue.Status == parser.StatusClosed {
+		return text
+	}
+
+	var relative string
+	switch days := state.DaysUntil(due, now); {
+	case days < -1:
+		relative = fmt.Sprintf("%d days overdue", -days)
+	case days == -1:
+		relative = "1 day overdue"
+	case days == 0:
+		relative = "today"
+	case days == 1:
+		relative = "tomorrow"
+	default:
+		relative = fmt.Sprintf("in %d days", days)
+	}
+	return fmt.Sprintf("[%s]%s (%s)[-]", GetDueColor(state.DueStatusOf(issue, now)), text, relative)
+}
+
+// formatDueDay is a short date, with the year only when it isn't this year
+func formatDueDay(due, now time.Time) string {
+	due = due.In(now.Location())
+	if due.Year() != now.Year() {
+		return due.Format("Jan 2 2006")
+	}
+	return due.Format("Jan 2")
+}
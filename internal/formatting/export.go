@@ -0,0 +1,108 @@
+package formatting
+
+import (
+	"encoding/csv"
+	"encoding/json"
+	"fmt"
+	"io"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/andy/beads-tui/internal/parser"
+)
+
+// ExportFormat is a file format for exporting a list of issues
+type ExportFormat string
+
+const (
+	ExportCSV      ExportFormat = "csv"
+	ExportJSON     ExportFormat = "json"
+	ExportMarkdown ExportFormat = "markdown"
+)
+
+// ExportFormats lists the formats in the order the export dialog offers them
+var ExportFormats = []ExportFormat{ExportCSV, ExportJSON, ExportMarkdown}
+
+// Extension returns the usual file extension for the format, with the dot
+func (f ExportFormat) Extension() string {
+	if f == ExportMarkdown {
+		return ".md"
+	}
+	return "." + string(f)
+}
+
+// exportColumns are the CSV and Markdown table columns
+var exportColumns = []string{"id", "title", "status", "priority", "type", "assignee", "labels", "estimate_minutes", "created_at", "updated_at", "closed_at", "external_ref"}
+
+// exportRow returns an issue's values for exportColumns
+func exportRow(issue *parser.Issue) []string {
+	estimate, closed, ref := "", "", ""
+	if issue.EstimatedMinutes != nil {
+		estimate = strconv.Itoa(*issue.EstimatedMinutes)
+	}
+	if issue.ClosedAt != nil {
+		closed = issue.ClosedAt.Format(time.RFC3339)
+	}
+	if issue.ExternalRef != nil {
+		ref = *issue.ExternalRef
+	}
+	return []string{
+		issue.ID,
+		issue.Title,
+		string(issue.Status),
+		fmt.Sprintf("P%d", issue.Priority),
+		string(issue.IssueType),
+		issue.Assignee,
+		strings.Join(issue.Labels, ";"),
+		estimate,
+		issue.CreatedAt.Format(time.RFC3339),
+		issue.UpdatedAt.Format(time.RFC3339),
+		closed,
+		ref,
+	}
+}
+
+// ExportIssues writes issues to w in the given format: CSV with a header
+// row, a JSON array of issues as bd stores them, or a Markdown table.
+func ExportIssues(w io.Writer, issues []*parser.Issue, format ExportFormat) error {
+	switch format {
+	case ExportCSV:
+		writer := csv.NewWriter(w)
+		if err := writer.Write(exportColumns); err != nil {
+			return err
+		}
+		for _, issue := range issues {
+			if err := writer.Write(exportRow(issue)); err != nil {
+				return err
+			}
+		}
+		writer.Flush()
+		return writer.Error()
+
+	case ExportJSON:
+		if issues == nil {
+			issues = []*parser.Issue{} // [] rather than null
+		}
+		encoder := json.NewEncoder(w)
+		encoder.SetIndent("", "  ")
+		return encoder.Encode(issues)
+
+	case ExportMarkdown:
+		var sb strings.Builder
+		sb.WriteString("| " + strings.Join(exportColumns, " | ") + " |\n")
+		sb.WriteString(strings.Repeat("| --- ", len(exportColumns)) + "|\n")
+		for _, issue := range issues {
+			cells := exportRow(issue)
+			for i, cell := range cells {
+				// Keep each cell on one line without closing the column early
+				cell = strings.ReplaceAll(cell, "|", `\|`)
+				cells[i] = strings.Join(strings.Fields(cell), " ")
+			}
+			sb.WriteString("| " + strings.Join(cells, " | ") + " |\n")
+		}
+		_, err := io.WriteString(w, sb.String())
+		return err
+	}
+	return fmt.Errorf("unknown export format %q", format)
+}
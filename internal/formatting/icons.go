@@ -0,0 +1,177 @@
+package formatting
+
+import (
+	"fmt"
+	"strings"
+	"sync"
+
+	"github.com/andy/beads-tui/internal/parser"
+	"github.com/andy/beads-tui/internal/theme"
+)
+
+// IconSet is the glyphs drawn for issue status and type, list section
+// headers, and the tree view's branches and fold markers. Terminals and
+// fonts differ in what they draw well, so the set is picked with
+// "icon_set" in the config; the theme can then swap in its own type and
+// status icons (see theme.Icons).
+type IconSet struct {
+	Name string
+
+	// Status icons: ready (open and unblocked), blocked, in progress, closed
+	Ready, Blocked, InProgress, Closed string
+
+	// Section marks list section headers; Bullet is a plain status dot
+	Section, Bullet string
+
+	// Type icons, with Other for types without one
+	Bug, Feature, Task, Epic, Chore, Other string
+
+	// Tree branches: to a child, to the last child, and the line carried
+	// down past a child that has later siblings
+	Branch, LastBranch, Continuation string
+
+	// Fold markers on tree nodes with children
+	Collapsed, Expanded string
+}
+
+// Icon set names for the "icon_set" config setting
+const (
+	IconSetUnicode = "unicode" // Geometric shapes, emoji types, box drawing (the default)
+	IconSetASCII   = "ascii"   // Plain ASCII, for terminals or fonts that mangle the others
+	IconSetNerd    = "nerd"    // Nerd Font glyphs (needs a patched font)
+)
+
+var iconSets = map[string]*IconSet{
+	IconSetUnicode: {
+		Name:  IconSetUnicode,
+		Ready: "●", Blocked: "○", InProgress: "◆", Closed: "✓",
+		Section: "⬤", Bullet: "●",
+		Bug: "🐛", Feature: "✨", Task: "📋", Epic: "🎯", Chore: "🔧", Other: "•",
+		Branch: "├── ", LastBranch: "└── ", Continuation: "│   ",
+		Collapsed: "▶", Expanded: "▼",
+	},
+	IconSetASCII: {
+		Name:  IconSetASCII,
+		Ready: "*", Blocked: "o", InProgress: ">", Closed: "x",
+		Section: "#", Bullet: "*",
+		Bug: "B", Feature: "F", Task: "T", Epic: "E", Chore: "C", Other: "-",
+		Branch: "|-- ", LastBranch: "`-- ", Continuation: "|   ",
+		Collapsed: "+", Expanded: "-",
+	},
+	IconSetNerd: {
+		Name:         IconSetNerd,
+		Ready:        "\uf111", // nf-fa-circle
+		Blocked:      "\uf10c", // nf-fa-circle_o
+		InProgress:   "\uf144", // nf-fa-play_circle
+		Closed:       "\uf00c", // nf-fa-check
+		Section:      "\uf111", // nf-fa-circle
+		Bullet:       "\uf111", // nf-fa-circle
+		Bug:          "\uf188", // nf-fa-bug
+		Feature:      "\uf005", // nf-fa-star
+		Task:         "\uf0ae", // nf-fa-tasks
+		Epic:         "\uf140", // nf-fa-bullseye
+		Chore:        "\uf0ad", // nf-fa-wrench
+		Other:        "•",
+		Branch:       "├── ",
+		LastBranch:   "└── ",
+		Continuation: "│   ",
+		Collapsed:    "\uf054", // nf-fa-chevron_right
+		Expanded:     "\uf078", // nf-fa-chevron_down
+	},
+}
+
+var (
+	currentIcons = iconSets[IconSetUnicode]
+	iconsMutex   sync.RWMutex
+)
+
+// Icons returns the icon set in use, with the current theme's icons
+func Icons() *IconSet {
+	return IconsFor(theme.Current())
+}
+
+// IconsFor returns the configured icon set with t's icons in place of its
+// own (t may be nil)
+func IconsFor(t theme.Theme) *IconSet {
+	iconsMutex.RLock()
+	set := currentIcons
+	iconsMutex.RUnlock()
+
+	if t == nil {
+		return set
+	}
+	overrides := t.Icons()
+	if overrides == (theme.Icons{}) {
+		return set
+	}
+	merged := *set
+	for _, icon := range []struct {
+		glyph    *string
+		override string
+	}{
+		{&merged.Bug, overrides.Bug},
+		{&merged.Feature, overrides.Feature},
+		{&merged.Task, overrides.Task},
+		{&merged.Epic, overrides.Epic},
+		{&merged.Chore, overrides.Chore},
+		{&merged.Ready, overrides.Open},
+		{&merged.InProgress, overrides.InProgress},
+		{&merged.Blocked, overrides.Blocked},
+		{&merged.Closed, overrides.Closed},
+	} {
+		if icon.override != "" {
+			*icon.glyph = icon.override
+		}
+	}
+	return &merged
+}
+
+// SetIconSet switches to the named icon set ("" is the default, unicode)
+func SetIconSet(name string) error {
+	name = strings.ToLower(strings.TrimSpace(name))
+	if name == "" {
+		name = IconSetUnicode
+	}
+	set, ok := iconSets[name]
+	if !ok {
+		return fmt.Errorf("unknown icon set %q (choose %s, %s, or %s)", name, IconSetUnicode, IconSetASCII, IconSetNerd)
+	}
+	iconsMutex.Lock()
+	defer iconsMutex.Unlock()
+
+	currentIcons = set
+	return nil
+}
+
+// StatusIcon returns the icon for a status, as written on the issue (not
+// accounting for blocking dependencies)
+func (s *IconSet) StatusIcon(status parser.Status) string {
+	switch status {
+	case parser.StatusInProgress:
+		return s.InProgress
+	case parser.StatusBlocked:
+		return s.Blocked
+	case parser.StatusClosed:
+		return s.Closed
+	default:
+		return s.Ready
+	}
+}
+
+// TypeIcon returns the icon for an issue type
+func (s *IconSet) TypeIcon(issueType parser.IssueType) string {
+	switch issueType {
+	case parser.TypeBug:
+		return s.Bug
+	case parser.TypeFeature:
+		return s.Feature
+	case parser.TypeTask:
+		return s.Task
+	case parser.TypeEpic:
+		return s.Epic
+	case parser.TypeChore:
+		return s.Chore
+	default:
+		return s.Other
+	}
+}
@@ -0,0 +1,82 @@
+package formatting
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/andy/beads-tui/internal/parser"
+)
+
+// FormatIssueMarkdown renders a whole issue as Markdown for pasting into a PR
+// description or chat: title, metadata, the text fields, dependencies, and
+// comments (attach them first; issues are loaded without). lookup resolves
+// dependency IDs to issues so their titles can be shown; it may be nil or
+// return nil for unknown IDs.
+func FormatIssueMarkdown(issue *parser.Issue, lookup func(id string) *parser.Issue) string {
+	var sb strings.Builder
+	sb.WriteString(fmt.Sprintf("## %s: %s\n\n", issue.ID, issue.Title))
+
+	// Metadata
+	sb.WriteString(fmt.Sprintf("- **Status:** %s\n", issue.Status))
+	sb.WriteString(fmt.Sprintf("- **Priority:** P%d\n", issue.Priority))
+	sb.WriteString(fmt.Sprintf("- **Type:** %s\n", issue.IssueType))
+	if issue.Assignee != "" {
+		sb.WriteString(fmt.Sprintf("- **Assignee:** @%s\n", issue.Assignee))
+	}
+	if len(issue.Labels) > 0 {
+		sb.WriteString(fmt.Sprintf("- **Labels:** %s\n", strings.Join(issue.Labels, ", ")))
+	}
+	if issue.EstimatedMinutes != nil {
+		sb.WriteString(fmt.Sprintf("- **Estimate:** %dh %dm\n", *issue.EstimatedMinutes/60, *issue.EstimatedMinutes%60))
+	}
+	if issue.DueDate != nil {
+		sb.WriteString(fmt.Sprintf("- **Due:** %s\n", issue.DueDate.Format("2006-01-02")))
+	}
+	if issue.ExternalRef != nil {
+		sb.WriteString(fmt.Sprintf("- **External ref:** %s\n", *issue.ExternalRef))
+	}
+	sb.WriteString(fmt.Sprintf("- **Created:** %s\n", issue.CreatedAt.Format("2006-01-02 15:04")))
+	sb.WriteString(fmt.Sprintf("- **Updated:** %s\n", issue.UpdatedAt.Format("2006-01-02 15:04")))
+	if issue.ClosedAt != nil {
+		sb.WriteString(fmt.Sprintf("- **Closed:** %s\n", issue.ClosedAt.Format("2006-01-02 15:04")))
+	}
+
+	// Text fields
+	for _, section := range []struct{ heading, text string }{
+		{"Description", issue.Description},
+		{"Design", issue.Design},
+		{"Acceptance Criteria", issue.AcceptanceCriteria},
+		{"Notes", issue.Notes},
+	} {
+		if text := strings.TrimSpace(section.text); text != "" {
+			sb.WriteString(fmt.Sprintf("\n### %s\n\n%s\n", section.heading, text))
+		}
+	}
+
+	// Dependencies
+	if len(issue.Dependencies) > 0 {
+		sb.WriteString("\n### Dependencies\n\n")
+		for _, dep := range issue.Dependencies {
+			line := fmt.Sprintf("- %s **%s**", formatDependencyPhrase(dep.Type), dep.DependsOnID)
+			if lookup != nil {
+				if target := lookup(dep.DependsOnID); target != nil {
+					line += fmt.Sprintf(" %s (%s)", target.Title, target.Status)
+				}
+			}
+			sb.WriteString(line + "\n")
+		}
+	}
+
+	// Comments, quoted so their own Markdown stays inside the quote
+	if len(issue.Comments) > 0 {
+		sb.WriteString("\n### Comments\n")
+		for _, comment := range issue.Comments {
+			sb.WriteString(fmt.Sprintf("\n**%s** (%s):\n\n", comment.Author, comment.CreatedAt.Format("2006-01-02 15:04")))
+			for _, line := range strings.Split(strings.TrimSpace(comment.Text), "\n") {
+				sb.WriteString(strings.TrimRight("> "+line, " ") + "\n")
+			}
+		}
+	}
+
+	return sb.String()
+}
@@ -0,0 +1,40 @@
+package formatting
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/andy/beads-tui/internal/parser"
+	"github.com/andy/beads-tui/internal/state"
+)
+
+// progressBarWidth is the number of cells in an epic's mini progress bar
+const progressBarWidth = 5
+
+// FormatProgress renders an epic's child completion as a mini bar and
+// fraction ("▰▰▰▱▱ 3/5"), in the closed color once every child is done
+func FormatProgress(progress state.Progress) string {
+	filled := progress.Closed * progressBarWidth / max(progress.Total, 1)
+	if progress.Closed > 0 && filled == 0 {
+		filled = 1 // Show that work has started
+	}
+	color := GetAccentColor()
+	if progress.Closed == progress.Total {
+		color = GetStatusColor(parser.StatusClosed)
+	}
+	return fmt.Sprintf("[%s]%s[-][%s]%s[-] [%s]%d/%d[-]",
+		color, strings.Repeat("▰", filled),
+		GetMutedColor(), strings.Repeat("▱", progressBarWidth-filled),
+		GetMutedColor(), progress.Closed, progress.Total)
+}
+
+// FormatEstimateRollup renders how much of the work estimated on an epic's
+// descendants is closed ("Est: 12h of 30h closed"), or "" if none of them
+// has an estimate
+func FormatEstimateRollup(progress state.Progress) string {
+	if progress.EstimatedMinutes == 0 {
+		return ""
+	}
+	return fmt.Sprintf("[%s]Est: %s of %s closed[-]", GetMutedColor(),
+		FormatMinutes(progress.ClosedEstimatedMinutes), FormatMinutes(progress.EstimatedMinutes))
+}
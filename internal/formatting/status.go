@@ -31,10 +31,7 @@ func GetStatusBarText(
 	}
 
 	// Count visible issues after filtering
-	visibleCount := len(appState.GetReadyIssues()) + len(appState.GetBlockedIssues()) + len(appState.GetInProgressIssues())
-	if showClosedIssues {
-		visibleCount += len(appState.GetClosedIssues())
-	}
+	visibleCount := appState.Snapshot().Visible(showClosedIssues)
 
 	filterText := ""
 	if appState.HasActiveFilters() {
@@ -1,5 +1,10 @@
 package formatting
 
+import (
+	"fmt"
+	"time"
+)
+
 // ContainsCaseInsensitive checks if s contains substr (case-insensitive)
 func ContainsCaseInsensitive(s, substr string) bool {
 	s = ToLower(s)
@@ -46,3 +51,30 @@ func FormatIssueID(id string, showPrefix bool) string {
 	// No hyphen found, return as-is
 	return id
 }
+
+// FormatMinutes renders a duration in minutes as hours and minutes, leaving
+// out a zero part ("1h 30m", "2h", "45m")
+func FormatMinutes(minutes int) string {
+	hours, mins := minutes/60, minutes%60
+	switch {
+	case hours == 0:
+		return fmt.Sprintf("%dm", mins)
+	case mins == 0:
+		return fmt.Sprintf("%dh", hours)
+	default:
+		return fmt.Sprintf("%dh %dm", hours, mins)
+	}
+}
+
+// FormatAge renders how long ago t was, coarsely ("today", "3d", "5w")
+func FormatAge(t, now time.Time) string {
+	days := int(now.Sub(t).Hours() / 24)
+	switch {
+	case days < 1:
+		return "today"
+	case days < 14:
+		return fmt.Sprintf("%dd", days)
+	default:
+		return fmt.Sprintf("%dw", days/7)
+	}
+}
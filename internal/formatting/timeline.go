@@ -0,0 +1,161 @@
+package formatting
+
+import (
+	"fmt"
+	"sort"
+	"strings"
+	"time"
+
+	"github.com/andy/beads-tui/internal/parser"
+	"github.com/rivo/tview"
+)
+
+// TimelineEntry is one point in an issue's reconstructed history
+type TimelineEntry struct {
+	Time   time.Time
+	Kind   string // "created", "comment", "closed", "updated", or a bd event type
+	Actor  string
+	Detail string
+}
+
+// BuildTimeline reconstructs issue's history, oldest first. When bd's audit
+// trail is available (events), every recorded change is listed; otherwise
+// the history is inferred from created_at, closed_at, and the last
+// updated_at. Comments always come from the issue so their text is shown.
+func BuildTimeline(issue *parser.Issue, events []*parser.Event) []TimelineEntry {
+	var entries []TimelineEntry
+
+	hasCreated := false
+	for _, event := range events {
+		switch event.EventType {
+		case "commented":
+			continue // Listed below with the comment text
+		case "created":
+			hasCreated = true
+		}
+		entries = append(entries, TimelineEntry{
+			Time:   event.CreatedAt,
+			Kind:   event.EventType,
+			Actor:  event.Actor,
+			Detail: eventDetail(event),
+		})
+	}
+
+	// Issues older than the events table have no creation event
+	if !hasCreated {
+		entries = append(entries, TimelineEntry{Time: issue.CreatedAt, Kind: "created"})
+	}
+
+	for _, comment := range issue.Comments {
+		if comment != nil {
+			entries = append(entries, TimelineEntry{Time: comment.CreatedAt, Kind: "comment", Actor: comment.Author, Detail: comment.Text})
+		}
+	}
+
+	if len(events) == 0 {
+		if issue.ClosedAt != nil {
+			entries = append(entries, TimelineEntry{Time: *issue.ClosedAt, Kind: "closed"})
+		}
+		if lastUpdateIsNew(issue) {
+			entries = append(entries, TimelineEntry{Time: issue.UpdatedAt, Kind: "updated", Detail: "last modified (details not recorded)"})
+		}
+	}
+
+	sort.SliceStable(entries, func(i, j int) bool {
+		return entries[i].Time.Before(entries[j].Time)
+	})
+	return entries
+}
+
+// eventDetail describes what an event changed: its comment if bd recorded
+// one (e.g. "Added label: ui"), otherwise the old and new values
+func eventDetail(event *parser.Event) string {
+	if event.Comment != nil && *event.Comment != "" {
+		return *event.Comment
+	}
+	switch {
+	case event.OldValue != nil && event.NewValue != nil:
+		return *event.OldValue + " → " + *event.NewValue
+	case event.NewValue != nil:
+		return *event.NewValue
+	case event.OldValue != nil:
+		return "was " + *event.OldValue
+	}
+	return ""
+}
+
+// lastUpdateIsNew reports whether updated_at records a change of its own
+// rather than just reflecting the creation, a comment, or the close
+func lastUpdateIsNew(issue *parser.Issue) bool {
+	events := []time.Time{issue.CreatedAt}
+	for _, comment := range issue.Comments {
+		if comment != nil {
+			events = append(events, comment.CreatedAt)
+		}
+	}
+	if issue.ClosedAt != nil {
+		events = append(events, *issue.ClosedAt)
+	}
+	for _, event := range events {
+		if diff := issue.UpdatedAt.Sub(event); diff > -time.Minute && diff < time.Minute {
+			return false
+		}
+	}
+	return true
+}
+
+// FormatTimeline renders timeline entries for display, one line per entry
+// with multi-line details (comments) indented beneath it
+func FormatTimeline(entries []TimelineEntry, now time.Time) string {
+	mutedColor := GetMutedColor()
+	var sb strings.Builder
+	for _, entry := range entries {
+		sb.WriteString(fmt.Sprintf("[%s]%s[-] [%s]%-8s[-] [%s]● %s[-]",
+			GetAccentColor(), entry.Time.Local().Format("2006-01-02 15:04"),
+			mutedColor, timeAgo(entry.Time, now),
+			timelineColor(entry.Kind), strings.ReplaceAll(entry.Kind, "_", " ")))
+		if entry.Actor != "" {
+			sb.WriteString(fmt.Sprintf(" [%s]by %s[-]", mutedColor, tview.Escape(entry.Actor)))
+		}
+		sb.WriteString("\n")
+
+		detail := strings.TrimSpace(entry.Detail)
+		if detail != "" {
+			for _, line := range strings.Split(detail, "\n") {
+				sb.WriteString("      " + tview.Escape(line) + "\n")
+			}
+		}
+	}
+	return sb.String()
+}
+
+// timelineColor picks the marker color for an entry kind
+func timelineColor(kind string) string {
+	switch kind {
+	case "created", "reopened":
+		return GetSuccessColor()
+	case "closed":
+		return GetStatusColor(parser.StatusClosed)
+	case "comment":
+		return GetEmphasisColor()
+	default:
+		return GetInfoColor()
+	}
+}
+
+// timeAgo renders how long before now t was ("just now", "5m ago", "3d ago")
+func timeAgo(t, now time.Time) string {
+	d := now.Sub(t)
+	switch {
+	case d < time.Minute:
+		return "just now"
+	case d < time.Hour:
+		return fmt.Sprintf("%dm ago", int(d.Minutes()))
+	case d < 24*time.Hour:
+		return fmt.Sprintf("%dh ago", int(d.Hours()))
+	case d < 60*24*time.Hour:
+		return fmt.Sprintf("%dd ago", int(d.Hours()/24))
+	default:
+		return fmt.Sprintf("%dmo ago", int(d.Hours()/24/30))
+	}
+}
@@ -0,0 +1,89 @@
+package git
+
+import "sync"
+
+// CommitCache keeps the commits found for each issue, so moving through the
+// list runs git log once per issue rather than on every selection. Clear it
+// when commits may have been made.
+type CommitCache struct {
+	limit int                                               // Commits kept per issue
+	find  func(issueID string, limit int) ([]Commit, error) // Runs git log (Repo.CommitsMentioning)
+
+	mu         sync.Mutex
+	entries    map[string][]Commit
+	loading    map[string]bool // Issues LoadAsync is loading
+	generation int             // Bumped by Clear, so loads started before it are dropped
+}
+
+// NewCommitCache creates an empty cache that keeps up to limit commits per
+// issue from repo
+func NewCommitCache(repo *Repo, limit int) *CommitCache {
+	return &CommitCache{
+		limit:   limit,
+		find:    repo.CommitsMentioning,
+		entries: make(map[string][]Commit),
+		loading: make(map[string]bool),
+	}
+}
+
+// Cached returns the issue's commits if they have been loaded
+func (c *CommitCache) Cached(issueID string) ([]Commit, bool) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	commits, ok := c.entries[issueID]
+	return commits, ok
+}
+
+// Load runs git log for the issue's commits and caches them. A failed load
+// is cached as no commits, so it isn't retried on every selection.
+func (c *CommitCache) Load(issueID string) ([]Commit, error) {
+	c.mu.Lock()
+	generation := c.generation
+	c.mu.Unlock()
+
+	commits, err := c.find(issueID, c.limit)
+
+	c.mu.Lock()
+	if generation == c.generation {
+		c.entries[issueID] = commits
+	}
+	c.mu.Unlock()
+	return commits, err
+}
+
+// LoadAsync loads the issue's commits as Load does, in the background, then
+// calls done on the goroutine that loaded them. It does nothing while a load
+// of the issue is already running; that load's done reports the result.
+func (c *CommitCache) LoadAsync(issueID string, done func(err error)) {
+	c.mu.Lock()
+	if c.loading[issueID] {
+		c.mu.Unlock()
+		return
+	}
+	c.loading[issueID] = true
+	generation := c.generation
+	c.mu.Unlock()
+
+	go func() {
+		commits, err := c.find(issueID, c.limit)
+
+		// A load from before Clear caches nothing, and leaves the issue to
+		// any load started since
+		c.mu.Lock()
+		if generation == c.generation {
+			c.entries[issueID] = commits
+			delete(c.loading, issueID)
+		}
+		c.mu.Unlock()
+		done(err)
+	}()
+}
+
+// Clear drops every cached issue
+func (c *CommitCache) Clear() {
+	c.mu.Lock()
+	c.entries = make(map[string][]Commit)
+	c.loading = make(map[string]bool)
+	c.generation++
+	c.mu.Unlock()
+}
@@ -0,0 +1,205 @@
+// Package git links issues to the git repository the beads project lives in:
+// branches named after issues, and commits whose messages mention them.
+package git
+
+import (
+	"bytes"
+	"context"
+	"errors"
+	"fmt"
+	"os/exec"
+	"regexp"
+	"strings"
+	"time"
+	"unicode"
+
+	"github.com/andy/beads-tui/internal/parser"
+)
+
+// DefaultBranchTemplate names branches after the issue ID and its title
+const DefaultBranchTemplate = "{{id}}-{{slug(title)}}"
+
+// commandTimeout bounds how long a single git invocation may run
+const commandTimeout = 5 * time.Second
+
+// maxSlugLength keeps slugged titles short enough for a branch name
+const maxSlugLength = 40
+
+// Repo is a git working tree
+type Repo struct {
+	Dir string // Top-level directory of the working tree
+}
+
+// Commit is one commit from git log
+type Commit struct {
+	Hash    string // Abbreviated hash
+	Author  string
+	Date    time.Time
+	Subject string
+}
+
+// Open finds the working tree containing dir. It fails if git isn't
+// installed or dir isn't inside a repository.
+func Open(dir string) (*Repo, error) {
+	out, err := (&Repo{Dir: dir}).run("rev-parse", "--show-toplevel")
+	if err != nil {
+		return nil, err
+	}
+	return &Repo{Dir: out}, nil
+}
+
+// run executes git in the working tree and returns its trimmed stdout
+func (r *Repo) run(args ...string) (string, error) {
+	ctx, cancel := context.WithTimeout(context.Background(), commandTimeout)
+	defer cancel()
+
+	cmd := exec.CommandContext(ctx, "git", append([]string{"-C", r.Dir}, args...)...)
+	var stdout, stderr bytes.Buffer
+	cmd.Stdout = &stdout
+	cmd.Stderr = &stderr
+	if err := cmd.Run(); err != nil {
+		if errors.Is(ctx.Err(), context.DeadlineExceeded) {
+			return "", fmt.Errorf("git %s timed out after %s", args[0], commandTimeout)
+		}
+		if message := strings.TrimSpace(stderr.String()); message != "" {
+			return "", fmt.Errorf("git %s: %s", args[0], firstLine(message))
+		}
+		return "", fmt.Errorf("git %s: %w", args[0], err)
+	}
+	return strings.TrimSpace(stdout.String()), nil
+}
+
+// CurrentBranch returns the checked-out branch, or "" on a detached HEAD
+func (r *Repo) CurrentBranch() (string, error) {
+	branch, err := r.run("rev-parse", "--abbrev-ref", "HEAD")
+	if err != nil || branch == "HEAD" {
+		return "", err
+	}
+	return branch, nil
+}
+
+// BranchExists reports whether a local branch with this name exists
+func (r *Repo) BranchExists(name string) bool {
+	_, err := r.run("show-ref", "--verify", "--quiet", "refs/heads/"+name)
+	return err == nil
+}
+
+// Checkout switches to the named branch, creating it from the current HEAD
+// if it doesn't exist yet. Returns true if the branch was created.
+func (r *Repo) Checkout(name string) (bool, error) {
+	if r.BranchExists(name) {
+		_, err := r.run("checkout", name)
+		return false, err
+	}
+	_, err := r.run("checkout", "-b", name)
+	return err == nil, err
+}
+
+// CommitsMentioning returns up to limit commits on any branch whose message
+// mentions the issue ID, newest first. git log --grep matches the ID as
+// plain text, so commits that only mention a longer ID (tui-12, or the child
+// tui-1.2, for tui-1) are dropped afterwards.
+func (r *Repo) CommitsMentioning(issueID string, limit int) ([]Commit, error) {
+	out, err := r.run("log", "--all", "--regexp-ignore-case", "--fixed-strings",
+		"--grep="+issueID, fmt.Sprintf("--max-count=%d", limit),
+		"--format=%h%x1f%an%x1f%aI%x1f%s%x1f%b%x1e")
+	if err != nil {
+		return nil, err
+	}
+	return parseLog(out, issueID), nil
+}
+
+// parseLog reads the records written by CommitsMentioning's log format,
+// keeping the commits that mention the issue ID as a whole word
+func parseLog(out, issueID string) []Commit {
+	mention := regexp.MustCompile(`(?i)(^|[^\pL\pN])` + regexp.QuoteMeta(issueID) + `($|[^\pL\pN.]|\.($|[^\pN]))`)
+	var commits []Commit
+	for _, record := range strings.Split(out, "\x1e") {
+		fields := strings.Split(strings.TrimLeft(record, "\n"), "\x1f")
+		if len(fields) < 5 {
+			continue
+		}
+		if !mention.MatchString(fields[3] + "\n" + fields[4]) {
+			continue
+		}
+		date, _ := time.Parse(time.RFC3339, fields[2])
+		commits = append(commits, Commit{Hash: fields[0], Author: fields[1], Date: date, Subject: fields[3]})
+	}
+	return commits
+}
+
+// branchPlaceholder matches {{field}} and {{slug(field)}} in a branch template
+var branchPlaceholder = regexp.MustCompile(`\{\{\s*(\w+)\s*(?:\(\s*(\w+)\s*\))?\s*\}\}`)
+
+// BranchName fills in a branch template for an issue. Placeholders are
+// {{id}}, {{title}} and {{type}}, optionally wrapped in slug(), as in the
+// default "{{id}}-{{slug(title)}}". Whitespace becomes dashes, and dashes
+// or slashes left dangling by an empty field are trimmed.
+func BranchName(template string, issue *parser.Issue) (string, error) {
+	if template == "" {
+		template = DefaultBranchTemplate
+	}
+	var err error
+	name := branchPlaceholder.ReplaceAllStringFunc(template, func(placeholder string) string {
+		match := branchPlaceholder.FindStringSubmatch(placeholder)
+		function, field := "", match[1]
+		if match[2] != "" {
+			function, field = match[1], match[2]
+		}
+
+		var value string
+		switch field {
+		case "id":
+			value = issue.ID
+		case "title":
+			value = issue.Title
+		case "type":
+			value = string(issue.IssueType)
+		default:
+			err = fmt.Errorf("unknown branch template field %q in %q", field, template)
+			return placeholder
+		}
+		switch function {
+		case "":
+		case "slug":
+			value = Slug(value)
+		default:
+			err = fmt.Errorf("unknown branch template function %q in %q", function, template)
+		}
+		return value
+	})
+	if err != nil {
+		return "", err
+	}
+	name = strings.Join(strings.Fields(name), "-")
+	return strings.Trim(name, "-/"), nil
+}
+
+// Slug lowercases text and joins its words with dashes, dropping everything
+// but letters and digits, and cuts it at a word break after maxSlugLength
+// characters: "Fix login (OAuth) bug!" becomes "fix-login-oauth-bug".
+func Slug(text string) string {
+	words := strings.FieldsFunc(strings.ToLower(text), func(r rune) bool {
+		return r > unicode.MaxASCII || !unicode.IsLetter(r) && !unicode.IsDigit(r)
+	})
+	slug := ""
+	for _, word := range words {
+		if slug != "" && len(slug)+1+len(word) > maxSlugLength {
+			break
+		}
+		if slug != "" {
+			slug += "-"
+		}
+		slug += word
+	}
+	if len(slug) > maxSlugLength {
+		slug = slug[:maxSlugLength]
+	}
+	return slug
+}
+
+// firstLine returns text up to its first newline
+func firstLine(text string) string {
+	line, _, _ := strings.Cut(text, "\n")
+	return line
+}
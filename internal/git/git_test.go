@@ -0,0 +1,188 @@
+package git
+
+import (
+	"os/exec"
+	"reflect"
+	"sync"
+	"sync/atomic"
+	"testing"
+
+	"github.com/andy/beads-tui/internal/parser"
+)
+
+func TestSlug(t *testing.T) {
+	tests := []struct {
+		text string
+		want string
+	}{
+		{"Fix login (OAuth) bug!", "fix-login-oauth-bug"},
+		{"  spaces   everywhere  ", "spaces-everywhere"},
+		{"Café déjà vu", "caf-d-j-vu"},
+		{"!!!", ""},
+		{"Support exporting the whole dependency graph as Graphviz dot files", "support-exporting-the-whole-dependency"},
+		{"averyveryveryveryveryveryveryveryverylongsingleword", "averyveryveryveryveryveryveryveryverylon"},
+	}
+	for _, tt := range tests {
+		if got := Slug(tt.text); got != tt.want {
+			t.Errorf("Slug(%q) = %q, want %q", tt.text, got, tt.want)
+		}
+	}
+}
+
+func TestBranchName(t *testing.T) {
+	issue := &parser.Issue{ID: "tui-12", Title: "Fix login bug", IssueType: parser.TypeBug}
+
+	tests := []struct {
+		template string
+		want     string
+	}{
+		{"", "tui-12-fix-login-bug"},
+		{"{{id}}", "tui-12"},
+		{"{{type}}/{{ id }}-{{ slug( title ) }}", "bug/tui-12-fix-login-bug"},
+		{"{{id}} {{title}}", "tui-12-Fix-login-bug"},
+	}
+	for _, tt := range tests {
+		got, err := BranchName(tt.template, issue)
+		if err != nil || got != tt.want {
+			t.Errorf("BranchName(%q) = %q, %v; want %q", tt.template, got, err, tt.want)
+		}
+	}
+
+	// A title with nothing to slug leaves no dangling dash
+	if got, _ := BranchName("", &parser.Issue{ID: "tui-1", Title: "???"}); got != "tui-1" {
+		t.Errorf("BranchName with an empty slug = %q, want tui-1", got)
+	}
+
+	for _, template := range []string{"{{assignee}}", "{{upper(id)}}"} {
+		if _, err := BranchName(template, issue); err == nil {
+			t.Errorf("BranchName(%q) succeeded, want an error", template)
+		}
+	}
+}
+
+func TestParseLog(t *testing.T) {
+	out := "abc123\x1fAda\x1f2026-03-01T10:00:00Z\x1fFix tui-1 crash\x1f\x1e\n" +
+		"def456\x1fBob\x1f2026-03-02T10:00:00Z\x1fWork on tui-12\x1f\x1e\n" +
+		"0a1b2c\x1fAda\x1f2026-03-03T10:00:00Z\x1fChild done\x1fCloses tui-1.2.\x1e\n" +
+		"fed987\x1fCy\x1f2026-03-04T10:00:00Z\x1fRefactor\x1fPart of TUI-1.\n\nMore text\x1e\n"
+
+	var hashes []string
+	for _, commit := range parseLog(out, "tui-1") {
+		hashes = append(hashes, commit.Hash)
+	}
+	if want := []string{"abc123", "fed987"}; !reflect.DeepEqual(hashes, want) {
+		t.Errorf("parseLog hashes = %v, want %v", hashes, want)
+	}
+
+	commits := parseLog(out, "tui-1.2")
+	if len(commits) != 1 || commits[0].Subject != "Child done" || commits[0].Author != "Ada" || commits[0].Date.Day() != 3 {
+		t.Errorf("parseLog(tui-1.2) = %+v", commits)
+	}
+}
+
+func TestRepo(t *testing.T) {
+	if _, err := exec.LookPath("git"); err != nil {
+		t.Skip("git not installed")
+	}
+	dir := t.TempDir()
+	for _, args := range [][]string{
+		{"init", "-q", "-b", "main"},
+		{"config", "user.name", "Test"},
+		{"config", "user.email", "test@example.com"},
+		{"commit", "-q", "--allow-empty", "-m", "Start tui-1"},
+	} {
+		if out, err := exec.Command("git", append([]string{"-C", dir}, args...)...).CombinedOutput(); err != nil {
+			t.Fatalf("git %v: %v\n%s", args, err, out)
+		}
+	}
+
+	repo, err := Open(dir)
+	if err != nil {
+		t.Fatalf("Open: %v", err)
+	}
+	if branch, err := repo.CurrentBranch(); err != nil || branch != "main" {
+		t.Errorf("CurrentBranch = %q, %v; want main", branch, err)
+	}
+
+	created, err := repo.Checkout("tui-1-start")
+	if err != nil || !created {
+		t.Fatalf("Checkout new branch = %v, %v", created, err)
+	}
+	if branch, _ := repo.CurrentBranch(); branch != "tui-1-start" {
+		t.Errorf("CurrentBranch after checkout = %q", branch)
+	}
+	if created, err := repo.Checkout("main"); err != nil || created {
+		t.Errorf("Checkout existing branch = %v, %v", created, err)
+	}
+
+	commits, err := repo.CommitsMentioning("tui-1", 10)
+	if err != nil || len(commits) != 1 || commits[0].Subject != "Start tui-1" {
+		t.Errorf("CommitsMentioning = %+v, %v", commits, err)
+	}
+
+	cache := NewCommitCache(repo, 10)
+	if _, ok := cache.Cached("tui-1"); ok {
+		t.Error("expected an empty cache")
+	}
+	if commits, err := cache.Load("tui-1"); err != nil || len(commits) != 1 {
+		t.Errorf("CommitCache.Load = %+v, %v", commits, err)
+	}
+	if commits, ok := cache.Cached("tui-1"); !ok || len(commits) != 1 {
+		t.Errorf("CommitCache.Cached after Load = %+v, %v", commits, ok)
+	}
+	cache.Clear()
+	if _, ok := cache.Cached("tui-1"); ok {
+		t.Error("expected Clear to drop the cached commits")
+	}
+
+	if _, err := Open(t.TempDir()); err == nil {
+		t.Error("Open outside a repository succeeded, want an error")
+	}
+}
+
+func TestCommitCacheLoadAsync(t *testing.T) {
+	var runs atomic.Int32
+	release := make(chan struct{})
+	cache := &CommitCache{
+		limit:   10,
+		entries: make(map[string][]Commit),
+		loading: make(map[string]bool),
+		find: func(issueID string, limit int) ([]Commit, error) {
+			runs.Add(1)
+			<-release
+			return []Commit{{Hash: "abc123", Subject: "Fix " + issueID}}, nil
+		},
+	}
+
+	// Each render of the details asks again until the commits are cached;
+	// only the first starts git log
+	var done sync.WaitGroup
+	done.Add(1)
+	for range 5 {
+		cache.LoadAsync("tui-1", func(err error) { done.Done() })
+	}
+	close(release)
+	done.Wait()
+	if n := runs.Load(); n != 1 {
+		t.Errorf("expected git log to run once, ran %d times", n)
+	}
+	if commits, ok := cache.Cached("tui-1"); !ok || len(commits) != 1 {
+		t.Errorf("Cached after LoadAsync = %+v, %v", commits, ok)
+	}
+
+	// A load that was running when the cache was cleared doesn't hold up
+	// the next one, and caches nothing
+	release = make(chan struct{})
+	done.Add(2)
+	cache.LoadAsync("tui-2", func(err error) { done.Done() })
+	cache.Clear()
+	cache.LoadAsync("tui-2", func(err error) { done.Done() })
+	close(release)
+	done.Wait()
+	if n := runs.Load(); n != 3 {
+		t.Errorf("expected a new load after Clear, git log ran %d times", n)
+	}
+	if _, ok := cache.Cached("tui-2"); !ok {
+		t.Error("expected the load started after Clear to be cached")
+	}
+}
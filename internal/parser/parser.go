@@ -2,14 +2,53 @@ package parser
 
 import (
 	"bufio"
+	"bytes"
 	"encoding/json"
+	"errors"
 	"fmt"
+	"io"
 	"os"
 )
 
+// progressInterval is how many lines are parsed between progress reports
+const progressInterval = 1000
+
+// Progress reports how far a parse has got through the file
+type Progress struct {
+	BytesRead  int64
+	TotalBytes int64 // Size of the file when the parse started
+	Issues     int   // Issues parsed so far
+	Skipped    int   // Malformed lines skipped (lenient mode only)
+}
+
+// Percent returns the fraction of the file read, from 0 to 100
+func (p Progress) Percent() int {
+	if p.TotalBytes <= 0 {
+		return 100
+	}
+	return int(min(p.BytesRead*100/p.TotalBytes, 100))
+}
+
+// LineError is a malformed line in a JSONL file
+type LineError struct {
+	Line int
+	Err  error
+}
+
+func (e *LineError) Error() string {
+	return fmt.Sprintf("line %d: %v", e.Line, e.Err)
+}
+
+func (e *LineError) Unwrap() error {
+	return e.Err
+}
+
 // Parser reads and parses JSONL files containing beads issues
 type Parser struct {
-	path string
+	path     string
+	lenient  bool
+	progress func(Progress)
+	errors   []*LineError
 }
 
 // New creates a new parser for the given JSONL file path
@@ -17,39 +56,114 @@ func New(path string) *Parser {
 	return &Parser{path: path}
 }
 
-// ParseAll reads all issues from the JSONL file
-func (p *Parser) ParseAll() ([]*Issue, error) {
+// SetLenient makes the parser skip malformed lines instead of failing the
+// whole parse. Skipped lines are available from Errors afterwards.
+func (p *Parser) SetLenient(lenient bool) *Parser {
+	p.lenient = lenient
+	return p
+}
+
+// SetProgress sets a function called every few thousand lines, and once at
+// the end, with how much of the file has been read
+func (p *Parser) SetProgress(fn func(Progress)) *Parser {
+	p.progress = fn
+	return p
+}
+
+// Errors returns the malformed lines skipped by the last parse in lenient mode
+func (p *Parser) Errors() []*LineError {
+	return p.errors
+}
+
+// Stream reads the file one line at a time, calling fn with each issue as it
+// is parsed so large files never have to be held in memory at once. Lines
+// may be any length. If fn returns an error, parsing stops and Stream
+// returns it.
+func (p *Parser) Stream(fn func(*Issue) error) error {
 	file, err := os.Open(p.path)
 	if err != nil {
-		return nil, fmt.Errorf("failed to open file: %w", err)
+		return fmt.Errorf("failed to open file: %w", err)
 	}
 	defer file.Close()
 
-	var issues []*Issue
-	scanner := bufio.NewScanner(file)
-	lineNum := 0
-
-	for scanner.Scan() {
-		lineNum++
-		line := scanner.Bytes()
+	var total int64
+	if info, err := file.Stat(); err == nil {
+		total = info.Size()
+	}
 
-		// Skip empty lines
-		if len(line) == 0 {
-			continue
+	p.errors = nil
+	progress := Progress{TotalBytes: total}
+	report := func() {
+		if p.progress != nil {
+			p.progress(progress)
 		}
+	}
 
-		var issue Issue
-		if err := json.Unmarshal(line, &issue); err != nil {
-			return nil, fmt.Errorf("invalid JSON at line %d: %w", lineNum, err)
+	reader := bufio.NewReader(file)
+	lineNum := 0
+	for {
+		line, readErr := reader.ReadBytes('\n')
+		if readErr != nil && !errors.Is(readErr, io.EOF) {
+			return fmt.Errorf("error reading file: %w", readErr)
 		}
+		if len(line) > 0 {
+			lineNum++
+			progress.BytesRead += int64(len(line))
+
+			// Skip empty lines
+			if line = bytes.TrimSpace(line); len(line) > 0 {
+				issue, err := parseLine(line)
+				if err != nil {
+					lineErr := &LineError{Line: lineNum, Err: err}
+					if !p.lenient {
+						return fmt.Errorf("invalid issue at %w", lineErr)
+					}
+					p.errors = append(p.errors, lineErr)
+					progress.Skipped++
+				} else {
+					progress.Issues++
+					if err := fn(issue); err != nil {
+						return err
+					}
+				}
+			}
 
-		issues = append(issues, &issue)
+			if lineNum%progressInterval == 0 {
+				report()
+			}
+		}
+		if readErr != nil { // io.EOF
+			break
+		}
 	}
 
-	if err := scanner.Err(); err != nil {
-		return nil, fmt.Errorf("error reading file: %w", err)
+	report()
+	return nil
+}
+
+// parseLine decodes a single JSONL record. Fields this version doesn't know
+// about are ignored, so newer bd exports still load.
+func parseLine(line []byte) (*Issue, error) {
+	var issue Issue
+	if err := json.Unmarshal(line, &issue); err != nil {
+		return nil, err
+	}
+	if issue.ID == "" {
+		return nil, errors.New("issue has no id")
 	}
+	return &issue, nil
+}
 
+// ParseAll reads all issues from the JSONL file
+func (p *Parser) ParseAll() ([]*Issue, error) {
+	var issues []*Issue
+	err := p.Stream(func(issue *Issue) error {
+		issues = append(issues, issue)
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
 	return issues, nil
 }
 
@@ -1,8 +1,11 @@
 package parser
 
 import (
+	"errors"
+	"fmt"
 	"os"
 	"path/filepath"
+	"strings"
 	"testing"
 )
 
@@ -97,3 +100,122 @@ func TestParseInvalidJSON(t *testing.T) {
 		t.Error("Expected error for invalid JSON, got nil")
 	}
 }
+
+func TestParseLenient(t *testing.T) {
+	tmpDir := t.TempDir()
+	jsonlPath := filepath.Join(tmpDir, "mixed.jsonl")
+
+	content := `{"id":"test-1","title":"Valid"}
+{invalid json}
+
+{"title":"No ID"}
+{"id":"test-3","title":"Also valid"}`
+
+	if err := os.WriteFile(jsonlPath, []byte(content), 0644); err != nil {
+		t.Fatalf("Failed to create test file: %v", err)
+	}
+
+	p := New(jsonlPath).SetLenient(true)
+	issues, err := p.ParseAll()
+	if err != nil {
+		t.Fatalf("Lenient parse failed: %v", err)
+	}
+	if len(issues) != 2 || issues[0].ID != "test-1" || issues[1].ID != "test-3" {
+		t.Fatalf("Expected the 2 valid issues, got %d", len(issues))
+	}
+
+	lineErrs := p.Errors()
+	if len(lineErrs) != 2 {
+		t.Fatalf("Expected 2 skipped lines, got %d", len(lineErrs))
+	}
+	if lineErrs[0].Line != 2 || lineErrs[1].Line != 4 {
+		t.Errorf("Expected errors on lines 2 and 4, got %d and %d", lineErrs[0].Line, lineErrs[1].Line)
+	}
+}
+
+func TestParseProgress(t *testing.T) {
+	tmpDir := t.TempDir()
+	jsonlPath := filepath.Join(tmpDir, "large.jsonl")
+
+	var sb strings.Builder
+	lines := 2*progressInterval + 5
+	for i := 0; i < lines; i++ {
+		fmt.Fprintf(&sb, `{"id":"test-%d","title":"Issue %d"}`+"\n", i, i)
+	}
+	if err := os.WriteFile(jsonlPath, []byte(sb.String()), 0644); err != nil {
+		t.Fatalf("Failed to create test file: %v", err)
+	}
+
+	var reports []Progress
+	count := 0
+	err := New(jsonlPath).
+		SetProgress(func(p Progress) { reports = append(reports, p) }).
+		Stream(func(issue *Issue) error {
+			count++
+			return nil
+		})
+	if err != nil {
+		t.Fatalf("Stream failed: %v", err)
+	}
+	if count != lines {
+		t.Errorf("Expected %d issues streamed, got %d", lines, count)
+	}
+	if len(reports) != 3 {
+		t.Fatalf("Expected 3 progress reports, got %d", len(reports))
+	}
+	if reports[0].Issues != progressInterval || reports[0].Percent() >= 100 {
+		t.Errorf("Unexpected first report: %+v", reports[0])
+	}
+	if last := reports[len(reports)-1]; last.Percent() != 100 || last.Issues != lines || last.BytesRead != last.TotalBytes {
+		t.Errorf("Expected final report to cover the whole file, got %+v", last)
+	}
+}
+
+func TestParseStreamStops(t *testing.T) {
+	tmpDir := t.TempDir()
+	jsonlPath := filepath.Join(tmpDir, "test.jsonl")
+
+	content := `{"id":"test-1"}
+{"id":"test-2"}
+`
+	if err := os.WriteFile(jsonlPath, []byte(content), 0644); err != nil {
+		t.Fatalf("Failed to create test file: %v", err)
+	}
+
+	stop := errors.New("stop")
+	count := 0
+	err := New(jsonlPath).Stream(func(issue *Issue) error {
+		count++
+		return stop
+	})
+	if !errors.Is(err, stop) || count != 1 {
+		t.Errorf("Expected Stream to stop after the first issue, got err=%v count=%d", err, count)
+	}
+}
+
+func TestParseLongLinesAndNewFields(t *testing.T) {
+	tmpDir := t.TempDir()
+	jsonlPath := filepath.Join(tmpDir, "modern.jsonl")
+
+	// Longer than bufio.Scanner's default 64KB token limit
+	description := strings.Repeat("x", 200*1024)
+	content := `{"id":"test-1","content_hash":"abc123","source_repo":"../other","compaction_level":1,"title":"Modern","description":"` + description + `","status":"open","priority":2,"issue_type":"task","created_at":"2025-01-01T00:00:00Z","updated_at":"2025-01-01T00:00:00Z"}` + "\r\n"
+	if err := os.WriteFile(jsonlPath, []byte(content), 0644); err != nil {
+		t.Fatalf("Failed to create test file: %v", err)
+	}
+
+	issues, err := ParseFile(jsonlPath)
+	if err != nil {
+		t.Fatalf("ParseFile failed: %v", err)
+	}
+	if len(issues) != 1 {
+		t.Fatalf("Expected 1 issue, got %d", len(issues))
+	}
+	issue := issues[0]
+	if issue.ContentHash != "abc123" || issue.SourceRepo != "../other" {
+		t.Errorf("Expected content_hash and source_repo, got %q and %q", issue.ContentHash, issue.SourceRepo)
+	}
+	if len(issue.Description) != len(description) {
+		t.Errorf("Expected %d byte description, got %d", len(description), len(issue.Description))
+	}
+}
@@ -19,6 +19,7 @@ type Issue struct {
 	CreatedAt          time.Time     `json:"created_at"`
 	UpdatedAt          time.Time     `json:"updated_at"`
 	ClosedAt           *time.Time    `json:"closed_at,omitempty"`
+	DueDate            *time.Time    `json:"due_date,omitempty"`
 	ExternalRef        *string       `json:"external_ref,omitempty"`
 	SourceRepo         string        `json:"source_repo,omitempty"`
 	Labels             []string      `json:"labels,omitempty"`
@@ -74,3 +75,16 @@ type Comment struct {
 	Text      string    `json:"text"`
 	CreatedAt time.Time `json:"created_at"`
 }
+
+// Event is one entry in bd's audit trail (the events table): a status change,
+// field update, label or dependency change, comment, and so on
+type Event struct {
+	ID        int64     `json:"id"`
+	IssueID   string    `json:"issue_id"`
+	EventType string    `json:"event_type"` // e.g. "created", "status_changed", "label_added"
+	Actor     string    `json:"actor"`
+	OldValue  *string   `json:"old_value,omitempty"`
+	NewValue  *string   `json:"new_value,omitempty"`
+	Comment   *string   `json:"comment,omitempty"`
+	CreatedAt time.Time `json:"created_at"`
+}
@@ -0,0 +1,150 @@
+// Package standup summarizes recent activity for a daily standup: the issues
+// closed, started, and created since a point in time, plus in-progress work
+// that saw no update in that window, grouped by assignee.
+package standup
+
+import (
+	"sort"
+	"strings"
+	"time"
+
+	"github.com/andy/beads-tui/internal/parser"
+)
+
+// Unassigned is the group name for issues without an assignee
+const Unassigned = "Unassigned"
+
+// Group is one assignee's activity in the window
+type Group struct {
+	Assignee string
+	Closed   []*parser.Issue // Closed in the window
+	Started  []*parser.Issue // Moved to in_progress in the window
+	Created  []*parser.Issue // Created in the window
+	Stale    []*parser.Issue // In progress, but not updated in the window
+}
+
+// Count returns the number of entries in the group (an issue can appear in
+// more than one list, e.g. created and closed on the same day)
+func (g Group) Count() int {
+	return len(g.Closed) + len(g.Started) + len(g.Created) + len(g.Stale)
+}
+
+// Summary is the activity between Since and Until
+type Summary struct {
+	Since  time.Time
+	Until  time.Time
+	Groups []Group // Alphabetical by assignee, Unassigned last; empty groups are left out
+
+	// FromEvents reports whether Started came from the status_changed audit
+	// trail; without one, it falls back to in-progress issues updated in the
+	// window, which can include work that was only edited
+	FromEvents bool
+}
+
+// Totals returns the number of closed, started, created, and stale entries
+// across all groups
+func (s Summary) Totals() (closed, started, created, stale int) {
+	for _, g := range s.Groups {
+		closed += len(g.Closed)
+		started += len(g.Started)
+		created += len(g.Created)
+		stale += len(g.Stale)
+	}
+	return closed, started, created, stale
+}
+
+// Build summarizes the activity in issues since the given time. statusChanges
+// are status_changed events at or after since (from the database's audit
+// trail); pass nil if there is no audit trail.
+func Build(issues []*parser.Issue, statusChanges []*parser.Event, since, now time.Time) Summary {
+	summary := Summary{Since: since, Until: now, FromEvents: statusChanges != nil}
+
+	inWindow := func(t time.Time) bool {
+		return !t.IsZero() && !t.Before(since) && !t.After(now)
+	}
+
+	started := make(map[string]bool)
+	for _, event := range statusChanges {
+		if event.NewValue != nil && statusValue(*event.NewValue) == parser.StatusInProgress && inWindow(event.CreatedAt) {
+			started[event.IssueID] = true
+		}
+	}
+
+	groups := make(map[string]*Group)
+	group := func(issue *parser.Issue) *Group {
+		name := strings.TrimSpace(issue.Assignee)
+		if name == "" {
+			name = Unassigned
+		}
+		g, ok := groups[name]
+		if !ok {
+			g = &Group{Assignee: name}
+			groups[name] = g
+		}
+		return g
+	}
+
+	for _, issue := range issues {
+		if issue.Status == parser.StatusClosed && inWindow(closedTime(issue)) {
+			g := group(issue)
+			g.Closed = append(g.Closed, issue)
+		}
+		if statusChanges != nil && started[issue.ID] ||
+			statusChanges == nil && issue.Status == parser.StatusInProgress && inWindow(issue.UpdatedAt) {
+			g := group(issue)
+			g.Started = append(g.Started, issue)
+		}
+		if inWindow(issue.CreatedAt) {
+			g := group(issue)
+			g.Created = append(g.Created, issue)
+		}
+		if issue.Status == parser.StatusInProgress && issue.UpdatedAt.Before(since) {
+			g := group(issue)
+			g.Stale = append(g.Stale, issue)
+		}
+	}
+
+	for _, g := range groups {
+		sortByPriority(g.Closed)
+		sortByPriority(g.Started)
+		sortByPriority(g.Created)
+		// Longest-untouched first, like the home screen's stale list
+		sort.SliceStable(g.Stale, func(i, j int) bool {
+			return g.Stale[i].UpdatedAt.Before(g.Stale[j].UpdatedAt)
+		})
+		summary.Groups = append(summary.Groups, *g)
+	}
+	sort.Slice(summary.Groups, func(i, j int) bool {
+		a, b := summary.Groups[i].Assignee, summary.Groups[j].Assignee
+		if (a == Unassigned) != (b == Unassigned) {
+			return b == Unassigned
+		}
+		return strings.ToLower(a) < strings.ToLower(b)
+	})
+	return summary
+}
+
+// closedTime is when a closed issue was closed, falling back to its last
+// update for issues closed without a timestamp
+func closedTime(issue *parser.Issue) time.Time {
+	if issue.ClosedAt != nil {
+		return *issue.ClosedAt
+	}
+	return issue.UpdatedAt
+}
+
+// statusValue normalizes an event's status value, which some bd versions
+// store JSON-quoted
+func statusValue(value string) parser.Status {
+	return parser.Status(strings.Trim(strings.TrimSpace(value), `"`))
+}
+
+// sortByPriority orders issues by priority, then ID
+func sortByPriority(issues []*parser.Issue) {
+	sort.SliceStable(issues, func(i, j int) bool {
+		if issues[i].Priority != issues[j].Priority {
+			return issues[i].Priority < issues[j].Priority
+		}
+		return issues[i].ID < issues[j].ID
+	})
+}
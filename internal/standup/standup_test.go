@@ -0,0 +1,112 @@
+package standup
+
+import (
+	"testing"
+	"time"
+
+	"github.com/andy/beads-tui/internal/parser"
+)
+
+var now = time.Date(2026, 3, 2, 9, 0, 0, 0, time.UTC)
+
+const day = 24 * time.Hour
+
+func issue(id, assignee string, status parser.Status, created, updated time.Duration) *parser.Issue {
+	return &parser.Issue{ID: id, Assignee: assignee, Status: status, CreatedAt: now.Add(-created), UpdatedAt: now.Add(-updated)}
+}
+
+func statusChange(issueID, value string, ago time.Duration) *parser.Event {
+	return &parser.Event{IssueID: issueID, EventType: "status_changed", NewValue: &value, CreatedAt: now.Add(-ago)}
+}
+
+func ids(issues []*parser.Issue) []string {
+	var result []string
+	for _, issue := range issues {
+		result = append(result, issue.ID)
+	}
+	return result
+}
+
+func equal(a, b []string) bool {
+	if len(a) != len(b) {
+		return false
+	}
+	for i := range a {
+		if a[i] != b[i] {
+			return false
+		}
+	}
+	return true
+}
+
+func TestBuild(t *testing.T) {
+	closedAt := now.Add(-2 * time.Hour)
+	oldClose := now.Add(-3 * day)
+	issues := []*parser.Issue{
+		{ID: "done", Assignee: "ada", Status: parser.StatusClosed, CreatedAt: now.Add(-10 * day), UpdatedAt: closedAt, ClosedAt: &closedAt},
+		{ID: "done-long-ago", Assignee: "ada", Status: parser.StatusClosed, CreatedAt: now.Add(-10 * day), UpdatedAt: oldClose, ClosedAt: &oldClose},
+		issue("started", "ada", parser.StatusInProgress, 5*day, time.Hour),
+		issue("edited", "ada", parser.StatusInProgress, 5*day, time.Hour), // Updated, but started last week
+		issue("stuck", "bob", parser.StatusInProgress, 20*day, 4*day),
+		issue("fresh", "", parser.StatusOpen, 3*time.Hour, 3*time.Hour),
+		issue("quiet", "bob", parser.StatusOpen, 30*day, 30*day),
+	}
+	events := []*parser.Event{
+		statusChange("started", `"in_progress"`, 5*time.Hour),
+		statusChange("done", "closed", 2*time.Hour),
+	}
+
+	summary := Build(issues, events, now.Add(-day), now)
+	if !summary.FromEvents {
+		t.Error("expected FromEvents with an audit trail")
+	}
+	if len(summary.Groups) != 3 {
+		t.Fatalf("expected groups ada, bob, Unassigned; got %+v", summary.Groups)
+	}
+	ada, bob, unassigned := summary.Groups[0], summary.Groups[1], summary.Groups[2]
+	if ada.Assignee != "ada" || bob.Assignee != "bob" || unassigned.Assignee != Unassigned {
+		t.Errorf("unexpected group order %q, %q, %q", ada.Assignee, bob.Assignee, unassigned.Assignee)
+	}
+	if got := ids(ada.Closed); !equal(got, []string{"done"}) {
+		t.Errorf("ada closed = %v", got)
+	}
+	if got := ids(ada.Started); !equal(got, []string{"started"}) {
+		t.Errorf("ada started = %v", got)
+	}
+	if got := ids(bob.Stale); !equal(got, []string{"stuck"}) {
+		t.Errorf("bob stale = %v", got)
+	}
+	if got := ids(unassigned.Created); !equal(got, []string{"fresh"}) {
+		t.Errorf("unassigned created = %v", got)
+	}
+	if closed, started, created, stale := summary.Totals(); closed != 1 || started != 1 || created != 1 || stale != 1 {
+		t.Errorf("totals = %d, %d, %d, %d", closed, started, created, stale)
+	}
+}
+
+func TestBuild_WithoutAuditTrail(t *testing.T) {
+	issues := []*parser.Issue{
+		issue("started", "ada", parser.StatusInProgress, 5*day, time.Hour),
+		issue("stuck", "ada", parser.StatusInProgress, 5*day, 2*day),
+	}
+	summary := Build(issues, nil, now.Add(-day), now)
+	if summary.FromEvents {
+		t.Error("expected FromEvents to be false without an audit trail")
+	}
+	if len(summary.Groups) != 1 {
+		t.Fatalf("expected one group, got %+v", summary.Groups)
+	}
+	if got := ids(summary.Groups[0].Started); !equal(got, []string{"started"}) {
+		t.Errorf("started = %v", got)
+	}
+	if got := ids(summary.Groups[0].Stale); !equal(got, []string{"stuck"}) {
+		t.Errorf("stale = %v", got)
+	}
+}
+
+func TestBuild_Empty(t *testing.T) {
+	summary := Build(nil, []*parser.Event{}, now.Add(-day), now)
+	if len(summary.Groups) != 0 {
+		t.Errorf("expected no groups, got %+v", summary.Groups)
+	}
+}
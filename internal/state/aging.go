@@ -0,0 +1,103 @@
+package state
+
+import (
+	"sort"
+	"time"
+
+	"github.com/andy/beads-tui/internal/parser"
+)
+
+// DefaultAgingDays is how many days an unclosed issue of each priority can
+// stay open before it's flagged for escalation. P4 is never flagged.
+var DefaultAgingDays = map[int]int{0: 3, 1: 14, 2: 45, 3: 120}
+
+// Aging describes an unclosed issue that has been open longer than its
+// priority allows. The zero value means the issue isn't aging.
+type Aging struct {
+	AgeDays   int // Days since the issue was created
+	IdleDays  int // Days since it was last updated
+	LimitDays int // How long its priority allows
+	Suggested int // Priority to escalate to (one step up; P0 stays P0)
+}
+
+// Aged reports whether the issue is past its priority's limit
+func (a Aging) Aged() bool {
+	return a.LimitDays > 0
+}
+
+// Overdue returns how far past the limit the issue is, as a multiple of the
+// limit (1.5 means half again as long as allowed)
+func (a Aging) Overdue() float64 {
+	if a.LimitDays <= 0 {
+		return 0
+	}
+	return float64(a.AgeDays) / float64(a.LimitDays)
+}
+
+// AgingOf checks an issue against the per-priority limits (in days; a
+// missing or non-positive limit disables the check for that priority).
+// Closed issues never age.
+func AgingOf(issue *parser.Issue, limits map[int]int, now time.Time) Aging {
+	if issue.Status == parser.StatusClosed || issue.CreatedAt.IsZero() {
+		return Aging{}
+	}
+	limit := limits[issue.Priority]
+	if limit <= 0 {
+		return Aging{}
+	}
+	age := int(now.Sub(issue.CreatedAt).Hours() / 24)
+	if age < limit {
+		return Aging{}
+	}
+	idle := age
+	if !issue.UpdatedAt.IsZero() {
+		idle = int(now.Sub(issue.UpdatedAt).Hours() / 24)
+	}
+	return Aging{AgeDays: age, IdleDays: idle, LimitDays: limit, Suggested: max(issue.Priority-1, 0)}
+}
+
+// SetAgingDays sets the per-priority aging limits (nil restores
+// DefaultAgingDays)
+func (s *State) SetAgingDays(limits map[int]int) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	s.agingDays = limits
+}
+
+// agingLimits returns the configured limits, or the defaults
+func (s *State) agingLimits() map[int]int {
+	if s.agingDays == nil {
+		return DefaultAgingDays
+	}
+	return s.agingDays
+}
+
+// Aging checks an issue against the configured aging limits
+func (s *State) Aging(issue *parser.Issue, now time.Time) Aging {
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+	return AgingOf(issue, s.agingLimits(), now)
+}
+
+// AgingIssues returns the unclosed issues past their priority's limit, most
+// overdue first. Filters don't apply.
+func (s *State) AgingIssues(now time.Time) []*parser.Issue {
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+	limits := s.agingLimits()
+	var aging []*parser.Issue
+	overdue := make(map[string]float64)
+	for _, issue := range s.issues {
+		if a := AgingOf(issue, limits, now); a.Aged() {
+			aging = append(aging, issue)
+			overdue[issue.ID] = a.Overdue()
+		}
+	}
+	sort.SliceStable(aging, func(i, j int) bool {
+		if overdue[aging[i].ID] != overdue[aging[j].ID] {
+			return overdue[aging[i].ID] > overdue[aging[j].ID]
+		}
+		return aging[i].ID < aging[j].ID
+	})
+	return aging
+}
@@ -0,0 +1,62 @@
+package state
+
+import (
+	"testing"
+	"time"
+
+	"github.com/andy/beads-tui/internal/parser"
+)
+
+func TestAgingOf(t *testing.T) {
+	now := time.Date(2026, 3, 1, 12, 0, 0, 0, time.UTC)
+	day := 24 * time.Hour
+	limits := map[int]int{0: 2, 2: 30}
+	issue := func(priority int, status parser.Status, age, idle int) *parser.Issue {
+		return &parser.Issue{ID: "tui-1", Priority: priority, Status: status,
+			CreatedAt: now.Add(-time.Duration(age) * day), UpdatedAt: now.Add(-time.Duration(idle) * day)}
+	}
+
+	aging := AgingOf(issue(2, parser.StatusOpen, 45, 10), limits, now)
+	if !aging.Aged() || aging.AgeDays != 45 || aging.IdleDays != 10 || aging.LimitDays != 30 || aging.Suggested != 1 {
+		t.Errorf("unexpected aging %+v", aging)
+	}
+	if aging.Overdue() != 1.5 {
+		t.Errorf("Overdue() = %v, want 1.5", aging.Overdue())
+	}
+	if a := AgingOf(issue(0, parser.StatusInProgress, 5, 5), limits, now); !a.Aged() || a.Suggested != 0 {
+		t.Errorf("expected aging P0 to stay P0, got %+v", a)
+	}
+
+	for name, notAging := range map[string]*parser.Issue{
+		"within limit":  issue(2, parser.StatusOpen, 29, 29),
+		"closed":        issue(2, parser.StatusClosed, 90, 90),
+		"no limit (P1)": issue(1, parser.StatusOpen, 90, 90),
+	} {
+		if a := AgingOf(notAging, limits, now); a.Aged() {
+			t.Errorf("%s: expected no aging, got %+v", name, a)
+		}
+	}
+}
+
+func TestAgingIssues(t *testing.T) {
+	now := time.Date(2026, 3, 1, 12, 0, 0, 0, time.UTC)
+	day := 24 * time.Hour
+	state := New()
+	state.LoadIssues([]*parser.Issue{
+		{ID: "tui-1", Priority: 2, Status: parser.StatusOpen, CreatedAt: now.Add(-60 * day)},  // 2x the P2 limit
+		{ID: "tui-2", Priority: 0, Status: parser.StatusOpen, CreatedAt: now.Add(-9 * day)},   // 3x the P0 limit
+		{ID: "tui-3", Priority: 2, Status: parser.StatusOpen, CreatedAt: now.Add(-10 * day)},  // Within the limit
+		{ID: "tui-4", Priority: 4, Status: parser.StatusOpen, CreatedAt: now.Add(-900 * day)}, // P4 never ages
+	})
+	state.SetAgingDays(map[int]int{0: 3, 2: 30})
+
+	got := state.AgingIssues(now)
+	if len(got) != 2 || got[0].ID != "tui-2" || got[1].ID != "tui-1" {
+		t.Errorf("expected tui-2 then tui-1, got %v", got)
+	}
+
+	state.SetAgingDays(nil)
+	if a := state.Aging(state.GetIssueByID("tui-3"), now); a.Aged() {
+		t.Errorf("expected the default P2 limit (%dd) to leave tui-3 alone, got %+v", DefaultAgingDays[2], a)
+	}
+}
@@ -0,0 +1,95 @@
+package state
+
+import (
+	"fmt"
+	"testing"
+	"time"
+
+	"github.com/andy/beads-tui/internal/parser"
+)
+
+// benchSizes are the synthetic dataset sizes the refresh path is measured at
+var benchSizes = []int{1000, 10000, 50000}
+
+// benchIssues makes n issues with a spread of statuses, priorities, types,
+// and labels. Every tenth issue is blocked by the one before it, and every
+// hundredth is an epic whose next ten issues are its children.
+func benchIssues(n int) []*parser.Issue {
+	statuses := []parser.Status{parser.StatusOpen, parser.StatusInProgress, parser.StatusBlocked, parser.StatusClosed}
+	types := []parser.IssueType{parser.TypeTask, parser.TypeBug, parser.TypeFeature, parser.TypeChore}
+	created := time.Date(2024, 1, 1, 0, 0, 0, 0, time.UTC)
+	issues := make([]*parser.Issue, n)
+	for i := range issues {
+		issue := &parser.Issue{
+			ID:          fmt.Sprintf("bench-%d", i),
+			Title:       fmt.Sprintf("Synthetic issue %d", i),
+			Description: "A description long enough to be representative of a real issue.",
+			Status:      statuses[i%len(statuses)],
+			Priority:    i % 5,
+			IssueType:   types[i%len(types)],
+			Assignee:    fmt.Sprintf("user%d", i%7),
+			Labels:      []string{fmt.Sprintf("area-%d", i%12)},
+			CreatedAt:   created.Add(time.Duration(i) * time.Minute),
+			UpdatedAt:   created.Add(time.Duration(i) * time.Minute),
+		}
+		if i%100 == 0 {
+			issue.IssueType = parser.TypeEpic
+		} else if epic := i - i%100; i%100 <= 10 {
+			issue.Dependencies = append(issue.Dependencies, &parser.Dependency{
+				IssueID: issue.ID, DependsOnID: fmt.Sprintf("bench-%d", epic), Type: parser.DepParentChild,
+			})
+		}
+		if i > 0 && i%10 == 0 {
+			issue.Dependencies = append(issue.Dependencies, &parser.Dependency{
+				IssueID: issue.ID, DependsOnID: fmt.Sprintf("bench-%d", i-1), Type: parser.DepBlocks,
+			})
+		}
+		issues[i] = issue
+	}
+	return issues
+}
+
+func BenchmarkLoadIssues(b *testing.B) {
+	for _, n := range benchSizes {
+		b.Run(fmt.Sprintf("issues=%d", n), func(b *testing.B) {
+			issues := benchIssues(n)
+			s := New()
+			b.ReportAllocs()
+			b.ResetTimer()
+			for i := 0; i < b.N; i++ {
+				s.LoadIssues(issues)
+			}
+		})
+	}
+}
+
+func BenchmarkLoadIssuesTree(b *testing.B) {
+	// LoadIssues in tree view also rebuilds the dependency tree
+	for _, n := range benchSizes {
+		b.Run(fmt.Sprintf("issues=%d", n), func(b *testing.B) {
+			issues := benchIssues(n)
+			s := New()
+			s.SetViewMode(ViewTree)
+			b.ReportAllocs()
+			b.ResetTimer()
+			for i := 0; i < b.N; i++ {
+				s.LoadIssues(issues)
+			}
+		})
+	}
+}
+
+func BenchmarkCategorizeIssues(b *testing.B) {
+	for _, n := range benchSizes {
+		b.Run(fmt.Sprintf("issues=%d", n), func(b *testing.B) {
+			s := New()
+			s.LoadIssues(benchIssues(n))
+			b.ReportAllocs()
+			b.ResetTimer()
+			for i := 0; i < b.N; i++ {
+				s.readyIssues, s.blockedIssues, s.inProgressIssues, s.closedIssues = nil, nil, nil, nil
+				s.categorizeIssues()
+			}
+		})
+	}
+}
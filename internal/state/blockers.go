@@ -0,0 +1,155 @@
+package state
+
+import (
+	"sort"
+
+	"github.com/andy/beads-tui/internal/parser"
+)
+
+// Blocker is an unclosed issue that must close before another issue can be
+// ready
+type Blocker struct {
+	Issue  *parser.Issue
+	Depth  int    // 1 for the issue's own blockers, 2 for their blockers, ...
+	Blocks string // ID of the issue in the chain that this one blocks
+}
+
+// TransitiveBlockers returns everything that must close before the issue is
+// ready: the unclosed issues it depends on with blocks dependencies, the
+// blockers of its parents (a blocked parent blocks its children), and so on
+// up the chain. Blockers are in breadth-first order, nearest first, each
+// listed once; cycles are cut where they close.
+func (s *State) TransitiveBlockers(issueID string) []Blocker {
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+	type pending struct {
+		id    string
+		depth int
+	}
+	visited := map[string]bool{issueID: true}
+	queue := []pending{{issueID, 0}}
+	var blockers []Blocker
+
+	for len(queue) > 0 {
+		current := queue[0]
+		queue = queue[1:]
+
+		var found []Blocker
+		for _, blockedID := range s.withParents(current.id) {
+			issue := s.issuesByID[blockedID]
+			if issue == nil {
+				continue
+			}
+			for _, dep := range issue.Dependencies {
+				if dep.Type != parser.DepBlocks || visited[dep.DependsOnID] {
+					continue
+				}
+				blocker := s.issuesByID[dep.DependsOnID]
+				if blocker == nil || blocker.Status == parser.StatusClosed {
+					continue
+				}
+				visited[blocker.ID] = true
+				found = append(found, Blocker{Issue: blocker, Depth: current.depth + 1, Blocks: blockedID})
+			}
+		}
+
+		sort.SliceStable(found, func(i, j int) bool { return found[i].Issue.ID < found[j].Issue.ID })
+		for _, blocker := range found {
+			blockers = append(blockers, blocker)
+			queue = append(queue, pending{blocker.Issue.ID, blocker.Depth})
+		}
+	}
+	return blockers
+}
+
+// withParents returns the issue ID followed by its ancestors through
+// parent-child dependencies, stopping at a cycle
+func (s *State) withParents(issueID string) []string {
+	ids := []string{issueID}
+	seen := map[string]bool{issueID: true}
+	for id := issueID; ; {
+		parentID := ""
+		if issue := s.issuesByID[id]; issue != nil {
+			for _, dep := range issue.Dependencies {
+				if dep.Type == parser.DepParentChild {
+					parentID = dep.DependsOnID
+					break
+				}
+			}
+		}
+		if parentID == "" || seen[parentID] {
+			return ids
+		}
+		seen[parentID] = true
+		ids = append(ids, parentID)
+		id = parentID
+	}
+}
+
+// Dependent is an issue with a dependency on another issue, and the type of
+// that dependency
+type Dependent struct {
+	Issue *parser.Issue
+	Type  parser.DependencyType
+}
+
+// Dependents returns the issues that depend on the issue, whatever the
+// dependency type: the issues it blocks, its children, and issues related to
+// or discovered from it. Blocked issues come first, then the other types, each
+// in ID order.
+func (s *State) Dependents(issueID string) []Dependent {
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+	var dependents []Dependent
+	for _, issue := range s.issues {
+		for _, dep := range issue.Dependencies {
+			if dep.DependsOnID == issueID && issue.ID != issueID {
+				dependents = append(dependents, Dependent{Issue: issue, Type: dep.Type})
+			}
+		}
+	}
+
+	rank := func(depType parser.DependencyType) int {
+		switch depType {
+		case parser.DepBlocks:
+			return 0
+		case parser.DepParentChild:
+			return 1
+		case parser.DepRelated:
+			return 2
+		case parser.DepDiscoveredFrom:
+			return 3
+		default:
+			return 4
+		}
+	}
+	sort.SliceStable(dependents, func(i, j int) bool {
+		if ri, rj := rank(dependents[i].Type), rank(dependents[j].Type); ri != rj {
+			return ri < rj
+		}
+		return dependents[i].Issue.ID < dependents[j].Issue.ID
+	})
+	return dependents
+}
+
+// SetBlockedReasons replaces the reasons given for blocking issues, by issue ID
+func (s *State) SetBlockedReasons(reasons map[string]string) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	s.blockedReasons = reasons
+}
+
+// BlockedReason returns why the issue is blocked, or "" if no reason was
+// given or it isn't blocked any more (by status or by its dependencies)
+func (s *State) BlockedReason(issueID string) string {
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+	issue := s.issuesByID[issueID]
+	if issue == nil || issue.Status == parser.StatusClosed {
+		return ""
+	}
+	if issue.Status != parser.StatusBlocked && !s.effectivelyBlocked[issueID] {
+		return ""
+	}
+	return s.blockedReasons[issueID]
+}
@@ -0,0 +1,114 @@
+package state
+
+import (
+	"testing"
+
+	"github.com/andy/beads-tui/internal/parser"
+)
+
+func TestTransitiveBlockers(t *testing.T) {
+	dep := func(id string, depType parser.DependencyType) *parser.Dependency {
+		return &parser.Dependency{DependsOnID: id, Type: depType}
+	}
+	state := New()
+	state.LoadIssues([]*parser.Issue{
+		{ID: "tui-1", Status: parser.StatusOpen, Dependencies: []*parser.Dependency{
+			dep("tui-2", parser.DepBlocks), dep("tui-3", parser.DepBlocks), dep("tui-epic", parser.DepParentChild),
+		}},
+		{ID: "tui-2", Status: parser.StatusInProgress, Dependencies: []*parser.Dependency{dep("tui-4", parser.DepBlocks)}},
+		{ID: "tui-3", Status: parser.StatusClosed, Dependencies: []*parser.Dependency{dep("tui-5", parser.DepBlocks)}}, // done, so tui-5 doesn't matter
+		{ID: "tui-4", Status: parser.StatusOpen, Dependencies: []*parser.Dependency{dep("tui-1", parser.DepBlocks)}},   // cycle back to tui-1
+		{ID: "tui-5", Status: parser.StatusOpen},
+		{ID: "tui-epic", Status: parser.StatusOpen, IssueType: parser.TypeEpic, Dependencies: []*parser.Dependency{dep("tui-6", parser.DepBlocks)}},
+		{ID: "tui-6", Status: parser.StatusBlocked},
+	})
+
+	blockers := state.TransitiveBlockers("tui-1")
+	want := []struct {
+		id     string
+		depth  int
+		blocks string
+	}{
+		{"tui-2", 1, "tui-1"},
+		{"tui-6", 1, "tui-epic"},
+		{"tui-4", 2, "tui-2"},
+	}
+	if len(blockers) != len(want) {
+		t.Fatalf("expected %d blockers, got %+v", len(want), blockers)
+	}
+	for i, w := range want {
+		if b := blockers[i]; b.Issue.ID != w.id || b.Depth != w.depth || b.Blocks != w.blocks {
+			t.Errorf("blocker %d = %s depth %d blocks %s, want %s depth %d blocks %s",
+				i, b.Issue.ID, b.Depth, b.Blocks, w.id, w.depth, w.blocks)
+		}
+	}
+
+	if got := state.TransitiveBlockers("tui-5"); len(got) != 0 {
+		t.Errorf("expected no blockers for an unblocked issue, got %+v", got)
+	}
+}
+
+func TestDependents(t *testing.T) {
+	dep := func(id string, depType parser.DependencyType) *parser.Dependency {
+		return &parser.Dependency{DependsOnID: id, Type: depType}
+	}
+	state := New()
+	state.LoadIssues([]*parser.Issue{
+		{ID: "tui-1", Status: parser.StatusOpen},
+		{ID: "tui-2", Status: parser.StatusOpen, Dependencies: []*parser.Dependency{dep("tui-1", parser.DepRelated)}},
+		{ID: "tui-3", Status: parser.StatusOpen, Dependencies: []*parser.Dependency{dep("tui-1", parser.DepParentChild)}},
+		{ID: "tui-4", Status: parser.StatusClosed, Dependencies: []*parser.Dependency{dep("tui-1", parser.DepBlocks)}},
+		{ID: "tui-5", Status: parser.StatusOpen, Dependencies: []*parser.Dependency{dep("tui-1", parser.DepBlocks), dep("tui-2", parser.DepBlocks)}},
+	})
+
+	dependents := state.Dependents("tui-1")
+	want := []struct {
+		id      string
+		depType parser.DependencyType
+	}{
+		{"tui-4", parser.DepBlocks},
+		{"tui-5", parser.DepBlocks},
+		{"tui-3", parser.DepParentChild},
+		{"tui-2", parser.DepRelated},
+	}
+	if len(dependents) != len(want) {
+		t.Fatalf("expected %d dependents, got %+v", len(want), dependents)
+	}
+	for i, w := range want {
+		if d := dependents[i]; d.Issue.ID != w.id || d.Type != w.depType {
+			t.Errorf("dependent %d = %s (%s), want %s (%s)", i, d.Issue.ID, d.Type, w.id, w.depType)
+		}
+	}
+
+	if got := state.Dependents("tui-5"); len(got) != 0 {
+		t.Errorf("expected no dependents, got %+v", got)
+	}
+}
+
+func TestBlockedReason(t *testing.T) {
+	state := New()
+	state.LoadIssues([]*parser.Issue{
+		{ID: "tui-1", Status: parser.StatusBlocked},
+		{ID: "tui-2", Status: parser.StatusOpen, Dependencies: []*parser.Dependency{{DependsOnID: "tui-3", Type: parser.DepBlocks}}},
+		{ID: "tui-3", Status: parser.StatusOpen},
+		{ID: "tui-4", Status: parser.StatusClosed},
+	})
+	state.SetBlockedReasons(map[string]string{
+		"tui-1": "waiting on vendor",
+		"tui-2": "needs tui-3's API",
+		"tui-3": "stale reason",
+		"tui-4": "old reason",
+	})
+
+	for issueID, want := range map[string]string{
+		"tui-1": "waiting on vendor", // Blocked by status
+		"tui-2": "needs tui-3's API", // Blocked by a dependency
+		"tui-3": "",                  // No longer blocked
+		"tui-4": "",                  // Closed
+		"tui-9": "",                  // Unknown
+	} {
+		if got := state.BlockedReason(issueID); got != want {
+			t.Errorf("BlockedReason(%q) = %q, expected %q", issueID, got, want)
+		}
+	}
+}
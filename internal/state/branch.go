@@ -0,0 +1,73 @@
+package state
+
+import (
+	"strings"
+	"unicode"
+)
+
+// SetCurrentBranch records the checked-out git branch ("" when unknown or
+// not in a repository) and finds the issue it is named after
+func (s *State) SetCurrentBranch(branch string) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	s.currentBranch = branch
+	s.matchBranchIssue()
+}
+
+// CurrentBranch returns the checked-out git branch, if known
+func (s *State) CurrentBranch() string {
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+	return s.currentBranch
+}
+
+// BranchIssue returns the ID of the issue the current branch is named after,
+// or "" if it doesn't name one
+func (s *State) BranchIssue() string {
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+	return s.branchIssue
+}
+
+// matchBranchIssue finds the issue whose ID appears in the branch name as a
+// whole word (tui-12-fix-login, feature/tui-12). The longest match wins
+// (ties go to the lower ID).
+func (s *State) matchBranchIssue() {
+	s.branchIssue = ""
+	branch := strings.ToLower(s.currentBranch)
+	if branch == "" {
+		return
+	}
+	for id := range s.issuesByID {
+		longer := len(id) > len(s.branchIssue) || len(id) == len(s.branchIssue) && id < s.branchIssue
+		if longer && containsID(branch, strings.ToLower(id)) {
+			s.branchIssue = id
+		}
+	}
+}
+
+// containsID reports whether id appears in text with no letter or digit
+// directly before it, and nothing after it that would continue the ID: a
+// letter, a digit, or a dot followed by a digit (a child ID like tui-1.2)
+func containsID(text, id string) bool {
+	for start := 0; ; {
+		i := strings.Index(text[start:], id)
+		if i < 0 {
+			return false
+		}
+		i += start
+		end := i + len(id)
+		before := i == 0 || !isIDChar(rune(text[i-1]))
+		after := end == len(text) || !isIDChar(rune(text[end])) &&
+			!(text[end] == '.' && end+1 < len(text) && unicode.IsDigit(rune(text[end+1])))
+		if before && after {
+			return true
+		}
+		start = i + 1
+	}
+}
+
+// isIDChar reports whether r can be part of an issue ID's prefix or number
+func isIDChar(r rune) bool {
+	return unicode.IsLetter(r) || unicode.IsDigit(r)
+}
@@ -0,0 +1,46 @@
+package state
+
+import (
+	"testing"
+
+	"github.com/andy/beads-tui/internal/parser"
+)
+
+func TestBranchIssue(t *testing.T) {
+	state := New()
+	state.LoadIssues([]*parser.Issue{
+		{ID: "tui-1", Title: "Epic"},
+		{ID: "tui-1.2", Title: "Child"},
+		{ID: "tui-12", Title: "Other"},
+		{ID: "bd-a1b2", Title: "Hash ID"},
+	})
+
+	tests := []struct {
+		branch string
+		want   string
+	}{
+		{"tui-1-fix-login", "tui-1"},
+		{"feature/tui-1", "tui-1"},
+		{"tui-1.2-child-work", "tui-1.2"},
+		{"tui-12-other", "tui-12"},
+		{"TUI-12", "tui-12"},
+		{"bd-a1b2-hash", "bd-a1b2"},
+		{"xtui-1", ""},
+		{"tui-123", ""},
+		{"main", ""},
+		{"", ""},
+	}
+	for _, tt := range tests {
+		state.SetCurrentBranch(tt.branch)
+		if got := state.BranchIssue(); got != tt.want {
+			t.Errorf("BranchIssue() on %q = %q, want %q", tt.branch, got, tt.want)
+		}
+	}
+
+	// Reloading the issues re-matches the branch
+	state.SetCurrentBranch("tui-7-new")
+	state.LoadIssues([]*parser.Issue{{ID: "tui-7", Title: "New"}})
+	if got := state.BranchIssue(); got != "tui-7" {
+		t.Errorf("BranchIssue() after reload = %q, want tui-7", got)
+	}
+}
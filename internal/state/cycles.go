@@ -0,0 +1,181 @@
+package state
+
+import (
+	"sort"
+
+	"github.com/andy/beads-tui/internal/parser"
+)
+
+// CycleLink is one dependency in a cycle: IssueID depends on DependsOnID
+type CycleLink struct {
+	IssueID     string
+	DependsOnID string
+	Type        parser.DependencyType
+}
+
+// Cycle is a circular chain of blocks and parent-child dependencies. Each
+// link's DependsOnID is the next link's IssueID, and the last link leads back
+// to the first. A cycle has no sensible order (through blocks links, none of
+// its issues can ever become ready), so one of its dependencies has to go.
+type Cycle []CycleLink
+
+// IssueIDs returns the issues in the cycle, in chain order
+func (c Cycle) IssueIDs() []string {
+	ids := make([]string, len(c))
+	for i, link := range c {
+		ids[i] = link.IssueID
+	}
+	return ids
+}
+
+// Cycles returns the dependency cycles found when the issues were loaded:
+// one per group of issues that all (indirectly) depend on each other, ordered
+// by the group's lowest issue ID
+func (s *State) Cycles() []Cycle {
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+	return s.cycles
+}
+
+// InCycle reports whether an issue is part of a dependency cycle
+func (s *State) InCycle(issueID string) bool {
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+	return s.inCycle[issueID]
+}
+
+// detectCycles finds the groups of issues whose blocks and parent-child
+// dependencies lead back to themselves (strongly connected components, found
+// with Tarjan's algorithm) and, for each group, the shortest cycle through
+// its lowest issue ID. Every issue in a group is flagged, since breaking the
+// reported cycle may still leave another one.
+func (s *State) detectCycles() {
+	s.cycles = nil
+	s.inCycle = make(map[string]bool)
+
+	ids := make([]string, 0, len(s.issues))
+	for _, issue := range s.issues {
+		ids = append(ids, issue.ID)
+	}
+	sort.Strings(ids)
+
+	index := make(map[string]int, len(ids))
+	lowLink := make(map[string]int, len(ids))
+	onStack := make(map[string]bool)
+	var stack []string
+	var groups [][]string
+
+	var connect func(id string)
+	connect = func(id string) {
+		index[id] = len(index)
+		lowLink[id] = index[id]
+		stack = append(stack, id)
+		onStack[id] = true
+
+		for _, link := range s.cycleLinks(id) {
+			next := link.DependsOnID
+			if _, seen := index[next]; !seen {
+				connect(next)
+				lowLink[id] = min(lowLink[id], lowLink[next])
+			} else if onStack[next] {
+				lowLink[id] = min(lowLink[id], index[next])
+			}
+		}
+
+		if lowLink[id] != index[id] {
+			return
+		}
+		var group []string
+		for {
+			top := stack[len(stack)-1]
+			stack = stack[:len(stack)-1]
+			onStack[top] = false
+			group = append(group, top)
+			if top == id {
+				break
+			}
+		}
+		if len(group) > 1 || s.dependsOnItself(id) {
+			groups = append(groups, group)
+		}
+	}
+	for _, id := range ids {
+		if _, seen := index[id]; !seen {
+			connect(id)
+		}
+	}
+
+	for _, group := range groups {
+		members := make(map[string]bool, len(group))
+		for _, id := range group {
+			members[id] = true
+			s.inCycle[id] = true
+		}
+		sort.Strings(group)
+		if cycle := s.shortestCycle(group[0], members); cycle != nil {
+			s.cycles = append(s.cycles, cycle)
+		}
+	}
+	sort.Slice(s.cycles, func(i, j int) bool {
+		return s.cycles[i][0].IssueID < s.cycles[j][0].IssueID
+	})
+}
+
+// cycleLinks returns the issue's blocks and parent-child dependencies on
+// loaded issues, the dependencies that can form a cycle
+func (s *State) cycleLinks(issueID string) []CycleLink {
+	issue := s.issuesByID[issueID]
+	if issue == nil {
+		return nil
+	}
+	var links []CycleLink
+	for _, dep := range issue.Dependencies {
+		if dep.Type != parser.DepBlocks && dep.Type != parser.DepParentChild {
+			continue
+		}
+		if _, ok := s.issuesByID[dep.DependsOnID]; ok {
+			links = append(links, CycleLink{IssueID: issueID, DependsOnID: dep.DependsOnID, Type: dep.Type})
+		}
+	}
+	return links
+}
+
+// dependsOnItself reports whether an issue has a blocks or parent-child
+// dependency on itself
+func (s *State) dependsOnItself(issueID string) bool {
+	for _, link := range s.cycleLinks(issueID) {
+		if link.DependsOnID == issueID {
+			return true
+		}
+	}
+	return false
+}
+
+// shortestCycle finds the shortest chain of links from start back to itself
+// that stays within members (breadth-first)
+func (s *State) shortestCycle(start string, members map[string]bool) Cycle {
+	via := make(map[string]CycleLink) // issue ID -> link it was reached by
+	queue := []string{start}
+	for len(queue) > 0 {
+		id := queue[0]
+		queue = queue[1:]
+		for _, link := range s.cycleLinks(id) {
+			next := link.DependsOnID
+			if !members[next] {
+				continue
+			}
+			if next == start {
+				cycle := Cycle{link}
+				for at := id; at != start; at = via[at].IssueID {
+					cycle = append(Cycle{via[at]}, cycle...)
+				}
+				return cycle
+			}
+			if _, seen := via[next]; !seen {
+				via[next] = link
+				queue = append(queue, next)
+			}
+		}
+	}
+	return nil
+}
@@ -0,0 +1,74 @@
+package state
+
+import (
+	"reflect"
+	"testing"
+
+	"github.com/andy/beads-tui/internal/parser"
+)
+
+func TestDetectCycles(t *testing.T) {
+	dep := func(id string, depType parser.DependencyType) *parser.Dependency {
+		return &parser.Dependency{DependsOnID: id, Type: depType}
+	}
+	state := New()
+	state.LoadIssues([]*parser.Issue{
+		// tui-1 → tui-2 → tui-3 → tui-1, with a shortcut tui-2 → tui-1
+		{ID: "tui-3", Status: parser.StatusOpen, Dependencies: []*parser.Dependency{dep("tui-1", parser.DepParentChild)}},
+		{ID: "tui-1", Status: parser.StatusOpen, Dependencies: []*parser.Dependency{dep("tui-2", parser.DepBlocks)}},
+		{ID: "tui-2", Status: parser.StatusOpen, Dependencies: []*parser.Dependency{dep("tui-3", parser.DepBlocks), dep("tui-1", parser.DepBlocks)}},
+		// Depends on the cycle without being part of it
+		{ID: "tui-4", Status: parser.StatusOpen, Dependencies: []*parser.Dependency{dep("tui-1", parser.DepBlocks)}},
+		// Related links don't count
+		{ID: "tui-5", Status: parser.StatusOpen, Dependencies: []*parser.Dependency{dep("tui-6", parser.DepRelated)}},
+		{ID: "tui-6", Status: parser.StatusOpen, Dependencies: []*parser.Dependency{dep("tui-5", parser.DepBlocks)}},
+		// Self-dependency
+		{ID: "tui-7", Status: parser.StatusOpen, Dependencies: []*parser.Dependency{dep("tui-7", parser.DepBlocks)}},
+	})
+
+	cycles := state.Cycles()
+	if len(cycles) != 2 {
+		t.Fatalf("expected 2 cycles, got %+v", cycles)
+	}
+	want := Cycle{
+		{IssueID: "tui-1", DependsOnID: "tui-2", Type: parser.DepBlocks},
+		{IssueID: "tui-2", DependsOnID: "tui-1", Type: parser.DepBlocks},
+	}
+	if !reflect.DeepEqual(cycles[0], want) {
+		t.Errorf("expected the shortest cycle through tui-1, got %+v", cycles[0])
+	}
+	if got := cycles[1].IssueIDs(); !reflect.DeepEqual(got, []string{"tui-7"}) {
+		t.Errorf("expected the self-dependency of tui-7, got %v", got)
+	}
+
+	for _, id := range []string{"tui-1", "tui-2", "tui-3", "tui-7"} {
+		if !state.InCycle(id) {
+			t.Errorf("expected %s to be in a cycle", id)
+		}
+	}
+	for _, id := range []string{"tui-4", "tui-5", "tui-6"} {
+		if state.InCycle(id) {
+			t.Errorf("expected %s not to be in a cycle", id)
+		}
+	}
+}
+
+func TestBuildDependencyTree_CycleWithoutRoot(t *testing.T) {
+	dep := func(id string) *parser.Dependency {
+		return &parser.Dependency{DependsOnID: id, Type: parser.DepBlocks}
+	}
+	state := New()
+	state.SetViewMode(ViewTree)
+	state.LoadIssues([]*parser.Issue{
+		{ID: "tui-1", Status: parser.StatusOpen, Dependencies: []*parser.Dependency{dep("tui-2")}},
+		{ID: "tui-2", Status: parser.StatusOpen, Dependencies: []*parser.Dependency{dep("tui-1")}},
+	})
+
+	nodes := state.GetTreeNodes()
+	if len(nodes) != 1 || nodes[0].Issue.ID != "tui-1" {
+		t.Fatalf("expected the cycle rooted at tui-1, got %d roots", len(nodes))
+	}
+	if len(nodes[0].Children) != 1 || nodes[0].Children[0].Issue.ID != "tui-2" {
+		t.Errorf("expected tui-2 under tui-1")
+	}
+}
@@ -0,0 +1,84 @@
+package state
+
+import (
+	"strings"
+	"time"
+
+	"github.com/andy/beads-tui/internal/parser"
+)
+
+// DueSoonDays is how many days ahead a due date counts as due soon
+const DueSoonDays = 3
+
+// DueStatus classifies an issue's due date relative to today
+type DueStatus string
+
+const (
+	DueNone    DueStatus = "none"    // No due date, or closed
+	DueLater   DueStatus = "later"   // Due after the next DueSoonDays days
+	DueSoon    DueStatus = "soon"    // Due today or within DueSoonDays days
+	DueOverdue DueStatus = "overdue" // Due date has passed
+)
+
+// dueStatuses are the due: filter values, in display order
+var dueStatuses = []DueStatus{DueOverdue, DueSoon, DueLater, DueNone}
+
+// DueStatusOf classifies an issue's due date by calendar day in now's
+// location, so an issue due today is due soon rather than overdue. Closed
+// issues are never due.
+func DueStatusOf(issue *parser.Issue, now time.Time) DueStatus {
+	if issue.DueDate == nil || issue.Status == parser.StatusClosed {
+		return DueNone
+	}
+	switch days := DaysUntil(*issue.DueDate, now); {
+	case days < 0:
+		return DueOverdue
+	case days <= DueSoonDays:
+		return DueSoon
+	default:
+		return DueLater
+	}
+}
+
+// DaysUntil returns the number of calendar days from now to due, negative
+// once the due day has passed
+func DaysUntil(due, now time.Time) int {
+	due = due.In(now.Location())
+	dueDay := time.Date(due.Year(), due.Month(), due.Day(), 0, 0, 0, 0, time.UTC)
+	today := time.Date(now.Year(), now.Month(), now.Day(), 0, 0, 0, 0, time.UTC)
+	return int(dueDay.Sub(today).Hours() / 24)
+}
+
+// ToggleDueFilter toggles a due status (overdue, soon, later, or none) in
+// the filter. Unknown statuses are ignored.
+func (s *State) ToggleDueFilter(status string) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	due := DueStatus(strings.ToLower(status))
+	known := false
+	for _, d := range dueStatuses {
+		known = known || d == due
+	}
+	if !known {
+		return
+	}
+	if s.dueFilter == nil {
+		s.dueFilter = make(map[DueStatus]bool)
+	}
+
+	if s.dueFilter[due] {
+		delete(s.dueFilter, due)
+		if len(s.dueFilter) == 0 {
+			s.dueFilter = nil
+		}
+	} else {
+		s.dueFilter[due] = true
+	}
+}
+
+// IsDueFiltered returns true if the given due status is in the active filter
+func (s *State) IsDueFiltered(status string) bool {
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+	return s.dueFilter != nil && s.dueFilter[DueStatus(strings.ToLower(status))]
+}
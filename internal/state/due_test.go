@@ -0,0 +1,69 @@
+package state
+
+import (
+	"testing"
+	"time"
+
+	"github.com/andy/beads-tui/internal/parser"
+)
+
+func TestDueStatusOf(t *testing.T) {
+	now := time.Date(2026, 5, 10, 15, 0, 0, 0, time.UTC)
+	due := func(year int, month time.Month, day int) *time.Time {
+		d := time.Date(year, month, day, 0, 0, 0, 0, time.UTC)
+		return &d
+	}
+
+	tests := []struct {
+		name  string
+		issue *parser.Issue
+		want  DueStatus
+	}{
+		{"no due date", &parser.Issue{Status: parser.StatusOpen}, DueNone},
+		{"yesterday", &parser.Issue{Status: parser.StatusOpen, DueDate: due(2026, 5, 9)}, DueOverdue},
+		{"earlier today", &parser.Issue{Status: parser.StatusOpen, DueDate: due(2026, 5, 10)}, DueSoon},
+		{"in three days", &parser.Issue{Status: parser.StatusInProgress, DueDate: due(2026, 5, 13)}, DueSoon},
+		{"in four days", &parser.Issue{Status: parser.StatusOpen, DueDate: due(2026, 5, 14)}, DueLater},
+		{"closed late", &parser.Issue{Status: parser.StatusClosed, DueDate: due(2026, 5, 1)}, DueNone},
+	}
+	for _, tt := range tests {
+		if got := DueStatusOf(tt.issue, now); got != tt.want {
+			t.Errorf("%s: DueStatusOf = %q, want %q", tt.name, got, tt.want)
+		}
+	}
+
+	if got := DaysUntil(*due(2026, 4, 30), now); got != -10 {
+		t.Errorf("DaysUntil = %d, want -10", got)
+	}
+}
+
+func TestFilterByDue(t *testing.T) {
+	now := time.Now()
+	days := func(d int) *time.Time { due := now.AddDate(0, 0, d); return &due }
+	state := New()
+	state.LoadIssues([]*parser.Issue{
+		{ID: "test-1", Title: "Late", Status: parser.StatusOpen, DueDate: days(-2), CreatedAt: now, UpdatedAt: now},
+		{ID: "test-2", Title: "Tomorrow", Status: parser.StatusOpen, DueDate: days(1), CreatedAt: now, UpdatedAt: now},
+		{ID: "test-3", Title: "Next month", Status: parser.StatusOpen, DueDate: days(30), CreatedAt: now, UpdatedAt: now},
+		{ID: "test-4", Title: "Whenever", Status: parser.StatusOpen, CreatedAt: now, UpdatedAt: now},
+	})
+
+	state.ApplyFilterQuery("due:overdue")
+	if ready := state.GetReadyIssues(); len(ready) != 1 || ready[0].ID != "test-1" {
+		t.Fatalf("expected only test-1 with due:overdue, got %v", issueIDs(ready))
+	}
+
+	state.ApplyFilterQuery("due:soon,due:overdue due:bogus")
+	if got := len(state.GetReadyIssues()); got != 2 {
+		t.Errorf("expected 2 issues due soon or overdue, got %d", got)
+	}
+	if filterStr := state.GetActiveFilters(); filterStr != "Due: overdue,soon" {
+		t.Errorf("unexpected filter description %q", filterStr)
+	}
+
+	state.ToggleDueFilter("soon")
+	state.ToggleDueFilter("overdue")
+	if state.HasActiveFilters() {
+		t.Error("expected due filter to be removed when emptied")
+	}
+}
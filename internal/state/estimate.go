@@ -0,0 +1,83 @@
+package state
+
+import (
+	"strings"
+
+	"github.com/andy/beads-tui/internal/parser"
+)
+
+// EstimateBucket is a range of estimates used by the est: filter and the
+// priority × estimate grid
+type EstimateBucket struct {
+	Name       string // Filter token suffix, e.g. "4h" in est:4h
+	Label      string // Short column heading
+	MaxMinutes int    // Inclusive upper bound; 0 = no upper bound
+}
+
+// EstimateBuckets are the estimate ranges from smallest to largest, followed
+// by unestimated issues. Each bucket starts where the previous one ends.
+var EstimateBuckets = []EstimateBucket{
+	{Name: "1h", Label: "≤1h", MaxMinutes: 60},
+	{Name: "4h", Label: "1-4h", MaxMinutes: 4 * 60},
+	{Name: "8h", Label: "4-8h", MaxMinutes: 8 * 60},
+	{Name: "24h", Label: "8-24h", MaxMinutes: 24 * 60},
+	{Name: "24h+", Label: ">24h"},
+	{Name: EstimateNone, Label: "none"},
+}
+
+// EstimateNone is the bucket name for issues without an estimate
+const EstimateNone = "none"
+
+// EstimateBucketOf returns the name of the bucket the issue's estimate falls in
+func EstimateBucketOf(issue *parser.Issue) string {
+	if issue.EstimatedMinutes == nil {
+		return EstimateNone
+	}
+	minutes := *issue.EstimatedMinutes
+	for _, bucket := range EstimateBuckets {
+		if bucket.Name != EstimateNone && (bucket.MaxMinutes == 0 || minutes <= bucket.MaxMinutes) {
+			return bucket.Name
+		}
+	}
+	return EstimateNone
+}
+
+// isEstimateBucket reports whether name is one of EstimateBuckets
+func isEstimateBucket(name string) bool {
+	for _, bucket := range EstimateBuckets {
+		if bucket.Name == name {
+			return true
+		}
+	}
+	return false
+}
+
+// ToggleEstimateFilter toggles an estimate bucket (see EstimateBuckets) in
+// the filter. Unknown bucket names are ignored.
+func (s *State) ToggleEstimateFilter(bucket string) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	bucket = strings.ToLower(bucket)
+	if !isEstimateBucket(bucket) {
+		return
+	}
+	if s.estimateFilter == nil {
+		s.estimateFilter = make(map[string]bool)
+	}
+
+	if s.estimateFilter[bucket] {
+		delete(s.estimateFilter, bucket)
+		if len(s.estimateFilter) == 0 {
+			s.estimateFilter = nil
+		}
+	} else {
+		s.estimateFilter[bucket] = true
+	}
+}
+
+// IsEstimateFiltered returns true if the given bucket is in the active filter
+func (s *State) IsEstimateFiltered(bucket string) bool {
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+	return s.estimateFilter != nil && s.estimateFilter[strings.ToLower(bucket)]
+}
@@ -0,0 +1,157 @@
+package state
+
+import (
+	"slices"
+	"strings"
+	"time"
+
+	"github.com/andy/beads-tui/internal/parser"
+)
+
+// ToggleExcludeFilter toggles hiding the issues a quick filter token matches
+// (e.g. "chore" for "!chore", everything except chores). Tokens that aren't
+// part of the syntax are ignored.
+func (s *State) ToggleExcludeFilter(token string) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	token = strings.ToLower(token)
+	if s.tokenMatcher(token, time.Now()) == nil {
+		return
+	}
+	if s.excludeFilter == nil {
+		s.excludeFilter = make(map[string]bool)
+	}
+
+	if s.excludeFilter[token] {
+		delete(s.excludeFilter, token)
+		if len(s.excludeFilter) == 0 {
+			s.excludeFilter = nil
+		}
+	} else {
+		s.excludeFilter[token] = true
+	}
+}
+
+// IsExcludeFiltered returns true if issues matching the token are hidden
+func (s *State) IsExcludeFiltered(token string) bool {
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+	return s.excludeFilter != nil && s.excludeFilter[strings.ToLower(token)]
+}
+
+// excluded reports whether an issue matches any excluded token. matchers
+// are the excluded tokens' tests, built once per filtering pass.
+func excluded(issue *parser.Issue, matchers []func(issue *parser.Issue) bool) bool {
+	for _, matches := range matchers {
+		if matches(issue) {
+			return true
+		}
+	}
+	return false
+}
+
+// excludeMatchers builds the tests for the excluded tokens
+func (s *State) excludeMatchers(now time.Time) []func(issue *parser.Issue) bool {
+	var matchers []func(issue *parser.Issue) bool
+	for _, token := range sortedKeys(s.excludeFilter) {
+		if matches := s.tokenMatcher(token, now); matches != nil {
+			matchers = append(matchers, matches)
+		}
+	}
+	return matchers
+}
+
+// tokenMatcher returns a test for whether an issue matches one lowercased
+// quick filter token on its own, as a negated token needs. It returns nil if
+// the token isn't part of the syntax. Dependency tests read the state's
+// indexes when they run, so they run under its lock.
+func (s *State) tokenMatcher(token string, now time.Time) func(issue *parser.Issue) bool {
+	// Labels: "#a,#b" is two tokens; "#a+#b" needs every label
+	if strings.HasPrefix(token, "#") {
+		var labels []string
+		for _, label := range strings.Split(token, "+") {
+			if label = strings.TrimPrefix(label, "#"); label != "" {
+				labels = append(labels, label)
+			}
+		}
+		if len(labels) == 0 {
+			return nil
+		}
+		return func(issue *parser.Issue) bool {
+			for _, label := range labels {
+				if !slices.ContainsFunc(issue.Labels, func(issueLabel string) bool { return strings.EqualFold(issueLabel, label) }) {
+					return false
+				}
+			}
+			return true
+		}
+	}
+
+	if assignee, ok := strings.CutPrefix(token, "@"); ok {
+		if assignee == "" {
+			return nil
+		}
+		return func(issue *parser.Issue) bool { return strings.EqualFold(issue.Assignee, assignee) }
+	}
+	if text, ok := strings.CutPrefix(token, "title:"); ok {
+		if text == "" {
+			return nil
+		}
+		return func(issue *parser.Issue) bool { return strings.Contains(strings.ToLower(issue.Title), text) }
+	}
+	if bucket, ok := strings.CutPrefix(token, "est:"); ok {
+		if !isEstimateBucket(bucket) {
+			return nil
+		}
+		return func(issue *parser.Issue) bool { return EstimateBucketOf(issue) == bucket }
+	}
+	if status, ok := strings.CutPrefix(token, "due:"); ok {
+		if !slices.Contains(dueStatuses, DueStatus(status)) {
+			return nil
+		}
+		return func(issue *parser.Issue) bool { return DueStatusOf(issue, now) == DueStatus(status) }
+	}
+	if id, ok := strings.CutPrefix(token, "blocked-by:"); ok {
+		if id == "" {
+			return nil
+		}
+		return func(issue *parser.Issue) bool {
+			return slices.ContainsFunc(issue.Dependencies, func(dep *parser.Dependency) bool {
+				return dep.Type == parser.DepBlocks && strings.EqualFold(dep.DependsOnID, id)
+			})
+		}
+	}
+	if id, ok := strings.CutPrefix(token, "blocks:"); ok {
+		if id == "" {
+			return nil
+		}
+		return func(issue *parser.Issue) bool {
+			return slices.ContainsFunc(s.blockingDependents[issue.ID], func(dependentID string) bool {
+				return strings.EqualFold(dependentID, id)
+			})
+		}
+	}
+
+	// Priority (p0-p4)
+	if len(token) == 2 && token[0] == 'p' && token[1] >= '0' && token[1] <= '4' {
+		priority := int(token[1] - '0')
+		return func(issue *parser.Issue) bool { return issue.Priority == priority }
+	}
+
+	switch token {
+	case "no-deps":
+		return s.hasNoDeps
+	case "has-children":
+		return func(issue *parser.Issue) bool { return s.hasChildren[issue.ID] }
+	case "bug", "feature", "task", "epic", "chore":
+		issueType := parser.IssueType(token)
+		return func(issue *parser.Issue) bool { return issue.IssueType == issueType }
+	case "open", "in_progress", "inprogress", "blocked", "closed":
+		status := parser.Status(token)
+		if token == "inprogress" {
+			status = parser.StatusInProgress
+		}
+		return func(issue *parser.Issue) bool { return issue.Status == status }
+	}
+	return nil
+}
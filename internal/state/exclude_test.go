@@ -0,0 +1,57 @@
+package state
+
+import (
+	"slices"
+	"testing"
+
+	"github.com/andy/beads-tui/internal/parser"
+)
+
+func visibleIDs(s *State) []string {
+	var ids []string
+	for _, issue := range s.applyFilters(s.issues) {
+		ids = append(ids, issue.ID)
+	}
+	return ids
+}
+
+func TestNegatedFilterTokens(t *testing.T) {
+	state := New()
+	state.LoadIssues([]*parser.Issue{
+		{ID: "tui-1", Title: "Fix login page", Priority: 0, IssueType: parser.TypeBug, Status: parser.StatusOpen, Labels: []string{"ui"}},
+		{ID: "tui-2", Title: "Tidy config", Priority: 3, IssueType: parser.TypeChore, Status: parser.StatusOpen},
+		{ID: "tui-3", Title: "Login with SSO", Priority: 1, IssueType: parser.TypeFeature, Status: parser.StatusInProgress, Labels: []string{"ui", "urgent"}, Assignee: "Alice"},
+		{ID: "tui-4", Title: "Docs", Priority: 2, IssueType: parser.TypeTask, Status: parser.StatusClosed},
+	})
+
+	tests := []struct {
+		query string
+		want  []string
+	}{
+		{"!chore", []string{"tui-1", "tui-3", "tui-4"}},
+		{"-p0 -closed", []string{"tui-2", "tui-3"}},
+		{"!chore !bug", []string{"tui-3", "tui-4"}},
+		{"!#ui+#urgent", []string{"tui-1", "tui-2", "tui-4"}},
+		{"!#ui", []string{"tui-2", "tui-4"}},
+		{"!@alice", []string{"tui-1", "tui-2", "tui-4"}},
+		{"title:LOGIN", []string{"tui-1", "tui-3"}},
+		{"title:login !in_progress", []string{"tui-1"}},
+		{"title:docs title:config", []string{"tui-2", "tui-4"}},
+		{"!title:login open", []string{"tui-2"}},
+	}
+	for _, tt := range tests {
+		state.ApplyFilterQuery(tt.query)
+		if got := visibleIDs(state); !slices.Equal(got, tt.want) {
+			t.Errorf("%q shows %v, want %v", tt.query, got, tt.want)
+		}
+	}
+
+	if check := state.CheckFilterQuery("!nonsense - ! title:"); len(check.Unknown) != 4 {
+		t.Errorf("expected every token to be unknown, got %v", check.Unknown)
+	}
+
+	state.ApplyFilterQuery("!chore title:login")
+	if got := state.GetActiveFilters(); got != "Title: login | Not: chore" {
+		t.Errorf("GetActiveFilters() = %q", got)
+	}
+}
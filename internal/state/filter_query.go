@@ -0,0 +1,281 @@
+package state
+
+import (
+	"fmt"
+	"maps"
+	"slices"
+	"strings"
+
+	"github.com/andy/beads-tui/internal/parser"
+	"github.com/andy/beads-tui/internal/storage"
+)
+
+// ApplyFilterQuery replaces the active filters with those described by a quick
+// filter query. Tokens are separated by spaces or commas and matched
+// case-insensitively:
+//
+//	p0-p4                               Priority
+//	bug, feature, task, epic, chore     Type
+//	open, in_progress, blocked, closed  Status
+//	#label                              Label (several match any of them)
+//	#label+#other                       Issues with every listed label
+//	@name                               Assignee
+//	title:<text>                        Title contains <text>
+//	est:1h, est:4h, est:8h, est:24h     Estimate up to that long (and over the next smaller one)
+//	est:24h+, est:none                  Estimate over a day, or no estimate
+//	due:overdue, due:soon               Past due, or due within DueSoonDays days
+//	due:later, due:none                 Due further out, or no due date
+//	blocked-by:<id>                     Issues waiting on <id>
+//	blocks:<id>                         Issues that <id> waits on
+//	no-deps                             No blocking relationships either way
+//	has-children                        Issues with child issues
+//	!<token>, -<token>                  Hide what <token> matches (e.g. !chore, -p0)
+//
+// Unrecognized tokens are ignored (CheckFilterQuery lists them). An empty
+// query clears all filters. Each filter is set through its own method, so
+// the query isn't applied atomically; it's meant for the UI goroutine.
+func (s *State) ApplyFilterQuery(query string) {
+	s.ClearAllFilters()
+	for _, token := range filterTokens(query) {
+		s.applyFilterToken(token)
+	}
+}
+
+// FilterCheck is what a quick filter query would do if it were applied
+type FilterCheck struct {
+	Matches int      // Issues the query's filters let through
+	Total   int      // Every loaded issue
+	Unknown []string // Tokens that aren't part of the syntax (lowercased)
+}
+
+// CheckFilterQuery previews a quick filter query without changing the active
+// filters: how many issues it matches, and which tokens it doesn't know.
+// Labels, assignees, and IDs aren't checked against the issues; a misspelled
+// one just matches nothing.
+func (s *State) CheckFilterQuery(query string) FilterCheck {
+	s.mu.RLock()
+	scratch := &State{
+		issues:             s.issues,
+		issuesByID:         s.issuesByID,
+		blockingDependents: s.blockingDependents,
+		hasChildren:        s.hasChildren,
+	}
+	s.mu.RUnlock()
+
+	check := FilterCheck{Total: len(scratch.issues)}
+	for _, token := range filterTokens(query) {
+		if !scratch.applyFilterToken(token) {
+			check.Unknown = append(check.Unknown, token)
+		}
+	}
+	check.Matches = len(scratch.applyFilters(scratch.issues))
+	return check
+}
+
+// filterTokens splits a quick filter query into lowercased tokens
+func filterTokens(query string) []string {
+	query = strings.ToLower(strings.TrimSpace(query))
+	return strings.FieldsFunc(query, func(r rune) bool {
+		return r == ' ' || r == ','
+	})
+}
+
+// applyFilterToken turns on the filter for one lowercased query token. It
+// returns false if the token isn't part of the syntax.
+func (s *State) applyFilterToken(token string) bool {
+	// Check for negation (!token or -token)
+	if negated, ok := cutNegation(token); ok {
+		s.ToggleExcludeFilter(negated)
+		return s.IsExcludeFiltered(negated)
+	}
+
+	// Check for label (starts with #); "#a+#b" requires all of them
+	if strings.HasPrefix(token, "#") {
+		labels := strings.Split(token, "+")
+		known := false
+		for _, label := range labels {
+			if label = strings.TrimPrefix(label, "#"); label != "" {
+				known = true
+				if !s.IsLabelFiltered(label) {
+					s.ToggleLabelFilter(label)
+				}
+			}
+		}
+		if len(labels) > 1 {
+			s.SetLabelMatchAll(true)
+		}
+		return known
+	}
+
+	// Check for assignee (starts with @)
+	if strings.HasPrefix(token, "@") {
+		assignee := strings.TrimPrefix(token, "@")
+		if assignee != "" {
+			s.ToggleAssigneeFilter(assignee)
+		}
+		return assignee != ""
+	}
+
+	// Check for title text
+	if text, ok := strings.CutPrefix(token, "title:"); ok {
+		if text != "" && !s.IsTitleFiltered(text) {
+			s.ToggleTitleFilter(text)
+		}
+		return text != ""
+	}
+
+	// Check for estimate bucket
+	if bucket, ok := strings.CutPrefix(token, "est:"); ok {
+		s.ToggleEstimateFilter(bucket)
+		return isEstimateBucket(bucket)
+	}
+
+	// Check for due date status
+	if status, ok := strings.CutPrefix(token, "due:"); ok {
+		s.ToggleDueFilter(status)
+		return slices.Contains(dueStatuses, DueStatus(status))
+	}
+
+	// Check for dependency filters
+	if id, ok := strings.CutPrefix(token, "blocked-by:"); ok {
+		if id != "" {
+			s.ToggleBlockedByFilter(id)
+		}
+		return id != ""
+	}
+	if id, ok := strings.CutPrefix(token, "blocks:"); ok {
+		if id != "" {
+			s.ToggleBlocksFilter(id)
+		}
+		return id != ""
+	}
+	switch token {
+	case "no-deps":
+		s.ToggleNoDepsFilter()
+		return true
+	case "has-children":
+		s.ToggleHasChildrenFilter()
+		return true
+	}
+
+	// Check for priority (p0-p4)
+	if len(token) == 2 && token[0] == 'p' && token[1] >= '0' && token[1] <= '4' {
+		s.TogglePriorityFilter(int(token[1] - '0'))
+		return true
+	}
+
+	// Check for type
+	switch token {
+	case "bug":
+		s.ToggleTypeFilter(parser.TypeBug)
+	case "feature":
+		s.ToggleTypeFilter(parser.TypeFeature)
+	case "task":
+		s.ToggleTypeFilter(parser.TypeTask)
+	case "epic":
+		s.ToggleTypeFilter(parser.TypeEpic)
+	case "chore":
+		s.ToggleTypeFilter(parser.TypeChore)
+
+	// Check for status
+	case "open":
+		s.ToggleStatusFilter(parser.StatusOpen)
+	case "in_progress", "inprogress":
+		s.ToggleStatusFilter(parser.StatusInProgress)
+	case "blocked":
+		s.ToggleStatusFilter(parser.StatusBlocked)
+	case "closed":
+		s.ToggleStatusFilter(parser.StatusClosed)
+	default:
+		return false
+	}
+	return true
+}
+
+// cutNegation strips the ! or - that negates a token
+func cutNegation(token string) (string, bool) {
+	if len(token) > 1 && (token[0] == '!' || token[0] == '-') {
+		return token[1:], true
+	}
+	return token, false
+}
+
+// FilterQuery describes the active filters as a quick filter query, so that
+// ApplyFilterQuery restores them. It's empty when no filter is active.
+func (s *State) FilterQuery() string {
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+	var tokens []string
+	for p := 0; p <= 4; p++ {
+		if s.priorityFilter[p] {
+			tokens = append(tokens, fmt.Sprintf("p%d", p))
+		}
+	}
+	for _, t := range []parser.IssueType{parser.TypeBug, parser.TypeFeature, parser.TypeTask, parser.TypeEpic, parser.TypeChore} {
+		if s.typeFilter[t] {
+			tokens = append(tokens, string(t))
+		}
+	}
+	for _, st := range []parser.Status{parser.StatusOpen, parser.StatusInProgress, parser.StatusBlocked, parser.StatusClosed} {
+		if s.statusFilter[st] {
+			tokens = append(tokens, string(st))
+		}
+	}
+	if labels := sortedKeys(s.labelFilter); len(labels) > 0 {
+		for i, label := range labels {
+			labels[i] = "#" + label
+		}
+		if s.labelMatchAll {
+			tokens = append(tokens, strings.Join(labels, "+"))
+		} else {
+			tokens = append(tokens, labels...)
+		}
+	}
+	for _, assignee := range sortedKeys(s.assigneeFilter) {
+		tokens = append(tokens, "@"+assignee)
+	}
+	for _, text := range sortedKeys(s.titleFilter) {
+		tokens = append(tokens, "title:"+text)
+	}
+	for _, bucket := range EstimateBuckets {
+		if s.estimateFilter[bucket.Name] {
+			tokens = append(tokens, "est:"+bucket.Name)
+		}
+	}
+	for _, due := range dueStatuses {
+		if s.dueFilter[due] {
+			tokens = append(tokens, "due:"+string(due))
+		}
+	}
+	for _, id := range sortedKeys(s.blockedByFilter) {
+		tokens = append(tokens, "blocked-by:"+id)
+	}
+	for _, id := range sortedKeys(s.blocksFilter) {
+		tokens = append(tokens, "blocks:"+id)
+	}
+	if s.noDepsFilter {
+		tokens = append(tokens, "no-deps")
+	}
+	if s.hasChildrenFilter {
+		tokens = append(tokens, "has-children")
+	}
+	for _, token := range sortedKeys(s.excludeFilter) {
+		tokens = append(tokens, "!"+token)
+	}
+	return strings.Join(tokens, " ")
+}
+
+// FilterSpec returns the priority, type, status, and label filters as a
+// storage.FilterSpec, for loading only the issues they can match from a
+// large database. The other filters are still applied in memory.
+func (s *State) FilterSpec() storage.FilterSpec {
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+	return storage.FilterSpec{
+		Priorities:    slices.Sorted(maps.Keys(s.priorityFilter)),
+		Types:         slices.Sorted(maps.Keys(s.typeFilter)),
+		Statuses:      slices.Sorted(maps.Keys(s.statusFilter)),
+		Labels:        sortedKeys(s.labelFilter),
+		LabelMatchAll: s.labelMatchAll,
+	}
+}
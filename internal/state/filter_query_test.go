@@ -0,0 +1,311 @@
+package state
+
+import (
+	"reflect"
+	"sort"
+	"testing"
+	"time"
+
+	"github.com/andy/beads-tui/internal/parser"
+	"github.com/andy/beads-tui/internal/storage"
+)
+
+func TestApplyFilterQuery(t *testing.T) {
+	state := New()
+
+	state.ApplyFilterQuery("P1,bug #UI @Alice open")
+
+	if !state.IsPriorityFiltered(1) {
+		t.Error("expected priority 1 to be filtered")
+	}
+	if !state.IsTypeFiltered(parser.TypeBug) {
+		t.Error("expected bug type to be filtered")
+	}
+	if !state.IsStatusFiltered(parser.StatusOpen) {
+		t.Error("expected open status to be filtered")
+	}
+	if !state.IsLabelFiltered("ui") {
+		t.Error("expected label 'ui' to be filtered")
+	}
+	if !state.IsAssigneeFiltered("alice") {
+		t.Error("expected assignee 'alice' to be filtered")
+	}
+
+	// A new query replaces previous filters
+	state.ApplyFilterQuery("p2")
+	if state.IsPriorityFiltered(1) || state.IsTypeFiltered(parser.TypeBug) || state.IsAssigneeFiltered("alice") {
+		t.Error("expected previous filters to be cleared")
+	}
+	if !state.IsPriorityFiltered(2) {
+		t.Error("expected priority 2 to be filtered")
+	}
+
+	// Empty query clears everything; bare prefixes and unknown tokens are ignored
+	state.ApplyFilterQuery("  ")
+	if state.HasActiveFilters() {
+		t.Error("expected no active filters after empty query")
+	}
+	state.ApplyFilterQuery("@ # nonsense")
+	if state.HasActiveFilters() {
+		t.Errorf("expected no active filters, got %q", state.GetActiveFilters())
+	}
+}
+
+func TestCheckFilterQuery(t *testing.T) {
+	state := New()
+	state.LoadIssues([]*parser.Issue{
+		{ID: "tui-1", Priority: 1, IssueType: parser.TypeBug, Status: parser.StatusOpen, Labels: []string{"ui"}},
+		{ID: "tui-2", Priority: 1, IssueType: parser.TypeTask, Status: parser.StatusOpen},
+		{ID: "tui-3", Priority: 2, IssueType: parser.TypeBug, Status: parser.StatusClosed, Labels: []string{"ui"}},
+	})
+	state.ApplyFilterQuery("p2")
+
+	check := state.CheckFilterQuery("P1 #ui, nonsense est:2h due:someday @ #+#")
+	if check.Matches != 1 || check.Total != 3 {
+		t.Errorf("expected 1 of 3 issues to match, got %d of %d", check.Matches, check.Total)
+	}
+	if want := []string{"nonsense", "est:2h", "due:someday", "@", "#+#"}; !reflect.DeepEqual(check.Unknown, want) {
+		t.Errorf("expected unknown tokens %v, got %v", want, check.Unknown)
+	}
+	if check := state.CheckFilterQuery(""); check.Matches != 3 || check.Unknown != nil {
+		t.Errorf("expected an empty query to match everything, got %+v", check)
+	}
+
+	// The active filters are left alone
+	if state.FilterQuery() != "p2" {
+		t.Errorf("expected the active filters to stay p2, got %q", state.FilterQuery())
+	}
+}
+
+func TestFilterByAssignee(t *testing.T) {
+	state := New()
+
+	issues := []*parser.Issue{
+		{ID: "test-1", Title: "Alice's", Status: parser.StatusOpen, Priority: 1, IssueType: parser.TypeTask, Assignee: "Alice", CreatedAt: time.Now(), UpdatedAt: time.Now()},
+		{ID: "test-2", Title: "Bob's", Status: parser.StatusOpen, Priority: 1, IssueType: parser.TypeTask, Assignee: "bob", CreatedAt: time.Now(), UpdatedAt: time.Now()},
+		{ID: "test-3", Title: "Unassigned", Status: parser.StatusOpen, Priority: 1, IssueType: parser.TypeTask, CreatedAt: time.Now(), UpdatedAt: time.Now()},
+	}
+	state.LoadIssues(issues)
+
+	state.ToggleAssigneeFilter("alice")
+	readyIssues := state.GetReadyIssues()
+	if len(readyIssues) != 1 || readyIssues[0].ID != "test-1" {
+		t.Fatalf("expected only test-1 with @alice filter, got %d issues", len(readyIssues))
+	}
+
+	state.ToggleAssigneeFilter("BOB")
+	if got := len(state.GetReadyIssues()); got != 2 {
+		t.Errorf("expected 2 issues with @alice,@bob filter, got %d", got)
+	}
+	if filterStr := state.GetActiveFilters(); filterStr != "Assignee: @alice,@bob" {
+		t.Errorf("unexpected filter description %q", filterStr)
+	}
+
+	state.ToggleAssigneeFilter("alice")
+	state.ToggleAssigneeFilter("bob")
+	if state.HasActiveFilters() {
+		t.Error("expected assignee filter to be removed when emptied")
+	}
+
+	if assignees := state.GetAllAssignees(); len(assignees) != 2 || assignees[0] != "Alice" || assignees[1] != "bob" {
+		t.Errorf("unexpected assignees: %v", assignees)
+	}
+}
+
+func TestDependencyFilters(t *testing.T) {
+	state := New()
+
+	issues := []*parser.Issue{
+		{ID: "tui-abc", Title: "Blocker", Status: parser.StatusOpen, Priority: 1, IssueType: parser.TypeTask},
+		{ID: "tui-w1", Title: "Waits on abc", Status: parser.StatusOpen, Priority: 1, IssueType: parser.TypeTask,
+			Dependencies: []*parser.Dependency{{IssueID: "tui-w1", DependsOnID: "tui-abc", Type: parser.DepBlocks}}},
+		{ID: "tui-w2", Title: "Related to abc", Status: parser.StatusOpen, Priority: 1, IssueType: parser.TypeTask,
+			Dependencies: []*parser.Dependency{{IssueID: "tui-w2", DependsOnID: "tui-abc", Type: parser.DepRelated}}},
+		{ID: "tui-epic", Title: "Epic", Status: parser.StatusOpen, Priority: 1, IssueType: parser.TypeEpic},
+		{ID: "tui-leaf", Title: "Child", Status: parser.StatusOpen, Priority: 1, IssueType: parser.TypeTask,
+			Dependencies: []*parser.Dependency{{IssueID: "tui-leaf", DependsOnID: "tui-epic", Type: parser.DepParentChild}}},
+		{ID: "tui-epic.1", Title: "ID child", Status: parser.StatusOpen, Priority: 1, IssueType: parser.TypeTask},
+		{ID: "tui-solo", Title: "Solo", Status: parser.StatusOpen, Priority: 1, IssueType: parser.TypeTask},
+		{ID: "tui-solo.1", Title: "Solo child", Status: parser.StatusOpen, Priority: 1, IssueType: parser.TypeTask},
+	}
+	state.LoadIssues(issues)
+
+	ids := func() map[string]bool {
+		result := make(map[string]bool)
+		for _, list := range [][]*parser.Issue{state.GetReadyIssues(), state.GetBlockedIssues(), state.GetInProgressIssues()} {
+			for _, issue := range list {
+				result[issue.ID] = true
+			}
+		}
+		return result
+	}
+
+	state.ApplyFilterQuery("blocked-by:TUI-ABC")
+	if got := ids(); len(got) != 1 || !got["tui-w1"] {
+		t.Errorf("blocked-by:tui-abc: expected only tui-w1, got %v", got)
+	}
+
+	state.ApplyFilterQuery("blocks:tui-w1")
+	if got := ids(); len(got) != 1 || !got["tui-abc"] {
+		t.Errorf("blocks:tui-w1: expected only tui-abc, got %v", got)
+	}
+
+	state.ApplyFilterQuery("no-deps")
+	got := ids()
+	if got["tui-abc"] || got["tui-w1"] {
+		t.Errorf("no-deps: expected blocking pair excluded, got %v", got)
+	}
+	if !got["tui-w2"] || !got["tui-leaf"] || !got["tui-solo"] {
+		t.Errorf("no-deps: expected issues without blocking relationships, got %v", got)
+	}
+
+	state.ApplyFilterQuery("has-children")
+	if got := ids(); len(got) != 2 || !got["tui-epic"] || !got["tui-solo"] {
+		t.Errorf("has-children: expected tui-epic and tui-solo, got %v", got)
+	}
+
+	state.ApplyFilterQuery("has-children epic")
+	if got := ids(); len(got) != 1 || !got["tui-epic"] {
+		t.Errorf("has-children epic: expected only tui-epic, got %v", got)
+	}
+
+	if desc := state.GetActiveFilters(); desc != "Type: epic | Deps: has-children" {
+		t.Errorf("unexpected filter description %q", desc)
+	}
+
+	state.ClearAllFilters()
+	if state.HasActiveFilters() {
+		t.Error("expected dependency filters cleared")
+	}
+}
+
+func TestFilterByLabels(t *testing.T) {
+	state := New()
+	state.LoadIssues([]*parser.Issue{
+		{ID: "test-1", Title: "UI only", Status: parser.StatusOpen, Labels: []string{"UI"}},
+		{ID: "test-2", Title: "Urgent only", Status: parser.StatusOpen, Labels: []string{"urgent"}},
+		{ID: "test-3", Title: "Both", Status: parser.StatusOpen, Labels: []string{"ui", "Urgent"}},
+		{ID: "test-4", Title: "Neither", Status: parser.StatusOpen},
+	})
+
+	tests := []struct {
+		query   string
+		want    []string
+		display string
+	}{
+		{"#ui", []string{"test-1", "test-3"}, "Label: ui"},
+		{"#ui #urgent", []string{"test-1", "test-2", "test-3"}, "Label: ui,urgent"},
+		{"#urgent+#UI", []string{"test-3"}, "Label: ui+urgent"},
+		{"#ui+urgent", []string{"test-3"}, "Label: ui+urgent"},
+	}
+	for _, tt := range tests {
+		t.Run(tt.query, func(t *testing.T) {
+			state.ApplyFilterQuery(tt.query)
+			var got []string
+			for _, issue := range state.GetReadyIssues() {
+				got = append(got, issue.ID)
+			}
+			sort.Strings(got)
+			if !reflect.DeepEqual(got, tt.want) {
+				t.Errorf("ApplyFilterQuery(%q) matched %v, want %v", tt.query, got, tt.want)
+			}
+			if display := state.GetActiveFilters(); display != tt.display {
+				t.Errorf("expected filter display %q, got %q", tt.display, display)
+			}
+		})
+	}
+
+	state.ClearAllFilters()
+	if state.LabelMatchAll() {
+		t.Error("expected ClearAllFilters to reset label matching to any")
+	}
+}
+
+func TestFilterByEstimate(t *testing.T) {
+	state := New()
+
+	minutes := func(m int) *int { return &m }
+	issues := []*parser.Issue{
+		{ID: "test-1", Title: "Quick", Status: parser.StatusOpen, Priority: 3, EstimatedMinutes: minutes(30), CreatedAt: time.Now(), UpdatedAt: time.Now()},
+		{ID: "test-2", Title: "Half day", Status: parser.StatusOpen, Priority: 3, EstimatedMinutes: minutes(240), CreatedAt: time.Now(), UpdatedAt: time.Now()},
+		{ID: "test-3", Title: "Huge", Status: parser.StatusOpen, Priority: 0, EstimatedMinutes: minutes(3000), CreatedAt: time.Now(), UpdatedAt: time.Now()},
+		{ID: "test-4", Title: "Unestimated", Status: parser.StatusOpen, Priority: 0, CreatedAt: time.Now(), UpdatedAt: time.Now()},
+	}
+	state.LoadIssues(issues)
+
+	wantBuckets := []string{"1h", "4h", "24h+", EstimateNone}
+	for i, issue := range issues {
+		if got := EstimateBucketOf(issue); got != wantBuckets[i] {
+			t.Errorf("EstimateBucketOf(%s) = %q, want %q", issue.ID, got, wantBuckets[i])
+		}
+	}
+
+	state.ApplyFilterQuery("p0 est:24h+ est:bogus")
+	readyIssues := state.GetReadyIssues()
+	if len(readyIssues) != 1 || readyIssues[0].ID != "test-3" {
+		t.Fatalf("expected only test-3 with p0 est:24h+, got %d issues", len(readyIssues))
+	}
+	if filterStr := state.GetActiveFilters(); filterStr != "Priority: P0 | Estimate: >24h" {
+		t.Errorf("unexpected filter description %q", filterStr)
+	}
+
+	state.ApplyFilterQuery("est:none est:1h")
+	if got := len(state.GetReadyIssues()); got != 2 {
+		t.Errorf("expected 2 issues with est:none,est:1h, got %d", got)
+	}
+
+	state.ToggleEstimateFilter("none")
+	state.ToggleEstimateFilter("1h")
+	if state.HasActiveFilters() {
+		t.Error("expected estimate filter to be removed when emptied")
+	}
+}
+
+func TestFilterQueryRoundTrip(t *testing.T) {
+	state := New()
+	if got := state.FilterQuery(); got != "" {
+		t.Errorf("FilterQuery() with no filters = %q, want empty", got)
+	}
+
+	for _, query := range []string{
+		"p0 p1 bug epic in_progress #docs #ui @alice est:1h due:overdue blocked-by:tui-abc blocks:tui-xyz no-deps has-children",
+		"#ui+#urgent",
+		"closed",
+		"@bob title:login !#ui+#urgent !chore !p0",
+	} {
+		state.ApplyFilterQuery(query)
+		if got := state.FilterQuery(); got != query {
+			t.Errorf("FilterQuery() after applying %q = %q", query, got)
+		}
+	}
+
+	state.ClearAllFilters()
+	if got := state.FilterQuery(); got != "" {
+		t.Errorf("FilterQuery() after clearing = %q, want empty", got)
+	}
+}
+
+func TestFilterSpec(t *testing.T) {
+	state := New()
+	if spec := state.FilterSpec(); !spec.IsEmpty() {
+		t.Errorf("expected an empty spec with no filters, got %+v", spec)
+	}
+
+	state.ApplyFilterQuery("p1 p0 bug open #UI+#docs @alice !chore")
+	want := storage.FilterSpec{
+		Priorities:    []int{0, 1},
+		Types:         []parser.IssueType{parser.TypeBug},
+		Statuses:      []parser.Status{parser.StatusOpen},
+		Labels:        []string{"docs", "ui"},
+		LabelMatchAll: true,
+	}
+	if spec := state.FilterSpec(); !reflect.DeepEqual(spec, want) {
+		t.Errorf("expected %+v, got %+v", want, spec)
+	}
+
+	// Filters the query can't apply leave the spec empty
+	state.ApplyFilterQuery("@alice title:login no-deps")
+	if spec := state.FilterSpec(); !spec.IsEmpty() {
+		t.Errorf("expected an empty spec, got %+v", spec)
+	}
+}
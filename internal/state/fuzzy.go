@@ -0,0 +1,116 @@
+package state
+
+import (
+	"sort"
+	"strings"
+	"unicode"
+
+	"github.com/andy/beads-tui/internal/parser"
+)
+
+// FuzzyMatch is one issue matched by FuzzyFind
+type FuzzyMatch struct {
+	Issue *parser.Issue
+	Score int
+}
+
+// Fuzzy scoring: every matched character scores, with bonuses for runs of
+// consecutive characters and for characters that start a word, and a small
+// penalty for the characters skipped between matches
+const (
+	fuzzyMatchScore       = 1
+	fuzzyConsecutiveBonus = 5
+	fuzzyWordStartBonus   = 3
+	fuzzyFirstCharBonus   = 6
+	fuzzyMaxGapPenalty    = 3
+	// fuzzyIDWeight favors ID matches, which are usually typed on purpose
+	fuzzyIDWeight = 2
+)
+
+// FuzzyScore reports whether every character of pattern appears in text in
+// order (ignoring case and spaces in the pattern), and how well it matches.
+// Higher scores mean tighter matches; "tl" scores higher against "Tree List"
+// than against "tabular".
+func FuzzyScore(pattern, text string) (int, bool) {
+	needle := []rune(strings.ToLower(strings.ReplaceAll(pattern, " ", "")))
+	if len(needle) == 0 {
+		return 0, true
+	}
+	haystack := []rune(text)
+
+	score, matched, last := 0, 0, -1
+	for i := 0; i < len(haystack) && matched < len(needle); i++ {
+		if unicode.ToLower(haystack[i]) != needle[matched] {
+			continue
+		}
+		score += fuzzyMatchScore
+		switch {
+		case i == 0:
+			score += fuzzyFirstCharBonus
+		case !unicode.IsLetter(haystack[i-1]) && !unicode.IsDigit(haystack[i-1]),
+			unicode.IsUpper(haystack[i]) && unicode.IsLower(haystack[i-1]):
+			score += fuzzyWordStartBonus
+		}
+		if last >= 0 {
+			if i == last+1 {
+				score += fuzzyConsecutiveBonus
+			} else {
+				score -= min(i-last-1, fuzzyMaxGapPenalty)
+			}
+		}
+		last = i
+		matched++
+	}
+	if matched < len(needle) {
+		return 0, false
+	}
+	return score, true
+}
+
+// FuzzyFind matches query against every issue's ID and title, including
+// closed issues and ones hidden by filters, best match first. An empty query
+// returns the most recently updated issues. At most limit matches are
+// returned (all of them if limit <= 0).
+func (s *State) FuzzyFind(query string, limit int) []FuzzyMatch {
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+	query = strings.TrimSpace(query)
+
+	var matches []FuzzyMatch
+	for _, issue := range s.issues {
+		if query == "" {
+			matches = append(matches, FuzzyMatch{Issue: issue})
+			continue
+		}
+		best, found := 0, false
+		if score, ok := FuzzyScore(query, issue.ID); ok {
+			best, found = score*fuzzyIDWeight, true
+		}
+		if score, ok := FuzzyScore(query, issue.Title); ok && (!found || score > best) {
+			best, found = score, true
+		}
+		if found {
+			matches = append(matches, FuzzyMatch{Issue: issue, Score: best})
+		}
+	}
+
+	sort.SliceStable(matches, func(i, j int) bool {
+		a, b := matches[i], matches[j]
+		if a.Score != b.Score {
+			return a.Score > b.Score
+		}
+		// Open work before closed, then most recently updated
+		if closedA, closedB := a.Issue.Status == parser.StatusClosed, b.Issue.Status == parser.StatusClosed; closedA != closedB {
+			return closedB
+		}
+		if !a.Issue.UpdatedAt.Equal(b.Issue.UpdatedAt) {
+			return a.Issue.UpdatedAt.After(b.Issue.UpdatedAt)
+		}
+		return a.Issue.ID < b.Issue.ID
+	})
+
+	if limit > 0 && len(matches) > limit {
+		matches = matches[:limit]
+	}
+	return matches
+}
@@ -0,0 +1,73 @@
+package state
+
+import (
+	"reflect"
+	"testing"
+	"time"
+
+	"github.com/andy/beads-tui/internal/parser"
+)
+
+func TestFuzzyScore(t *testing.T) {
+	if _, ok := FuzzyScore("xyz", "Tree List"); ok {
+		t.Error("expected missing characters not to match")
+	}
+	if _, ok := FuzzyScore("il", "Tree List"); ok {
+		t.Error("expected out-of-order characters not to match")
+	}
+	if score, ok := FuzzyScore("", "anything"); !ok || score != 0 {
+		t.Errorf("expected an empty pattern to match with 0, got %d %v", score, ok)
+	}
+
+	words, _ := FuzzyScore("tl", "Tree List")
+	scattered, _ := FuzzyScore("tl", "tabular")
+	if words <= scattered {
+		t.Errorf("expected word starts to outrank a scattered match: %d vs %d", words, scattered)
+	}
+	run, _ := FuzzyScore("list", "Tree List")
+	spread, _ := FuzzyScore("list", "lots in store")
+	if run <= spread {
+		t.Errorf("expected a consecutive run to outrank a spread match: %d vs %d", run, spread)
+	}
+	if _, ok := FuzzyScore("tree list", "TreeList"); !ok {
+		t.Error("expected spaces in the pattern to be ignored")
+	}
+}
+
+func TestFuzzyFind(t *testing.T) {
+	now := time.Now()
+	state := New()
+	state.LoadIssues([]*parser.Issue{
+		{ID: "tui-1", Title: "Fix login timeout", Status: parser.StatusOpen, UpdatedAt: now.Add(-time.Hour)},
+		{ID: "tui-2", Title: "Tree list folding", Status: parser.StatusClosed, UpdatedAt: now},
+		{ID: "tui-3", Title: "Tabular export", Status: parser.StatusOpen, UpdatedAt: now.Add(-2 * time.Hour)},
+		{ID: "tui-12", Title: "Theme loader", Status: parser.StatusOpen, UpdatedAt: now.Add(-3 * time.Hour)},
+	})
+
+	ids := func(matches []FuzzyMatch) []string {
+		var ids []string
+		for _, match := range matches {
+			ids = append(ids, match.Issue.ID)
+		}
+		return ids
+	}
+
+	tests := []struct {
+		query string
+		limit int
+		want  []string
+	}{
+		{"", 0, []string{"tui-1", "tui-3", "tui-12", "tui-2"}}, // open first, then most recent
+		{"", 2, []string{"tui-1", "tui-3"}},
+		{"tree lst", 0, []string{"tui-2"}},              // closed issues are included
+		{"tl", 0, []string{"tui-12", "tui-2", "tui-3"}}, // word starts tie; open first
+		{"tui12", 0, []string{"tui-12"}},
+		{"login", 0, []string{"tui-1"}},
+		{"nothing", 0, nil},
+	}
+	for _, tt := range tests {
+		if got := ids(state.FuzzyFind(tt.query, tt.limit)); !reflect.DeepEqual(got, tt.want) {
+			t.Errorf("FuzzyFind(%q, %d) = %v, want %v", tt.query, tt.limit, got, tt.want)
+		}
+	}
+}
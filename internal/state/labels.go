@@ -0,0 +1,63 @@
+package state
+
+import (
+	"sort"
+	"strings"
+
+	"github.com/andy/beads-tui/internal/parser"
+)
+
+// LabelCount is how many loaded issues carry a label, open (any status but
+// closed) and closed
+type LabelCount struct {
+	Name   string
+	Open   int
+	Closed int
+}
+
+// Total returns the number of issues with the label
+func (c LabelCount) Total() int {
+	return c.Open + c.Closed
+}
+
+// Labels returns every label on a loaded issue with its counts, by name.
+// Labels that differ only in case are counted together (like the label
+// filter), under the spelling seen first. Filters don't apply.
+func (s *State) Labels() []LabelCount {
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+	return s.labelIndex
+}
+
+// indexLabels counts the issues per label
+func (s *State) indexLabels() {
+	counts := make(map[string]*LabelCount)
+	for _, issue := range s.issues {
+		seen := make(map[string]bool)
+		for _, label := range issue.Labels {
+			key := strings.ToLower(label)
+			if seen[key] {
+				continue
+			}
+			seen[key] = true
+			count, ok := counts[key]
+			if !ok {
+				count = &LabelCount{Name: label}
+				counts[key] = count
+			}
+			if issue.Status == parser.StatusClosed {
+				count.Closed++
+			} else {
+				count.Open++
+			}
+		}
+	}
+
+	s.labelIndex = make([]LabelCount, 0, len(counts))
+	for _, count := range counts {
+		s.labelIndex = append(s.labelIndex, *count)
+	}
+	sort.Slice(s.labelIndex, func(i, j int) bool {
+		return strings.ToLower(s.labelIndex[i].Name) < strings.ToLower(s.labelIndex[j].Name)
+	})
+}
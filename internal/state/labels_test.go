@@ -0,0 +1,36 @@
+package state
+
+import (
+	"reflect"
+	"testing"
+
+	"github.com/andy/beads-tui/internal/parser"
+)
+
+func TestLabels(t *testing.T) {
+	state := New()
+	state.LoadIssues([]*parser.Issue{
+		{ID: "tui-1", Status: parser.StatusOpen, Labels: []string{"ui", "urgent"}},
+		{ID: "tui-2", Status: parser.StatusClosed, Labels: []string{"UI"}},
+		{ID: "tui-3", Status: parser.StatusInProgress, Labels: []string{"backend", "ui", "Ui"}}, // Counted once
+		{ID: "tui-4", Status: parser.StatusOpen},
+	})
+
+	want := []LabelCount{
+		{Name: "backend", Open: 1},
+		{Name: "ui", Open: 2, Closed: 1},
+		{Name: "urgent", Open: 1},
+	}
+	if got := state.Labels(); !reflect.DeepEqual(got, want) {
+		t.Errorf("Labels() = %+v, want %+v", got, want)
+	}
+	if total := state.Labels()[1].Total(); total != 3 {
+		t.Errorf("ui total = %d, want 3", total)
+	}
+
+	// Rebuilt on every load
+	state.LoadIssues([]*parser.Issue{{ID: "tui-5", Status: parser.StatusOpen}})
+	if got := state.Labels(); len(got) != 0 {
+		t.Errorf("expected no labels after reload, got %+v", got)
+	}
+}
@@ -0,0 +1,145 @@
+package state
+
+import (
+	"strings"
+
+	"github.com/andy/beads-tui/internal/parser"
+)
+
+// Progress is how many of an epic's children are closed, and how much of
+// the work estimated on its descendants (children, their children, and so
+// on) is closed
+type Progress struct {
+	Closed int
+	Total  int
+
+	EstimatedMinutes       int // Estimates of every descendant
+	ClosedEstimatedMinutes int // Estimates of the closed descendants
+}
+
+// Percent returns the closed share of the children, 0-100
+func (p Progress) Percent() int {
+	if p.Total == 0 {
+		return 0
+	}
+	return p.Closed * 100 / p.Total
+}
+
+// indexEpicProgress counts the closed children of each epic. Children are the
+// issues with a parent-child dependency on the epic plus its children by ID
+// convention (nearest existing ancestor, as in GetIDChildren); an issue that
+// is both is counted once. The children are kept for rolling up logged time
+// and estimates.
+func (s *State) indexEpicProgress() {
+	s.epicProgress = make(map[string]Progress)
+
+	children := make(map[string]map[string]bool) // parent ID -> child IDs
+	s.childIDs = children
+	addChild := func(parentID, childID string) {
+		if children[parentID] == nil {
+			children[parentID] = make(map[string]bool)
+		}
+		children[parentID][childID] = true
+	}
+	for _, issue := range s.issues {
+		for _, dep := range issue.Dependencies {
+			if dep.Type == parser.DepParentChild {
+				addChild(dep.DependsOnID, issue.ID)
+			}
+		}
+		for i := strings.LastIndex(issue.ID, "."); i > 0; i = strings.LastIndex(issue.ID[:i], ".") {
+			if _, ok := s.issuesByID[issue.ID[:i]]; ok {
+				addChild(issue.ID[:i], issue.ID)
+				break
+			}
+		}
+	}
+
+	for parentID, childIDs := range children {
+		parent := s.issuesByID[parentID]
+		if parent == nil || parent.IssueType != parser.TypeEpic {
+			continue
+		}
+		var progress Progress
+		for childID := range childIDs {
+			progress.Total++
+			if s.issuesByID[childID].Status == parser.StatusClosed {
+				progress.Closed++
+			}
+		}
+		progress.EstimatedMinutes, progress.ClosedEstimatedMinutes = s.rollUpEstimates(parentID)
+		s.epicProgress[parentID] = progress
+	}
+}
+
+// rollUpEstimates sums the estimates of an issue's descendants, all of them
+// and the closed ones. Each descendant counts once, however it's reached.
+func (s *State) rollUpEstimates(issueID string) (total, closed int) {
+	visited := map[string]bool{issueID: true}
+	var walk func(id string)
+	walk = func(id string) {
+		for childID := range s.childIDs[id] {
+			if visited[childID] {
+				continue
+			}
+			visited[childID] = true
+			if child := s.issuesByID[childID]; child.EstimatedMinutes != nil && *child.EstimatedMinutes > 0 {
+				total += *child.EstimatedMinutes
+				if child.Status == parser.StatusClosed {
+					closed += *child.EstimatedMinutes
+				}
+			}
+			walk(childID)
+		}
+	}
+	walk(issueID)
+	return total, closed
+}
+
+// EpicProgress returns the child completion of an epic. ok is false for
+// issues that aren't epics or have no children.
+func (s *State) EpicProgress(issueID string) (Progress, bool) {
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+	progress, ok := s.epicProgress[issueID]
+	return progress, ok
+}
+
+// SetLoggedMinutes replaces the time logged per issue ID (from the worklog)
+func (s *State) SetLoggedMinutes(totals map[string]int) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	s.loggedMinutes = totals
+}
+
+// TimeSpent is the time logged on an issue, in minutes. For epics, Total
+// adds the time logged on every descendant; for other issues it equals Own.
+type TimeSpent struct {
+	Own   int
+	Total int
+}
+
+// LoggedMinutes returns the time logged on an issue
+func (s *State) LoggedMinutes(issueID string) TimeSpent {
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+	own := s.loggedMinutes[issueID]
+	issue := s.issuesByID[issueID]
+	if issue == nil || issue.IssueType != parser.TypeEpic {
+		return TimeSpent{Own: own, Total: own}
+	}
+
+	visited := map[string]bool{issueID: true}
+	var sum func(id string) int
+	sum = func(id string) int {
+		minutes := s.loggedMinutes[id]
+		for childID := range s.childIDs[id] {
+			if !visited[childID] {
+				visited[childID] = true
+				minutes += sum(childID)
+			}
+		}
+		return minutes
+	}
+	return TimeSpent{Own: own, Total: sum(issueID)}
+}
@@ -0,0 +1,86 @@
+package state
+
+import (
+	"testing"
+
+	"github.com/andy/beads-tui/internal/parser"
+)
+
+func TestEpicProgress(t *testing.T) {
+	childOf := func(parentID string) []*parser.Dependency {
+		return []*parser.Dependency{{DependsOnID: parentID, Type: parser.DepParentChild}}
+	}
+	state := New()
+	state.LoadIssues([]*parser.Issue{
+		{ID: "tui-1", Title: "Epic", IssueType: parser.TypeEpic, Status: parser.StatusOpen},
+		{ID: "tui-1.1", Title: "ID child", Status: parser.StatusClosed},
+		{ID: "tui-1.1.1", Title: "Grandchild", Status: parser.StatusClosed},                       // belongs to tui-1.1
+		{ID: "tui-1.2", Title: "Both", Status: parser.StatusOpen, Dependencies: childOf("tui-1")}, // counted once
+		{ID: "tui-2", Title: "Dep child", Status: parser.StatusClosed, Dependencies: childOf("tui-1")},
+		{ID: "tui-3", Title: "Feature parent", IssueType: parser.TypeFeature, Status: parser.StatusOpen},
+		{ID: "tui-4", Title: "Feature child", Status: parser.StatusOpen, Dependencies: childOf("tui-3")},
+		{ID: "tui-5", Title: "Empty epic", IssueType: parser.TypeEpic, Status: parser.StatusOpen},
+	})
+
+	progress, ok := state.EpicProgress("tui-1")
+	if !ok || progress != (Progress{Closed: 2, Total: 3}) {
+		t.Errorf("expected 2/3 for the epic, got %+v (ok=%v)", progress, ok)
+	}
+	if progress.Percent() != 66 {
+		t.Errorf("expected 66%%, got %d", progress.Percent())
+	}
+	if _, ok := state.EpicProgress("tui-3"); ok {
+		t.Error("expected no progress for a non-epic parent")
+	}
+	if _, ok := state.EpicProgress("tui-5"); ok {
+		t.Error("expected no progress for an epic without children")
+	}
+}
+
+func TestLoggedMinutesRollsUpForEpics(t *testing.T) {
+	state := New()
+	state.LoadIssues([]*parser.Issue{
+		{ID: "tui-1", IssueType: parser.TypeEpic},
+		{ID: "tui-1.1", IssueType: parser.TypeFeature},
+		{ID: "tui-1.1.1", IssueType: parser.TypeTask},
+		{ID: "tui-2", IssueType: parser.TypeTask, Dependencies: []*parser.Dependency{{DependsOnID: "tui-1", Type: parser.DepParentChild}}},
+	})
+	state.SetLoggedMinutes(map[string]int{"tui-1": 10, "tui-1.1": 20, "tui-1.1.1": 30, "tui-2": 40})
+
+	if got := state.LoggedMinutes("tui-1"); got != (TimeSpent{Own: 10, Total: 100}) {
+		t.Errorf("expected the epic to roll up its descendants, got %+v", got)
+	}
+	if got := state.LoggedMinutes("tui-1.1"); got != (TimeSpent{Own: 20, Total: 20}) {
+		t.Errorf("expected only the feature's own time, got %+v", got)
+	}
+	if got := state.LoggedMinutes("tui-9"); got != (TimeSpent{}) {
+		t.Errorf("expected nothing for an unknown issue, got %+v", got)
+	}
+}
+
+func TestEpicProgressRollsUpEstimates(t *testing.T) {
+	minutes := func(m int) *int { return &m }
+	state := New()
+	state.LoadIssues([]*parser.Issue{
+		{ID: "tui-1", IssueType: parser.TypeEpic, EstimatedMinutes: minutes(600)}, // its own estimate isn't rolled up
+		{ID: "tui-1.1", IssueType: parser.TypeFeature, Status: parser.StatusOpen, EstimatedMinutes: minutes(120)},
+		{ID: "tui-1.1.1", IssueType: parser.TypeTask, Status: parser.StatusClosed, EstimatedMinutes: minutes(60)},
+		{ID: "tui-1.2", IssueType: parser.TypeTask, Status: parser.StatusOpen}, // no estimate
+		{ID: "tui-2", IssueType: parser.TypeTask, Status: parser.StatusClosed, EstimatedMinutes: minutes(30),
+			Dependencies: []*parser.Dependency{{DependsOnID: "tui-1.1", Type: parser.DepParentChild}}},
+		{ID: "tui-3", IssueType: parser.TypeEpic},
+		{ID: "tui-3.1", IssueType: parser.TypeTask, Status: parser.StatusOpen},
+	})
+
+	progress, ok := state.EpicProgress("tui-1")
+	if !ok {
+		t.Fatal("expected progress for the epic")
+	}
+	if progress.EstimatedMinutes != 210 || progress.ClosedEstimatedMinutes != 90 {
+		t.Errorf("expected 90 of 210 estimated minutes closed, got %d of %d",
+			progress.ClosedEstimatedMinutes, progress.EstimatedMinutes)
+	}
+	if progress, _ := state.EpicProgress("tui-3"); progress.EstimatedMinutes != 0 {
+		t.Errorf("expected no estimates for an epic without estimated children, got %d", progress.EstimatedMinutes)
+	}
+}
@@ -0,0 +1,230 @@
+package state
+
+import (
+	"sort"
+	"strings"
+	"unicode"
+
+	"github.com/andy/beads-tui/internal/parser"
+)
+
+// SearchField identifies an indexed issue field
+type SearchField string
+
+const (
+	FieldID          SearchField = "id"
+	FieldTitle       SearchField = "title"
+	FieldDescription SearchField = "description"
+	FieldDesign      SearchField = "design"
+	FieldAcceptance  SearchField = "acceptance"
+	FieldNotes       SearchField = "notes"
+	FieldComments    SearchField = "comments"
+	FieldLabels      SearchField = "labels"
+)
+
+// searchFields lists indexed fields in display order, with their ranking weight
+var searchFields = []struct {
+	field  SearchField
+	weight int
+}{
+	{FieldID, 10},
+	{FieldTitle, 8},
+	{FieldLabels, 5},
+	{FieldDescription, 3},
+	{FieldAcceptance, 2},
+	{FieldDesign, 2},
+	{FieldNotes, 2},
+	{FieldComments, 1},
+}
+
+// searchFieldPrefixes maps query prefixes (e.g. "desc:") to fields
+var searchFieldPrefixes = map[string]SearchField{
+	"id":          FieldID,
+	"title":       FieldTitle,
+	"desc":        FieldDescription,
+	"description": FieldDescription,
+	"design":      FieldDesign,
+	"ac":          FieldAcceptance,
+	"acceptance":  FieldAcceptance,
+	"notes":       FieldNotes,
+	"note":        FieldNotes,
+	"comment":     FieldComments,
+	"comments":    FieldComments,
+	"label":       FieldLabels,
+	"labels":      FieldLabels,
+}
+
+// maxOccurrenceScore caps how many repeats of a term count toward ranking, so
+// a long description mentioning a word many times doesn't outrank a title hit
+const maxOccurrenceScore = 3
+
+// SearchResult is one issue matching a search query
+type SearchResult struct {
+	Issue  *parser.Issue
+	Score  int
+	Fields []SearchField // Fields that matched, in display order
+}
+
+// searchTerm is one parsed query term; an empty field matches any field
+type searchTerm struct {
+	field SearchField
+	text  string
+}
+
+// buildSearchIndex lowercases each issue's searchable fields (called in LoadIssues)
+func (s *State) buildSearchIndex() {
+	s.searchIndex = make(map[string]map[SearchField]string, len(s.issues))
+	for _, issue := range s.issues {
+		var comments []string
+		for _, comment := range issue.Comments {
+			if comment != nil {
+				comments = append(comments, comment.Text)
+			}
+		}
+		s.searchIndex[issue.ID] = map[SearchField]string{
+			FieldID:          strings.ToLower(issue.ID),
+			FieldTitle:       strings.ToLower(issue.Title),
+			FieldDescription: strings.ToLower(issue.Description),
+			FieldDesign:      strings.ToLower(issue.Design),
+			FieldAcceptance:  strings.ToLower(issue.AcceptanceCriteria),
+			FieldNotes:       strings.ToLower(issue.Notes),
+			FieldComments:    strings.ToLower(strings.Join(comments, "\n")),
+			FieldLabels:      strings.ToLower(strings.Join(issue.Labels, "\n")),
+		}
+	}
+}
+
+// SetCommentSearcher makes Search count comment matches with searcher
+// (matching comments per issue ID) instead of the comments loaded with the
+// issues, for when issues are loaded without their comments
+func (s *State) SetCommentSearcher(searcher func(text string) map[string]int) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	s.commentSearcher = searcher
+}
+
+// parseSearchQuery splits a query into terms. Terms are separated by spaces;
+// double quotes group a phrase, and a known prefix such as "desc:" or
+// "comment:" restricts the term to one field.
+func parseSearchQuery(query string) []searchTerm {
+	var raw []string
+	var current strings.Builder
+	inQuotes := false
+	for _, r := range strings.ToLower(query) {
+		switch {
+		case r == '"':
+			inQuotes = !inQuotes
+		case unicode.IsSpace(r) && !inQuotes:
+			if current.Len() > 0 {
+				raw = append(raw, current.String())
+				current.Reset()
+			}
+		default:
+			current.WriteRune(r)
+		}
+	}
+	if current.Len() > 0 {
+		raw = append(raw, current.String())
+	}
+
+	var terms []searchTerm
+	for _, token := range raw {
+		term := searchTerm{text: token}
+		if prefix, rest, ok := strings.Cut(token, ":"); ok {
+			if field, known := searchFieldPrefixes[prefix]; known {
+				term = searchTerm{field: field, text: rest}
+			}
+		}
+		if strings.TrimSpace(term.text) != "" {
+			terms = append(terms, term)
+		}
+	}
+	return terms
+}
+
+// Search returns all issues (ignoring filters) matching every term in the
+// query, best match first. Matches are case-insensitive substrings, ranked by
+// field weight (ID and title highest, comments lowest) and occurrence count.
+// Ties are broken by priority, then ID.
+func (s *State) Search(query string) []SearchResult {
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+	terms := parseSearchQuery(query)
+	if len(terms) == 0 {
+		return nil
+	}
+
+	// Comments may live only in the database; ask it once per term
+	commentHits := make([]map[string]int, len(terms))
+	if s.commentSearcher != nil {
+		for i, term := range terms {
+			if term.field == "" || term.field == FieldComments {
+				commentHits[i] = s.commentSearcher(term.text)
+			}
+		}
+	}
+
+	var results []SearchResult
+	for _, issue := range s.issues {
+		fields := s.searchIndex[issue.ID]
+		if fields == nil {
+			continue
+		}
+
+		score := 0
+		matched := make(map[SearchField]bool)
+		allMatched := true
+		for i, term := range terms {
+			termScore := 0
+			for _, sf := range searchFields {
+				if term.field != "" && term.field != sf.field {
+					continue
+				}
+				count := strings.Count(fields[sf.field], term.text)
+				if sf.field == FieldComments && commentHits[i] != nil {
+					count = commentHits[i][issue.ID]
+				}
+				if count == 0 {
+					continue
+				}
+				termScore += sf.weight * min(count, maxOccurrenceScore)
+				matched[sf.field] = true
+			}
+			if termScore == 0 {
+				allMatched = false
+				break
+			}
+			score += termScore
+		}
+		if !allMatched {
+			continue
+		}
+
+		// Exact ID or title match ranks first
+		if len(terms) == 1 {
+			text := terms[0].text
+			if fields[FieldID] == text || fields[FieldTitle] == text {
+				score += 100
+			}
+		}
+
+		result := SearchResult{Issue: issue, Score: score}
+		for _, sf := range searchFields {
+			if matched[sf.field] {
+				result.Fields = append(result.Fields, sf.field)
+			}
+		}
+		results = append(results, result)
+	}
+
+	sort.SliceStable(results, func(i, j int) bool {
+		if results[i].Score != results[j].Score {
+			return results[i].Score > results[j].Score
+		}
+		if results[i].Issue.Priority != results[j].Issue.Priority {
+			return results[i].Issue.Priority < results[j].Issue.Priority
+		}
+		return results[i].Issue.ID < results[j].Issue.ID
+	})
+	return results
+}
@@ -0,0 +1,100 @@
+package state
+
+import (
+	"reflect"
+	"testing"
+
+	"github.com/andy/beads-tui/internal/parser"
+)
+
+func searchIDs(results []SearchResult) []string {
+	var ids []string
+	for _, result := range results {
+		ids = append(ids, result.Issue.ID)
+	}
+	return ids
+}
+
+func TestSearch(t *testing.T) {
+	state := New()
+	state.LoadIssues([]*parser.Issue{
+		{ID: "tui-1", Title: "Fix login timeout", Status: parser.StatusOpen, Priority: 2, IssueType: parser.TypeBug},
+		{ID: "tui-2", Title: "Refactor session code", Description: "The login flow holds a lock during timeout handling", Status: parser.StatusOpen, Priority: 1, IssueType: parser.TypeTask},
+		{ID: "tui-3", Title: "Docs", Notes: "mention login", Labels: []string{"Docs"}, Status: parser.StatusClosed, Priority: 3, IssueType: parser.TypeChore,
+			Comments: []*parser.Comment{{Text: "Waiting on the Timeout fix"}}},
+		{ID: "tui-4", Title: "Unrelated", Design: "plain design", AcceptanceCriteria: "works offline", Status: parser.StatusOpen, Priority: 2, IssueType: parser.TypeTask},
+	})
+
+	tests := []struct {
+		query string
+		want  []string
+	}{
+		{"login", []string{"tui-1", "tui-2", "tui-3"}}, // title outranks description outranks notes
+		{"LOGIN timeout", []string{"tui-1", "tui-2", "tui-3"}},
+		{"desc:login", []string{"tui-2"}},
+		{"comment:timeout", []string{"tui-3"}},
+		{"label:docs", []string{"tui-3"}},
+		{"design:plain ac:offline", []string{"tui-4"}},
+		{`"holds a lock"`, []string{"tui-2"}},
+		{"tui-4", []string{"tui-4"}},
+		{"title:login desc:login", nil},
+		{"nomatch", nil},
+		{"   ", nil},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.query, func(t *testing.T) {
+			if got := searchIDs(state.Search(tt.query)); !reflect.DeepEqual(got, tt.want) {
+				t.Errorf("Search(%q) = %v, want %v", tt.query, got, tt.want)
+			}
+		})
+	}
+}
+
+func TestSearchReportsMatchedFields(t *testing.T) {
+	state := New()
+	state.LoadIssues([]*parser.Issue{
+		{ID: "tui-1", Title: "Cache", Description: "cache eviction", Comments: []*parser.Comment{{Text: "cache is slow"}}},
+	})
+
+	results := state.Search("cache")
+	if len(results) != 1 {
+		t.Fatalf("expected 1 result, got %d", len(results))
+	}
+	want := []SearchField{FieldTitle, FieldDescription, FieldComments}
+	if !reflect.DeepEqual(results[0].Fields, want) {
+		t.Errorf("expected fields %v, got %v", want, results[0].Fields)
+	}
+
+	// An unknown prefix is treated as literal text
+	if got := state.Search("foo:cache"); len(got) != 0 {
+		t.Errorf("expected no results for unknown prefix, got %v", searchIDs(got))
+	}
+}
+
+func TestSearchWithCommentSearcher(t *testing.T) {
+	state := New()
+	state.LoadIssues([]*parser.Issue{
+		{ID: "tui-1", Title: "Cache"},
+		{ID: "tui-2", Title: "Other"},
+	})
+	var asked []string
+	state.SetCommentSearcher(func(text string) map[string]int {
+		asked = append(asked, text)
+		if text == "eviction" {
+			return map[string]int{"tui-2": 1}
+		}
+		return nil
+	})
+
+	if got := searchIDs(state.Search("comment:eviction")); !reflect.DeepEqual(got, []string{"tui-2"}) {
+		t.Errorf("expected the searcher's match, got %v", got)
+	}
+	if got := searchIDs(state.Search("title:cache")); !reflect.DeepEqual(got, []string{"tui-1"}) {
+		t.Errorf("expected a title match, got %v", got)
+	}
+	// Only terms that can match comments reach the searcher
+	if !reflect.DeepEqual(asked, []string{"eviction"}) {
+		t.Errorf("expected one comment search, got %v", asked)
+	}
+}
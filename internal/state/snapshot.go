@@ -0,0 +1,56 @@
+package state
+
+import "github.com/andy/beads-tui/internal/parser"
+
+// Categories is the issues split into the list's status sections
+type Categories struct {
+	InProgress []*parser.Issue
+	Ready      []*parser.Issue
+	Blocked    []*parser.Issue
+	Closed     []*parser.Issue
+}
+
+// Snapshot is the issue lists as of one moment. They're read together, so a
+// refresh loading issues on another goroutine can't land between them. The
+// lists are shared with the State and must not be modified.
+type Snapshot struct {
+	Categories                 // Filtered, like GetReadyIssues and the rest
+	Unfiltered Categories      // The same sections without the filters
+	All        []*parser.Issue // Every loaded issue, unfiltered
+	TreeNodes  []*TreeNode     // The dependency tree (nil in list view)
+}
+
+// Snapshot returns the categorized issue lists atomically
+func (s *State) Snapshot() Snapshot {
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+	snapshot := Snapshot{
+		Categories: Categories{
+			InProgress: s.applyFilters(s.inProgressIssues),
+			Ready:      s.applyFilters(s.readyIssues),
+			Blocked:    s.applyFilters(s.blockedIssues),
+			Closed:     s.applyFilters(s.closedIssues),
+		},
+		Unfiltered: Categories{
+			InProgress: s.inProgressIssues,
+			Ready:      s.readyIssues,
+			Blocked:    s.blockedIssues,
+			Closed:     s.closedIssues,
+		},
+		All: s.issues,
+	}
+	if s.viewMode == ViewTree {
+		snapshot.TreeNodes = s.treeNodes
+	}
+	return snapshot
+}
+
+// Visible returns how many issues the list shows: the unclosed ones that
+// pass the filters, plus the closed ones if showClosed
+func (snapshot Snapshot) Visible(showClosed bool) int {
+	count := len(snapshot.InProgress) + len(snapshot.Ready) + len(snapshot.Blocked)
+	if showClosed {
+		count += len(snapshot.Closed)
+	}
+	return count
+}
@@ -0,0 +1,127 @@
+package state
+
+import (
+	"fmt"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/andy/beads-tui/internal/parser"
+)
+
+// snapshotIssues makes n issues cycling through open, in progress, blocked,
+// and closed, with every fifth one blocked by the issue before it
+func snapshotIssues(n int) []*parser.Issue {
+	statuses := []parser.Status{parser.StatusOpen, parser.StatusInProgress, parser.StatusBlocked, parser.StatusClosed}
+	issues := make([]*parser.Issue, n)
+	for i := range issues {
+		issues[i] = &parser.Issue{
+			ID:        fmt.Sprintf("snap-%d", i),
+			Title:     fmt.Sprintf("Issue %d", i),
+			Status:    statuses[i%len(statuses)],
+			Priority:  i % 5,
+			IssueType: parser.TypeTask,
+			CreatedAt: time.Now().Add(-time.Duration(i) * time.Hour),
+		}
+		if i > 0 && i%5 == 0 {
+			issues[i].Dependencies = []*parser.Dependency{
+				{IssueID: issues[i].ID, DependsOnID: issues[i-1].ID, Type: parser.DepBlocks},
+			}
+		}
+	}
+	return issues
+}
+
+func TestSnapshot(t *testing.T) {
+	s := New()
+	s.LoadIssues(snapshotIssues(20))
+
+	snapshot := s.Snapshot()
+	if len(snapshot.All) != 20 {
+		t.Fatalf("Expected 20 issues, got %d", len(snapshot.All))
+	}
+	categorized := len(snapshot.InProgress) + len(snapshot.Ready) + len(snapshot.Blocked) + len(snapshot.Closed)
+	if categorized != 20 {
+		t.Errorf("Expected the categories to cover all 20 issues, got %d", categorized)
+	}
+	if got := snapshot.Visible(false); got != 15 {
+		t.Errorf("Expected 15 visible unclosed issues, got %d", got)
+	}
+	if got := snapshot.Visible(true); got != 20 {
+		t.Errorf("Expected 20 visible issues with closed shown, got %d", got)
+	}
+	if snapshot.TreeNodes != nil {
+		t.Error("Expected no tree nodes in list view")
+	}
+
+	s.TogglePriorityFilter(0)
+	filtered := s.Snapshot()
+	if got := filtered.Visible(true); got != 4 {
+		t.Errorf("Expected 4 P0 issues, got %d", got)
+	}
+	if got, want := len(filtered.Unfiltered.Ready), len(snapshot.Ready); got != want {
+		t.Errorf("Expected the unfiltered ready list to keep all %d issues, got %d", want, got)
+	}
+
+	s.SetViewMode(ViewTree)
+	if s.Snapshot().TreeNodes == nil {
+		t.Error("Expected tree nodes in tree view")
+	}
+}
+
+func TestSnapshotUnchangedBySort(t *testing.T) {
+	// A list handed out before the sort mode changes keeps its order
+	s := New()
+	s.LoadIssues(snapshotIssues(20))
+
+	ready := s.Snapshot().Ready
+	before := make([]string, len(ready))
+	for i, issue := range ready {
+		before[i] = issue.ID
+	}
+	s.SetSortMode(SortTitle)
+	for i, issue := range ready {
+		if issue.ID != before[i] {
+			t.Fatalf("Ready list changed after sorting: %v", before)
+		}
+	}
+}
+
+func TestStateConcurrentRefresh(t *testing.T) {
+	// Refreshes on one goroutine while another reads and changes the view,
+	// as the watcher and UI do (run with -race)
+	s := New()
+	s.LoadIssues(snapshotIssues(50))
+
+	var wg sync.WaitGroup
+	wg.Add(2)
+	go func() {
+		defer wg.Done()
+		for i := 0; i < 100; i++ {
+			s.LoadIssues(snapshotIssues(40 + i%20))
+			s.MarkUnseenChanges([]string{"snap-1"})
+			_ = s.Cycles()
+		}
+	}()
+	go func() {
+		defer wg.Done()
+		for i := 0; i < 100; i++ {
+			snapshot := s.Snapshot()
+			if got := len(snapshot.InProgress) + len(snapshot.Ready) + len(snapshot.Blocked) + len(snapshot.Closed); got != len(snapshot.All) {
+				t.Errorf("Snapshot categories cover %d of %d issues", got, len(snapshot.All))
+				return
+			}
+			s.ToggleViewMode()
+			s.ToggleCollapse("snap-0")
+			s.CycleSortMode()
+			s.ToggleStatusFilter(parser.StatusOpen)
+			_ = s.GetActiveFilters()
+			_ = s.GetReadyIssues()
+			s.ToggleStatusFilter(parser.StatusOpen)
+			_ = s.Search("issue")
+			_ = s.IsCollapsed("snap-5")
+			_ = s.GetIssueByID("snap-3")
+		}
+	}()
+	wg.Wait()
+}
@@ -0,0 +1,128 @@
+package state
+
+import (
+	"slices"
+	"sort"
+	"strings"
+
+	"github.com/andy/beads-tui/internal/parser"
+)
+
+// SortMode orders issues within each list view section
+type SortMode int
+
+const (
+	SortCreated  SortMode = iota // Newest first (the database order)
+	SortPriority                 // P0 first, then most recently updated
+	SortUpdated                  // Most recently updated first
+	SortID                       // Issue ID, A-Z
+	SortTitle                    // Title, A-Z (case-insensitive)
+	SortEstimate                 // Smallest estimate first, unestimated last
+	SortDue                      // Soonest due date first, undated last
+)
+
+// sortModeNames are the SortMode names shown in the status bar and saved in
+// preferences, in cycle order
+var sortModeNames = []string{"created", "priority", "updated", "id", "title", "estimate", "due"}
+
+// String returns the mode's name, e.g. "priority"
+func (m SortMode) String() string {
+	if m < 0 || int(m) >= len(sortModeNames) {
+		return sortModeNames[SortCreated]
+	}
+	return sortModeNames[m]
+}
+
+// ParseSortMode returns the mode with the given name
+func ParseSortMode(name string) (SortMode, bool) {
+	for i, modeName := range sortModeNames {
+		if modeName == strings.ToLower(name) {
+			return SortMode(i), true
+		}
+	}
+	return SortCreated, false
+}
+
+// SetSortMode sets the list ordering and re-sorts the issues
+func (s *State) SetSortMode(mode SortMode) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	s.sortMode = mode
+	s.sortCategorized()
+}
+
+// GetSortMode returns the current list ordering
+func (s *State) GetSortMode() SortMode {
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+	return s.sortMode
+}
+
+// CycleSortMode switches to the next ordering and returns it
+func (s *State) CycleSortMode() SortMode {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	s.sortMode = (s.sortMode + 1) % SortMode(len(sortModeNames))
+	s.sortCategorized()
+	return s.sortMode
+}
+
+// sortCategorized orders each status section by the current sort mode
+// (called in categorizeIssues and whenever the mode changes). The sections
+// are replaced with sorted copies, so lists already handed out don't change
+// under their readers.
+func (s *State) sortCategorized() {
+	for _, issues := range []*[]*parser.Issue{&s.readyIssues, &s.blockedIssues, &s.inProgressIssues, &s.closedIssues} {
+		*issues = slices.Clone(*issues)
+		sortIssues(*issues, s.sortMode)
+	}
+}
+
+// sortIssues sorts issues in place by mode. Ties are broken by newest
+// created, then ID, so the order is stable across refreshes.
+func sortIssues(issues []*parser.Issue, mode SortMode) {
+	sort.SliceStable(issues, func(i, j int) bool {
+		a, b := issues[i], issues[j]
+		switch mode {
+		case SortPriority:
+			if a.Priority != b.Priority {
+				return a.Priority < b.Priority
+			}
+			if !a.UpdatedAt.Equal(b.UpdatedAt) {
+				return a.UpdatedAt.After(b.UpdatedAt)
+			}
+		case SortUpdated:
+			if !a.UpdatedAt.Equal(b.UpdatedAt) {
+				return a.UpdatedAt.After(b.UpdatedAt)
+			}
+		case SortID:
+			if a.ID != b.ID {
+				return a.ID < b.ID
+			}
+		case SortTitle:
+			if titleA, titleB := strings.ToLower(a.Title), strings.ToLower(b.Title); titleA != titleB {
+				return titleA < titleB
+			}
+		case SortEstimate:
+			if a.EstimatedMinutes == nil || b.EstimatedMinutes == nil {
+				if (a.EstimatedMinutes == nil) != (b.EstimatedMinutes == nil) {
+					return a.EstimatedMinutes != nil
+				}
+			} else if *a.EstimatedMinutes != *b.EstimatedMinutes {
+				return *a.EstimatedMinutes < *b.EstimatedMinutes
+			}
+		case SortDue:
+			if a.DueDate == nil || b.DueDate == nil {
+				if (a.DueDate == nil) != (b.DueDate == nil) {
+					return a.DueDate != nil
+				}
+			} else if !a.DueDate.Equal(*b.DueDate) {
+				return a.DueDate.Before(*b.DueDate)
+			}
+		}
+		if !a.CreatedAt.Equal(b.CreatedAt) {
+			return a.CreatedAt.After(b.CreatedAt)
+		}
+		return a.ID < b.ID
+	})
+}
@@ -0,0 +1,71 @@
+package state
+
+import (
+	"reflect"
+	"testing"
+	"time"
+
+	"github.com/andy/beads-tui/internal/parser"
+)
+
+func issueIDs(issues []*parser.Issue) []string {
+	var ids []string
+	for _, issue := range issues {
+		ids = append(ids, issue.ID)
+	}
+	return ids
+}
+
+func TestSortModes(t *testing.T) {
+	base := time.Date(2026, 1, 1, 0, 0, 0, 0, time.UTC)
+	minutes := func(m int) *int { return &m }
+	day := func(d int) *time.Time { due := base.AddDate(0, 0, d); return &due }
+	state := New()
+	state.LoadIssues([]*parser.Issue{
+		{ID: "tui-b", Title: "beta", Status: parser.StatusOpen, Priority: 2, CreatedAt: base.Add(3 * time.Hour), UpdatedAt: base.Add(4 * time.Hour), EstimatedMinutes: minutes(60), DueDate: day(1)},
+		{ID: "tui-a", Title: "Alpha", Status: parser.StatusOpen, Priority: 0, CreatedAt: base.Add(1 * time.Hour), UpdatedAt: base.Add(9 * time.Hour), DueDate: day(2)},
+		{ID: "tui-c", Title: "gamma", Status: parser.StatusOpen, Priority: 2, CreatedAt: base.Add(2 * time.Hour), UpdatedAt: base.Add(5 * time.Hour), EstimatedMinutes: minutes(30)},
+	})
+
+	tests := []struct {
+		mode SortMode
+		want []string
+	}{
+		{SortCreated, []string{"tui-b", "tui-c", "tui-a"}},
+		{SortPriority, []string{"tui-a", "tui-c", "tui-b"}},
+		{SortUpdated, []string{"tui-a", "tui-c", "tui-b"}},
+		{SortID, []string{"tui-a", "tui-b", "tui-c"}},
+		{SortTitle, []string{"tui-a", "tui-b", "tui-c"}},
+		{SortEstimate, []string{"tui-c", "tui-b", "tui-a"}},
+		{SortDue, []string{"tui-b", "tui-a", "tui-c"}},
+	}
+	for _, tt := range tests {
+		t.Run(tt.mode.String(), func(t *testing.T) {
+			state.SetSortMode(tt.mode)
+			if got := issueIDs(state.GetReadyIssues()); !reflect.DeepEqual(got, tt.want) {
+				t.Errorf("sort %s = %v, want %v", tt.mode, got, tt.want)
+			}
+		})
+	}
+}
+
+func TestCycleSortMode(t *testing.T) {
+	state := New()
+	seen := map[SortMode]bool{state.GetSortMode(): true}
+	for i := 1; i < len(sortModeNames); i++ {
+		seen[state.CycleSortMode()] = true
+	}
+	if len(seen) != len(sortModeNames) {
+		t.Errorf("expected to visit all %d modes, visited %d", len(sortModeNames), len(seen))
+	}
+	if state.CycleSortMode() != SortCreated {
+		t.Errorf("expected cycling to wrap around to created")
+	}
+
+	if mode, ok := ParseSortMode("Priority"); !ok || mode != SortPriority {
+		t.Errorf("ParseSortMode(Priority) = %v, %v", mode, ok)
+	}
+	if _, ok := ParseSortMode("bogus"); ok {
+		t.Error("expected unknown sort mode to be rejected")
+	}
+}
@@ -2,13 +2,21 @@ package state
 
 import (
 	"fmt"
+	"sort"
+	"strconv"
 	"strings"
+	"sync"
+	"time"
 
 	"github.com/andy/beads-tui/internal/parser"
 )
 
-// State manages the application state and issue data
+// State manages the application state and issue data. It's safe for
+// concurrent use: a refresh can load issues on another goroutine while the
+// UI reads them. Snapshot reads the categorized lists in one go.
 type State struct {
+	mu sync.RWMutex
+
 	issues           []*parser.Issue
 	issuesByID       map[string]*parser.Issue
 	readyIssues      []*parser.Issue
@@ -18,21 +26,76 @@ type State struct {
 	selectedIssue    *parser.Issue
 	filterMode       FilterMode
 	viewMode         ViewMode
+	sortMode         SortMode
 	treeNodes        []*TreeNode
 
 	// Computed blocking state (includes dependency-based blocking)
 	// This is set by categorizeIssues() and used by IsEffectivelyBlocked()
 	effectivelyBlocked map[string]bool
 
+	// Relationship indexes (computed in LoadIssues) used by dependency filters
+	blockingDependents map[string][]string        // issue ID -> IDs of issues it blocks
+	hasChildren        map[string]bool            // issue ID -> has parent-child or ID-prefix children
+	epicProgress       map[string]Progress        // epic ID -> closed/total children
+	childIDs           map[string]map[string]bool // parent ID -> parent-child and ID-prefix child IDs
+
+	// Circular blocks/parent-child chains (computed in LoadIssues)
+	cycles  []Cycle
+	inCycle map[string]bool
+
+	// Every label with its open/closed issue counts, by name (computed in LoadIssues)
+	labelIndex []LabelCount
+
+	// Checked-out git branch and the issue it is named after ("" = none)
+	currentBranch string
+	branchIssue   string
+
+	// Days an unclosed issue of each priority can stay open before it's
+	// flagged for escalation (nil = DefaultAgingDays)
+	agingDays map[int]int
+
+	// Minutes logged per issue ID (from the TUI's worklog; bd doesn't track time)
+	loggedMinutes map[string]int
+
+	// Why issues are blocked, per issue ID (from "BLOCKED:" comments, as bd
+	// has no field for it)
+	blockedReasons map[string]string
+
+	// Full-text search index (computed in LoadIssues): issue ID -> lowercased field text
+	searchIndex map[string]map[SearchField]string
+
+	// Counts matching comments per issue when comments aren't loaded with
+	// the issues (nil = search the comments in the index)
+	commentSearcher func(text string) map[string]int
+
 	// Tree collapse state - persists across tree rebuilds
 	// Maps issue ID to collapsed state (true = collapsed)
 	collapsedNodes map[string]bool
 
+	// Watch list and the watched issues that changed since they were last viewed
+	watched       map[string]bool
+	unseenChanges map[string]bool
+
 	// Filter state
 	priorityFilter map[int]bool              // nil = no filter, otherwise only show these priorities
 	typeFilter     map[parser.IssueType]bool // nil = no filter, otherwise only show these types
 	statusFilter   map[parser.Status]bool    // nil = no filter, otherwise only show these statuses
-	labelFilter    map[string]bool           // nil = no filter, otherwise only show issues with these labels
+	labelFilter    map[string]bool           // nil = no filter, otherwise only show issues with these (lowercased) labels
+	labelMatchAll  bool                      // true = issues need every filtered label, false = any of them
+	assigneeFilter map[string]bool           // nil = no filter, otherwise only show issues assigned to these (lowercased) assignees
+	titleFilter    map[string]bool           // nil = no filter, otherwise only show issues whose title contains one of these (lowercased) texts
+	estimateFilter map[string]bool           // nil = no filter, otherwise only show issues in these estimate buckets
+	dueFilter      map[DueStatus]bool        // nil = no filter, otherwise only show issues with these due statuses
+
+	// Dependency filters
+	blockedByFilter   map[string]bool // nil = no filter, otherwise only show issues blocked by these (lowercased) IDs
+	blocksFilter      map[string]bool // nil = no filter, otherwise only show issues blocking these (lowercased) IDs
+	noDepsFilter      bool            // only show issues with no blocking relationships in either direction
+	hasChildrenFilter bool            // only show issues that have children
+
+	// Negated quick filter tokens ("!chore"): nil = none, otherwise hide the
+	// issues any of these (lowercased) tokens would match
+	excludeFilter map[string]bool
 }
 
 // FilterMode represents different filtering options
@@ -76,6 +139,8 @@ func New() *State {
 
 // LoadIssues updates the state with a new set of issues
 func (s *State) LoadIssues(issues []*parser.Issue) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
 	s.issues = issues
 	s.issuesByID = make(map[string]*parser.Issue)
 
@@ -92,6 +157,12 @@ func (s *State) LoadIssues(issues []*parser.Issue) {
 
 	// Categorize issues
 	s.categorizeIssues()
+	s.indexRelationships()
+	s.detectCycles()
+	s.matchBranchIssue()
+	s.indexLabels()
+	s.indexEpicProgress()
+	s.buildSearchIndex()
 
 	// Rebuild tree if in tree view mode
 	if s.viewMode == ViewTree {
@@ -176,6 +247,32 @@ func (s *State) categorizeIssues() {
 			}
 		}
 	}
+
+	s.sortCategorized()
+}
+
+// indexRelationships builds the reverse blocking and children indexes used by dependency filters
+func (s *State) indexRelationships() {
+	s.blockingDependents = make(map[string][]string)
+	s.hasChildren = make(map[string]bool)
+
+	for _, issue := range s.issues {
+		for _, dep := range issue.Dependencies {
+			switch dep.Type {
+			case parser.DepBlocks:
+				s.blockingDependents[dep.DependsOnID] = append(s.blockingDependents[dep.DependsOnID], issue.ID)
+			case parser.DepParentChild:
+				s.hasChildren[dep.DependsOnID] = true
+			}
+		}
+
+		// ID-based children (e.g., tui-y4h.1 is a child of tui-y4h)
+		if i := strings.LastIndex(issue.ID, "."); i > 0 {
+			if _, ok := s.issuesByID[issue.ID[:i]]; ok {
+				s.hasChildren[issue.ID[:i]] = true
+			}
+		}
+	}
 }
 
 // IsEffectivelyBlocked returns true if the issue is blocked either by:
@@ -184,6 +281,8 @@ func (s *State) categorizeIssues() {
 // - Being a child of a blocked parent (transitive)
 // This is useful for rendering where we want consistent status display
 func (s *State) IsEffectivelyBlocked(issueID string) bool {
+	s.mu.RLock()
+	defer s.mu.RUnlock()
 	issue := s.issuesByID[issueID]
 	if issue == nil {
 		return false
@@ -198,10 +297,12 @@ func (s *State) IsEffectivelyBlocked(issueID string) bool {
 
 // applyFilters filters a list of issues based on active filters
 func (s *State) applyFilters(issues []*parser.Issue) []*parser.Issue {
-	if s.priorityFilter == nil && s.typeFilter == nil && s.statusFilter == nil && s.labelFilter == nil {
+	if !s.hasActiveFilters() {
 		return issues
 	}
 
+	now := time.Now()
+	excludes := s.excludeMatchers(now)
 	var filtered []*parser.Issue
 	for _, issue := range issues {
 		// Check priority filter
@@ -220,18 +321,38 @@ func (s *State) applyFilters(issues []*parser.Issue) []*parser.Issue {
 		}
 
 		// Check label filter
-		if s.labelFilter != nil {
-			// Issue must have at least one of the filtered labels
-			hasMatchingLabel := false
-			for _, label := range issue.Labels {
-				if s.labelFilter[label] {
-					hasMatchingLabel = true
-					break
-				}
-			}
-			if !hasMatchingLabel {
-				continue
-			}
+		if s.labelFilter != nil && !s.matchesLabelFilter(issue) {
+			continue
+		}
+
+		// Check assignee filter (case-insensitive)
+		if s.assigneeFilter != nil && !s.assigneeFilter[strings.ToLower(issue.Assignee)] {
+			continue
+		}
+
+		// Check estimate filter
+		if s.estimateFilter != nil && !s.estimateFilter[EstimateBucketOf(issue)] {
+			continue
+		}
+
+		// Check due date filter
+		if s.dueFilter != nil && !s.dueFilter[DueStatusOf(issue, now)] {
+			continue
+		}
+
+		// Check title filter (case-insensitive substring)
+		if s.titleFilter != nil && !s.matchesTitleFilter(issue) {
+			continue
+		}
+
+		// Check dependency filters
+		if !s.matchesDependencyFilters(issue) {
+			continue
+		}
+
+		// Hide issues matching a negated token
+		if excluded(issue, excludes) {
+			continue
 		}
 
 		filtered = append(filtered, issue)
@@ -239,48 +360,202 @@ func (s *State) applyFilters(issues []*parser.Issue) []*parser.Issue {
 	return filtered
 }
 
+// matchesLabelFilter reports whether an issue has any (or, with
+// labelMatchAll, every) filtered label. Labels match case-insensitively.
+func (s *State) matchesLabelFilter(issue *parser.Issue) bool {
+	matched := 0
+	for label := range s.labelFilter {
+		for _, issueLabel := range issue.Labels {
+			if strings.EqualFold(issueLabel, label) {
+				matched++
+				break
+			}
+		}
+	}
+	if s.labelMatchAll {
+		return matched == len(s.labelFilter)
+	}
+	return matched > 0
+}
+
+// matchesDependencyFilters reports whether an issue passes the blocked-by, blocks,
+// no-deps, and has-children filters
+func (s *State) matchesDependencyFilters(issue *parser.Issue) bool {
+	if s.blockedByFilter != nil {
+		matched := false
+		for _, dep := range issue.Dependencies {
+			if dep.Type == parser.DepBlocks && s.blockedByFilter[strings.ToLower(dep.DependsOnID)] {
+				matched = true
+				break
+			}
+		}
+		if !matched {
+			return false
+		}
+	}
+
+	if s.blocksFilter != nil {
+		matched := false
+		for _, dependentID := range s.blockingDependents[issue.ID] {
+			if s.blocksFilter[strings.ToLower(dependentID)] {
+				matched = true
+				break
+			}
+		}
+		if !matched {
+			return false
+		}
+	}
+
+	if s.noDepsFilter && !s.hasNoDeps(issue) {
+		return false
+	}
+
+	if s.hasChildrenFilter && !s.hasChildren[issue.ID] {
+		return false
+	}
+
+	return true
+}
+
+// hasNoDeps reports whether an issue has no blocking relationships in either
+// direction
+func (s *State) hasNoDeps(issue *parser.Issue) bool {
+	if len(s.blockingDependents[issue.ID]) > 0 {
+		return false
+	}
+	for _, dep := range issue.Dependencies {
+		if dep.Type == parser.DepBlocks {
+			return false
+		}
+	}
+	return true
+}
+
+// matchesTitleFilter reports whether an issue's title contains any filtered
+// text, case-insensitively
+func (s *State) matchesTitleFilter(issue *parser.Issue) bool {
+	title := strings.ToLower(issue.Title)
+	for text := range s.titleFilter {
+		if strings.Contains(title, text) {
+			return true
+		}
+	}
+	return false
+}
+
 // GetReadyIssues returns issues that are ready to work on
 func (s *State) GetReadyIssues() []*parser.Issue {
+	s.mu.RLock()
+	defer s.mu.RUnlock()
 	return s.applyFilters(s.readyIssues)
 }
 
 // GetBlockedIssues returns issues that are blocked
 func (s *State) GetBlockedIssues() []*parser.Issue {
+	s.mu.RLock()
+	defer s.mu.RUnlock()
 	return s.applyFilters(s.blockedIssues)
 }
 
 // GetInProgressIssues returns issues that are in progress
 func (s *State) GetInProgressIssues() []*parser.Issue {
+	s.mu.RLock()
+	defer s.mu.RUnlock()
 	return s.applyFilters(s.inProgressIssues)
 }
 
 // GetClosedIssues returns closed issues
 func (s *State) GetClosedIssues() []*parser.Issue {
+	s.mu.RLock()
+	defer s.mu.RUnlock()
 	return s.applyFilters(s.closedIssues)
 }
 
 // GetAllIssues returns all issues
 func (s *State) GetAllIssues() []*parser.Issue {
+	s.mu.RLock()
+	defer s.mu.RUnlock()
 	return s.issues
 }
 
+// GetIDChildren returns the issues that are children of parentID by ID
+// convention (tui-y4h.1, tui-y4h.2, ... for tui-y4h), including closed ones,
+// in numeric order. As in the tree view, an issue belongs to its nearest
+// existing ancestor, so tui-y4h.2.1 is listed under tui-y4h.2 when that exists.
+func (s *State) GetIDChildren(parentID string) []*parser.Issue {
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+	var children []*parser.Issue
+	for _, issue := range s.issues {
+		if !strings.HasPrefix(issue.ID, parentID+".") {
+			continue
+		}
+		for i := len(issue.ID) - 1; i >= 0; i-- {
+			if issue.ID[i] == '.' {
+				if _, ok := s.issuesByID[issue.ID[:i]]; ok {
+					if issue.ID[:i] == parentID {
+						children = append(children, issue)
+					}
+					break
+				}
+			}
+		}
+	}
+	sort.Slice(children, func(i, j int) bool {
+		return idSuffixLess(children[i].ID[len(parentID)+1:], children[j].ID[len(parentID)+1:])
+	})
+	return children
+}
+
+// idSuffixLess orders dotted ID suffixes numerically ("2" < "10"), falling
+// back to string order for non-numeric parts
+func idSuffixLess(a, b string) bool {
+	partsA, partsB := strings.Split(a, "."), strings.Split(b, ".")
+	for i := 0; i < len(partsA) && i < len(partsB); i++ {
+		if partsA[i] == partsB[i] {
+			continue
+		}
+		numA, errA := strconv.Atoi(partsA[i])
+		numB, errB := strconv.Atoi(partsB[i])
+		if errA == nil && errB == nil {
+			return numA < numB
+		}
+		return partsA[i] < partsB[i]
+	}
+	return len(partsA) < len(partsB)
+}
+
 // GetIssueByID returns an issue by its ID
 func (s *State) GetIssueByID(id string) *parser.Issue {
+	s.mu.RLock()
+	defer s.mu.RUnlock()
 	return s.issuesByID[id]
 }
 
 // SetSelectedIssue sets the currently selected issue
 func (s *State) SetSelectedIssue(issue *parser.Issue) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
 	s.selectedIssue = issue
 }
 
 // GetSelectedIssue returns the currently selected issue
 func (s *State) GetSelectedIssue() *parser.Issue {
+	s.mu.RLock()
+	defer s.mu.RUnlock()
 	return s.selectedIssue
 }
 
 // SetViewMode sets the current view mode
 func (s *State) SetViewMode(mode ViewMode) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	s.setViewMode(mode)
+}
+
+// setViewMode sets the view mode, building the tree for tree view
+func (s *State) setViewMode(mode ViewMode) {
 	s.viewMode = mode
 	if mode == ViewTree {
 		s.buildDependencyTree()
@@ -289,51 +564,90 @@ func (s *State) SetViewMode(mode ViewMode) {
 
 // GetViewMode returns the current view mode
 func (s *State) GetViewMode() ViewMode {
+	s.mu.RLock()
+	defer s.mu.RUnlock()
 	return s.viewMode
 }
 
 // ToggleViewMode switches between list and tree view
 func (s *State) ToggleViewMode() ViewMode {
+	s.mu.Lock()
+	defer s.mu.Unlock()
 	if s.viewMode == ViewList {
-		s.SetViewMode(ViewTree)
+		s.setViewMode(ViewTree)
 	} else {
-		s.SetViewMode(ViewList)
+		s.setViewMode(ViewList)
 	}
 	return s.viewMode
 }
 
 // GetTreeNodes returns the tree structure for tree view
 func (s *State) GetTreeNodes() []*TreeNode {
+	s.mu.RLock()
+	defer s.mu.RUnlock()
 	return s.treeNodes
 }
 
 // IsCollapsed returns true if the given issue is collapsed in tree view
 // Uses smart defaults (collapse if no active work in subtree) when no explicit state is set
 func (s *State) IsCollapsed(issueID string) bool {
-	collapsed, _ := s.GetCollapseState(issueID)
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+	return s.isCollapsed(issueID)
+}
+
+// isCollapsed is IsCollapsed for callers holding the lock
+func (s *State) isCollapsed(issueID string) bool {
+	collapsed, _ := s.collapseState(issueID)
 	return collapsed
 }
 
 // ToggleCollapse toggles the collapse state for an issue and returns the new state
 // Takes into account smart defaults when toggling for the first time
 func (s *State) ToggleCollapse(issueID string) bool {
-	currentState := s.IsCollapsed(issueID) // Gets smart default if not explicitly set
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	currentState := s.isCollapsed(issueID) // Gets smart default if not explicitly set
 	s.collapsedNodes[issueID] = !currentState
 	return s.collapsedNodes[issueID]
 }
 
-// SetCollapsed sets the collapse state for an issue
-func (s *State) SetCollapsed(issueID string, collapsed bool) {
-	if collapsed {
-		s.collapsedNodes[issueID] = true
-	} else {
-		delete(s.collapsedNodes, issueID)
+// SetCollapsed explicitly sets the collapse state for an issue (overriding smart defaults)
+// Returns true if the visible state changed
+func (s *State) SetCollapsed(issueID string, collapsed bool) bool {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	changed := s.isCollapsed(issueID) != collapsed
+	s.collapsedNodes[issueID] = collapsed
+	return changed
+}
+
+// TreeParent returns the ID of the issue's parent node in the tree view,
+// or "" if the issue is a root or not in the tree
+func (s *State) TreeParent(issueID string) string {
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+	var find func(nodes []*TreeNode, parentID string) (string, bool)
+	find = func(nodes []*TreeNode, parentID string) (string, bool) {
+		for _, node := range nodes {
+			if node.Issue.ID == issueID {
+				return parentID, true
+			}
+			if found, ok := find(node.Children, node.Issue.ID); ok {
+				return found, true
+			}
+		}
+		return "", false
 	}
+	parentID, _ := find(s.treeNodes, "")
+	return parentID
 }
 
 // HasChildren returns true if the issue has children in the tree
 // This is useful to know whether the collapse toggle is meaningful
 func (s *State) HasChildren(issueID string) bool {
+	s.mu.RLock()
+	defer s.mu.RUnlock()
 	// Search in tree nodes recursively for this issue
 	for _, node := range s.treeNodes {
 		if found := s.findNodeWithChildren(node, issueID); found {
@@ -359,6 +673,13 @@ func (s *State) findNodeWithChildren(node *TreeNode, issueID string) bool {
 // SubtreeHasActiveWork returns true if any issue in the subtree (children) is in_progress
 // This is used for smart collapse defaults - expand nodes with active work
 func (s *State) SubtreeHasActiveWork(issueID string) bool {
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+	return s.hasActiveSubtree(issueID)
+}
+
+// hasActiveSubtree is SubtreeHasActiveWork for callers holding the lock
+func (s *State) hasActiveSubtree(issueID string) bool {
 	for _, node := range s.treeNodes {
 		if found, hasActive := s.findNodeAndCheckActive(node, issueID); found {
 			return hasActive
@@ -399,21 +720,32 @@ func (s *State) subtreeHasActiveWork(node *TreeNode) bool {
 // (when no explicit user preference is set)
 // Logic: collapse if subtree has NO active work, expand if it does
 func (s *State) ShouldDefaultCollapse(issueID string) bool {
-	return !s.SubtreeHasActiveWork(issueID)
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+	return !s.hasActiveSubtree(issueID)
 }
 
 // GetCollapseState returns the collapse state for an issue, using smart defaults
 // if no explicit state has been set. Returns (isCollapsed, isExplicitlySet)
 func (s *State) GetCollapseState(issueID string) (bool, bool) {
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+	return s.collapseState(issueID)
+}
+
+// collapseState is GetCollapseState for callers holding the lock
+func (s *State) collapseState(issueID string) (bool, bool) {
 	if collapsed, exists := s.collapsedNodes[issueID]; exists {
 		return collapsed, true
 	}
 	// No explicit state - use smart default
-	return s.ShouldDefaultCollapse(issueID), false
+	return !s.hasActiveSubtree(issueID), false
 }
 
 // GetCollapsedNodes returns a copy of the collapsed nodes map for persistence
 func (s *State) GetCollapsedNodes() map[string]bool {
+	s.mu.RLock()
+	defer s.mu.RUnlock()
 	result := make(map[string]bool)
 	for k, v := range s.collapsedNodes {
 		result[k] = v
@@ -423,6 +755,8 @@ func (s *State) GetCollapsedNodes() map[string]bool {
 
 // SetCollapsedNodes sets the collapsed nodes map (for loading from persistence)
 func (s *State) SetCollapsedNodes(nodes map[string]bool) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
 	s.collapsedNodes = make(map[string]bool)
 	for k, v := range nodes {
 		s.collapsedNodes[k] = v
@@ -432,6 +766,8 @@ func (s *State) SetCollapsedNodes(nodes map[string]bool) {
 // ExpandAll expands all nodes in the tree (clears all collapse state)
 // Returns the number of nodes affected
 func (s *State) ExpandAll() int {
+	s.mu.Lock()
+	defer s.mu.Unlock()
 	count := 0
 	s.expandAllNodes(s.treeNodes, &count)
 	return count
@@ -442,7 +778,7 @@ func (s *State) expandAllNodes(nodes []*TreeNode, count *int) {
 	for _, node := range nodes {
 		if len(node.Children) > 0 {
 			// Only count if it was actually collapsed (explicit or smart default)
-			if s.IsCollapsed(node.Issue.ID) {
+			if s.isCollapsed(node.Issue.ID) {
 				*count++
 			}
 			// Set explicit expanded state (false overrides smart defaults)
@@ -455,6 +791,8 @@ func (s *State) expandAllNodes(nodes []*TreeNode, count *int) {
 // CollapseAll collapses all parent nodes in the tree
 // Returns the number of nodes affected
 func (s *State) CollapseAll() int {
+	s.mu.Lock()
+	defer s.mu.Unlock()
 	count := 0
 	s.collapseAllNodes(s.treeNodes, &count)
 	return count
@@ -465,7 +803,7 @@ func (s *State) collapseAllNodes(nodes []*TreeNode, count *int) {
 	for _, node := range nodes {
 		if len(node.Children) > 0 {
 			// Only count if it wasn't already collapsed (explicit or smart default)
-			if !s.IsCollapsed(node.Issue.ID) {
+			if !s.isCollapsed(node.Issue.ID) {
 				*count++
 			}
 			s.collapsedNodes[node.Issue.ID] = true
@@ -576,6 +914,20 @@ func (s *State) buildDependencyTree() {
 			}
 		}
 	}
+
+	// Issues in a dependency cycle all have incoming dependencies, so a cycle
+	// that no root leads into would be missing; root it at its first issue
+	for _, cycle := range s.cycles {
+		for _, id := range cycle.IssueIDs() {
+			issue := openIssueIDs[id]
+			if issue == nil || visited[id] {
+				continue
+			}
+			if node := s.buildTreeNode(issue, 0, childrenMap, blockedByMap, visited); node != nil {
+				s.treeNodes = append(s.treeNodes, node)
+			}
+		}
+	}
 }
 
 // maxTreeDepth is the maximum allowed nesting depth for tree building.
@@ -623,6 +975,8 @@ func (s *State) buildTreeNode(issue *parser.Issue, depth int, childrenMap map[st
 
 // TogglePriorityFilter toggles a priority in the filter
 func (s *State) TogglePriorityFilter(priority int) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
 	if s.priorityFilter == nil {
 		s.priorityFilter = make(map[int]bool)
 	}
@@ -640,6 +994,8 @@ func (s *State) TogglePriorityFilter(priority int) {
 
 // ToggleTypeFilter toggles an issue type in the filter
 func (s *State) ToggleTypeFilter(issueType parser.IssueType) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
 	if s.typeFilter == nil {
 		s.typeFilter = make(map[parser.IssueType]bool)
 	}
@@ -656,6 +1012,8 @@ func (s *State) ToggleTypeFilter(issueType parser.IssueType) {
 
 // ToggleStatusFilter toggles a status in the filter
 func (s *State) ToggleStatusFilter(status parser.Status) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
 	if s.statusFilter == nil {
 		s.statusFilter = make(map[parser.Status]bool)
 	}
@@ -670,8 +1028,11 @@ func (s *State) ToggleStatusFilter(status parser.Status) {
 	}
 }
 
-// ToggleLabelFilter toggles a label in the filter
+// ToggleLabelFilter toggles a label in the filter (matched case-insensitively)
 func (s *State) ToggleLabelFilter(label string) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	label = strings.ToLower(label)
 	if s.labelFilter == nil {
 		s.labelFilter = make(map[string]bool)
 	}
@@ -686,42 +1047,190 @@ func (s *State) ToggleLabelFilter(label string) {
 	}
 }
 
+// SetLabelMatchAll chooses whether issues must have every filtered label
+// (true) or at least one of them (false, the default)
+func (s *State) SetLabelMatchAll(all bool) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	s.labelMatchAll = all
+}
+
+// LabelMatchAll reports whether the label filter requires every label
+func (s *State) LabelMatchAll() bool {
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+	return s.labelMatchAll
+}
+
+// ToggleAssigneeFilter toggles an assignee in the filter (matched case-insensitively)
+func (s *State) ToggleAssigneeFilter(assignee string) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	assignee = strings.ToLower(assignee)
+	if s.assigneeFilter == nil {
+		s.assigneeFilter = make(map[string]bool)
+	}
+
+	if s.assigneeFilter[assignee] {
+		delete(s.assigneeFilter, assignee)
+		if len(s.assigneeFilter) == 0 {
+			s.assigneeFilter = nil
+		}
+	} else {
+		s.assigneeFilter[assignee] = true
+	}
+}
+
+// ToggleTitleFilter toggles showing issues whose title contains text
+// (matched case-insensitively)
+func (s *State) ToggleTitleFilter(text string) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	text = strings.ToLower(text)
+	if text == "" {
+		return
+	}
+	if s.titleFilter == nil {
+		s.titleFilter = make(map[string]bool)
+	}
+
+	if s.titleFilter[text] {
+		delete(s.titleFilter, text)
+		if len(s.titleFilter) == 0 {
+			s.titleFilter = nil
+		}
+	} else {
+		s.titleFilter[text] = true
+	}
+}
+
+// IsTitleFiltered returns true if the given text is in the title filter
+func (s *State) IsTitleFiltered(text string) bool {
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+	return s.titleFilter != nil && s.titleFilter[strings.ToLower(text)]
+}
+
+// ToggleBlockedByFilter toggles showing issues blocked by the given issue ID
+func (s *State) ToggleBlockedByFilter(issueID string) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	s.blockedByFilter = toggleIDFilter(s.blockedByFilter, issueID)
+}
+
+// ToggleBlocksFilter toggles showing issues that block the given issue ID
+func (s *State) ToggleBlocksFilter(issueID string) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	s.blocksFilter = toggleIDFilter(s.blocksFilter, issueID)
+}
+
+// ToggleNoDepsFilter toggles showing only issues with no blocking relationships
+func (s *State) ToggleNoDepsFilter() {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	s.noDepsFilter = !s.noDepsFilter
+}
+
+// ToggleHasChildrenFilter toggles showing only issues that have children
+func (s *State) ToggleHasChildrenFilter() {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	s.hasChildrenFilter = !s.hasChildrenFilter
+}
+
+// toggleIDFilter adds or removes a (lowercased) issue ID from an ID filter set,
+// returning nil when the set becomes empty
+func toggleIDFilter(filter map[string]bool, issueID string) map[string]bool {
+	issueID = strings.ToLower(issueID)
+	if filter == nil {
+		filter = make(map[string]bool)
+	}
+	if filter[issueID] {
+		delete(filter, issueID)
+		if len(filter) == 0 {
+			return nil
+		}
+	} else {
+		filter[issueID] = true
+	}
+	return filter
+}
+
 // ClearAllFilters removes all active filters
 func (s *State) ClearAllFilters() {
+	s.mu.Lock()
+	defer s.mu.Unlock()
 	s.priorityFilter = nil
 	s.typeFilter = nil
 	s.statusFilter = nil
 	s.labelFilter = nil
+	s.labelMatchAll = false
+	s.assigneeFilter = nil
+	s.titleFilter = nil
+	s.estimateFilter = nil
+	s.dueFilter = nil
+	s.blockedByFilter = nil
+	s.blocksFilter = nil
+	s.noDepsFilter = false
+	s.hasChildrenFilter = false
+	s.excludeFilter = nil
 }
 
 // IsPriorityFiltered returns true if the given priority is in the active filter
 func (s *State) IsPriorityFiltered(priority int) bool {
+	s.mu.RLock()
+	defer s.mu.RUnlock()
 	return s.priorityFilter != nil && s.priorityFilter[priority]
 }
 
 // IsTypeFiltered returns true if the given type is in the active filter
 func (s *State) IsTypeFiltered(issueType parser.IssueType) bool {
+	s.mu.RLock()
+	defer s.mu.RUnlock()
 	return s.typeFilter != nil && s.typeFilter[issueType]
 }
 
 // IsStatusFiltered returns true if the given status is in the active filter
 func (s *State) IsStatusFiltered(status parser.Status) bool {
+	s.mu.RLock()
+	defer s.mu.RUnlock()
 	return s.statusFilter != nil && s.statusFilter[status]
 }
 
 // IsLabelFiltered returns true if the given label is in the active filter
 func (s *State) IsLabelFiltered(label string) bool {
-	return s.labelFilter != nil && s.labelFilter[label]
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+	return s.labelFilter != nil && s.labelFilter[strings.ToLower(label)]
+}
+
+// IsAssigneeFiltered returns true if the given assignee is in the active filter
+func (s *State) IsAssigneeFiltered(assignee string) bool {
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+	return s.assigneeFilter != nil && s.assigneeFilter[strings.ToLower(assignee)]
 }
 
 // HasActiveFilters returns true if any filters are active
 func (s *State) HasActiveFilters() bool {
-	return s.priorityFilter != nil || s.typeFilter != nil || s.statusFilter != nil || s.labelFilter != nil
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+	return s.hasActiveFilters()
+}
+
+// hasActiveFilters is HasActiveFilters for callers holding the lock
+func (s *State) hasActiveFilters() bool {
+	return s.priorityFilter != nil || s.typeFilter != nil || s.statusFilter != nil || s.labelFilter != nil ||
+		s.assigneeFilter != nil || s.titleFilter != nil || s.estimateFilter != nil || s.dueFilter != nil || s.blockedByFilter != nil || s.blocksFilter != nil ||
+		s.noDepsFilter || s.hasChildrenFilter || s.excludeFilter != nil
 }
 
 // GetActiveFilters returns a human-readable description of active filters
 func (s *State) GetActiveFilters() string {
-	if !s.HasActiveFilters() {
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+	if !s.hasActiveFilters() {
 		return ""
 	}
 
@@ -767,21 +1276,112 @@ func (s *State) GetActiveFilters() string {
 	}
 
 	// Label filters
-	if s.labelFilter != nil {
-		var labels []string
-		for label := range s.labelFilter {
-			labels = append(labels, label)
+	if labels := sortedKeys(s.labelFilter); len(labels) > 0 {
+		separator := ","
+		if s.labelMatchAll {
+			separator = "+"
+		}
+		filters = append(filters, "Label: "+strings.Join(labels, separator))
+	}
+
+	// Assignee filters
+	if s.assigneeFilter != nil {
+		var assignees []string
+		for assignee := range s.assigneeFilter {
+			assignees = append(assignees, "@"+assignee)
+		}
+		sort.Strings(assignees)
+		if len(assignees) > 0 {
+			filters = append(filters, "Assignee: "+strings.Join(assignees, ","))
 		}
-		if len(labels) > 0 {
-			filters = append(filters, "Label: "+strings.Join(labels, ","))
+	}
+
+	// Estimate filters, in bucket order
+	if s.estimateFilter != nil {
+		var buckets []string
+		for _, bucket := range EstimateBuckets {
+			if s.estimateFilter[bucket.Name] {
+				buckets = append(buckets, bucket.Label)
+			}
 		}
+		filters = append(filters, "Estimate: "+strings.Join(buckets, ","))
+	}
+
+	// Due date filters
+	if s.dueFilter != nil {
+		var statuses []string
+		for _, status := range dueStatuses {
+			if s.dueFilter[status] {
+				statuses = append(statuses, string(status))
+			}
+		}
+		filters = append(filters, "Due: "+strings.Join(statuses, ","))
+	}
+
+	// Dependency filters
+	var deps []string
+	for _, id := range sortedKeys(s.blockedByFilter) {
+		deps = append(deps, "blocked-by:"+id)
+	}
+	for _, id := range sortedKeys(s.blocksFilter) {
+		deps = append(deps, "blocks:"+id)
+	}
+	if s.noDepsFilter {
+		deps = append(deps, "no-deps")
+	}
+	if s.hasChildrenFilter {
+		deps = append(deps, "has-children")
+	}
+	if len(deps) > 0 {
+		filters = append(filters, "Deps: "+strings.Join(deps, ","))
+	}
+
+	// Title filters
+	if titles := sortedKeys(s.titleFilter); len(titles) > 0 {
+		filters = append(filters, "Title: "+strings.Join(titles, ","))
+	}
+
+	// Negated tokens
+	if excludes := sortedKeys(s.excludeFilter); len(excludes) > 0 {
+		filters = append(filters, "Not: "+strings.Join(excludes, ","))
 	}
 
 	return strings.Join(filters, " | ")
 }
 
+// sortedKeys returns the keys of a string set in sorted order
+func sortedKeys(set map[string]bool) []string {
+	keys := make([]string, 0, len(set))
+	for key := range set {
+		keys = append(keys, key)
+	}
+	sort.Strings(keys)
+	return keys
+}
+
+// GetAllAssignees returns all unique non-empty assignees across all issues, sorted
+func (s *State) GetAllAssignees() []string {
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+	assigneeSet := make(map[string]bool)
+	for _, issue := range s.issues {
+		if issue.Assignee != "" {
+			assigneeSet[issue.Assignee] = true
+		}
+	}
+
+	assignees := make([]string, 0, len(assigneeSet))
+	for assignee := range assigneeSet {
+		assignees = append(assignees, assignee)
+	}
+	sort.Strings(assignees)
+	return assignees
+}
+
 // GetAllLabels returns all unique labels across all issues
 func (s *State) GetAllLabels() []string {
+	s.mu.RLock()
+	defer s.mu.RUnlock()
 	labelSet := make(map[string]bool)
 	for _, issue := range s.issues {
 		for _, label := range issue.Labels {
@@ -2,6 +2,7 @@ package state
 
 import (
 	"fmt"
+	"strings"
 	"testing"
 	"time"
 
@@ -925,6 +926,33 @@ func TestIDBasedParentChildRelationship(t *testing.T) {
 
 // TestBlockingPropagatesThroughParentChild verifies that blocking propagates
 // through parent-child relationships, matching bd ready behavior
+func TestGetIDChildren(t *testing.T) {
+	state := New()
+	state.LoadIssues([]*parser.Issue{
+		{ID: "tui-y4h", Status: parser.StatusOpen},
+		{ID: "tui-y4h.10", Status: parser.StatusOpen},
+		{ID: "tui-y4h.2", Status: parser.StatusClosed},
+		{ID: "tui-y4h.2.1", Status: parser.StatusOpen}, // Child of tui-y4h.2
+		{ID: "tui-y4h.3.1", Status: parser.StatusOpen}, // No tui-y4h.3, so a child of tui-y4h
+		{ID: "tui-y4hx.1", Status: parser.StatusOpen},  // Different issue
+	})
+
+	var ids []string
+	for _, child := range state.GetIDChildren("tui-y4h") {
+		ids = append(ids, child.ID)
+	}
+	if got := strings.Join(ids, ","); got != "tui-y4h.2,tui-y4h.3.1,tui-y4h.10" {
+		t.Errorf("expected ID-convention children in numeric order, got %s", got)
+	}
+
+	if got := state.GetIDChildren("tui-y4h.2"); len(got) != 1 || got[0].ID != "tui-y4h.2.1" {
+		t.Errorf("expected tui-y4h.2.1 under tui-y4h.2, got %v", got)
+	}
+	if got := state.GetIDChildren("tui-y4h.10"); len(got) != 0 {
+		t.Errorf("expected no children, got %v", got)
+	}
+}
+
 func TestBlockingPropagatesThroughParentChild(t *testing.T) {
 	state := New()
 	now := time.Now()
@@ -1263,3 +1291,45 @@ func TestBuildDependencyTreeMaxDepth(t *testing.T) {
 		}
 	}
 }
+
+func TestSetCollapsedAndTreeParent(t *testing.T) {
+	state := New()
+	now := time.Now()
+
+	issues := []*parser.Issue{
+		{ID: "tui-e", Title: "Epic", Status: parser.StatusOpen, Priority: 1, IssueType: parser.TypeEpic, CreatedAt: now, UpdatedAt: now},
+		{ID: "tui-e.1", Title: "Active child", Status: parser.StatusInProgress, Priority: 1, IssueType: parser.TypeTask, CreatedAt: now, UpdatedAt: now},
+		{ID: "tui-solo", Title: "Root task", Status: parser.StatusOpen, Priority: 2, IssueType: parser.TypeTask, CreatedAt: now, UpdatedAt: now},
+	}
+	state.SetViewMode(ViewTree)
+	state.LoadIssues(issues)
+
+	if parent := state.TreeParent("tui-e.1"); parent != "tui-e" {
+		t.Errorf("expected parent tui-e, got %q", parent)
+	}
+	if parent := state.TreeParent("tui-solo"); parent != "" {
+		t.Errorf("expected no parent for root issue, got %q", parent)
+	}
+
+	// Active subtree is expanded by default
+	if state.IsCollapsed("tui-e") {
+		t.Fatal("expected tui-e expanded by default (active child)")
+	}
+	if !state.SetCollapsed("tui-e", true) || !state.IsCollapsed("tui-e") {
+		t.Error("expected collapse to change state")
+	}
+	if state.SetCollapsed("tui-e", true) {
+		t.Error("expected collapsing a collapsed node to report no change")
+	}
+
+	// Expanding stores an explicit state that survives the save/load round-trip
+	state.SetCollapsed("tui-e", false)
+	if collapsed, explicit := state.GetCollapseState("tui-e"); collapsed || !explicit {
+		t.Errorf("expected explicit expanded state, got collapsed=%v explicit=%v", collapsed, explicit)
+	}
+	restored := New()
+	restored.SetCollapsedNodes(state.GetCollapsedNodes())
+	if collapsed, explicit := restored.GetCollapseState("tui-e"); collapsed || !explicit {
+		t.Errorf("expected restored explicit expanded state, got collapsed=%v explicit=%v", collapsed, explicit)
+	}
+}
@@ -0,0 +1,64 @@
+package state
+
+// treeSiblings returns the nodes at the same level as the issue's node (the
+// root nodes for a top-level issue) and the node's position among them
+func (s *State) treeSiblings(issueID string) ([]*TreeNode, int) {
+	var find func(nodes []*TreeNode) ([]*TreeNode, int)
+	find = func(nodes []*TreeNode) ([]*TreeNode, int) {
+		for i, node := range nodes {
+			if node.Issue.ID == issueID {
+				return nodes, i
+			}
+			if siblings, index := find(node.Children); siblings != nil {
+				return siblings, index
+			}
+		}
+		return nil, -1
+	}
+	return find(s.treeNodes)
+}
+
+// TreeSibling returns the ID of the sibling offset places after the issue in
+// the tree (negative offsets go back), or "" if there is none
+func (s *State) TreeSibling(issueID string, offset int) string {
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+	siblings, index := s.treeSiblings(issueID)
+	if siblings == nil || index+offset < 0 || index+offset >= len(siblings) {
+		return ""
+	}
+	return siblings[index+offset].Issue.ID
+}
+
+// TreeChild returns the ID of the issue's nth child in the tree (1-based),
+// or "" if it has fewer children
+func (s *State) TreeChild(issueID string, n int) string {
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+	siblings, index := s.treeSiblings(issueID)
+	if siblings == nil || n < 1 || n > len(siblings[index].Children) {
+		return ""
+	}
+	return siblings[index].Children[n-1].Issue.ID
+}
+
+// IsTreeDescendant reports whether the issue sits anywhere under ancestorID
+// in the tree
+func (s *State) IsTreeDescendant(issueID, ancestorID string) bool {
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+	siblings, index := s.treeSiblings(ancestorID)
+	if siblings == nil {
+		return false
+	}
+	var find func(nodes []*TreeNode) bool
+	find = func(nodes []*TreeNode) bool {
+		for _, node := range nodes {
+			if node.Issue.ID == issueID || find(node.Children) {
+				return true
+			}
+		}
+		return false
+	}
+	return find(siblings[index].Children)
+}
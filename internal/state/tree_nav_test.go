@@ -0,0 +1,56 @@
+package state
+
+import (
+	"testing"
+	"time"
+
+	"github.com/andy/beads-tui/internal/parser"
+)
+
+func TestTreeSiblingAndChild(t *testing.T) {
+	now := time.Now()
+	issue := func(id string, created time.Duration) *parser.Issue {
+		return &parser.Issue{ID: id, Title: id, Status: parser.StatusOpen, IssueType: parser.TypeTask, CreatedAt: now.Add(created), UpdatedAt: now}
+	}
+	state := New()
+	state.SetViewMode(ViewTree)
+	state.LoadIssues([]*parser.Issue{
+		issue("tui-a", 0), issue("tui-a.1", 0), issue("tui-a.2", 0), issue("tui-a.3", 0),
+		issue("tui-b", 0),
+	})
+
+	first := state.TreeChild("tui-a", 1)
+	if first == "" || state.TreeParent(first) != "tui-a" {
+		t.Fatalf("expected a first child of tui-a, got %q", first)
+	}
+	third := state.TreeChild("tui-a", 3)
+	if third == "" || state.TreeChild("tui-a", 4) != "" || state.TreeChild("tui-a", 0) != "" {
+		t.Errorf("expected exactly three children, third = %q", third)
+	}
+	if state.TreeChild("tui-b", 1) != "" {
+		t.Error("expected no children for a leaf")
+	}
+
+	second := state.TreeSibling(first, 1)
+	if second == "" || second == first || state.TreeSibling(second, -1) != first {
+		t.Errorf("expected next/previous sibling to round-trip, got %q", second)
+	}
+	if state.TreeSibling(first, -1) != "" || state.TreeSibling(third, 1) != "" {
+		t.Error("expected no sibling before the first child or after the last")
+	}
+
+	roots := []string{state.GetTreeNodes()[0].Issue.ID, state.GetTreeNodes()[1].Issue.ID}
+	if got := state.TreeSibling(roots[0], 1); got != roots[1] {
+		t.Errorf("expected top-level issues to be siblings, got %q", got)
+	}
+	if state.TreeSibling("tui-missing", 1) != "" {
+		t.Error("expected no sibling for an unknown issue")
+	}
+
+	if !state.IsTreeDescendant(first, "tui-a") || state.IsTreeDescendant("tui-a", first) {
+		t.Error("expected tui-a's children, and only them, to be its descendants")
+	}
+	if state.IsTreeDescendant("tui-b", "tui-a") || state.IsTreeDescendant("tui-a", "tui-a") {
+		t.Error("expected neither a sibling nor the issue itself to be a descendant")
+	}
+}
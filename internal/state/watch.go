@@ -0,0 +1,78 @@
+package state
+
+// SetWatched replaces the watched issue IDs (the per-project watch list)
+func (s *State) SetWatched(issueIDs []string) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	s.watched = make(map[string]bool, len(issueIDs))
+	for _, id := range issueIDs {
+		s.watched[id] = true
+	}
+}
+
+// IsWatched reports whether an issue is on the watch list
+func (s *State) IsWatched(issueID string) bool {
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+	return s.watched[issueID]
+}
+
+// ToggleWatched adds an issue to the watch list, or removes it (and any
+// unseen change) if it is already there. Returns true if it is now watched.
+func (s *State) ToggleWatched(issueID string) bool {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	if s.watched == nil {
+		s.watched = make(map[string]bool)
+	}
+	if s.watched[issueID] {
+		delete(s.watched, issueID)
+		delete(s.unseenChanges, issueID)
+		return false
+	}
+	s.watched[issueID] = true
+	return true
+}
+
+// WatchedIDs returns the watched issue IDs in sorted order
+func (s *State) WatchedIDs() []string {
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+	return sortedKeys(s.watched)
+}
+
+// MarkUnseenChanges flags watched issues that changed in a refresh until
+// they are looked at. IDs that aren't watched are ignored.
+func (s *State) MarkUnseenChanges(issueIDs []string) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	for _, id := range issueIDs {
+		if !s.watched[id] {
+			continue
+		}
+		if s.unseenChanges == nil {
+			s.unseenChanges = make(map[string]bool)
+		}
+		s.unseenChanges[id] = true
+	}
+}
+
+// HasUnseenChange reports whether a watched issue changed since it was last
+// looked at
+func (s *State) HasUnseenChange(issueID string) bool {
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+	return s.unseenChanges[issueID]
+}
+
+// ClearUnseenChange marks an issue's changes as seen. Returns true if there
+// was anything to clear.
+func (s *State) ClearUnseenChange(issueID string) bool {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	if !s.unseenChanges[issueID] {
+		return false
+	}
+	delete(s.unseenChanges, issueID)
+	return true
+}
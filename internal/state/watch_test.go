@@ -0,0 +1,34 @@
+package state
+
+import (
+	"reflect"
+	"testing"
+)
+
+func TestWatchList(t *testing.T) {
+	state := New()
+	state.SetWatched([]string{"tui-2", "tui-1"})
+
+	if got := state.WatchedIDs(); !reflect.DeepEqual(got, []string{"tui-1", "tui-2"}) {
+		t.Errorf("WatchedIDs = %v", got)
+	}
+	if !state.ToggleWatched("tui-3") || !state.IsWatched("tui-3") {
+		t.Error("expected tui-3 to be watched after toggling it on")
+	}
+
+	state.MarkUnseenChanges([]string{"tui-1", "tui-9"})
+	if !state.HasUnseenChange("tui-1") {
+		t.Error("expected an unseen change on watched tui-1")
+	}
+	if state.HasUnseenChange("tui-9") {
+		t.Error("expected unwatched issues to be ignored")
+	}
+	if !state.ClearUnseenChange("tui-1") || state.ClearUnseenChange("tui-1") {
+		t.Error("expected the change to be cleared exactly once")
+	}
+
+	state.MarkUnseenChanges([]string{"tui-2"})
+	if state.ToggleWatched("tui-2") || state.HasUnseenChange("tui-2") {
+		t.Error("expected unwatching to drop the unseen change")
+	}
+}
@@ -0,0 +1,207 @@
+// Package stats computes time-series statistics over issues for the
+// statistics dashboard: weekly flow (opened vs closed), the open backlog over
+// time, time to close, the oldest open issues, logged time against
+// estimates, and the estimated open work.
+package stats
+
+import (
+	"sort"
+	"time"
+
+	"github.com/andy/beads-tui/internal/parser"
+)
+
+// week is the length of one bucket in the weekly series
+const week = 7 * 24 * time.Hour
+
+// WeekFlow counts the issues opened and closed in one week
+type WeekFlow struct {
+	Start  time.Time // Start of the week (the week ends 7 days later)
+	Opened int
+	Closed int
+	Open   int // Issues still open at the end of the week (the burndown line)
+}
+
+// WeeklyFlow returns the given number of weeks ending at now, oldest first.
+// Weeks are rolling 7-day windows, so the last one always ends at now.
+func WeeklyFlow(issues []*parser.Issue, now time.Time, weeks int) []WeekFlow {
+	start := now.Add(-time.Duration(weeks) * week)
+	flow := make([]WeekFlow, weeks)
+	for i := range flow {
+		flow[i].Start = start.Add(time.Duration(i) * week)
+	}
+
+	bucket := func(t time.Time) int {
+		if !t.After(start) {
+			return -1
+		}
+		return min(int(t.Sub(start)/week), weeks-1) // Clock skew: future events count as this week
+	}
+
+	for _, issue := range issues {
+		if i := bucket(issue.CreatedAt); i >= 0 {
+			flow[i].Opened++
+		}
+		if closedAt := closedTime(issue); closedAt != nil {
+			if i := bucket(*closedAt); i >= 0 {
+				flow[i].Closed++
+			}
+		}
+	}
+
+	for i := range flow {
+		flow[i].Open = OpenAt(issues, flow[i].Start.Add(week))
+	}
+	return flow
+}
+
+// OpenAt counts the issues that existed and weren't closed at time t
+func OpenAt(issues []*parser.Issue, t time.Time) int {
+	count := 0
+	for _, issue := range issues {
+		if issue.CreatedAt.After(t) {
+			continue
+		}
+		if closedAt := closedTime(issue); closedAt != nil && !closedAt.After(t) {
+			continue
+		}
+		count++
+	}
+	return count
+}
+
+// CloseTimes summarizes how long closed issues took from creation to close
+type CloseTimes struct {
+	Count   int
+	Average time.Duration
+	Median  time.Duration
+}
+
+// TimeToClose measures creation-to-close time over the closed issues
+func TimeToClose(issues []*parser.Issue) CloseTimes {
+	var durations []time.Duration
+	for _, issue := range issues {
+		if closedAt := closedTime(issue); closedAt != nil && !closedAt.Before(issue.CreatedAt) {
+			durations = append(durations, closedAt.Sub(issue.CreatedAt))
+		}
+	}
+	if len(durations) == 0 {
+		return CloseTimes{}
+	}
+
+	sort.Slice(durations, func(i, j int) bool { return durations[i] < durations[j] })
+	var total time.Duration
+	for _, d := range durations {
+		total += d
+	}
+
+	result := CloseTimes{Count: len(durations), Average: total / time.Duration(len(durations))}
+	if mid := len(durations) / 2; len(durations)%2 == 1 {
+		result.Median = durations[mid]
+	} else {
+		result.Median = (durations[mid-1] + durations[mid]) / 2
+	}
+	return result
+}
+
+// OldestOpen returns up to limit open issues, oldest first
+func OldestOpen(issues []*parser.Issue, limit int) []*parser.Issue {
+	var open []*parser.Issue
+	for _, issue := range issues {
+		if issue.Status != parser.StatusClosed {
+			open = append(open, issue)
+		}
+	}
+	sort.SliceStable(open, func(i, j int) bool {
+		return open[i].CreatedAt.Before(open[j].CreatedAt)
+	})
+	if len(open) > limit {
+		open = open[:limit]
+	}
+	return open
+}
+
+// TimeTracking compares the time logged on issues with their estimates
+type TimeTracking struct {
+	LoggedMinutes int // Logged across all issues
+	LoggedIssues  int // Issues with time logged
+
+	// Issues with both an estimate and logged time
+	Compared         int
+	EstimatedMinutes int // Their estimates
+	ComparedLogged   int // Time logged on them
+
+	OverEstimate []*parser.Issue // Compared issues logged past their estimate, furthest over first
+}
+
+// CompareToEstimates totals the time logged per issue ID (each issue's own
+// time, so epics don't count their children twice) against the estimates
+func CompareToEstimates(issues []*parser.Issue, logged map[string]int) TimeTracking {
+	var result TimeTracking
+	over := make(map[string]int)
+	for _, issue := range issues {
+		minutes := logged[issue.ID]
+		if minutes <= 0 {
+			continue
+		}
+		result.LoggedMinutes += minutes
+		result.LoggedIssues++
+
+		if issue.EstimatedMinutes == nil || *issue.EstimatedMinutes <= 0 {
+			continue
+		}
+		result.Compared++
+		result.EstimatedMinutes += *issue.EstimatedMinutes
+		result.ComparedLogged += minutes
+		if minutes > *issue.EstimatedMinutes {
+			over[issue.ID] = minutes - *issue.EstimatedMinutes
+			result.OverEstimate = append(result.OverEstimate, issue)
+		}
+	}
+	sort.SliceStable(result.OverEstimate, func(i, j int) bool {
+		return over[result.OverEstimate[i].ID] > over[result.OverEstimate[j].ID]
+	})
+	return result
+}
+
+// OpenEstimates is the work estimated on issues that aren't closed
+type OpenEstimates struct {
+	Minutes     int    // Estimated across open issues
+	Issues      int    // Open issues with an estimate
+	Unestimated int    // Open issues without one
+	ByPriority  [5]int // Estimated minutes per priority, P0-P4
+}
+
+// EstimateOpenWork totals the estimates of the issues that aren't closed,
+// each issue's own estimate only (epics aren't rolled up, so nothing counts
+// twice)
+func EstimateOpenWork(issues []*parser.Issue) OpenEstimates {
+	var result OpenEstimates
+	for _, issue := range issues {
+		if issue.Status == parser.StatusClosed {
+			continue
+		}
+		if issue.EstimatedMinutes == nil || *issue.EstimatedMinutes <= 0 {
+			result.Unestimated++
+			continue
+		}
+		result.Minutes += *issue.EstimatedMinutes
+		result.Issues++
+		if issue.Priority >= 0 && issue.Priority < len(result.ByPriority) {
+			result.ByPriority[issue.Priority] += *issue.EstimatedMinutes
+		}
+	}
+	return result
+}
+
+// closedTime returns when a closed issue was closed. Closed issues without a
+// closed_at fall back to their last update.
+func closedTime(issue *parser.Issue) *time.Time {
+	if issue.Status != parser.StatusClosed {
+		return nil
+	}
+	if issue.ClosedAt != nil {
+		return issue.ClosedAt
+	}
+	return &issue.UpdatedAt
+}
@@ -0,0 +1,126 @@
+package stats
+
+import (
+	"testing"
+	"time"
+
+	"github.com/andy/beads-tui/internal/parser"
+)
+
+var now = time.Date(2026, 3, 1, 12, 0, 0, 0, time.UTC)
+
+const day = 24 * time.Hour
+
+func closedIssue(id string, created, closed time.Duration) *parser.Issue {
+	closedAt := now.Add(-closed)
+	return &parser.Issue{ID: id, Status: parser.StatusClosed, CreatedAt: now.Add(-created), ClosedAt: &closedAt}
+}
+
+func openIssue(id string, created time.Duration) *parser.Issue {
+	return &parser.Issue{ID: id, Status: parser.StatusOpen, CreatedAt: now.Add(-created)}
+}
+
+func TestWeeklyFlow(t *testing.T) {
+	issues := []*parser.Issue{
+		openIssue("old", 30*day),           // Before the window
+		openIssue("new", 2*day),            // Opened this week
+		closedIssue("fast", 10*day, 9*day), // Opened and closed last week
+		closedIssue("slow", 40*day, 1*day), // Closed this week
+		{ID: "no-close-time", Status: parser.StatusClosed, CreatedAt: now.Add(-3 * day), UpdatedAt: now.Add(-day)},
+	}
+
+	flow := WeeklyFlow(issues, now, 2)
+	if len(flow) != 2 {
+		t.Fatalf("expected 2 weeks, got %d", len(flow))
+	}
+	if !flow[0].Start.Equal(now.Add(-14*day)) || !flow[1].Start.Equal(now.Add(-7*day)) {
+		t.Errorf("unexpected week starts %v, %v", flow[0].Start, flow[1].Start)
+	}
+	if flow[0].Opened != 1 || flow[0].Closed != 1 {
+		t.Errorf("expected last week 1 opened/1 closed, got %+v", flow[0])
+	}
+	if flow[1].Opened != 2 || flow[1].Closed != 2 {
+		t.Errorf("expected this week 2 opened/2 closed, got %+v", flow[1])
+	}
+	// End of last week: old, slow open; end of this week: old, new
+	if flow[0].Open != 2 || flow[1].Open != 2 {
+		t.Errorf("expected 2 open at the end of each week, got %d and %d", flow[0].Open, flow[1].Open)
+	}
+}
+
+func TestTimeToClose(t *testing.T) {
+	if got := TimeToClose([]*parser.Issue{openIssue("a", day)}); got.Count != 0 {
+		t.Errorf("expected no closed issues, got %+v", got)
+	}
+
+	issues := []*parser.Issue{
+		closedIssue("a", 3*day, 2*day),  // 1 day
+		closedIssue("b", 10*day, 7*day), // 3 days
+		closedIssue("c", 12*day, 4*day), // 8 days
+		openIssue("d", 50*day),
+	}
+	got := TimeToClose(issues)
+	if got.Count != 3 || got.Average != 4*day || got.Median != 3*day {
+		t.Errorf("unexpected close times %+v", got)
+	}
+
+	issues = append(issues, closedIssue("e", 6*day, day)) // 5 days
+	if got := TimeToClose(issues); got.Median != 4*day {
+		t.Errorf("expected median of even count to average the middle two, got %v", got.Median)
+	}
+}
+
+func TestOldestOpen(t *testing.T) {
+	issues := []*parser.Issue{
+		openIssue("newest", day),
+		closedIssue("closed", 100*day, day),
+		openIssue("oldest", 90*day),
+		openIssue("middle", 30*day),
+	}
+	got := OldestOpen(issues, 2)
+	if len(got) != 2 || got[0].ID != "oldest" || got[1].ID != "middle" {
+		t.Errorf("unexpected oldest open issues %v", got)
+	}
+}
+
+func TestCompareToEstimates(t *testing.T) {
+	estimate := func(minutes int) *int { return &minutes }
+	issues := []*parser.Issue{
+		{ID: "under", EstimatedMinutes: estimate(120)},
+		{ID: "over", EstimatedMinutes: estimate(60)},
+		{ID: "way-over", EstimatedMinutes: estimate(30)},
+		{ID: "no-estimate"},
+		{ID: "no-time", EstimatedMinutes: estimate(60)},
+	}
+	logged := map[string]int{"under": 90, "over": 75, "way-over": 90, "no-estimate": 20, "gone": 500}
+
+	got := CompareToEstimates(issues, logged)
+	if got.LoggedMinutes != 275 || got.LoggedIssues != 4 {
+		t.Errorf("expected 275m over 4 issues, got %dm over %d", got.LoggedMinutes, got.LoggedIssues)
+	}
+	if got.Compared != 3 || got.EstimatedMinutes != 210 || got.ComparedLogged != 255 {
+		t.Errorf("unexpected comparison: %+v", got)
+	}
+	if len(got.OverEstimate) != 2 || got.OverEstimate[0].ID != "way-over" || got.OverEstimate[1].ID != "over" {
+		t.Errorf("expected way-over then over, got %v", got.OverEstimate)
+	}
+}
+
+func TestEstimateOpenWork(t *testing.T) {
+	estimate := func(minutes int) *int { return &minutes }
+	issues := []*parser.Issue{
+		{ID: "p0", Status: parser.StatusOpen, Priority: 0, EstimatedMinutes: estimate(60)},
+		{ID: "p2", Status: parser.StatusInProgress, Priority: 2, EstimatedMinutes: estimate(120)},
+		{ID: "p2b", Status: parser.StatusBlocked, Priority: 2, EstimatedMinutes: estimate(30)},
+		{ID: "closed", Status: parser.StatusClosed, Priority: 1, EstimatedMinutes: estimate(500)},
+		{ID: "none", Status: parser.StatusOpen, Priority: 3},
+	}
+
+	got := EstimateOpenWork(issues)
+	if got.Minutes != 210 || got.Issues != 3 || got.Unestimated != 1 {
+		t.Errorf("expected 210m over 3 issues and 1 unestimated, got %+v", got)
+	}
+	if got.ByPriority != [5]int{60, 0, 150, 0, 0} {
+		t.Errorf("unexpected estimates by priority: %v", got.ByPriority)
+	}
+}
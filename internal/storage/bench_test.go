@@ -0,0 +1,89 @@
+package storage
+
+import (
+	"context"
+	"database/sql"
+	"fmt"
+	"testing"
+	"time"
+)
+
+// benchSizes are the synthetic dataset sizes the refresh path is measured at
+var benchSizes = []int{1000, 10000, 50000}
+
+// fillBenchDB inserts n issues with a spread of statuses, priorities, and
+// types; every issue gets a label, every tenth one a blocking dependency on
+// the issue before it, and every twentieth one a comment
+func fillBenchDB(b *testing.B, dbPath string, n int) {
+	b.Helper()
+	db, err := sql.Open("sqlite3", dbPath)
+	if err != nil {
+		b.Fatalf("failed to open database: %v", err)
+	}
+	defer db.Close()
+
+	tx, err := db.Begin()
+	if err != nil {
+		b.Fatalf("failed to begin: %v", err)
+	}
+	statuses := []string{"open", "in_progress", "blocked", "closed"}
+	types := []string{"task", "bug", "feature", "epic", "chore"}
+	created := time.Date(2024, 1, 1, 0, 0, 0, 0, time.UTC)
+	for i := 0; i < n; i++ {
+		id := fmt.Sprintf("bench-%d", i)
+		at := created.Add(time.Duration(i) * time.Minute)
+		if _, err := tx.Exec(`INSERT INTO issues (id, title, description, status, priority, issue_type, assignee, created_at, updated_at)
+			VALUES (?, ?, ?, ?, ?, ?, ?, ?, ?)`,
+			id, fmt.Sprintf("Synthetic issue %d", i), "A description long enough to be representative of a real issue.",
+			statuses[i%len(statuses)], i%5, types[i%len(types)], fmt.Sprintf("user%d", i%7), at, at); err != nil {
+			b.Fatalf("failed to insert issue: %v", err)
+		}
+		if _, err := tx.Exec(`INSERT INTO labels (issue_id, label) VALUES (?, ?)`, id, fmt.Sprintf("area-%d", i%12)); err != nil {
+			b.Fatalf("failed to insert label: %v", err)
+		}
+		if i > 0 && i%10 == 0 {
+			if _, err := tx.Exec(`INSERT INTO dependencies (issue_id, depends_on_id, type) VALUES (?, ?, 'blocks')`,
+				id, fmt.Sprintf("bench-%d", i-1)); err != nil {
+				b.Fatalf("failed to insert dependency: %v", err)
+			}
+		}
+		if i%20 == 0 {
+			if _, err := tx.Exec(`INSERT INTO comments (issue_id, author, text, created_at) VALUES (?, ?, ?, ?)`,
+				id, "bench", "A comment", at); err != nil {
+				b.Fatalf("failed to insert comment: %v", err)
+			}
+		}
+	}
+	if err := tx.Commit(); err != nil {
+		b.Fatalf("failed to commit: %v", err)
+	}
+}
+
+func BenchmarkLoadIssues(b *testing.B) {
+	for _, n := range benchSizes {
+		b.Run(fmt.Sprintf("issues=%d", n), func(b *testing.B) {
+			dbPath, cleanup := setupTestDB(b)
+			defer cleanup()
+			fillBenchDB(b, dbPath, n)
+
+			reader, err := NewSQLiteReader(dbPath)
+			if err != nil {
+				b.Fatalf("NewSQLiteReader failed: %v", err)
+			}
+			defer reader.Close()
+
+			ctx := context.Background()
+			b.ReportAllocs()
+			b.ResetTimer()
+			for i := 0; i < b.N; i++ {
+				issues, err := reader.LoadIssues(ctx)
+				if err != nil {
+					b.Fatalf("LoadIssues failed: %v", err)
+				}
+				if len(issues) != n {
+					b.Fatalf("Expected %d issues, got %d", n, len(issues))
+				}
+			}
+		})
+	}
+}
@@ -0,0 +1,62 @@
+package storage
+
+import (
+	"context"
+	"sync"
+	"time"
+
+	"github.com/andy/beads-tui/internal/parser"
+)
+
+// CommentCache loads comments one issue at a time and keeps them until the
+// issue's updated_at changes, so moving through the list doesn't query the
+// database again for issues already seen.
+type CommentCache struct {
+	reader IssueSource
+
+	mu      sync.Mutex
+	entries map[string]cachedComments
+}
+
+// cachedComments is an issue's comments as of one updated_at
+type cachedComments struct {
+	updatedAt time.Time
+	comments  []*parser.Comment
+}
+
+// NewCommentCache creates an empty cache reading from reader
+func NewCommentCache(reader IssueSource) *CommentCache {
+	return &CommentCache{
+		reader:  reader,
+		entries: make(map[string]cachedComments),
+	}
+}
+
+// Comments returns the issue's comments, reading them only if they aren't
+// cached for the issue's current updated_at
+func (c *CommentCache) Comments(ctx context.Context, issue *parser.Issue) ([]*parser.Comment, error) {
+	c.mu.Lock()
+	entry, ok := c.entries[issue.ID]
+	c.mu.Unlock()
+	if ok && entry.updatedAt.Equal(issue.UpdatedAt) {
+		return entry.comments, nil
+	}
+
+	comments, err := c.reader.LoadComments(ctx, issue.ID)
+	if err != nil {
+		return nil, err
+	}
+
+	c.mu.Lock()
+	c.entries[issue.ID] = cachedComments{updatedAt: issue.UpdatedAt, comments: comments}
+	c.mu.Unlock()
+	return comments, nil
+}
+
+// Forget drops an issue's cached comments, for changes that might not
+// touch updated_at (such as adding a comment)
+func (c *CommentCache) Forget(issueID string) {
+	c.mu.Lock()
+	delete(c.entries, issueID)
+	c.mu.Unlock()
+}
@@ -0,0 +1,71 @@
+package storage
+
+import (
+	"context"
+	"database/sql"
+	"testing"
+	"time"
+
+	"github.com/andy/beads-tui/internal/parser"
+)
+
+func TestCommentCache(t *testing.T) {
+	dbPath, cleanup := setupTestDB(t)
+	defer cleanup()
+
+	db, err := sql.Open("sqlite3", dbPath)
+	if err != nil {
+		t.Fatalf("failed to open database: %v", err)
+	}
+	defer db.Close()
+
+	now := time.Now().UTC().Truncate(time.Second)
+	addComment := func(text string) {
+		t.Helper()
+		if _, err := db.Exec(`INSERT INTO comments (issue_id, author, text, created_at) VALUES ('test-1', 'alice', ?, ?)`, text, now); err != nil {
+			t.Fatalf("failed to insert comment: %v", err)
+		}
+	}
+	addComment("first")
+
+	reader, err := NewSQLiteReader(dbPath)
+	if err != nil {
+		t.Fatalf("NewSQLiteReader failed: %v", err)
+	}
+	defer reader.Close()
+
+	cache := NewCommentCache(reader)
+	ctx := context.Background()
+	issue := &parser.Issue{ID: "test-1", UpdatedAt: now}
+	count := func() int {
+		t.Helper()
+		comments, err := cache.Comments(ctx, issue)
+		if err != nil {
+			t.Fatalf("Comments failed: %v", err)
+		}
+		return len(comments)
+	}
+
+	if got := count(); got != 1 {
+		t.Fatalf("expected 1 comment, got %d", got)
+	}
+
+	// Same updated_at: served from the cache
+	addComment("second")
+	if got := count(); got != 1 {
+		t.Errorf("expected the cached comment, got %d", got)
+	}
+
+	// A newer updated_at reads again
+	issue.UpdatedAt = now.Add(time.Minute)
+	if got := count(); got != 2 {
+		t.Errorf("expected 2 comments after the update, got %d", got)
+	}
+
+	// Forget reads again without an update
+	addComment("third")
+	cache.Forget("test-1")
+	if got := count(); got != 3 {
+		t.Errorf("expected 3 comments after Forget, got %d", got)
+	}
+}
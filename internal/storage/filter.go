@@ -0,0 +1,117 @@
+package storage
+
+import (
+	"slices"
+	"strings"
+
+	"github.com/andy/beads-tui/internal/parser"
+)
+
+// FilterSpec is the part of the list filters LoadIssuesFiltered applies in
+// the query. An empty field doesn't filter; the others must all match.
+type FilterSpec struct {
+	Priorities    []int              // Any of these priorities
+	Types         []parser.IssueType // Any of these types
+	Statuses      []parser.Status    // Any of these statuses
+	Labels        []string           // Any of these labels (lowercased), or every one with LabelMatchAll
+	LabelMatchAll bool
+}
+
+// IsEmpty reports whether the spec filters nothing
+func (f FilterSpec) IsEmpty() bool {
+	return len(f.Priorities) == 0 && len(f.Types) == 0 && len(f.Statuses) == 0 && len(f.Labels) == 0
+}
+
+// Equal reports whether two specs select the same issues. Fields are
+// compared in order, so build both the same way (see State.FilterSpec).
+func (f FilterSpec) Equal(other FilterSpec) bool {
+	matchAll := f.LabelMatchAll == other.LabelMatchAll || len(f.Labels) < 2
+	return slices.Equal(f.Priorities, other.Priorities) &&
+		slices.Equal(f.Types, other.Types) &&
+		slices.Equal(f.Statuses, other.Statuses) &&
+		slices.Equal(f.Labels, other.Labels) &&
+		matchAll
+}
+
+// wantedCTE builds a "wanted" table of the issues the spec matches plus
+// their blockers and parents, transitively, and returns it as a WITH clause
+// to put ahead of a query, with its parameters
+func (f FilterSpec) wantedCTE() (string, []any) {
+	var conditions []string
+	var args []any
+	in := func(column string, values []any) {
+		conditions = append(conditions, column+" IN (?"+strings.Repeat(", ?", len(values)-1)+")")
+		args = append(args, values...)
+	}
+	if len(f.Priorities) > 0 {
+		values := make([]any, len(f.Priorities))
+		for i, p := range f.Priorities {
+			values[i] = p
+		}
+		in("priority", values)
+	}
+	if len(f.Types) > 0 {
+		values := make([]any, len(f.Types))
+		for i, t := range f.Types {
+			values[i] = string(t)
+		}
+		in("issue_type", values)
+	}
+	if len(f.Statuses) > 0 {
+		values := make([]any, len(f.Statuses))
+		for i, st := range f.Statuses {
+			values[i] = string(st)
+		}
+		in("status", values)
+	}
+	if len(f.Labels) > 0 {
+		labels := "lower(label) IN (?" + strings.Repeat(", ?", len(f.Labels)-1) + ")"
+		if f.LabelMatchAll {
+			conditions = append(conditions, "(SELECT COUNT(DISTINCT lower(label)) FROM labels WHERE issue_id = issues.id AND "+labels+") = ?")
+		} else {
+			conditions = append(conditions, "id IN (SELECT issue_id FROM labels WHERE "+labels+")")
+		}
+		for _, label := range f.Labels {
+			args = append(args, strings.ToLower(label))
+		}
+		if f.LabelMatchAll {
+			args = append(args, len(f.Labels))
+		}
+	}
+
+	where := ""
+	if len(conditions) > 0 {
+		where = " WHERE " + strings.Join(conditions, " AND ")
+	}
+	return `WITH RECURSIVE wanted(id) AS (
+		SELECT id FROM issues` + where + `
+		UNION
+		SELECT d.depends_on_id FROM dependencies d JOIN wanted w ON d.issue_id = w.id
+		WHERE d.type IN ('blocks', 'parent-child')
+	) `, args
+}
+
+// Matches reports whether the spec selects the issue itself, as the
+// conditions in wantedCTE do (without the blockers and parents it adds).
+// For issue sources that aren't a database.
+func (f FilterSpec) Matches(issue *parser.Issue) bool {
+	if len(f.Priorities) > 0 && !slices.Contains(f.Priorities, issue.Priority) {
+		return false
+	}
+	if len(f.Types) > 0 && !slices.Contains(f.Types, issue.IssueType) {
+		return false
+	}
+	if len(f.Statuses) > 0 && !slices.Contains(f.Statuses, issue.Status) {
+		return false
+	}
+	if len(f.Labels) == 0 {
+		return true
+	}
+	has := func(label string) bool {
+		return slices.ContainsFunc(issue.Labels, func(l string) bool { return strings.EqualFold(l, label) })
+	}
+	if f.LabelMatchAll {
+		return !slices.ContainsFunc(f.Labels, func(label string) bool { return !has(label) })
+	}
+	return slices.ContainsFunc(f.Labels, has)
+}
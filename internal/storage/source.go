@@ -0,0 +1,48 @@
+package storage
+
+import (
+	"context"
+	"time"
+
+	"github.com/andy/beads-tui/internal/parser"
+)
+
+// IssueSource is what the TUI reads issues, comments, and the audit trail
+// from: a beads database (SQLiteReader), or the generated issues of demo
+// mode, kept in memory
+type IssueSource interface {
+	LoadIssues(ctx context.Context) ([]*parser.Issue, error)
+	LoadIssuesFiltered(ctx context.Context, spec FilterSpec) ([]*parser.Issue, error)
+	CountIssues(ctx context.Context) (int, error)
+	LoadClosedIssues(ctx context.Context, q ClosedQuery) ([]*parser.Issue, int, error)
+
+	LoadComments(ctx context.Context, issueID string) ([]*parser.Comment, error)
+	SearchComments(ctx context.Context, text string) (map[string]int, error)
+	CountComments(ctx context.Context) (map[string]int, error)
+	LatestCommentsWithPrefix(ctx context.Context, prefix string) (map[string]string, error)
+
+	LoadEvents(ctx context.Context, issueID string) ([]*parser.Event, error)
+	LoadStatusChanges(ctx context.Context, since time.Time) ([]*parser.Event, error)
+
+	// RawIssue and Path are for the raw-data inspector
+	RawIssue(ctx context.Context, issueID string) ([]RawTable, error)
+	Path() string
+
+	Close() error
+}
+
+// IssueWriter applies the simple changes --direct-write makes without bd
+// (see SQLiteWriter), returning the issue as stored afterwards
+type IssueWriter interface {
+	UpdateStatus(ctx context.Context, issueID string, status parser.Status, reason string) (*parser.Issue, error)
+	UpdatePriority(ctx context.Context, issueID string, priority int) (*parser.Issue, error)
+	AddLabel(ctx context.Context, issueID, label string) (*parser.Issue, error)
+	RemoveLabel(ctx context.Context, issueID, label string) (*parser.Issue, error)
+	AddComment(ctx context.Context, issueID, text string) (*parser.Comment, error)
+	Close() error
+}
+
+var (
+	_ IssueSource = (*SQLiteReader)(nil)
+	_ IssueWriter = (*SQLiteWriter)(nil)
+)
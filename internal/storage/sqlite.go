@@ -3,11 +3,13 @@ package storage
 import (
 	"context"
 	"database/sql"
+	"encoding/hex"
 	"errors"
 	"fmt"
 	"log"
 	"strings"
 	"time"
+	"unicode/utf8"
 
 	"github.com/andy/beads-tui/internal/parser"
 	_ "github.com/ncruces/go-sqlite3/driver"
@@ -168,11 +170,39 @@ func (r *SQLiteReader) reconnect(ctx context.Context) error {
 	return fmt.Errorf("failed to reconnect after %d attempts", maxRetries)
 }
 
-// LoadIssues reads all issues from the database with dependencies, labels, and comments
+// LoadIssues reads all issues from the database with dependencies and labels.
+// Comments are left out to keep the load small; use LoadComments (or a
+// CommentCache) for the issue being shown.
 // Uses read-only transaction to ensure consistent snapshot
 // Includes health check and automatic reconnection on stale connections
 // Returns ErrDatabaseCorrupted if the database is corrupted.
 func (r *SQLiteReader) LoadIssues(ctx context.Context) ([]*parser.Issue, error) {
+	return r.loadIssues(ctx, FilterSpec{})
+}
+
+// LoadIssuesFiltered is LoadIssues narrowed in the query to the issues the
+// spec matches, for databases too large to load whole. The issues those
+// depend on (their blockers and parents, and theirs in turn) are loaded
+// too, so blocked state and the tree still come out right.
+func (r *SQLiteReader) LoadIssuesFiltered(ctx context.Context, spec FilterSpec) ([]*parser.Issue, error) {
+	return r.loadIssues(ctx, spec)
+}
+
+// CountIssues returns how many issues the database holds
+func (r *SQLiteReader) CountIssues(ctx context.Context) (int, error) {
+	var count int
+	if err := r.db.QueryRowContext(ctx, "SELECT COUNT(*) FROM issues").Scan(&count); err != nil {
+		if isCorruptionError(err) {
+			return 0, fmt.Errorf("%w: %v", ErrDatabaseCorrupted, err)
+		}
+		return 0, fmt.Errorf("failed to count issues: %w", err)
+	}
+	return count, nil
+}
+
+// loadIssues reads the issues spec matches (all of them for an empty spec),
+// with dependencies and labels
+func (r *SQLiteReader) loadIssues(ctx context.Context, spec FilterSpec) ([]*parser.Issue, error) {
 	// Health check before reading
 	if err := r.healthCheck(ctx); err != nil {
 		if isCorruptionError(err) {
@@ -191,13 +221,20 @@ func (r *SQLiteReader) LoadIssues(ctx context.Context) ([]*parser.Issue, error)
 	defer func() { _ = tx.Rollback() }() // Safe to call even after commit
 
 	// Query all issues
-	rows, err := tx.QueryContext(ctx, `
-		SELECT id, title, description, design, acceptance_criteria, notes,
-		       status, priority, issue_type, assignee, estimated_minutes,
-		       created_at, updated_at, closed_at, external_ref
-		FROM issues
-		ORDER BY created_at DESC
-	`)
+	query, err := selectIssues(ctx, tx)
+	if err != nil {
+		if isCorruptionError(err) {
+			return nil, fmt.Errorf("%w: %v", ErrDatabaseCorrupted, err)
+		}
+		return nil, fmt.Errorf("failed to read issues schema: %w", err)
+	}
+	// A spec narrows every query to the issues in its "wanted" set
+	with, restrict, args := "", "", []any(nil)
+	if !spec.IsEmpty() {
+		with, args = spec.wantedCTE()
+		restrict = " WHERE id IN (SELECT id FROM wanted)"
+	}
+	rows, err := tx.QueryContext(ctx, with+query+restrict+" ORDER BY created_at DESC", args...)
 	if err != nil {
 		if isCorruptionError(err) {
 			return nil, fmt.Errorf("%w: %v", ErrDatabaseCorrupted, err)
@@ -208,41 +245,14 @@ func (r *SQLiteReader) LoadIssues(ctx context.Context) ([]*parser.Issue, error)
 
 	var issues []*parser.Issue
 	for rows.Next() {
-		var issue parser.Issue
-		var closedAt sql.NullTime
-		var estimatedMinutes sql.NullInt64
-		var assignee sql.NullString
-		var externalRef sql.NullString
-
-		err := rows.Scan(
-			&issue.ID, &issue.Title, &issue.Description, &issue.Design,
-			&issue.AcceptanceCriteria, &issue.Notes, &issue.Status,
-			&issue.Priority, &issue.IssueType, &assignee, &estimatedMinutes,
-			&issue.CreatedAt, &issue.UpdatedAt, &closedAt, &externalRef,
-		)
+		issue, err := scanIssue(rows)
 		if err != nil {
 			if isCorruptionError(err) {
 				return nil, fmt.Errorf("%w: %v", ErrDatabaseCorrupted, err)
 			}
 			return nil, fmt.Errorf("failed to scan issue: %w", err)
 		}
-
-		// Handle nullable fields
-		if closedAt.Valid {
-			issue.ClosedAt = &closedAt.Time
-		}
-		if estimatedMinutes.Valid {
-			mins := int(estimatedMinutes.Int64)
-			issue.EstimatedMinutes = &mins
-		}
-		if assignee.Valid {
-			issue.Assignee = assignee.String
-		}
-		if externalRef.Valid {
-			issue.ExternalRef = &externalRef.String
-		}
-
-		issues = append(issues, &issue)
+		issues = append(issues, issue)
 	}
 
 	if err := rows.Err(); err != nil {
@@ -253,24 +263,18 @@ func (r *SQLiteReader) LoadIssues(ctx context.Context) ([]*parser.Issue, error)
 	}
 
 	// Load dependencies for all issues (within same transaction)
-	deps, err := r.loadAllDependenciesTx(ctx, tx)
+	deps, err := r.loadAllDependenciesTx(ctx, tx, with, args)
 	if err != nil {
 		return nil, fmt.Errorf("failed to load dependencies: %w", err)
 	}
 
 	// Load labels for all issues (within same transaction)
-	labels, err := r.loadAllLabelsTx(ctx, tx)
+	labels, err := r.loadAllLabelsTx(ctx, tx, with, args)
 	if err != nil {
 		return nil, fmt.Errorf("failed to load labels: %w", err)
 	}
 
-	// Load comments for all issues (within same transaction)
-	comments, err := r.loadAllCommentsTx(ctx, tx)
-	if err != nil {
-		return nil, fmt.Errorf("failed to load comments: %w", err)
-	}
-
-	// Attach dependencies, labels, and comments to issues
+	// Attach dependencies and labels to issues
 	for _, issue := range issues {
 		if issueDeps, ok := deps[issue.ID]; ok {
 			issue.Dependencies = issueDeps
@@ -278,9 +282,6 @@ func (r *SQLiteReader) LoadIssues(ctx context.Context) ([]*parser.Issue, error)
 		if issueLabels, ok := labels[issue.ID]; ok {
 			issue.Labels = issueLabels
 		}
-		if issueComments, ok := comments[issue.ID]; ok {
-			issue.Comments = issueComments
-		}
 	}
 
 	// Read-only transaction can just be rolled back (no changes to commit)
@@ -289,13 +290,231 @@ func (r *SQLiteReader) LoadIssues(ctx context.Context) ([]*parser.Issue, error)
 	return issues, nil
 }
 
+// ClosedQuery selects a page of closed issues by when they were closed
+type ClosedQuery struct {
+	From, To    time.Time // Closed at or after From and before To (zero leaves that end open)
+	OldestFirst bool      // Order by closed_at ascending instead of newest first
+	Limit       int       // Issues per page (0 for all)
+	Offset      int       // Issues to skip, for later pages
+}
+
+// LoadClosedIssues reads one page of the closed issues in the query's
+// range, ordered by when they were closed, and how many the whole range
+// holds. Unlike LoadIssues only the page is read, so the archive of a
+// long-lived project stays cheap to browse; dependencies and labels are
+// left out.
+func (r *SQLiteReader) LoadClosedIssues(ctx context.Context, q ClosedQuery) ([]*parser.Issue, int, error) {
+	if err := r.healthCheck(ctx); err != nil {
+		if isCorruptionError(err) {
+			return nil, 0, fmt.Errorf("%w: %v", ErrDatabaseCorrupted, err)
+		}
+		return nil, 0, fmt.Errorf("database health check failed: %w", err)
+	}
+	tx, err := r.db.BeginTx(ctx, &sql.TxOptions{ReadOnly: true})
+	if err != nil {
+		return nil, 0, fmt.Errorf("failed to begin transaction: %w", err)
+	}
+	defer func() { _ = tx.Rollback() }()
+
+	// closed_at formats vary between bd versions; julianday reads them all
+	where := " WHERE status = 'closed' AND closed_at IS NOT NULL"
+	var args []any
+	if !q.From.IsZero() {
+		where += " AND julianday(closed_at) >= julianday(?)"
+		args = append(args, q.From.UTC().Format(time.RFC3339))
+	}
+	if !q.To.IsZero() {
+		where += " AND julianday(closed_at) < julianday(?)"
+		args = append(args, q.To.UTC().Format(time.RFC3339))
+	}
+
+	var total int
+	if err := tx.QueryRowContext(ctx, "SELECT COUNT(*) FROM issues"+where, args...).Scan(&total); err != nil {
+		if isCorruptionError(err) {
+			return nil, 0, fmt.Errorf("%w: %v", ErrDatabaseCorrupted, err)
+		}
+		return nil, 0, fmt.Errorf("failed to count closed issues: %w", err)
+	}
+
+	query, err := selectIssues(ctx, tx)
+	if err != nil {
+		return nil, 0, fmt.Errorf("failed to read issues schema: %w", err)
+	}
+	order := " ORDER BY julianday(closed_at) DESC, id"
+	if q.OldestFirst {
+		order = " ORDER BY julianday(closed_at), id"
+	}
+	limit := q.Limit
+	if limit <= 0 {
+		limit = -1 // No limit
+	}
+	rows, err := tx.QueryContext(ctx, query+where+order+" LIMIT ? OFFSET ?", append(args, limit, q.Offset)...)
+	if err != nil {
+		if isCorruptionError(err) {
+			return nil, 0, fmt.Errorf("%w: %v", ErrDatabaseCorrupted, err)
+		}
+		return nil, 0, fmt.Errorf("failed to query closed issues: %w", err)
+	}
+	defer rows.Close()
+
+	var issues []*parser.Issue
+	for rows.Next() {
+		issue, err := scanIssue(rows)
+		if err != nil {
+			return nil, 0, fmt.Errorf("failed to scan issue: %w", err)
+		}
+		issues = append(issues, issue)
+	}
+	return issues, total, rows.Err()
+}
+
+// issueColumns are the issues table columns read by scanIssue, in order.
+// selectIssues adds the optional due date after them.
+const issueColumns = `id, title, description, design, acceptance_criteria, notes,
+	status, priority, issue_type, assignee, estimated_minutes,
+	created_at, updated_at, closed_at, external_ref`
+
+// selectIssues returns the SELECT for the columns scanIssue reads. Older
+// beads schemas have no due_date column, so NULL is selected in its place.
+func selectIssues(ctx context.Context, tx *sql.Tx) (string, error) {
+	dueDate := "NULL"
+	ok, err := hasColumn(ctx, tx, "issues", "due_date")
+	if err != nil {
+		return "", err
+	}
+	if ok {
+		dueDate = "due_date"
+	}
+	return "SELECT " + issueColumns + ", " + dueDate + " FROM issues", nil
+}
+
+// hasColumn reports whether a table has the named column
+func hasColumn(ctx context.Context, tx *sql.Tx, table, column string) (bool, error) {
+	var count int
+	err := tx.QueryRowContext(ctx, "SELECT COUNT(*) FROM pragma_table_info(?) WHERE name = ?", table, column).Scan(&count)
+	return count > 0, err
+}
+
+// rowScanner is satisfied by *sql.Row and *sql.Rows
+type rowScanner interface {
+	Scan(dest ...any) error
+}
+
+// scanIssue reads one issue selected with selectIssues, converting nullable
+// columns. Values bd wouldn't write (unknown statuses, out-of-range
+// priorities) are logged but kept so the issue still shows up.
+func scanIssue(row rowScanner) (*parser.Issue, error) {
+	var issue parser.Issue
+	var closedAt sql.NullTime
+	var estimatedMinutes sql.NullInt64
+	var assignee sql.NullString
+	var externalRef sql.NullString
+	var dueDate any // Stored as text or a timestamp depending on the bd version
+
+	err := row.Scan(
+		&issue.ID, &issue.Title, &issue.Description, &issue.Design,
+		&issue.AcceptanceCriteria, &issue.Notes, &issue.Status,
+		&issue.Priority, &issue.IssueType, &assignee, &estimatedMinutes,
+		&issue.CreatedAt, &issue.UpdatedAt, &closedAt, &externalRef, &dueDate,
+	)
+	if err != nil {
+		return nil, err
+	}
+
+	// Handle nullable fields
+	if closedAt.Valid {
+		issue.ClosedAt = &closedAt.Time
+	}
+	if estimatedMinutes.Valid {
+		mins := int(estimatedMinutes.Int64)
+		issue.EstimatedMinutes = &mins
+	}
+	if assignee.Valid {
+		issue.Assignee = assignee.String
+	}
+	if externalRef.Valid {
+		issue.ExternalRef = &externalRef.String
+	}
+	if dueDate != nil {
+		if due, ok := parseDueDate(dueDate); ok {
+			issue.DueDate = &due
+		} else {
+			log.Printf("SQLite: Issue %s: unrecognized due date %v", issue.ID, dueDate)
+		}
+	}
+
+	if err := ValidateStatus(issue.Status); err != nil {
+		log.Printf("SQLite: Issue %s: %v", issue.ID, err)
+	}
+	if err := ValidatePriority(issue.Priority); err != nil {
+		log.Printf("SQLite: Issue %s: %v", issue.ID, err)
+	}
+
+	return &issue, nil
+}
+
+// dueDateLayouts are the text forms a due date may be stored in. Date-only
+// values are taken as local dates.
+var dueDateLayouts = []string{
+	"2006-01-02",
+	time.RFC3339Nano,
+	"2006-01-02 15:04:05.999999999-07:00",
+	"2006-01-02 15:04:05",
+	"2006-01-02T15:04:05",
+}
+
+// parseDueDate converts a due_date value (a timestamp, text, or Unix
+// seconds) to a time. An empty string is treated as no due date.
+func parseDueDate(value any) (time.Time, bool) {
+	switch v := value.(type) {
+	case time.Time:
+		return v, true
+	case int64:
+		return time.Unix(v, 0), true
+	case []byte:
+		return parseDueDate(string(v))
+	case string:
+		v = strings.TrimSpace(v)
+		for _, layout := range dueDateLayouts {
+			if due, err := time.ParseInLocation(layout, v, time.Local); err == nil {
+				return due, true
+			}
+		}
+	}
+	return time.Time{}, false
+}
+
+// ValidateStatus reports an error for statuses bd doesn't define
+func ValidateStatus(status parser.Status) error {
+	switch status {
+	case parser.StatusOpen, parser.StatusInProgress, parser.StatusBlocked, parser.StatusClosed:
+		return nil
+	}
+	return fmt.Errorf("invalid status %q (want open, in_progress, blocked, or closed)", status)
+}
+
+// ValidatePriority reports an error for priorities outside bd's 0-4 range
+func ValidatePriority(priority int) error {
+	if priority < 0 || priority > 4 {
+		return fmt.Errorf("invalid priority %d (want 0-4)", priority)
+	}
+	return nil
+}
+
 // loadAllDependenciesTx loads all dependencies indexed by issue ID within a transaction
-func (r *SQLiteReader) loadAllDependenciesTx(ctx context.Context, tx *sql.Tx) (map[string][]*parser.Dependency, error) {
-	rows, err := tx.QueryContext(ctx, `
+// (with, when not empty, is a FilterSpec's "wanted" CTE limiting them to its
+// issues, and args its parameters)
+func (r *SQLiteReader) loadAllDependenciesTx(ctx context.Context, tx *sql.Tx, with string, args []any) (map[string][]*parser.Dependency, error) {
+	where := ""
+	if with != "" {
+		where = "WHERE issue_id IN (SELECT id FROM wanted)"
+	}
+	rows, err := tx.QueryContext(ctx, with+`
 		SELECT issue_id, depends_on_id, type
 		FROM dependencies
+		`+where+`
 		ORDER BY issue_id, depends_on_id
-	`)
+	`, args...)
 	if err != nil {
 		return nil, fmt.Errorf("failed to query dependencies: %w", err)
 	}
@@ -320,12 +539,18 @@ func (r *SQLiteReader) loadAllDependenciesTx(ctx context.Context, tx *sql.Tx) (m
 }
 
 // loadAllLabelsTx loads all labels indexed by issue ID within a transaction
-func (r *SQLiteReader) loadAllLabelsTx(ctx context.Context, tx *sql.Tx) (map[string][]string, error) {
-	rows, err := tx.QueryContext(ctx, `
+// (limited by with and args as in loadAllDependenciesTx)
+func (r *SQLiteReader) loadAllLabelsTx(ctx context.Context, tx *sql.Tx, with string, args []any) (map[string][]string, error) {
+	where := ""
+	if with != "" {
+		where = "WHERE issue_id IN (SELECT id FROM wanted)"
+	}
+	rows, err := tx.QueryContext(ctx, with+`
 		SELECT issue_id, label
 		FROM labels
+		`+where+`
 		ORDER BY issue_id, label
-	`)
+	`, args...)
 	if err != nil {
 		return nil, fmt.Errorf("failed to query labels: %w", err)
 	}
@@ -345,36 +570,323 @@ func (r *SQLiteReader) loadAllLabelsTx(ctx context.Context, tx *sql.Tx) (map[str
 	return labels, rows.Err()
 }
 
-// loadAllCommentsTx loads all comments indexed by issue ID within a transaction
-func (r *SQLiteReader) loadAllCommentsTx(ctx context.Context, tx *sql.Tx) (map[string][]*parser.Comment, error) {
-	rows, err := tx.QueryContext(ctx, `
-		SELECT issue_id, author, text, created_at
+// LoadComments reads one issue's comments, oldest first
+func (r *SQLiteReader) LoadComments(ctx context.Context, issueID string) ([]*parser.Comment, error) {
+	rows, err := r.db.QueryContext(ctx, `
+		SELECT id, issue_id, author, text, created_at
 		FROM comments
-		ORDER BY issue_id, created_at
-	`)
+		WHERE issue_id = ?
+		ORDER BY created_at
+	`, issueID)
 	if err != nil {
+		if isCorruptionError(err) {
+			return nil, fmt.Errorf("%w: %v", ErrDatabaseCorrupted, err)
+		}
 		return nil, fmt.Errorf("failed to query comments: %w", err)
 	}
 	defer rows.Close()
 
-	comments := make(map[string][]*parser.Comment)
+	var comments []*parser.Comment
 	for rows.Next() {
-		var issueID, author, text string
-		var createdAt time.Time
+		var comment parser.Comment
+		if err := rows.Scan(&comment.ID, &comment.IssueID, &comment.Author, &comment.Text, &comment.CreatedAt); err != nil {
+			return nil, fmt.Errorf("failed to scan comment: %w", err)
+		}
+		comments = append(comments, &comment)
+	}
+
+	return comments, rows.Err()
+}
+
+// SearchComments counts, per issue, the comments containing text
+// (case-insensitive for ASCII), so comment search works without loading
+// every comment into memory
+func (r *SQLiteReader) SearchComments(ctx context.Context, text string) (map[string]int, error) {
+	rows, err := r.db.QueryContext(ctx, `
+		SELECT issue_id, COUNT(*)
+		FROM comments
+		WHERE instr(lower(text), lower(?)) > 0
+		GROUP BY issue_id
+	`, text)
+	if err != nil {
+		return nil, fmt.Errorf("failed to search comments: %w", err)
+	}
+	defer rows.Close()
+
+	matches := make(map[string]int)
+	for rows.Next() {
+		var issueID string
+		var count int
+		if err := rows.Scan(&issueID, &count); err != nil {
+			return nil, fmt.Errorf("failed to scan comment match: %w", err)
+		}
+		matches[issueID] = count
+	}
 
-		if err := rows.Scan(&issueID, &author, &text, &createdAt); err != nil {
+	return matches, rows.Err()
+}
+
+// CountComments returns the number of comments on each issue that has any
+func (r *SQLiteReader) CountComments(ctx context.Context) (map[string]int, error) {
+	rows, err := r.db.QueryContext(ctx, "SELECT issue_id, COUNT(*) FROM comments GROUP BY issue_id")
+	if err != nil {
+		if isCorruptionError(err) {
+			return nil, fmt.Errorf("%w: %v", ErrDatabaseCorrupted, err)
+		}
+		return nil, fmt.Errorf("failed to count comments: %w", err)
+	}
+	defer rows.Close()
+
+	counts := make(map[string]int)
+	for rows.Next() {
+		var issueID string
+		var count int
+		if err := rows.Scan(&issueID, &count); err != nil {
+			return nil, fmt.Errorf("failed to scan comment count: %w", err)
+		}
+		counts[issueID] = count
+	}
+
+	return counts, rows.Err()
+}
+
+// LatestCommentsWithPrefix returns, per issue, the text of its newest
+// comment starting with prefix (case-insensitive for ASCII). Used for
+// structured comments such as "BLOCKED: waiting on vendor".
+func (r *SQLiteReader) LatestCommentsWithPrefix(ctx context.Context, prefix string) (map[string]string, error) {
+	rows, err := r.db.QueryContext(ctx, `
+		SELECT issue_id, text
+		FROM comments
+		WHERE lower(substr(text, 1, length(?))) = lower(?)
+		ORDER BY created_at, id
+	`, prefix, prefix)
+	if err != nil {
+		if isCorruptionError(err) {
+			return nil, fmt.Errorf("%w: %v", ErrDatabaseCorrupted, err)
+		}
+		return nil, fmt.Errorf("failed to query comments: %w", err)
+	}
+	defer rows.Close()
+
+	latest := make(map[string]string)
+	for rows.Next() {
+		var issueID, text string
+		if err := rows.Scan(&issueID, &text); err != nil {
 			return nil, fmt.Errorf("failed to scan comment: %w", err)
 		}
+		latest[issueID] = text // Later rows are newer
+	}
 
-		comments[issueID] = append(comments[issueID], &parser.Comment{
-			IssueID:   issueID,
-			Author:    author,
-			Text:      text,
-			CreatedAt: createdAt,
-		})
+	return latest, rows.Err()
+}
+
+// LoadEvents reads the issue's audit trail from bd's events table, oldest
+// first. Returns no events (and no error) if the database has no events table.
+func (r *SQLiteReader) LoadEvents(ctx context.Context, issueID string) ([]*parser.Event, error) {
+	return r.queryEvents(ctx, "issue_id = ?", issueID)
+}
+
+// LoadStatusChanges reads the status_changed events at or after since, for
+// every issue, oldest first. Returns nil (and no error) if the database has
+// no events table, and an empty slice if it has one but nothing changed, so
+// callers can tell a missing audit trail from a quiet one.
+func (r *SQLiteReader) LoadStatusChanges(ctx context.Context, since time.Time) ([]*parser.Event, error) {
+	events, err := r.queryEvents(ctx, "event_type = 'status_changed'")
+	if events == nil || err != nil {
+		return events, err
+	}
+	// created_at formats vary between bd versions, so compare parsed times
+	recent := []*parser.Event{}
+	for _, event := range events {
+		if !event.CreatedAt.Before(since) {
+			recent = append(recent, event)
+		}
 	}
+	return recent, nil
+}
 
-	return comments, rows.Err()
+// queryEvents reads the events matching a WHERE clause, oldest first. The
+// result is nil if there is no events table, otherwise non-nil.
+func (r *SQLiteReader) queryEvents(ctx context.Context, where string, args ...any) ([]*parser.Event, error) {
+	if err := r.healthCheck(ctx); err != nil {
+		if isCorruptionError(err) {
+			return nil, fmt.Errorf("%w: %v", ErrDatabaseCorrupted, err)
+		}
+		return nil, fmt.Errorf("database health check failed: %w", err)
+	}
+
+	var tableCount int
+	err := r.db.QueryRowContext(ctx, "SELECT COUNT(*) FROM sqlite_master WHERE type='table' AND name='events'").Scan(&tableCount)
+	if err != nil {
+		return nil, fmt.Errorf("failed to check for events table: %w", err)
+	}
+	if tableCount == 0 {
+		return nil, nil
+	}
+
+	rows, err := r.db.QueryContext(ctx, `
+		SELECT id, issue_id, event_type, actor, old_value, new_value, comment, created_at
+		FROM events
+		WHERE `+where+`
+		ORDER BY created_at, id
+	`, args...)
+	if err != nil {
+		if isCorruptionError(err) {
+			return nil, fmt.Errorf("%w: %v", ErrDatabaseCorrupted, err)
+		}
+		return nil, fmt.Errorf("failed to query events: %w", err)
+	}
+	defer rows.Close()
+
+	events := []*parser.Event{}
+	for rows.Next() {
+		var event parser.Event
+		var actor, oldValue, newValue, comment sql.NullString
+
+		if err := rows.Scan(&event.ID, &event.IssueID, &event.EventType, &actor, &oldValue, &newValue, &comment, &event.CreatedAt); err != nil {
+			return nil, fmt.Errorf("failed to scan event: %w", err)
+		}
+
+		// Handle nullable fields
+		event.Actor = actor.String
+		if oldValue.Valid {
+			event.OldValue = &oldValue.String
+		}
+		if newValue.Valid {
+			event.NewValue = &newValue.String
+		}
+		if comment.Valid {
+			event.Comment = &comment.String
+		}
+
+		events = append(events, &event)
+	}
+
+	return events, rows.Err()
+}
+
+// RawColumn describes a table column as declared in the schema
+type RawColumn struct {
+	Name     string
+	DeclType string // Declared type, e.g. "TEXT" or "TIMESTAMP" (empty if undeclared)
+}
+
+// RawValue is a single stored value as returned by the driver, before any
+// conversion into parser types
+type RawValue struct {
+	Value string // Formatted value (empty when Null)
+	Type  string // Go type the driver returned, e.g. "int64" or "time.Time"
+	Null  bool
+}
+
+// RawTable holds rows from one table exactly as stored
+type RawTable struct {
+	Name    string
+	Columns []RawColumn
+	Rows    [][]RawValue
+}
+
+// RawIssue reads every column of the issue's row and of its dependency
+// (both directions), label, and comment rows without interpreting them, for
+// debugging differences between the database and what the TUI shows.
+// An unknown ID yields tables with no rows.
+func (r *SQLiteReader) RawIssue(ctx context.Context, issueID string) ([]RawTable, error) {
+	if err := r.healthCheck(ctx); err != nil {
+		if isCorruptionError(err) {
+			return nil, fmt.Errorf("%w: %v", ErrDatabaseCorrupted, err)
+		}
+		return nil, fmt.Errorf("database health check failed: %w", err)
+	}
+	tx, err := r.db.BeginTx(ctx, &sql.TxOptions{ReadOnly: true})
+	if err != nil {
+		if isCorruptionError(err) {
+			return nil, fmt.Errorf("%w: %v", ErrDatabaseCorrupted, err)
+		}
+		return nil, fmt.Errorf("failed to begin transaction: %w", err)
+	}
+	defer func() { _ = tx.Rollback() }()
+
+	queries := []struct {
+		table string
+		query string
+		args  []any
+	}{
+		{"issues", "SELECT * FROM issues WHERE id = ?", []any{issueID}},
+		{"dependencies", "SELECT * FROM dependencies WHERE issue_id = ? OR depends_on_id = ? ORDER BY issue_id, depends_on_id", []any{issueID, issueID}},
+		{"labels", "SELECT * FROM labels WHERE issue_id = ? ORDER BY label", []any{issueID}},
+		{"comments", "SELECT * FROM comments WHERE issue_id = ? ORDER BY created_at", []any{issueID}},
+	}
+
+	var tables []RawTable
+	for _, q := range queries {
+		table, err := queryRawTx(ctx, tx, q.table, q.query, q.args...)
+		if err != nil {
+			if isCorruptionError(err) {
+				return nil, fmt.Errorf("%w: %v", ErrDatabaseCorrupted, err)
+			}
+			return nil, fmt.Errorf("failed to read %s: %w", q.table, err)
+		}
+		tables = append(tables, table)
+	}
+	return tables, nil
+}
+
+// queryRawTx runs query and collects every column of every row as a RawValue
+func queryRawTx(ctx context.Context, tx *sql.Tx, name, query string, args ...any) (RawTable, error) {
+	table := RawTable{Name: name}
+	rows, err := tx.QueryContext(ctx, query, args...)
+	if err != nil {
+		return table, err
+	}
+	defer rows.Close()
+
+	columnTypes, err := rows.ColumnTypes()
+	if err != nil {
+		return table, err
+	}
+	for _, ct := range columnTypes {
+		table.Columns = append(table.Columns, RawColumn{Name: ct.Name(), DeclType: ct.DatabaseTypeName()})
+	}
+
+	for rows.Next() {
+		values := make([]any, len(columnTypes))
+		dest := make([]any, len(columnTypes))
+		for i := range values {
+			dest[i] = &values[i]
+		}
+		if err := rows.Scan(dest...); err != nil {
+			return table, err
+		}
+
+		row := make([]RawValue, len(values))
+		for i, v := range values {
+			row[i] = rawValue(v)
+		}
+		table.Rows = append(table.Rows, row)
+	}
+	return table, rows.Err()
+}
+
+// rawValue formats a scanned value, keeping track of its driver type
+func rawValue(v any) RawValue {
+	switch val := v.(type) {
+	case nil:
+		return RawValue{Type: "NULL", Null: true}
+	case []byte:
+		if utf8.Valid(val) {
+			return RawValue{Value: string(val), Type: "[]byte"}
+		}
+		return RawValue{Value: hex.EncodeToString(val), Type: "[]byte (hex)"}
+	case time.Time:
+		return RawValue{Value: val.Format(time.RFC3339Nano), Type: "time.Time"}
+	default:
+		return RawValue{Value: fmt.Sprint(val), Type: fmt.Sprintf("%T", val)}
+	}
+}
+
+// Path returns the database file path
+func (r *SQLiteReader) Path() string {
+	return r.dbPath
 }
 
 // Close closes the database connection
@@ -6,6 +6,7 @@ import (
 	"errors"
 	"os"
 	"path/filepath"
+	"reflect"
 	"testing"
 	"time"
 
@@ -15,7 +16,7 @@ import (
 )
 
 // setupTestDB creates a temporary database with the beads schema
-func setupTestDB(t *testing.T) (string, func()) {
+func setupTestDB(t testing.TB) (string, func()) {
 	t.Helper()
 
 	// Create temp directory
@@ -352,7 +353,7 @@ func TestLoadIssues_WithLabels(t *testing.T) {
 	}
 }
 
-func TestLoadIssues_WithComments(t *testing.T) {
+func TestLoadComments(t *testing.T) {
 	dbPath, cleanup := setupTestDB(t)
 	defer cleanup()
 
@@ -383,29 +384,34 @@ func TestLoadIssues_WithComments(t *testing.T) {
 		t.Fatalf("failed to insert comment: %v", err)
 	}
 
-	// Load issues
 	reader, err := NewSQLiteReader(dbPath)
 	if err != nil {
 		t.Fatalf("NewSQLiteReader failed: %v", err)
 	}
 	defer reader.Close()
 
+	// LoadIssues leaves comments to LoadComments
 	ctx := context.Background()
 	issues, err := reader.LoadIssues(ctx)
 	if err != nil {
 		t.Fatalf("LoadIssues failed: %v", err)
 	}
-
 	if len(issues) != 1 {
 		t.Fatalf("Expected 1 issue, got %d", len(issues))
 	}
+	if len(issues[0].Comments) != 0 {
+		t.Errorf("Expected LoadIssues to skip comments, got %d", len(issues[0].Comments))
+	}
 
-	issue := issues[0]
-	if len(issue.Comments) != 1 {
-		t.Fatalf("Expected 1 comment, got %d", len(issue.Comments))
+	comments, err := reader.LoadComments(ctx, "test-1")
+	if err != nil {
+		t.Fatalf("LoadComments failed: %v", err)
+	}
+	if len(comments) != 1 {
+		t.Fatalf("Expected 1 comment, got %d", len(comments))
 	}
 
-	comment := issue.Comments[0]
+	comment := comments[0]
 	if comment.Author != "alice" {
 		t.Errorf("Expected author 'alice', got '%s'", comment.Author)
 	}
@@ -415,6 +421,99 @@ func TestLoadIssues_WithComments(t *testing.T) {
 	if !comment.CreatedAt.Equal(commentTime) {
 		t.Errorf("Expected created_at %v, got %v", commentTime, comment.CreatedAt)
 	}
+
+	// Another issue's comments aren't included
+	comments, err = reader.LoadComments(ctx, "test-2")
+	if err != nil {
+		t.Fatalf("LoadComments failed: %v", err)
+	}
+	if len(comments) != 0 {
+		t.Errorf("Expected no comments for test-2, got %d", len(comments))
+	}
+}
+
+func TestSearchComments(t *testing.T) {
+	dbPath, cleanup := setupTestDB(t)
+	defer cleanup()
+
+	db, err := sql.Open("sqlite3", dbPath)
+	if err != nil {
+		t.Fatalf("failed to open database: %v", err)
+	}
+	defer db.Close()
+
+	now := time.Now().UTC()
+	for _, c := range []struct{ issueID, text string }{
+		{"test-1", "Needs a Migration"},
+		{"test-1", "migration done"},
+		{"test-2", "unrelated"},
+	} {
+		if _, err := db.Exec(`INSERT INTO comments (issue_id, author, text, created_at) VALUES (?, 'alice', ?, ?)`, c.issueID, c.text, now); err != nil {
+			t.Fatalf("failed to insert comment: %v", err)
+		}
+	}
+
+	reader, err := NewSQLiteReader(dbPath)
+	if err != nil {
+		t.Fatalf("NewSQLiteReader failed: %v", err)
+	}
+	defer reader.Close()
+
+	matches, err := reader.SearchComments(context.Background(), "MIGRATION")
+	if err != nil {
+		t.Fatalf("SearchComments failed: %v", err)
+	}
+	if len(matches) != 1 || matches["test-1"] != 2 {
+		t.Errorf("Expected two matching comments on test-1, got %v", matches)
+	}
+
+	counts, err := reader.CountComments(context.Background())
+	if err != nil {
+		t.Fatalf("CountComments failed: %v", err)
+	}
+	if len(counts) != 2 || counts["test-1"] != 2 || counts["test-2"] != 1 {
+		t.Errorf("Expected 2 comments on test-1 and 1 on test-2, got %v", counts)
+	}
+}
+
+func TestLatestCommentsWithPrefix(t *testing.T) {
+	dbPath, cleanup := setupTestDB(t)
+	defer cleanup()
+
+	db, err := sql.Open("sqlite3", dbPath)
+	if err != nil {
+		t.Fatalf("failed to open database: %v", err)
+	}
+	defer db.Close()
+
+	now := time.Now().UTC()
+	for _, c := range []struct {
+		issueID, text string
+		at            time.Time
+	}{
+		{"test-1", "BLOCKED: waiting on vendor", now},
+		{"test-1", "blocked: waiting on legal", now.Add(time.Hour)},
+		{"test-1", "Vendor replied", now.Add(2 * time.Hour)},
+		{"test-2", "Not BLOCKED: at the start", now},
+	} {
+		if _, err := db.Exec(`INSERT INTO comments (issue_id, author, text, created_at) VALUES (?, 'alice', ?, ?)`, c.issueID, c.text, c.at); err != nil {
+			t.Fatalf("failed to insert comment: %v", err)
+		}
+	}
+
+	reader, err := NewSQLiteReader(dbPath)
+	if err != nil {
+		t.Fatalf("NewSQLiteReader failed: %v", err)
+	}
+	defer reader.Close()
+
+	latest, err := reader.LatestCommentsWithPrefix(context.Background(), "BLOCKED:")
+	if err != nil {
+		t.Fatalf("LatestCommentsWithPrefix failed: %v", err)
+	}
+	if len(latest) != 1 || latest["test-1"] != "blocked: waiting on legal" {
+		t.Errorf("Expected the newest BLOCKED comment on test-1 only, got %v", latest)
+	}
 }
 
 func TestLoadIssues_NullableFields(t *testing.T) {
@@ -471,6 +570,259 @@ func TestLoadIssues_NullableFields(t *testing.T) {
 	}
 }
 
+func TestLoadIssues_DueDate(t *testing.T) {
+	dbPath, cleanup := setupTestDB(t)
+	defer cleanup()
+
+	db, err := sql.Open("sqlite3", dbPath)
+	if err != nil {
+		t.Fatalf("failed to open database: %v", err)
+	}
+	defer db.Close()
+
+	// Without the column, issues load with no due date
+	if _, err := db.Exec(`INSERT INTO issues (id, title) VALUES ('test-1', 'No column')`); err != nil {
+		t.Fatalf("failed to insert issue: %v", err)
+	}
+	reader, err := NewSQLiteReader(dbPath)
+	if err != nil {
+		t.Fatalf("NewSQLiteReader failed: %v", err)
+	}
+	defer reader.Close()
+	issues, err := reader.LoadIssues(context.Background())
+	if err != nil {
+		t.Fatalf("LoadIssues failed: %v", err)
+	}
+	if len(issues) != 1 || issues[0].DueDate != nil {
+		t.Fatalf("Expected one issue without a due date, got %+v", issues)
+	}
+
+	// Newer schemas have a due_date column
+	_, err = db.Exec(`
+		ALTER TABLE issues ADD COLUMN due_date TEXT;
+		UPDATE issues SET due_date = '2026-11-01' WHERE id = 'test-1';
+		INSERT INTO issues (id, title, due_date) VALUES ('test-2', 'Cleared', '');
+	`)
+	if err != nil {
+		t.Fatalf("failed to add due_date: %v", err)
+	}
+	issues, err = reader.LoadIssues(context.Background())
+	if err != nil {
+		t.Fatalf("LoadIssues failed: %v", err)
+	}
+	due := make(map[string]*time.Time)
+	for _, issue := range issues {
+		due[issue.ID] = issue.DueDate
+	}
+	want := time.Date(2026, 11, 1, 0, 0, 0, 0, time.Local)
+	if due["test-1"] == nil || !due["test-1"].Equal(want) {
+		t.Errorf("Expected test-1 due %v, got %v", want, due["test-1"])
+	}
+	if due["test-2"] != nil {
+		t.Errorf("Expected no due date for an empty value, got %v", due["test-2"])
+	}
+}
+
+func TestParseDueDate(t *testing.T) {
+	tests := []struct {
+		value any
+		want  time.Time
+		ok    bool
+	}{
+		{"2026-03-04", time.Date(2026, 3, 4, 0, 0, 0, 0, time.Local), true},
+		{[]byte("2026-03-04"), time.Date(2026, 3, 4, 0, 0, 0, 0, time.Local), true},
+		{"2026-03-04T09:30:00Z", time.Date(2026, 3, 4, 9, 30, 0, 0, time.UTC), true},
+		{"2026-03-04 09:30:00", time.Date(2026, 3, 4, 9, 30, 0, 0, time.Local), true},
+		{int64(0), time.Unix(0, 0), true},
+		{"", time.Time{}, false},
+		{"next week", time.Time{}, false},
+	}
+	for _, tt := range tests {
+		got, ok := parseDueDate(tt.value)
+		if ok != tt.ok || !got.Equal(tt.want) {
+			t.Errorf("parseDueDate(%v) = %v, %v; want %v, %v", tt.value, got, ok, tt.want, tt.ok)
+		}
+	}
+}
+
+func TestRawIssue(t *testing.T) {
+	dbPath, cleanup := setupTestDB(t)
+	defer cleanup()
+
+	db, err := sql.Open("sqlite3", dbPath)
+	if err != nil {
+		t.Fatalf("failed to open database: %v", err)
+	}
+	defer db.Close()
+
+	now := time.Now().UTC().Truncate(time.Second)
+	_, err = db.Exec(`
+		INSERT INTO issues (id, title, status, estimated_minutes, created_at, updated_at)
+		VALUES (?, ?, ?, ?, ?, ?), (?, ?, ?, ?, ?, ?)
+	`, "test-1", "Issue 1 ", "open", 30, now, now,
+		"test-2", "Issue 2", "open", nil, now, now)
+	if err != nil {
+		t.Fatalf("failed to insert issues: %v", err)
+	}
+	_, err = db.Exec(`INSERT INTO dependencies (issue_id, depends_on_id, type) VALUES ('test-2', 'test-1', 'blocks')`)
+	if err != nil {
+		t.Fatalf("failed to insert dependency: %v", err)
+	}
+	_, err = db.Exec(`INSERT INTO comments (issue_id, author, text, created_at) VALUES ('test-1', 'alice', 'Hi', ?)`, now)
+	if err != nil {
+		t.Fatalf("failed to insert comment: %v", err)
+	}
+
+	reader, err := NewSQLiteReader(dbPath)
+	if err != nil {
+		t.Fatalf("NewSQLiteReader failed: %v", err)
+	}
+	defer reader.Close()
+
+	tables, err := reader.RawIssue(context.Background(), "test-1")
+	if err != nil {
+		t.Fatalf("RawIssue failed: %v", err)
+	}
+	if len(tables) != 4 {
+		t.Fatalf("Expected 4 tables, got %d", len(tables))
+	}
+
+	issues := tables[0]
+	if issues.Name != "issues" || len(issues.Rows) != 1 {
+		t.Fatalf("Expected one issues row, got %+v", issues)
+	}
+	if len(issues.Columns) != 15 {
+		t.Errorf("Expected all 15 issue columns, got %d", len(issues.Columns))
+	}
+	values := make(map[string]RawValue)
+	for i, column := range issues.Columns {
+		values[column.Name] = issues.Rows[0][i]
+	}
+	if v := values["title"]; v.Value != "Issue 1 " {
+		t.Errorf("Expected title stored with trailing space, got %q", v.Value)
+	}
+	if v := values["estimated_minutes"]; v.Value != "30" || v.Type != "int64" {
+		t.Errorf("Expected estimated_minutes 30 (int64), got %+v", v)
+	}
+	if v := values["assignee"]; !v.Null {
+		t.Errorf("Expected NULL assignee, got %+v", v)
+	}
+
+	if deps := tables[1]; len(deps.Rows) != 1 {
+		t.Errorf("Expected the incoming dependency row, got %d rows", len(deps.Rows))
+	}
+	if labels := tables[2]; len(labels.Rows) != 0 {
+		t.Errorf("Expected no label rows, got %d", len(labels.Rows))
+	}
+	if comments := tables[3]; len(comments.Rows) != 1 || len(comments.Columns) != 5 {
+		t.Errorf("Expected one comment row with 5 columns, got %+v", comments)
+	}
+
+	missing, err := reader.RawIssue(context.Background(), "nope")
+	if err != nil {
+		t.Fatalf("RawIssue failed for unknown ID: %v", err)
+	}
+	if len(missing[0].Rows) != 0 {
+		t.Errorf("Expected no rows for unknown ID, got %d", len(missing[0].Rows))
+	}
+}
+
+func TestLoadEvents(t *testing.T) {
+	dbPath, cleanup := setupTestDB(t)
+	defer cleanup()
+
+	reader, err := NewSQLiteReader(dbPath)
+	if err != nil {
+		t.Fatalf("NewSQLiteReader failed: %v", err)
+	}
+	ctx := context.Background()
+
+	// Older databases have no events table
+	events, err := reader.LoadEvents(ctx, "test-1")
+	if err != nil {
+		t.Fatalf("LoadEvents without events table failed: %v", err)
+	}
+	if len(events) != 0 {
+		t.Errorf("Expected no events, got %d", len(events))
+	}
+	if changes, err := reader.LoadStatusChanges(ctx, time.Time{}); err != nil || changes != nil {
+		t.Errorf("Expected nil status changes without events table, got %v, %v", changes, err)
+	}
+	reader.Close()
+
+	db, err := sql.Open("sqlite3", dbPath)
+	if err != nil {
+		t.Fatalf("failed to open database: %v", err)
+	}
+	defer db.Close()
+
+	_, err = db.Exec(`
+		CREATE TABLE events (
+			id INTEGER PRIMARY KEY AUTOINCREMENT,
+			issue_id TEXT NOT NULL,
+			event_type TEXT NOT NULL,
+			actor TEXT NOT NULL,
+			old_value TEXT,
+			new_value TEXT,
+			comment TEXT,
+			created_at DATETIME NOT NULL DEFAULT CURRENT_TIMESTAMP
+		)
+	`)
+	if err != nil {
+		t.Fatalf("failed to create events table: %v", err)
+	}
+
+	now := time.Now().UTC().Truncate(time.Second)
+	_, err = db.Exec(`
+		INSERT INTO events (issue_id, event_type, actor, old_value, new_value, comment, created_at)
+		VALUES (?, ?, ?, ?, ?, ?, ?), (?, ?, ?, ?, ?, ?, ?), (?, ?, ?, ?, ?, ?, ?)
+	`, "test-1", "status_changed", "alice", "open", "in_progress", nil, now.Add(time.Hour),
+		"test-1", "created", "alice", nil, nil, nil, now,
+		"test-2", "created", "bob", nil, nil, nil, now)
+	if err != nil {
+		t.Fatalf("failed to insert events: %v", err)
+	}
+
+	reader, err = NewSQLiteReader(dbPath)
+	if err != nil {
+		t.Fatalf("NewSQLiteReader failed: %v", err)
+	}
+	defer reader.Close()
+
+	events, err = reader.LoadEvents(ctx, "test-1")
+	if err != nil {
+		t.Fatalf("LoadEvents failed: %v", err)
+	}
+	if len(events) != 2 {
+		t.Fatalf("Expected 2 events for test-1, got %d", len(events))
+	}
+	if events[0].EventType != "created" {
+		t.Errorf("Expected oldest event first, got %s", events[0].EventType)
+	}
+	changed := events[1]
+	if changed.Actor != "alice" || changed.OldValue == nil || *changed.OldValue != "open" || changed.NewValue == nil || *changed.NewValue != "in_progress" {
+		t.Errorf("Unexpected status event: %+v", changed)
+	}
+	if changed.Comment != nil {
+		t.Errorf("Expected NULL comment, got %q", *changed.Comment)
+	}
+	if !changed.CreatedAt.Equal(now.Add(time.Hour)) {
+		t.Errorf("Expected created_at %v, got %v", now.Add(time.Hour), changed.CreatedAt)
+	}
+
+	changes, err := reader.LoadStatusChanges(ctx, now)
+	if err != nil {
+		t.Fatalf("LoadStatusChanges failed: %v", err)
+	}
+	if len(changes) != 1 || changes[0].IssueID != "test-1" {
+		t.Errorf("Expected the one status change since %v, got %+v", now, changes)
+	}
+	quiet, err := reader.LoadStatusChanges(ctx, now.Add(2*time.Hour))
+	if err != nil || quiet == nil || len(quiet) != 0 {
+		t.Errorf("Expected an empty (non-nil) list for a quiet window, got %v, %v", quiet, err)
+	}
+}
+
 func TestLoadIssues_ContextCancellation(t *testing.T) {
 	dbPath, cleanup := setupTestDB(t)
 	defer cleanup()
@@ -619,3 +971,191 @@ func TestNewSQLiteReader_CorruptedDatabase(t *testing.T) {
 		t.Errorf("Expected ErrDatabaseCorrupted, got: %v", err)
 	}
 }
+
+func TestLoadClosedIssues(t *testing.T) {
+	dbPath, cleanup := setupTestDB(t)
+	defer cleanup()
+
+	db, err := sql.Open("sqlite3", dbPath)
+	if err != nil {
+		t.Fatalf("failed to open database: %v", err)
+	}
+	defer db.Close()
+
+	now := time.Date(2026, 3, 15, 12, 0, 0, 0, time.UTC)
+	for _, issue := range []struct {
+		id, status string
+		closedAt   any
+	}{
+		{"test-1", "closed", now.Add(-1 * 24 * time.Hour)},
+		{"test-2", "closed", now.Add(-10 * 24 * time.Hour).In(time.FixedZone("PST", -8*3600))},
+		{"test-3", "closed", "2026-01-05T09:30:00Z"}, // Written as text by some bd versions
+		{"test-4", "open", nil},
+		{"test-5", "closed", nil}, // Closed without a time
+	} {
+		if _, err := db.Exec(`INSERT INTO issues (id, title, status, closed_at, created_at, updated_at) VALUES (?, ?, ?, ?, ?, ?)`,
+			issue.id, "Issue "+issue.id, issue.status, issue.closedAt, now, now); err != nil {
+			t.Fatalf("failed to insert issue: %v", err)
+		}
+	}
+
+	reader, err := NewSQLiteReader(dbPath)
+	if err != nil {
+		t.Fatalf("NewSQLiteReader failed: %v", err)
+	}
+	defer reader.Close()
+
+	ids := func(issues []*parser.Issue) []string {
+		var result []string
+		for _, issue := range issues {
+			result = append(result, issue.ID)
+		}
+		return result
+	}
+	for _, tt := range []struct {
+		name  string
+		query ClosedQuery
+		want  []string
+		total int
+	}{
+		{"all, newest first", ClosedQuery{}, []string{"test-1", "test-2", "test-3"}, 3},
+		{"oldest first", ClosedQuery{OldestFirst: true}, []string{"test-3", "test-2", "test-1"}, 3},
+		{"last week", ClosedQuery{From: now.Add(-7 * 24 * time.Hour)}, []string{"test-1"}, 1},
+		{"range", ClosedQuery{From: time.Date(2026, 1, 1, 0, 0, 0, 0, time.UTC), To: now.Add(-7 * 24 * time.Hour)}, []string{"test-2", "test-3"}, 2},
+		{"second page", ClosedQuery{Limit: 2, Offset: 2}, []string{"test-3"}, 3},
+	} {
+		issues, total, err := reader.LoadClosedIssues(context.Background(), tt.query)
+		if err != nil {
+			t.Fatalf("%s: LoadClosedIssues failed: %v", tt.name, err)
+		}
+		if got := ids(issues); !reflect.DeepEqual(got, tt.want) || total != tt.total {
+			t.Errorf("%s: expected %v of %d, got %v of %d", tt.name, tt.want, tt.total, got, total)
+		}
+	}
+}
+
+func TestLoadIssuesFiltered(t *testing.T) {
+	dbPath, cleanup := setupTestDB(t)
+	defer cleanup()
+
+	db, err := sql.Open("sqlite3", dbPath)
+	if err != nil {
+		t.Fatalf("failed to open database: %v", err)
+	}
+	defer db.Close()
+
+	now := time.Date(2026, 3, 15, 12, 0, 0, 0, time.UTC)
+	for i, issue := range []struct {
+		id, status, issueType string
+		priority              int
+	}{
+		{"test-1", "open", "bug", 0},
+		{"test-2", "open", "task", 2},
+		{"test-3", "closed", "bug", 1},
+		{"test-4", "open", "epic", 3}, // Parent of test-1
+		{"test-5", "open", "task", 2}, // Blocks test-4
+		{"test-6", "in_progress", "feature", 1},
+	} {
+		if _, err := db.Exec(`INSERT INTO issues (id, title, status, issue_type, priority, created_at, updated_at) VALUES (?, ?, ?, ?, ?, ?, ?)`,
+			issue.id, "Issue "+issue.id, issue.status, issue.issueType, issue.priority, now.Add(time.Duration(-i)*time.Hour), now); err != nil {
+			t.Fatalf("failed to insert issue: %v", err)
+		}
+	}
+	for _, dep := range [][3]string{
+		{"test-1", "test-4", "parent-child"},
+		{"test-4", "test-5", "blocks"},
+		{"test-2", "test-6", "related"}, // Not followed
+	} {
+		if _, err := db.Exec(`INSERT INTO dependencies (issue_id, depends_on_id, type) VALUES (?, ?, ?)`,
+			dep[0], dep[1], dep[2]); err != nil {
+			t.Fatalf("failed to insert dependency: %v", err)
+		}
+	}
+	for _, label := range [][2]string{
+		{"test-2", "ui"}, {"test-2", "Urgent"}, {"test-6", "ui"}, {"test-3", "urgent"},
+	} {
+		if _, err := db.Exec(`INSERT INTO labels (issue_id, label) VALUES (?, ?)`, label[0], label[1]); err != nil {
+			t.Fatalf("failed to insert label: %v", err)
+		}
+	}
+
+	reader, err := NewSQLiteReader(dbPath)
+	if err != nil {
+		t.Fatalf("NewSQLiteReader failed: %v", err)
+	}
+	defer reader.Close()
+
+	if count, err := reader.CountIssues(context.Background()); err != nil || count != 6 {
+		t.Fatalf("expected 6 issues, got %d (%v)", count, err)
+	}
+
+	ids := func(issues []*parser.Issue) []string {
+		var result []string
+		for _, issue := range issues {
+			result = append(result, issue.ID)
+		}
+		return result
+	}
+	for _, tt := range []struct {
+		name string
+		spec FilterSpec
+		want []string
+	}{
+		{"empty loads everything", FilterSpec{}, []string{"test-1", "test-2", "test-3", "test-4", "test-5", "test-6"}},
+		{"priority brings in parent and its blocker", FilterSpec{Priorities: []int{0}}, []string{"test-1", "test-4", "test-5"}},
+		{"type and status", FilterSpec{Types: []parser.IssueType{parser.TypeBug}, Statuses: []parser.Status{parser.StatusClosed}}, []string{"test-3"}},
+		{"any label, case-insensitive", FilterSpec{Labels: []string{"urgent"}}, []string{"test-2", "test-3"}},
+		{"every label", FilterSpec{Labels: []string{"ui", "urgent"}, LabelMatchAll: true}, []string{"test-2"}},
+		{"nothing matches", FilterSpec{Priorities: []int{4}}, nil},
+	} {
+		issues, err := reader.LoadIssuesFiltered(context.Background(), tt.spec)
+		if err != nil {
+			t.Fatalf("%s: LoadIssuesFiltered failed: %v", tt.name, err)
+		}
+		if got := ids(issues); !reflect.DeepEqual(got, tt.want) {
+			t.Errorf("%s: expected %v, got %v", tt.name, tt.want, got)
+		}
+	}
+
+	// Dependencies and labels come along with the issues loaded
+	issues, err := reader.LoadIssuesFiltered(context.Background(), FilterSpec{Priorities: []int{0}})
+	if err != nil {
+		t.Fatalf("LoadIssuesFiltered failed: %v", err)
+	}
+	if len(issues[0].Dependencies) != 1 || issues[0].Dependencies[0].DependsOnID != "test-4" {
+		t.Errorf("expected test-1 to keep its parent, got %v", issues[0].Dependencies)
+	}
+}
+
+func TestFilterSpecEqual(t *testing.T) {
+	spec := FilterSpec{Priorities: []int{0, 1}, Labels: []string{"ui"}}
+	if !spec.Equal(FilterSpec{Priorities: []int{0, 1}, Labels: []string{"ui"}, LabelMatchAll: true}) {
+		t.Error("expected match-all not to matter with a single label")
+	}
+	if spec.Equal(FilterSpec{Priorities: []int{0}, Labels: []string{"ui"}}) {
+		t.Error("expected different priorities to differ")
+	}
+	if !(FilterSpec{}).IsEmpty() || spec.IsEmpty() || !(FilterSpec{LabelMatchAll: true}).IsEmpty() {
+		t.Error("IsEmpty is wrong")
+	}
+}
+
+func TestFilterSpecMatches(t *testing.T) {
+	issue := &parser.Issue{Priority: 1, IssueType: parser.TypeBug, Status: parser.StatusOpen, Labels: []string{"UI", "backend"}}
+	tests := []struct {
+		spec FilterSpec
+		want bool
+	}{
+		{FilterSpec{}, true},
+		{FilterSpec{Priorities: []int{0, 1}, Types: []parser.IssueType{parser.TypeBug}}, true},
+		{FilterSpec{Statuses: []parser.Status{parser.StatusClosed}}, false},
+		{FilterSpec{Labels: []string{"ui", "docs"}}, true},
+		{FilterSpec{Labels: []string{"ui", "docs"}, LabelMatchAll: true}, false},
+		{FilterSpec{Labels: []string{"ui", "backend"}, LabelMatchAll: true}, true},
+	}
+	for _, tt := range tests {
+		if got := tt.spec.Matches(issue); got != tt.want {
+			t.Errorf("%+v matches = %v, want %v", tt.spec, got, tt.want)
+		}
+	}
+}
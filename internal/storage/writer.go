@@ -0,0 +1,263 @@
+package storage
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"fmt"
+	"log"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/andy/beads-tui/internal/parser"
+)
+
+// ErrIssueNotFound is returned by SQLiteWriter for IDs that aren't in the database
+var ErrIssueNotFound = errors.New("issue not found")
+
+// SQLiteWriter applies simple mutations (status, priority, labels, comments)
+// directly to .beads/beads.db, as a fallback for machines where the bd CLI
+// is missing or slow. Each change runs in its own transaction, bumps the
+// issue's updated_at, and, like bd, records an event and marks the issue
+// dirty for the next JSONL export when the database has those tables.
+type SQLiteWriter struct {
+	db    *sql.DB
+	actor string // Recorded as the event actor
+}
+
+// NewSQLiteWriter opens the database for writing. actor names who is making
+// the changes in the audit trail (bd uses $BD_ACTOR or $USER).
+func NewSQLiteWriter(dbPath, actor string) (*SQLiteWriter, error) {
+	log.Printf("SQLite: Opening database for direct writes at %s", dbPath)
+
+	// Wait for bd or another writer rather than failing immediately on a lock
+	db, err := sql.Open("sqlite3", "file:"+dbPath+"?_pragma=busy_timeout(5000)")
+	if err != nil {
+		return nil, fmt.Errorf("failed to open database for writing: %w", err)
+	}
+	db.SetMaxOpenConns(1)
+
+	ctx, cancel := context.WithTimeout(context.Background(), 2*time.Second)
+	defer cancel()
+	if err := db.PingContext(ctx); err != nil {
+		db.Close()
+		if isCorruptionError(err) {
+			return nil, fmt.Errorf("%w: %v", ErrDatabaseCorrupted, err)
+		}
+		return nil, fmt.Errorf("failed to ping database: %w", err)
+	}
+
+	if actor == "" {
+		actor = "beads-tui"
+	}
+	return &SQLiteWriter{db: db, actor: actor}, nil
+}
+
+// UpdateStatus sets the issue's status, setting closed_at when it is closed
+// and clearing it when it is reopened. reason is recorded with the event.
+func (w *SQLiteWriter) UpdateStatus(ctx context.Context, issueID string, status parser.Status, reason string) (*parser.Issue, error) {
+	if err := ValidateStatus(status); err != nil {
+		return nil, err
+	}
+	return w.update(ctx, issueID, func(tx *sql.Tx, issue *parser.Issue, now time.Time) error {
+		var closedAt any // NULL unless closed
+		if status == parser.StatusClosed {
+			closedAt = now
+			if issue.ClosedAt != nil && issue.Status == parser.StatusClosed {
+				closedAt = *issue.ClosedAt
+			}
+		}
+		if _, err := tx.ExecContext(ctx, "UPDATE issues SET status = ?, closed_at = ?, updated_at = ? WHERE id = ?", string(status), closedAt, now, issueID); err != nil {
+			return err
+		}
+
+		eventType := "status_changed"
+		switch {
+		case status == parser.StatusClosed && issue.Status != parser.StatusClosed:
+			eventType = "closed"
+		case status != parser.StatusClosed && issue.Status == parser.StatusClosed:
+			eventType = "reopened"
+		}
+		return w.recordEvent(ctx, tx, issueID, eventType, string(issue.Status), string(status), reason, now)
+	})
+}
+
+// UpdatePriority sets the issue's priority (0-4)
+func (w *SQLiteWriter) UpdatePriority(ctx context.Context, issueID string, priority int) (*parser.Issue, error) {
+	if err := ValidatePriority(priority); err != nil {
+		return nil, err
+	}
+	return w.update(ctx, issueID, func(tx *sql.Tx, issue *parser.Issue, now time.Time) error {
+		if _, err := tx.ExecContext(ctx, "UPDATE issues SET priority = ?, updated_at = ? WHERE id = ?", priority, now, issueID); err != nil {
+			return err
+		}
+		return w.recordEvent(ctx, tx, issueID, "updated", strconv.Itoa(issue.Priority), strconv.Itoa(priority), "priority", now)
+	})
+}
+
+// AddLabel adds label to the issue (adding an existing label is a no-op)
+func (w *SQLiteWriter) AddLabel(ctx context.Context, issueID, label string) (*parser.Issue, error) {
+	label = strings.TrimSpace(label)
+	if label == "" {
+		return nil, errors.New("label is empty")
+	}
+	return w.update(ctx, issueID, func(tx *sql.Tx, issue *parser.Issue, now time.Time) error {
+		if _, err := tx.ExecContext(ctx, "INSERT OR IGNORE INTO labels (issue_id, label) VALUES (?, ?)", issueID, label); err != nil {
+			return err
+		}
+		if _, err := tx.ExecContext(ctx, "UPDATE issues SET updated_at = ? WHERE id = ?", now, issueID); err != nil {
+			return err
+		}
+		return w.recordEvent(ctx, tx, issueID, "label_added", "", "", "Added label: "+label, now)
+	})
+}
+
+// RemoveLabel removes label from the issue
+func (w *SQLiteWriter) RemoveLabel(ctx context.Context, issueID, label string) (*parser.Issue, error) {
+	return w.update(ctx, issueID, func(tx *sql.Tx, issue *parser.Issue, now time.Time) error {
+		if _, err := tx.ExecContext(ctx, "DELETE FROM labels WHERE issue_id = ? AND label = ?", issueID, label); err != nil {
+			return err
+		}
+		if _, err := tx.ExecContext(ctx, "UPDATE issues SET updated_at = ? WHERE id = ?", now, issueID); err != nil {
+			return err
+		}
+		return w.recordEvent(ctx, tx, issueID, "label_removed", "", "", "Removed label: "+label, now)
+	})
+}
+
+// AddComment adds a comment by the writer's actor and returns it
+func (w *SQLiteWriter) AddComment(ctx context.Context, issueID, text string) (*parser.Comment, error) {
+	if strings.TrimSpace(text) == "" {
+		return nil, errors.New("comment is empty")
+	}
+	var comment *parser.Comment
+	_, err := w.update(ctx, issueID, func(tx *sql.Tx, issue *parser.Issue, now time.Time) error {
+		result, err := tx.ExecContext(ctx, "INSERT INTO comments (issue_id, author, text, created_at) VALUES (?, ?, ?, ?)", issueID, w.actor, text, now)
+		if err != nil {
+			return err
+		}
+		id, err := result.LastInsertId()
+		if err != nil {
+			return err
+		}
+		comment = &parser.Comment{ID: id, IssueID: issueID, Author: w.actor, Text: text, CreatedAt: now}
+
+		if _, err := tx.ExecContext(ctx, "UPDATE issues SET updated_at = ? WHERE id = ?", now, issueID); err != nil {
+			return err
+		}
+		return w.recordEvent(ctx, tx, issueID, "commented", "", "", text, now)
+	})
+	if err != nil {
+		return nil, err
+	}
+	return comment, nil
+}
+
+// update runs change in a transaction against the current state of the
+// issue, marks it dirty, and returns the issue as stored afterwards
+func (w *SQLiteWriter) update(ctx context.Context, issueID string, change func(tx *sql.Tx, issue *parser.Issue, now time.Time) error) (*parser.Issue, error) {
+	tx, err := w.db.BeginTx(ctx, nil)
+	if err != nil {
+		return nil, fmt.Errorf("failed to begin transaction: %w", err)
+	}
+	defer func() { _ = tx.Rollback() }() // No-op after commit
+
+	query, err := selectIssues(ctx, tx)
+	if err != nil {
+		return nil, fmt.Errorf("failed to read issues schema: %w", err)
+	}
+	issue, err := scanIssue(tx.QueryRowContext(ctx, query+" WHERE id = ?", issueID))
+	if errors.Is(err, sql.ErrNoRows) {
+		return nil, fmt.Errorf("%w: %s", ErrIssueNotFound, issueID)
+	}
+	if err != nil {
+		return nil, fmt.Errorf("failed to read %s: %w", issueID, err)
+	}
+
+	now := time.Now().UTC()
+	if err := change(tx, issue, now); err != nil {
+		return nil, fmt.Errorf("failed to update %s: %w", issueID, err)
+	}
+	if err := markDirty(ctx, tx, issueID, now); err != nil {
+		return nil, fmt.Errorf("failed to mark %s for export: %w", issueID, err)
+	}
+
+	updated, err := scanIssue(tx.QueryRowContext(ctx, query+" WHERE id = ?", issueID))
+	if err != nil {
+		return nil, fmt.Errorf("failed to read back %s: %w", issueID, err)
+	}
+	if updated.Labels, err = loadLabelsTx(ctx, tx, issueID); err != nil {
+		return nil, fmt.Errorf("failed to read back %s labels: %w", issueID, err)
+	}
+
+	if err := tx.Commit(); err != nil {
+		return nil, fmt.Errorf("failed to commit: %w", err)
+	}
+	log.Printf("SQLite: Direct write to %s committed", issueID)
+	return updated, nil
+}
+
+// recordEvent adds an entry to bd's audit trail if the database has one.
+// Empty values are stored as NULL.
+func (w *SQLiteWriter) recordEvent(ctx context.Context, tx *sql.Tx, issueID, eventType, oldValue, newValue, comment string, now time.Time) error {
+	if ok, err := hasTable(ctx, tx, "events"); err != nil || !ok {
+		return err
+	}
+	nullable := func(s string) any {
+		if s == "" {
+			return nil
+		}
+		return s
+	}
+	_, err := tx.ExecContext(ctx, `
+		INSERT INTO events (issue_id, event_type, actor, old_value, new_value, comment, created_at)
+		VALUES (?, ?, ?, ?, ?, ?, ?)
+	`, issueID, eventType, w.actor, nullable(oldValue), nullable(newValue), nullable(comment), now)
+	return err
+}
+
+// markDirty flags the issue for bd's next JSONL export if the database tracks
+// dirty issues, so direct writes reach the git-tracked file too
+func markDirty(ctx context.Context, tx *sql.Tx, issueID string, now time.Time) error {
+	if ok, err := hasTable(ctx, tx, "dirty_issues"); err != nil || !ok {
+		return err
+	}
+	_, err := tx.ExecContext(ctx, "INSERT OR REPLACE INTO dirty_issues (issue_id, marked_at) VALUES (?, ?)", issueID, now)
+	return err
+}
+
+// hasTable reports whether the database has the named table
+func hasTable(ctx context.Context, tx *sql.Tx, name string) (bool, error) {
+	var count int
+	err := tx.QueryRowContext(ctx, "SELECT COUNT(*) FROM sqlite_master WHERE type='table' AND name=?", name).Scan(&count)
+	return count > 0, err
+}
+
+// loadLabelsTx loads one issue's labels
+func loadLabelsTx(ctx context.Context, tx *sql.Tx, issueID string) ([]string, error) {
+	rows, err := tx.QueryContext(ctx, "SELECT label FROM labels WHERE issue_id = ? ORDER BY label", issueID)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	var labels []string
+	for rows.Next() {
+		var label string
+		if err := rows.Scan(&label); err != nil {
+			return nil, err
+		}
+		labels = append(labels, label)
+	}
+	return labels, rows.Err()
+}
+
+// Close closes the database connection
+func (w *SQLiteWriter) Close() error {
+	if w.db != nil {
+		log.Printf("SQLite: Closing direct write connection")
+		return w.db.Close()
+	}
+	return nil
+}
@@ -0,0 +1,198 @@
+package storage
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/andy/beads-tui/internal/parser"
+)
+
+// setupWriterDB creates a test database with one issue plus bd's events and
+// dirty_issues tables, returning the path and a writer for it
+func setupWriterDB(t *testing.T) (string, *SQLiteWriter) {
+	t.Helper()
+	dbPath, cleanup := setupTestDB(t)
+	t.Cleanup(cleanup)
+
+	db, err := sql.Open("sqlite3", dbPath)
+	if err != nil {
+		t.Fatalf("failed to open database: %v", err)
+	}
+	defer db.Close()
+
+	now := time.Now().UTC().Truncate(time.Second)
+	_, err = db.Exec(`
+		CREATE TABLE events (
+			id INTEGER PRIMARY KEY AUTOINCREMENT,
+			issue_id TEXT NOT NULL,
+			event_type TEXT NOT NULL,
+			actor TEXT NOT NULL,
+			old_value TEXT,
+			new_value TEXT,
+			comment TEXT,
+			created_at DATETIME NOT NULL DEFAULT CURRENT_TIMESTAMP
+		);
+		CREATE TABLE dirty_issues (
+			issue_id TEXT PRIMARY KEY,
+			marked_at DATETIME NOT NULL DEFAULT CURRENT_TIMESTAMP
+		);
+	`)
+	if err != nil {
+		t.Fatalf("failed to create tables: %v", err)
+	}
+	_, err = db.Exec(`INSERT INTO issues (id, title, status, priority, created_at, updated_at) VALUES (?, ?, ?, ?, ?, ?)`,
+		"test-1", "Issue 1", "open", 2, now.Add(-time.Hour), now.Add(-time.Hour))
+	if err != nil {
+		t.Fatalf("failed to insert issue: %v", err)
+	}
+
+	writer, err := NewSQLiteWriter(dbPath, "alice")
+	if err != nil {
+		t.Fatalf("NewSQLiteWriter failed: %v", err)
+	}
+	t.Cleanup(func() { writer.Close() })
+	return dbPath, writer
+}
+
+// eventTypes returns the event types recorded for issueID, oldest first
+func eventTypes(t *testing.T, dbPath, issueID string) []string {
+	t.Helper()
+	reader, err := NewSQLiteReader(dbPath)
+	if err != nil {
+		t.Fatalf("NewSQLiteReader failed: %v", err)
+	}
+	defer reader.Close()
+
+	events, err := reader.LoadEvents(context.Background(), issueID)
+	if err != nil {
+		t.Fatalf("LoadEvents failed: %v", err)
+	}
+	var types []string
+	for _, event := range events {
+		if event.Actor != "alice" {
+			t.Errorf("expected actor alice, got %q", event.Actor)
+		}
+		types = append(types, event.EventType)
+	}
+	return types
+}
+
+func TestWriterUpdateStatus(t *testing.T) {
+	dbPath, writer := setupWriterDB(t)
+	ctx := context.Background()
+
+	issue, err := writer.UpdateStatus(ctx, "test-1", parser.StatusClosed, "Done")
+	if err != nil {
+		t.Fatalf("UpdateStatus failed: %v", err)
+	}
+	if issue.Status != parser.StatusClosed || issue.ClosedAt == nil {
+		t.Errorf("expected closed issue with closed_at, got status %s closed_at %v", issue.Status, issue.ClosedAt)
+	}
+	if time.Since(issue.UpdatedAt) > time.Minute {
+		t.Errorf("expected updated_at to be bumped, got %v", issue.UpdatedAt)
+	}
+
+	issue, err = writer.UpdateStatus(ctx, "test-1", parser.StatusOpen, "")
+	if err != nil {
+		t.Fatalf("UpdateStatus failed: %v", err)
+	}
+	if issue.ClosedAt != nil {
+		t.Errorf("expected reopening to clear closed_at, got %v", issue.ClosedAt)
+	}
+
+	if _, err := writer.UpdateStatus(ctx, "test-1", "done", ""); err == nil {
+		t.Error("expected an error for an invalid status")
+	}
+	if _, err := writer.UpdateStatus(ctx, "nope", parser.StatusOpen, ""); !errors.Is(err, ErrIssueNotFound) {
+		t.Errorf("expected ErrIssueNotFound, got %v", err)
+	}
+
+	if got := eventTypes(t, dbPath, "test-1"); len(got) != 2 || got[0] != "closed" || got[1] != "reopened" {
+		t.Errorf("expected closed and reopened events, got %v", got)
+	}
+
+	db, err := sql.Open("sqlite3", dbPath)
+	if err != nil {
+		t.Fatalf("failed to open database: %v", err)
+	}
+	defer db.Close()
+	var dirty int
+	if err := db.QueryRow("SELECT COUNT(*) FROM dirty_issues WHERE issue_id = 'test-1'").Scan(&dirty); err != nil || dirty != 1 {
+		t.Errorf("expected test-1 to be marked dirty, got %d (%v)", dirty, err)
+	}
+}
+
+func TestWriterUpdatePriority(t *testing.T) {
+	_, writer := setupWriterDB(t)
+	ctx := context.Background()
+
+	issue, err := writer.UpdatePriority(ctx, "test-1", 0)
+	if err != nil {
+		t.Fatalf("UpdatePriority failed: %v", err)
+	}
+	if issue.Priority != 0 {
+		t.Errorf("expected priority 0, got %d", issue.Priority)
+	}
+	if _, err := writer.UpdatePriority(ctx, "test-1", 7); err == nil {
+		t.Error("expected an error for priority 7")
+	}
+}
+
+func TestWriterLabelsAndComments(t *testing.T) {
+	dbPath, writer := setupWriterDB(t)
+	ctx := context.Background()
+
+	if _, err := writer.AddLabel(ctx, "test-1", "ui"); err != nil {
+		t.Fatalf("AddLabel failed: %v", err)
+	}
+	issue, err := writer.AddLabel(ctx, "test-1", "bug")
+	if err != nil {
+		t.Fatalf("AddLabel failed: %v", err)
+	}
+	if len(issue.Labels) != 2 || issue.Labels[0] != "bug" || issue.Labels[1] != "ui" {
+		t.Errorf("expected labels [bug ui], got %v", issue.Labels)
+	}
+	issue, err = writer.RemoveLabel(ctx, "test-1", "ui")
+	if err != nil {
+		t.Fatalf("RemoveLabel failed: %v", err)
+	}
+	if len(issue.Labels) != 1 || issue.Labels[0] != "bug" {
+		t.Errorf("expected labels [bug], got %v", issue.Labels)
+	}
+
+	comment, err := writer.AddComment(ctx, "test-1", "Looks good")
+	if err != nil {
+		t.Fatalf("AddComment failed: %v", err)
+	}
+	if comment.ID == 0 || comment.Author != "alice" || comment.Text != "Looks good" {
+		t.Errorf("unexpected comment %+v", comment)
+	}
+
+	reader, err := NewSQLiteReader(dbPath)
+	if err != nil {
+		t.Fatalf("NewSQLiteReader failed: %v", err)
+	}
+	defer reader.Close()
+	comments, err := reader.LoadComments(ctx, "test-1")
+	if err != nil {
+		t.Fatalf("LoadComments failed: %v", err)
+	}
+	if len(comments) != 1 || comments[0].Text != "Looks good" {
+		t.Errorf("expected the comment to be readable, got %v", comments)
+	}
+
+	want := []string{"label_added", "label_added", "label_removed", "commented"}
+	got := eventTypes(t, dbPath, "test-1")
+	if len(got) != len(want) {
+		t.Fatalf("expected events %v, got %v", want, got)
+	}
+	for i := range want {
+		if got[i] != want[i] {
+			t.Errorf("expected events %v, got %v", want, got)
+			break
+		}
+	}
+}
@@ -13,6 +13,7 @@ func TestAllThemesRegistered(t *testing.T) {
 		"dracula",
 		"tokyo-night",
 		"catppuccin-mocha",
+		"terminal",
 	}
 
 	themes := List()
@@ -47,6 +48,7 @@ func TestAllThemesCanBeSet(t *testing.T) {
 		"dracula",
 		"tokyo-night",
 		"catppuccin-mocha",
+		"terminal",
 	}
 
 	for _, themeName := range themes {
@@ -73,6 +75,7 @@ func TestAllThemesHaveCompleteColors(t *testing.T) {
 		"dracula",
 		"tokyo-night",
 		"catppuccin-mocha",
+		"terminal",
 	}
 
 	for _, themeName := range themes {
@@ -0,0 +1,74 @@
+package theme
+
+import "strings"
+
+// ColorMode is how many colors the terminal can show
+type ColorMode string
+
+// Color modes for the "color_mode" config setting
+const (
+	ColorModeAuto      ColorMode = "auto"      // Detect from the environment
+	ColorModeTrueColor ColorMode = "truecolor" // 24-bit color
+	ColorMode256       ColorMode = "256"       // xterm 256-color palette
+	ColorMode16        ColorMode = "16"        // The terminal's own 16 ANSI colors
+)
+
+// PaletteTheme uses only the terminal's 16 ANSI colors and its default
+// foreground and background, so it follows the terminal's color scheme
+const PaletteTheme = "terminal"
+
+// defaultThemeName is the theme used when none is configured, on terminals
+// with at least 256 colors
+const defaultThemeName = "gruvbox-dark"
+
+// ParseColorMode returns the color mode with the given name ("" is auto)
+func ParseColorMode(name string) (ColorMode, bool) {
+	switch mode := ColorMode(strings.ToLower(strings.TrimSpace(name))); mode {
+	case "":
+		return ColorModeAuto, true
+	case ColorModeAuto, ColorModeTrueColor, ColorMode256, ColorMode16:
+		return mode, true
+	}
+	return ColorModeAuto, false
+}
+
+// DetectColorMode guesses the terminal's color mode from COLORTERM and TERM,
+// as looked up with getenv
+func DetectColorMode(getenv func(string) string) ColorMode {
+	switch strings.ToLower(getenv("COLORTERM")) {
+	case "truecolor", "24bit", "24-bit":
+		return ColorModeTrueColor
+	}
+	term := getenv("TERM")
+	switch {
+	case strings.HasSuffix(term, "-truecolor") || strings.HasSuffix(term, "-direct"):
+		return ColorModeTrueColor
+	case strings.Contains(term, "256color"):
+		return ColorMode256
+	}
+	return ColorMode16
+}
+
+// DefaultTheme returns the theme to use when none is configured: the
+// palette theme on 16-color terminals, where hex colors would be
+// approximated badly
+func DefaultTheme(mode ColorMode) string {
+	if mode == ColorMode16 {
+		return PaletteTheme
+	}
+	return defaultThemeName
+}
+
+// ConfigureTerminal sets the environment variables tcell reads when the
+// screen starts, so a mode chosen in the config wins over what the terminal
+// reports: truecolor turns 24-bit color on, and 256 or 16 turn it off so hex
+// colors are mapped onto the palette. Auto leaves the environment alone.
+func ConfigureTerminal(mode ColorMode, setenv func(key, value string) error) error {
+	switch mode {
+	case ColorModeTrueColor:
+		return setenv("COLORTERM", "truecolor")
+	case ColorMode256, ColorMode16:
+		return setenv("TCELL_TRUECOLOR", "disable")
+	}
+	return nil
+}
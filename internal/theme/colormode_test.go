@@ -0,0 +1,105 @@
+package theme
+
+import (
+	"testing"
+
+	"github.com/gdamore/tcell/v2"
+)
+
+func TestDetectColorMode(t *testing.T) {
+	tests := []struct {
+		colorterm, term string
+		want            ColorMode
+	}{
+		{"truecolor", "xterm-256color", ColorModeTrueColor},
+		{"24bit", "xterm", ColorModeTrueColor},
+		{"", "xterm-direct", ColorModeTrueColor},
+		{"", "tmux-256color", ColorMode256},
+		{"", "xterm", ColorMode16},
+		{"", "linux", ColorMode16},
+		{"", "", ColorMode16},
+	}
+	for _, tt := range tests {
+		env := map[string]string{"COLORTERM": tt.colorterm, "TERM": tt.term}
+		if got := DetectColorMode(func(key string) string { return env[key] }); got != tt.want {
+			t.Errorf("DetectColorMode(COLORTERM=%q TERM=%q) = %s, want %s", tt.colorterm, tt.term, got, tt.want)
+		}
+	}
+}
+
+func TestParseColorMode(t *testing.T) {
+	for name, want := range map[string]ColorMode{"": ColorModeAuto, "auto": ColorModeAuto, "TrueColor": ColorModeTrueColor, "256": ColorMode256, " 16 ": ColorMode16} {
+		if got, ok := ParseColorMode(name); !ok || got != want {
+			t.Errorf("ParseColorMode(%q) = %s, %v; want %s", name, got, ok, want)
+		}
+	}
+	if _, ok := ParseColorMode("8"); ok {
+		t.Error("expected 8 to be rejected")
+	}
+}
+
+func TestDefaultThemeForColorMode(t *testing.T) {
+	if got := DefaultTheme(ColorMode16); got != PaletteTheme {
+		t.Errorf("DefaultTheme(16) = %s, want %s", got, PaletteTheme)
+	}
+	if got := DefaultTheme(ColorModeTrueColor); got != "gruvbox-dark" {
+		t.Errorf("DefaultTheme(truecolor) = %s, want gruvbox-dark", got)
+	}
+	if Get(PaletteTheme) == nil {
+		t.Errorf("expected the %s theme to be built in", PaletteTheme)
+	}
+}
+
+func TestConfigureTerminal(t *testing.T) {
+	for mode, want := range map[ColorMode]string{
+		ColorModeTrueColor: "COLORTERM=truecolor",
+		ColorMode256:       "TCELL_TRUECOLOR=disable",
+		ColorMode16:        "TCELL_TRUECOLOR=disable",
+		ColorModeAuto:      "",
+	} {
+		got := ""
+		_ = ConfigureTerminal(mode, func(key, value string) error {
+			got = key + "=" + value
+			return nil
+		})
+		if got != want {
+			t.Errorf("ConfigureTerminal(%s) set %q, want %q", mode, got, want)
+		}
+	}
+}
+
+func TestPaletteThemeColors(t *testing.T) {
+	palette := Get(PaletteTheme)
+	if palette == nil {
+		t.Fatalf("%s theme not registered", PaletteTheme)
+	}
+	if palette.AppBackground() != tcell.ColorDefault || palette.AppForeground() != tcell.ColorDefault {
+		t.Error("expected the terminal's default background and foreground")
+	}
+	// Component colors are palette entries, not RGB values
+	for name, color := range map[string]tcell.Color{
+		"selection_bg":  palette.SelectionBg(),
+		"selection_fg":  palette.SelectionFg(),
+		"input_field":   palette.InputFieldBackground(),
+		"border_active": palette.BorderFocused(),
+	} {
+		if !color.Valid() || color.IsRGB() {
+			t.Errorf("%s: expected a palette color, got %v", name, color)
+		}
+	}
+}
+
+func TestParseColor(t *testing.T) {
+	tests := map[string]tcell.Color{
+		"#FF0000": tcell.NewHexColor(0xFF0000),
+		"00ff00":  tcell.NewHexColor(0x00FF00),
+		"navy":    tcell.ColorNavy,
+		"Red":     tcell.ColorRed,
+		"default": tcell.ColorDefault,
+	}
+	for value, want := range tests {
+		if got := parseColor(value); got != want {
+			t.Errorf("parseColor(%q) = %v, want %v", value, got, want)
+		}
+	}
+}
@@ -49,6 +49,20 @@ type Theme interface {
 
 	// Input field colors
 	InputFieldBackground() tcell.Color
+
+	// Icon overrides for the configured icon set
+	Icons() Icons
+}
+
+// Icons are glyphs a theme draws in place of the configured icon set's (see
+// formatting.IconSet), so it can use ones that suit its font: a ladybug for
+// bugs, say. Empty fields keep the icon set's glyph.
+type Icons struct {
+	// Type icons
+	Bug, Feature, Task, Epic, Chore string
+
+	// Status icons (Open is for ready issues: open and unblocked)
+	Open, InProgress, Blocked, Closed string
 }
 
 var (
@@ -57,15 +71,16 @@ var (
 	registryMutex sync.RWMutex
 )
 
-// Register adds a theme to the global registry
+// Register adds a theme to the global registry, replacing any theme with
+// the same name. Replacing the current theme makes the new one current.
 func Register(t Theme) {
 	registryMutex.Lock()
 	defer registryMutex.Unlock()
 
 	registry[t.Name()] = t
 
-	// Set as current if it's the first theme registered
-	if currentTheme == nil {
+	// Set as current if it's the first theme registered, or replaces it
+	if currentTheme == nil || currentTheme.Name() == t.Name() {
 		currentTheme = t
 	}
 }
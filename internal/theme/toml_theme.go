@@ -2,6 +2,7 @@ package theme
 
 import (
 	"embed"
+	"errors"
 	"fmt"
 	"os"
 	"path/filepath"
@@ -76,6 +77,19 @@ type tomlThemeConfig struct {
 		AppForeground       string `toml:"app_foreground"`
 		InputFieldBackground string `toml:"input_field_background"`
 	} `toml:"component"`
+
+	// Icons is optional; themes without it use the icon set's glyphs
+	Icons struct {
+		Bug        string `toml:"bug"`
+		Feature    string `toml:"feature"`
+		Task       string `toml:"task"`
+		Epic       string `toml:"epic"`
+		Chore      string `toml:"chore"`
+		Open       string `toml:"open"`
+		InProgress string `toml:"in_progress"`
+		Blocked    string `toml:"blocked"`
+		Closed     string `toml:"closed"`
+	} `toml:"icons"`
 }
 
 // LoadTOMLTheme loads a theme from a TOML file (embedded or external)
@@ -88,10 +102,9 @@ func LoadTOMLTheme(name string) (*TOMLTheme, error) {
 	data, err = embeddedThemes.ReadFile(embeddedPath)
 	if err != nil {
 		// Try loading from external user themes directory
-		homeDir, homeErr := os.UserHomeDir()
-		if homeErr == nil {
-			externalPath := filepath.Join(homeDir, ".config", "beads-tui", "themes", name+".toml")
-			data, err = os.ReadFile(externalPath)
+		dir, dirErr := UserThemesDir()
+		if dirErr == nil {
+			data, err = os.ReadFile(filepath.Join(dir, name+".toml"))
 		}
 	}
 
@@ -99,6 +112,12 @@ func LoadTOMLTheme(name string) (*TOMLTheme, error) {
 		return nil, fmt.Errorf("failed to load theme %s: %w", name, err)
 	}
 
+	return parseTOMLTheme(name, data)
+}
+
+// parseTOMLTheme parses a theme file's contents. The file must name the
+// theme it's saved as.
+func parseTOMLTheme(name string, data []byte) (*TOMLTheme, error) {
 	var config tomlThemeConfig
 	if err := toml.Unmarshal(data, &config); err != nil {
 		return nil, fmt.Errorf("failed to parse theme %s: %w", name, err)
@@ -115,6 +134,55 @@ func LoadTOMLTheme(name string) (*TOMLTheme, error) {
 	}, nil
 }
 
+// UserThemesDir returns the directory user themes are loaded from
+// (~/.config/beads-tui/themes), one <name>.toml file per theme
+func UserThemesDir() (string, error) {
+	homeDir, err := os.UserHomeDir()
+	if err != nil {
+		return "", err
+	}
+	return filepath.Join(homeDir, ".config", "beads-tui", "themes"), nil
+}
+
+// LoadUserThemes registers every theme in UserThemesDir, replacing any
+// registered theme of the same name (including a built-in one, and the
+// current theme). Loading it again after a file changes picks up the edit.
+// A missing directory isn't an error; a theme that fails to load is skipped
+// and reported after the others are registered.
+func LoadUserThemes() error {
+	dir, err := UserThemesDir()
+	if err != nil {
+		return err
+	}
+	entries, err := os.ReadDir(dir)
+	if os.IsNotExist(err) {
+		return nil
+	}
+	if err != nil {
+		return fmt.Errorf("failed to read user themes: %w", err)
+	}
+
+	var errs []error
+	for _, entry := range entries {
+		if entry.IsDir() || !strings.HasSuffix(entry.Name(), ".toml") {
+			continue
+		}
+		name := strings.TrimSuffix(entry.Name(), ".toml")
+		data, err := os.ReadFile(filepath.Join(dir, entry.Name()))
+		if err != nil {
+			errs = append(errs, fmt.Errorf("failed to load theme %s: %w", name, err))
+			continue
+		}
+		theme, err := parseTOMLTheme(name, data)
+		if err != nil {
+			errs = append(errs, err)
+			continue
+		}
+		Register(theme)
+	}
+	return errors.Join(errs...)
+}
+
 // LoadAllEmbeddedThemes loads all TOML themes from the embedded filesystem
 func LoadAllEmbeddedThemes() error {
 	entries, err := embeddedThemes.ReadDir("themes")
@@ -218,31 +286,61 @@ func (t *TOMLTheme) Accent() string {
 }
 
 func (t *TOMLTheme) SelectionBg() tcell.Color {
-	return parseHexColor(t.config.Component.SelectionBg)
+	return parseColor(t.config.Component.SelectionBg)
 }
 
 func (t *TOMLTheme) SelectionFg() tcell.Color {
-	return parseHexColor(t.config.Component.SelectionFg)
+	return parseColor(t.config.Component.SelectionFg)
 }
 
 func (t *TOMLTheme) BorderNormal() tcell.Color {
-	return parseHexColor(t.config.Component.BorderNormal)
+	return parseColor(t.config.Component.BorderNormal)
 }
 
 func (t *TOMLTheme) BorderFocused() tcell.Color {
-	return parseHexColor(t.config.Component.BorderFocused)
+	return parseColor(t.config.Component.BorderFocused)
 }
 
 func (t *TOMLTheme) AppBackground() tcell.Color {
-	return parseHexColor(t.config.Component.AppBackground)
+	return parseColor(t.config.Component.AppBackground)
 }
 
 func (t *TOMLTheme) AppForeground() tcell.Color {
-	return parseHexColor(t.config.Component.AppForeground)
+	return parseColor(t.config.Component.AppForeground)
 }
 
 func (t *TOMLTheme) InputFieldBackground() tcell.Color {
-	return parseHexColor(t.config.Component.InputFieldBackground)
+	return parseColor(t.config.Component.InputFieldBackground)
+}
+
+func (t *TOMLTheme) Icons() Icons {
+	icons := t.config.Icons
+	return Icons{
+		Bug:        icons.Bug,
+		Feature:    icons.Feature,
+		Task:       icons.Task,
+		Epic:       icons.Epic,
+		Chore:      icons.Chore,
+		Open:       icons.Open,
+		InProgress: icons.InProgress,
+		Blocked:    icons.Blocked,
+		Closed:     icons.Closed,
+	}
+}
+
+// parseColor converts a component color to tcell.Color: "#rrggbb" (or
+// without the #), a color name such as "navy" (the 16 ANSI names are the
+// terminal's palette colors), or "default" for the terminal's own
+// foreground or background
+func parseColor(value string) tcell.Color {
+	name := strings.ToLower(strings.TrimSpace(value))
+	if name == "default" {
+		return tcell.ColorDefault
+	}
+	if color, ok := tcell.ColorNames[name]; ok {
+		return color
+	}
+	return parseHexColor(value)
 }
 
 // parseHexColor converts a hex color string to tcell.Color
@@ -1,6 +1,11 @@
 package theme
 
 import (
+	"fmt"
+	"os"
+	"path/filepath"
+	"regexp"
+	"strings"
 	"testing"
 )
 
@@ -29,6 +34,30 @@ func TestLoadTOMLTheme(t *testing.T) {
 	}
 }
 
+func TestTOMLThemeIcons(t *testing.T) {
+	base, err := embeddedThemes.ReadFile("themes/nord.toml")
+	if err != nil {
+		t.Fatal(err)
+	}
+	theme, err := parseTOMLTheme("nord", base)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if icons := theme.Icons(); icons != (Icons{}) {
+		t.Errorf("expected a theme without [icons] to override nothing, got %+v", icons)
+	}
+
+	data := string(base) + "\n[icons]\nbug = \"🐞\"\nfeature = \"⭐\"\nin_progress = \"▶\"\n"
+	theme, err = parseTOMLTheme("nord", []byte(data))
+	if err != nil {
+		t.Fatal(err)
+	}
+	want := Icons{Bug: "🐞", Feature: "⭐", InProgress: "▶"}
+	if icons := theme.Icons(); icons != want {
+		t.Errorf("expected icons %+v, got %+v", want, icons)
+	}
+}
+
 func TestLoadAllEmbeddedThemes(t *testing.T) {
 	// This test verifies that all TOML themes are loaded automatically
 	// The init() function should have already loaded them
@@ -109,3 +138,66 @@ func TestParseHexColor(t *testing.T) {
 		}
 	}
 }
+
+func TestLoadUserThemes(t *testing.T) {
+	home := t.TempDir()
+	t.Setenv("HOME", home)
+
+	// No themes directory: nothing to load
+	if err := LoadUserThemes(); err != nil {
+		t.Fatalf("LoadUserThemes() without a directory failed: %v", err)
+	}
+
+	dir, err := UserThemesDir()
+	if err != nil {
+		t.Fatal(err)
+	}
+	if err := os.MkdirAll(dir, 0755); err != nil {
+		t.Fatal(err)
+	}
+	base, err := embeddedThemes.ReadFile("themes/nord.toml")
+	if err != nil {
+		t.Fatal(err)
+	}
+	writeTheme := func(name, openColor string) {
+		data := strings.Replace(string(base), `name = "nord"`, fmt.Sprintf("name = %q", name), 1)
+		data = regexp.MustCompile(`(?m)^open = "[^"]*"`).ReplaceAllString(data, fmt.Sprintf("open = %q", openColor))
+		if err := os.WriteFile(filepath.Join(dir, name+".toml"), []byte(data), 0644); err != nil {
+			t.Fatal(err)
+		}
+	}
+
+	writeTheme("my-theme", "#111111")
+	if err := LoadUserThemes(); err != nil {
+		t.Fatalf("LoadUserThemes() failed: %v", err)
+	}
+	if theme := Get("my-theme"); theme == nil || theme.StatusOpen() != "#111111" {
+		t.Fatalf("expected my-theme to be registered, got %v", theme)
+	}
+
+	previous := Current().Name()
+	defer func() { _ = SetCurrent(previous) }()
+	if err := SetCurrent("my-theme"); err != nil {
+		t.Fatal(err)
+	}
+
+	// Editing the current theme's file and reloading applies the change
+	writeTheme("my-theme", "#222222")
+	if err := LoadUserThemes(); err != nil {
+		t.Fatalf("LoadUserThemes() after an edit failed: %v", err)
+	}
+	if got := Current().StatusOpen(); got != "#222222" {
+		t.Errorf("expected the reloaded current theme, got StatusOpen %s", got)
+	}
+
+	// A broken file is reported without dropping the others
+	if err := os.WriteFile(filepath.Join(dir, "broken.toml"), []byte("[theme\n"), 0644); err != nil {
+		t.Fatal(err)
+	}
+	if err := LoadUserThemes(); err == nil || !strings.Contains(err.Error(), "broken") {
+		t.Errorf("expected an error naming the broken theme, got %v", err)
+	}
+	if Get("my-theme") == nil {
+		t.Error("expected my-theme to stay registered")
+	}
+}
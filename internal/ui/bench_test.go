@@ -0,0 +1,70 @@
+package ui
+
+import (
+	"fmt"
+	"testing"
+	"time"
+
+	"github.com/andy/beads-tui/internal/parser"
+	"github.com/andy/beads-tui/internal/state"
+)
+
+// benchSizes are the synthetic dataset sizes the refresh path is measured at
+var benchSizes = []int{1000, 10000, 50000}
+
+// benchState loads n issues with a spread of statuses, priorities, and
+// labels; every tenth issue is a child of the one before it, so tree view
+// has some depth
+func benchState(n int, mode state.ViewMode) *state.State {
+	statuses := []parser.Status{parser.StatusOpen, parser.StatusInProgress, parser.StatusBlocked, parser.StatusClosed}
+	created := time.Date(2024, 1, 1, 0, 0, 0, 0, time.UTC)
+	issues := make([]*parser.Issue, n)
+	for i := range issues {
+		issues[i] = &parser.Issue{
+			ID:        fmt.Sprintf("bench-%d", i),
+			Title:     fmt.Sprintf("Synthetic issue %d", i),
+			Status:    statuses[i%len(statuses)],
+			Priority:  i % 5,
+			IssueType: parser.TypeTask,
+			Assignee:  fmt.Sprintf("user%d", i%7),
+			Labels:    []string{fmt.Sprintf("area-%d", i%12)},
+			CreatedAt: created.Add(time.Duration(i) * time.Minute),
+		}
+		if i > 0 && i%10 == 0 {
+			issues[i].Dependencies = []*parser.Dependency{
+				{IssueID: issues[i].ID, DependsOnID: issues[i-1].ID, Type: parser.DepParentChild},
+			}
+		}
+	}
+	appState := state.New()
+	appState.SetViewMode(mode)
+	appState.LoadIssues(issues)
+	return appState
+}
+
+func BenchmarkPopulateIssueList(b *testing.B) {
+	views := []struct {
+		name     string
+		mode     state.ViewMode
+		grouping Grouping
+	}{
+		{"list", state.ViewList, GroupByStatus},
+		{"label", state.ViewList, GroupByLabel},
+		{"tree", state.ViewTree, GroupByStatus},
+	}
+	for _, view := range views {
+		for _, n := range benchSizes {
+			b.Run(fmt.Sprintf("%s/issues=%d", view.name, n), func(b *testing.B) {
+				appState := benchState(n, view.mode)
+				list := NewVirtualList()
+				indexToIssue := make(map[int]*parser.Issue)
+				opts := ListOptions{ShowClosedIssues: true, ShowPrefix: true, Grouping: view.grouping}
+				b.ReportAllocs()
+				b.ResetTimer()
+				for i := 0; i < b.N; i++ {
+					PopulateIssueList(list, appState, opts, indexToIssue)
+				}
+			})
+		}
+	}
+}
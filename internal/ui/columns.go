@@ -0,0 +1,206 @@
+package ui
+
+import (
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/andy/beads-tui/internal/formatting"
+	"github.com/andy/beads-tui/internal/parser"
+	"github.com/andy/beads-tui/internal/state"
+	"github.com/mattn/go-runewidth"
+	"github.com/rivo/tview"
+)
+
+// Issue list column names, as used in the list_columns config
+const (
+	ColumnIcon     = "icon"
+	ColumnID       = "id"
+	ColumnPriority = "priority"
+	ColumnType     = "type"
+	ColumnAssignee = "assignee"
+	ColumnInitials = "initials"
+	ColumnEstimate = "estimate"
+	ColumnAge      = "age"
+	ColumnLabels   = "labels"
+	ColumnTitle    = "title"
+)
+
+// Column is one field of an issue list row
+type Column struct {
+	Name   string
+	Width  int  // Pad or truncate the value to this many cells (0: as wide as it is)
+	Hidden bool // Left out of the row (the columns dialog lists it to show again)
+}
+
+// DefaultColumns is the classic row layout: status icon, type, ID, priority,
+// the assignee's initials, title, and labels. The full assignee, estimate,
+// and age are available but hidden.
+func DefaultColumns() []Column {
+	return []Column{
+		{Name: ColumnIcon},
+		{Name: ColumnType},
+		{Name: ColumnID},
+		{Name: ColumnPriority},
+		{Name: ColumnInitials, Width: 2},
+		{Name: ColumnTitle},
+		{Name: ColumnLabels},
+		{Name: ColumnAssignee, Width: 12, Hidden: true},
+		{Name: ColumnEstimate, Hidden: true},
+		{Name: ColumnAge, Hidden: true},
+	}
+}
+
+// NormalizeColumns drops unknown and repeated columns, then appends any
+// column missing from the spec as hidden, so every column can be toggled.
+// An empty spec gives DefaultColumns.
+func NormalizeColumns(columns []Column) []Column {
+	defaults := DefaultColumns()
+	known := make(map[string]bool)
+	for _, column := range defaults {
+		known[column.Name] = true
+	}
+
+	seen := make(map[string]bool)
+	var result []Column
+	for _, column := range columns {
+		column.Name = strings.ToLower(strings.TrimSpace(column.Name))
+		if !known[column.Name] || seen[column.Name] {
+			continue
+		}
+		column.Width = max(column.Width, 0)
+		seen[column.Name] = true
+		result = append(result, column)
+	}
+	if len(result) == 0 {
+		return defaults
+	}
+	for _, column := range defaults {
+		if !seen[column.Name] {
+			column.Hidden = true
+			result = append(result, column)
+		}
+	}
+	return result
+}
+
+// rowStyle is how list and tree rows differ in drawing the shared columns
+type rowStyle struct {
+	StatusIcon  string
+	IconColor   string
+	IDColor     string // Empty for plain
+	TitleSuffix string // Drawn after the title, e.g. a collapsed node's child count
+	ShowPrefix  bool
+}
+
+// formatColumns renders an issue's visible columns, separated by spaces.
+// Columns with a width are padded so they line up across rows; empty
+// columns without one are left out.
+func formatColumns(appState *state.State, issue *parser.Issue, columns []Column, style rowStyle) string {
+	var cells []string
+	for _, column := range columns {
+		if column.Hidden {
+			continue
+		}
+		cell := formatColumn(appState, issue, column, style)
+		if cell == "" && column.Width == 0 {
+			continue
+		}
+		cells = append(cells, cell)
+	}
+	return strings.Join(cells, " ")
+}
+
+// formatColumn renders one column, fitted to its width
+func formatColumn(appState *state.State, issue *parser.Issue, column Column, style rowStyle) string {
+	mutedColor := formatting.GetMutedColor()
+	switch column.Name {
+	case ColumnIcon:
+		return colored(style.IconColor, fit(style.StatusIcon, column.Width))
+	case ColumnType:
+		return fit(formatting.GetTypeIcon(issue.IssueType), column.Width)
+	case ColumnID:
+		return colored(style.IDColor, fit(formatting.FormatIssueID(issue.ID, style.ShowPrefix), column.Width))
+	case ColumnPriority:
+		return fit(fmt.Sprintf("[P%d]", issue.Priority), column.Width)
+	case ColumnAssignee:
+		assignee := ""
+		if issue.Assignee != "" {
+			assignee = "@" + issue.Assignee
+		}
+		return colored(mutedColor, fit(assignee, column.Width))
+	case ColumnInitials:
+		initials := fit(formatting.AssigneeInitials(issue.Assignee), column.Width)
+		if strings.TrimSpace(initials) == "" {
+			return initials
+		}
+		return fmt.Sprintf("[%s::b]%s[-::-]", formatting.GetAssigneeColor(issue.Assignee), initials)
+	case ColumnEstimate:
+		estimate := ""
+		if issue.EstimatedMinutes != nil && *issue.EstimatedMinutes > 0 {
+			estimate = formatting.FormatMinutes(*issue.EstimatedMinutes)
+		}
+		return colored(mutedColor, fit(estimate, column.Width))
+	case ColumnAge:
+		return colored(mutedColor, fit(formatting.FormatAge(issue.CreatedAt, time.Now()), column.Width))
+	case ColumnLabels:
+		labels := make([]string, len(issue.Labels))
+		for i, label := range issue.Labels {
+			labels[i] = "#" + label
+		}
+		return colored(mutedColor, fit(strings.Join(labels, " "), column.Width))
+	case ColumnTitle:
+		return formatTitleColumn(appState, issue, column.Width, style.TitleSuffix)
+	}
+	return ""
+}
+
+// formatTitleColumn renders the title with its markers ahead of it and epic
+// progress, the due date, the reason a blocked issue is blocked, and the
+// row's suffix after it. Only the title itself is truncated to the width.
+func formatTitleColumn(appState *state.State, issue *parser.Issue, width int, suffix string) string {
+	title := issue.Title
+	if width > 0 {
+		title = runewidth.Truncate(title, width, "…")
+	}
+	text := branchMarker(appState, issue.ID) + cycleMarker(appState, issue.ID) + watchMarker(appState, issue.ID) + title
+
+	// Add child completion for epics, and their estimated work closed
+	if progress, ok := appState.EpicProgress(issue.ID); ok {
+		text += " " + formatting.FormatProgress(progress)
+		if estimate := formatting.FormatEstimateRollup(progress); estimate != "" {
+			text += " " + estimate
+		}
+	}
+
+	// Add the due date, colored once it's close or past
+	if due := formatting.FormatDueTag(issue, time.Now()); due != "" {
+		text += " " + due
+	}
+
+	// Add why a blocked issue is blocked
+	if reason := appState.BlockedReason(issue.ID); reason != "" {
+		text += fmt.Sprintf(" [%s]— %s[-]", formatting.GetMutedColor(), tview.Escape(reason))
+	}
+	if suffix != "" {
+		text += " " + suffix
+	}
+	return text
+}
+
+// fit truncates a value to width cells, or pads it to exactly width (no-op
+// for width 0)
+func fit(value string, width int) string {
+	if width <= 0 {
+		return value
+	}
+	return runewidth.FillRight(runewidth.Truncate(value, width, "…"), width)
+}
+
+// colored wraps text in a color tag, unless the color or text is empty
+func colored(color, text string) string {
+	if color == "" || strings.TrimSpace(text) == "" {
+		return text
+	}
+	return fmt.Sprintf("[%s]%s[-]", color, text)
+}
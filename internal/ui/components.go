@@ -9,7 +9,7 @@ import (
 type Components struct {
 	App         *tview.Application
 	StatusBar   *tview.TextView
-	IssueList   *tview.List
+	IssueList   *VirtualList
 	DetailPanel *tview.TextView
 	Pages       *tview.Pages
 	Layout      *tview.Flex
@@ -24,8 +24,7 @@ func CreateComponents() *Components {
 		SetDynamicColors(true)
 
 	// Issue list
-	issueList := tview.NewList().
-		ShowSecondaryText(false).
+	issueList := NewVirtualList().
 		SetSelectedBackgroundColor(tcell.ColorDarkCyan).
 		SetSelectedTextColor(tcell.ColorBlack)
 	issueList.SetBorder(true).SetTitle("Issues")
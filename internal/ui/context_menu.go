@@ -0,0 +1,203 @@
+package ui
+
+import (
+	"github.com/andy/beads-tui/internal/theme"
+	"github.com/gdamore/tcell/v2"
+	"github.com/rivo/tview"
+)
+
+// MenuItem is one entry of a ContextMenu: an action, or a submenu when Items
+// is set
+type MenuItem struct {
+	Label    string
+	Shortcut rune // Picks the item from the keyboard, or 0 for none
+	Action   func()
+	Items    []MenuItem
+}
+
+// submenuMarker ends the label of an item that opens a submenu
+const submenuMarker = " ▸"
+
+// ContextMenu is a small bordered menu shown at a screen position (where
+// the mouse was right-clicked, say) on top of the current page. Enter, a
+// click, or an item's shortcut picks an item; an item with Items opens them
+// as a submenu beside it (→ works too). Esc or ← closes a submenu, Esc or a
+// click outside closes the menu, and focus returns to where it was. The
+// picked action runs after the menu has closed, so it can open a dialog.
+type ContextMenu struct {
+	*tview.Box
+
+	app         *tview.Application
+	pages       *tview.Pages
+	name        string
+	returnFocus tview.Primitive
+	levels      []*menuLevel // The open menu and its submenus, last focused
+}
+
+// menuLevel is the menu or one of its open submenus
+type menuLevel struct {
+	list  *tview.List
+	items []MenuItem
+	x, y  int
+}
+
+// NewContextMenu creates a menu of items, shown as page name with its top
+// left corner at x, y (moved in as needed to fit on screen)
+func NewContextMenu(app *tview.Application, pages *tview.Pages, name string, x, y int, items []MenuItem) *ContextMenu {
+	m := &ContextMenu{
+		Box:   tview.NewBox(),
+		app:   app,
+		pages: pages,
+		name:  name,
+	}
+	m.open(items, x, y)
+	return m
+}
+
+// SetReturnFocus sets the primitive focused when the menu closes
+func (m *ContextMenu) SetReturnFocus(p tview.Primitive) *ContextMenu {
+	m.returnFocus = p
+	return m
+}
+
+// Show displays the menu on top of the current page
+func (m *ContextMenu) Show() {
+	m.pages.AddPage(m.name, m, true, true)
+	m.app.SetFocus(m)
+}
+
+// Close removes the menu's page and returns focus
+func (m *ContextMenu) Close() {
+	m.pages.RemovePage(m.name)
+	if m.returnFocus != nil {
+		m.app.SetFocus(m.returnFocus)
+	}
+}
+
+// open adds a menu level listing items at x, y
+func (m *ContextMenu) open(items []MenuItem, x, y int) {
+	currentTheme := theme.Current()
+	list := tview.NewList().
+		ShowSecondaryText(false).
+		SetHighlightFullLine(true).
+		SetMainTextColor(currentTheme.AppForeground()).
+		SetSelectedTextColor(currentTheme.SelectionFg()).
+		SetSelectedBackgroundColor(currentTheme.SelectionBg()).
+		SetShortcutColor(tcell.GetColor(currentTheme.Accent()))
+	list.SetBorder(true).SetBorderColor(currentTheme.BorderFocused())
+	for _, item := range items {
+		label := item.Label
+		if len(item.Items) > 0 {
+			label += submenuMarker
+		}
+		list.AddItem(tview.Escape(label), "", item.Shortcut, nil)
+	}
+
+	level := &menuLevel{list: list, items: items, x: x, y: y}
+	list.SetSelectedFunc(func(index int, _, _ string, _ rune) {
+		m.choose(level, index)
+	})
+	m.levels = append(m.levels, level)
+}
+
+// choose opens the item's submenu beside it, or closes the menu and runs it
+func (m *ContextMenu) choose(level *menuLevel, index int) {
+	item := level.items[index]
+	if len(item.Items) > 0 {
+		for m.levels[len(m.levels)-1] != level {
+			m.levels = m.levels[:len(m.levels)-1]
+		}
+		x, y, width, _ := level.list.GetRect()
+		offset, _ := level.list.GetOffset()
+		m.open(item.Items, x+width-1, y+index-offset)
+		m.app.SetFocus(m)
+		return
+	}
+	m.Close()
+	if item.Action != nil {
+		item.Action()
+	}
+}
+
+// Draw draws the open menus over whatever is below, each sized to its items
+// and kept on screen
+func (m *ContextMenu) Draw(screen tcell.Screen) {
+	screenWidth, screenHeight := screen.Size()
+	for _, level := range m.levels {
+		width := 0
+		for i := range level.items {
+			main, _ := level.list.GetItemText(i)
+			width = max(width, tview.TaggedStringWidth(main))
+		}
+		width += 6 // Border, shortcut, and padding
+		height := min(len(level.items)+2, screenHeight)
+		x := max(min(level.x, screenWidth-width), 0)
+		y := max(min(level.y, screenHeight-height), 0)
+		level.list.SetRect(x, y, width, height)
+		level.list.Draw(screen)
+	}
+}
+
+// Focus focuses the innermost open menu
+func (m *ContextMenu) Focus(delegate func(p tview.Primitive)) {
+	delegate(m.levels[len(m.levels)-1].list)
+}
+
+// HasFocus reports whether one of the open menus has focus
+func (m *ContextMenu) HasFocus() bool {
+	for _, level := range m.levels {
+		if level.list.HasFocus() {
+			return true
+		}
+	}
+	return false
+}
+
+// InputHandler handles Esc and the arrows for submenus, and passes the rest
+// (moving, Enter, shortcuts) to the innermost menu
+func (m *ContextMenu) InputHandler() func(event *tcell.EventKey, setFocus func(p tview.Primitive)) {
+	return m.WrapInputHandler(func(event *tcell.EventKey, setFocus func(p tview.Primitive)) {
+		level := m.levels[len(m.levels)-1]
+		switch event.Key() {
+		case tcell.KeyEscape, tcell.KeyLeft:
+			if len(m.levels) > 1 {
+				m.levels = m.levels[:len(m.levels)-1]
+				setFocus(m)
+			} else if event.Key() == tcell.KeyEscape {
+				m.Close()
+			}
+			return
+		case tcell.KeyRight:
+			if index := level.list.GetCurrentItem(); len(level.items[index].Items) > 0 {
+				m.choose(level, index)
+			}
+			return
+		}
+		if handler := level.list.InputHandler(); handler != nil {
+			handler(event, setFocus)
+		}
+	})
+}
+
+// MouseHandler passes clicks on a menu to it (closing any submenus it
+// opened) and closes the menu on a click anywhere else
+func (m *ContextMenu) MouseHandler() func(action tview.MouseAction, event *tcell.EventMouse, setFocus func(p tview.Primitive)) (consumed bool, capture tview.Primitive) {
+	return m.WrapMouseHandler(func(action tview.MouseAction, event *tcell.EventMouse, setFocus func(p tview.Primitive)) (consumed bool, capture tview.Primitive) {
+		for i := len(m.levels) - 1; i >= 0; i-- {
+			level := m.levels[i]
+			if !level.list.InRect(event.Position()) {
+				continue
+			}
+			if action == tview.MouseLeftClick && i < len(m.levels)-1 {
+				m.levels = m.levels[:i+1]
+			}
+			level.list.MouseHandler()(action, event, setFocus)
+			return true, nil
+		}
+		switch action {
+		case tview.MouseLeftClick, tview.MouseRightClick, tview.MouseMiddleClick:
+			m.Close()
+		}
+		return true, nil
+	})
+}
@@ -0,0 +1,270 @@
+package ui
+
+import (
+	"github.com/andy/beads-tui/internal/theme"
+	"github.com/gdamore/tcell/v2"
+	"github.com/rivo/tview"
+)
+
+// Dialog builds a centered modal form with themed field colors, consistent
+// keyboard handling, and page lifecycle (Show adds the page and focuses the
+// form, Close removes it and restores focus). Every dialog supports:
+//
+//	Ctrl-S         Run the primary action
+//	(key)          Run a button added with AddKeyButton
+//	Esc            Run the cancel action
+//	Tab/Shift-Tab  Fields first, then primary, cancel, and any other buttons
+//	Home/End       Jump to the first/last field (inside a text input, use
+//	               Ctrl or Alt with Home/End; plain Home/End move the cursor)
+//
+// SetSubmitOnEnter additionally runs the primary action on Enter from a
+// single-line input field.
+type Dialog struct {
+	Form *tview.Form
+
+	app           *tview.Application
+	pages         *tview.Pages
+	name          string
+	returnFocus   tview.Primitive
+	primaryLabel  string
+	primary       func()
+	cancelLabel   string
+	cancel        func()
+	buttons       []dialogButton
+	submitOnEnter bool
+	footer        tview.Primitive
+	footerHeight  int
+	width         int
+	height        int
+	fixedSize     bool
+}
+
+type dialogButton struct {
+	label  string
+	key    tcell.Key // Shortcut, or 0 for none
+	action func()
+}
+
+// NewDialog creates a dialog shown as page name, with an empty form titled
+// title. Add fields to d.Form, set the primary and cancel actions, then call Show.
+func NewDialog(app *tview.Application, pages *tview.Pages, name, title string) *Dialog {
+	form := tview.NewForm()
+	form.SetBorder(true).SetTitle(" " + title + " ").SetTitleAlign(tview.AlignCenter)
+
+	currentTheme := theme.Current()
+	form.SetFieldBackgroundColor(currentTheme.SelectionBg())
+	form.SetFieldTextColor(currentTheme.SelectionFg())
+	form.SetButtonBackgroundColor(currentTheme.SelectionBg())
+	form.SetButtonTextColor(currentTheme.SelectionFg())
+
+	return &Dialog{
+		Form:   form,
+		app:    app,
+		pages:  pages,
+		name:   name,
+		width:  2,
+		height: 2,
+	}
+}
+
+// SetReturnFocus sets the primitive focused when the dialog closes
+func (d *Dialog) SetReturnFocus(p tview.Primitive) *Dialog {
+	d.returnFocus = p
+	return d
+}
+
+// SetPrimary sets the primary action, shown as the first button and bound to Ctrl-S
+func (d *Dialog) SetPrimary(label string, action func()) *Dialog {
+	d.primaryLabel = label
+	d.primary = action
+	return d
+}
+
+// SetCancel sets the cancel action, shown after the primary button and bound
+// to Esc. A nil action just closes the dialog.
+func (d *Dialog) SetCancel(label string, action func()) *Dialog {
+	if action == nil {
+		action = d.Close
+	}
+	d.cancelLabel = label
+	d.cancel = action
+	return d
+}
+
+// AddButton adds a secondary button, placed after the primary and cancel
+// buttons so they stay one Tab away from the last field
+func (d *Dialog) AddButton(label string, action func()) *Dialog {
+	d.buttons = append(d.buttons, dialogButton{label: label, action: action})
+	return d
+}
+
+// AddKeyButton adds a secondary button that also runs on key (shown in the
+// label, e.g. "Create + New (Ctrl-N)")
+func (d *Dialog) AddKeyButton(label string, key tcell.Key, action func()) *Dialog {
+	d.buttons = append(d.buttons, dialogButton{label: label, key: key, action: action})
+	return d
+}
+
+// SetSubmitOnEnter makes Enter in a single-line input run the primary action
+func (d *Dialog) SetSubmitOnEnter(submit bool) *Dialog {
+	d.submitOnEnter = submit
+	return d
+}
+
+// SetFooter shows p below the form, height rows tall (e.g. a hint line).
+// A height of 0 splits the space evenly between the form and p.
+func (d *Dialog) SetFooter(p tview.Primitive, height int) *Dialog {
+	d.footer = p
+	d.footerHeight = height
+	return d
+}
+
+// SetSize sets the dialog's share of the screen, relative to a margin of 1 on
+// each side (the default of 2 x 2 takes half the width and height)
+func (d *Dialog) SetSize(width, height int) *Dialog {
+	d.width = width
+	d.height = height
+	d.fixedSize = false
+	return d
+}
+
+// SetFixedSize sets the dialog to an exact size in columns and rows
+func (d *Dialog) SetFixedSize(columns, rows int) *Dialog {
+	d.width = columns
+	d.height = rows
+	d.fixedSize = true
+	return d
+}
+
+// Show builds the dialog and displays it on top of the current page
+func (d *Dialog) Show() {
+	d.pages.AddPage(d.name, d.build(), true, true)
+	d.app.SetFocus(d.Form)
+}
+
+// Close removes the dialog's page and returns focus
+func (d *Dialog) Close() {
+	d.pages.RemovePage(d.name)
+	if d.returnFocus != nil {
+		d.app.SetFocus(d.returnFocus)
+	}
+}
+
+// build adds the buttons in focus order, installs the key bindings, and
+// returns the centered modal
+func (d *Dialog) build() tview.Primitive {
+	if d.primary != nil {
+		d.Form.AddButton(d.primaryLabel+" (Ctrl-S)", d.primary)
+	}
+	if d.cancel != nil {
+		d.Form.AddButton(d.cancelLabel+" (Esc)", d.cancel)
+		d.Form.SetCancelFunc(d.cancel)
+	}
+	for _, button := range d.buttons {
+		label := button.label
+		if button.key != 0 {
+			label += " (" + tcell.KeyNames[button.key] + ")"
+		}
+		d.Form.AddButton(label, button.action)
+	}
+
+	d.Form.SetInputCapture(d.handleKey)
+
+	var content tview.Primitive = d.Form
+	if d.footer != nil {
+		content = tview.NewFlex().SetDirection(tview.FlexRow).
+			AddItem(d.Form, 0, 1, true).
+			AddItem(d.footer, d.footerHeight, 1, false)
+	}
+
+	if d.fixedSize {
+		return centered(content, d.width, d.height, 1, 1)
+	}
+	return CenterModal(content, d.width, d.height)
+}
+
+// CenterModal centers p on screen, taking width x height shares of the space
+// against a margin of 1 on each side
+func CenterModal(p tview.Primitive, width, height int) *tview.Flex {
+	return centered(p, 0, 0, width, height)
+}
+
+// centered lays p out between flexible margins. Flex items take a fixed size
+// when non-zero, otherwise a proportion.
+func centered(p tview.Primitive, fixedWidth, fixedHeight, proportionWidth, proportionHeight int) *tview.Flex {
+	return tview.NewFlex().
+		AddItem(nil, 0, 1, false).
+		AddItem(tview.NewFlex().SetDirection(tview.FlexRow).
+			AddItem(nil, 0, 1, false).
+			AddItem(p, fixedHeight, proportionHeight, true).
+			AddItem(nil, 0, 1, false), fixedWidth, proportionWidth, true).
+		AddItem(nil, 0, 1, false)
+}
+
+// handleKey implements the shared dialog key bindings
+func (d *Dialog) handleKey(event *tcell.EventKey) *tcell.EventKey {
+	itemIndex, _ := d.Form.GetFocusedItemIndex()
+	var focused tview.FormItem
+	if itemIndex >= 0 {
+		focused = d.Form.GetFormItem(itemIndex)
+	}
+
+	// Leave keys alone while a dropdown list is open
+	if dropDown, ok := focused.(*tview.DropDown); ok && dropDown.IsOpen() {
+		return event
+	}
+
+	switch event.Key() {
+	case tcell.KeyCtrlS:
+		if d.primary != nil {
+			d.primary()
+			return nil
+		}
+	case tcell.KeyEnter:
+		if _, ok := focused.(*tview.InputField); ok && d.submitOnEnter && d.primary != nil {
+			d.primary()
+			return nil
+		}
+	case tcell.KeyHome, tcell.KeyEnd:
+		if isTextInput(focused) && event.Modifiers()&(tcell.ModCtrl|tcell.ModAlt) == 0 {
+			return event
+		}
+		if index := d.fieldIndex(event.Key() == tcell.KeyHome); index >= 0 {
+			d.Form.SetFocus(index)
+			d.app.SetFocus(d.Form)
+		}
+		return nil
+	}
+	for _, button := range d.buttons {
+		if button.key != 0 && event.Key() == button.key {
+			button.action()
+			return nil
+		}
+	}
+	return event
+}
+
+// fieldIndex returns the index of the first (or last) focusable form item,
+// or -1 if the form has none. Read-only text views are skipped.
+func (d *Dialog) fieldIndex(first bool) int {
+	count := d.Form.GetFormItemCount()
+	for i := 0; i < count; i++ {
+		index := i
+		if !first {
+			index = count - 1 - i
+		}
+		if _, readOnly := d.Form.GetFormItem(index).(*tview.TextView); !readOnly {
+			return index
+		}
+	}
+	return -1
+}
+
+// isTextInput reports whether item consumes Home/End for cursor movement
+func isTextInput(item tview.FormItem) bool {
+	switch item.(type) {
+	case *tview.InputField, *tview.TextArea:
+		return true
+	}
+	return false
+}
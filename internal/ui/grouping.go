@@ -0,0 +1,235 @@
+package ui
+
+import (
+	"fmt"
+	"sort"
+	"strings"
+
+	"github.com/andy/beads-tui/internal/formatting"
+	"github.com/andy/beads-tui/internal/parser"
+	"github.com/andy/beads-tui/internal/state"
+	"github.com/rivo/tview"
+)
+
+// Grouping decides how list view divides the issues into sections
+type Grouping int
+
+const (
+	GroupByStatus   Grouping = iota // In progress, ready, blocked, and closed
+	GroupByAssignee                 // One section per assignee, Unassigned last
+	GroupByLabel                    // One section per label, Unlabeled last
+)
+
+// groupingNames are the Grouping names saved in the config
+var groupingNames = []string{"status", "assignee", "label"}
+
+func (g Grouping) String() string {
+	if int(g) < len(groupingNames) {
+		return groupingNames[g]
+	}
+	return "unknown"
+}
+
+// Next returns the grouping after g, wrapping around to GroupByStatus
+func (g Grouping) Next() Grouping {
+	return (g + 1) % Grouping(len(groupingNames))
+}
+
+// ParseGrouping returns the grouping with the given name
+func ParseGrouping(name string) (Grouping, bool) {
+	for i, n := range groupingNames {
+		if n == name {
+			return Grouping(i), true
+		}
+	}
+	return GroupByStatus, false
+}
+
+// Titles of the sections of issues without an assignee or label
+const (
+	unassignedSection = "UNASSIGNED"
+	unlabeledSection  = "UNLABELED"
+)
+
+// Section is a titled run of issues in list view, as laid out in the rows
+type Section struct {
+	Title string // Status, assignee, or label ("" for the unassigned or unlabeled issues)
+	Row   int    // Row index of the section's header
+	Shown int    // Issues listed in it
+	Total int    // Issues it would list without the filters
+}
+
+// listSection is a titled run of issues in list view
+type listSection struct {
+	Title  string
+	Header string // Formatted header row
+	Rows   []sectionRow
+	Total  int // Rows without the filters
+}
+
+// sectionRow is an issue in a section, with the status icon it's drawn with
+type sectionRow struct {
+	Issue      *parser.Issue
+	StatusIcon string
+}
+
+// statusGroup is one of the status categories, in list order
+type statusGroup struct {
+	Title  string
+	Status parser.Status // For the header color
+	Icon   string
+	Issues []*parser.Issue
+	All    []*parser.Issue // Issues without the filters
+}
+
+// statusGroups returns the categorized issues in list order: in progress,
+// ready, blocked, and (if shown) closed, from one snapshot of the state
+func statusGroups(appState *state.State, showClosedIssues bool) []statusGroup {
+	snapshot := appState.Snapshot()
+	icons := formatting.Icons()
+	groups := []statusGroup{
+		{"IN PROGRESS", parser.StatusInProgress, icons.InProgress, snapshot.InProgress, snapshot.Unfiltered.InProgress},
+		{"READY", parser.StatusOpen, icons.Ready, snapshot.Ready, snapshot.Unfiltered.Ready},
+		{"BLOCKED", parser.StatusBlocked, icons.Blocked, snapshot.Blocked, snapshot.Unfiltered.Blocked},
+	}
+	if showClosedIssues {
+		groups = append(groups, statusGroup{"CLOSED", parser.StatusClosed, icons.Closed, snapshot.Closed, snapshot.Unfiltered.Closed})
+	}
+	return groups
+}
+
+// listSections divides the list view's issues into sections by the grouping.
+// Empty sections are left out.
+func listSections(appState *state.State, showClosedIssues bool, grouping Grouping) []listSection {
+	switch grouping {
+	case GroupByAssignee:
+		return assigneeSections(statusGroups(appState, showClosedIssues))
+	case GroupByLabel:
+		return labelSections(statusGroups(appState, showClosedIssues))
+	}
+
+	var sections []listSection
+	for i, group := range statusGroups(appState, showClosedIssues) {
+		if len(group.Issues) == 0 {
+			continue
+		}
+		header := fmt.Sprintf("[%s::b]%s %s (%s)[-::-]", formatting.GetStatusColor(group.Status), formatting.Icons().Section, group.Title, countBadge(len(group.Issues), len(group.All)))
+		if i > 0 {
+			header = "\n" + header
+		}
+		section := listSection{Title: group.Title, Header: header, Total: len(group.All)}
+		for _, issue := range group.Issues {
+			section.Rows = append(section.Rows, sectionRow{Issue: issue, StatusIcon: group.Icon})
+		}
+		sections = append(sections, section)
+	}
+	return sections
+}
+
+// assigneeSections makes a section per assignee (alphabetical, Unassigned
+// last), keeping the status order within each
+func assigneeSections(groups []statusGroup) []listSection {
+	assignee := func(issue *parser.Issue) []string {
+		if name := strings.TrimSpace(issue.Assignee); name != "" {
+			return []string{name}
+		}
+		return nil
+	}
+	return keyedSections(groups, assignee, func(name string, count string) string {
+		if name == "" {
+			return fmt.Sprintf("[%s::b]%s %s (%s)[-::-]", formatting.GetMutedColor(), formatting.Icons().Section, unassignedSection, count)
+		}
+		return fmt.Sprintf("[%s::b]%s %s[-::-] [%s::b]%s[-::-] (%s)",
+			formatting.GetAssigneeColor(name), formatting.Icons().Section, formatting.AssigneeInitials(name), formatting.GetEmphasisColor(), tview.Escape(name), count)
+	})
+}
+
+// labelSections makes a section per label (alphabetical, Unlabeled last),
+// keeping the status order within each. An issue with several labels is
+// listed under each of them.
+func labelSections(groups []statusGroup) []listSection {
+	labels := func(issue *parser.Issue) []string {
+		return issue.Labels
+	}
+	return keyedSections(groups, labels, func(label string, count string) string {
+		if label == "" {
+			return fmt.Sprintf("[%s::b]%s %s (%s)[-::-]", formatting.GetMutedColor(), formatting.Icons().Section, unlabeledSection, count)
+		}
+		return fmt.Sprintf("[%s::b]%s #%s (%s)[-::-]", formatting.GetAccentColor(), formatting.Icons().Section, tview.Escape(label), count)
+	})
+}
+
+// keyedSections makes a section per key (compared without case, in
+// alphabetical order), then one for the issues without a key, keeping the
+// status order within each. header formats a section's header row, given its
+// key (the spelling seen first; "" for the keyless section) and its count
+// badge.
+func keyedSections(groups []statusGroup, keys func(*parser.Issue) []string, header func(key string, count string) string) []listSection {
+	rowsByKey := make(map[string][]sectionRow)
+	names := make(map[string]string) // Lowercased key -> spelling seen first
+	totals := make(map[string]int)   // Lowercased key -> issues without the filters
+	issueKeys := func(issue *parser.Issue) []string {
+		var lowered []string
+		seen := make(map[string]bool)
+		for _, key := range keys(issue) {
+			lower := strings.ToLower(key)
+			if lower == "" || seen[lower] {
+				continue
+			}
+			seen[lower] = true
+			if _, ok := names[lower]; !ok {
+				names[lower] = key
+			}
+			lowered = append(lowered, lower)
+		}
+		if len(lowered) == 0 {
+			lowered = []string{""}
+		}
+		return lowered
+	}
+	for _, group := range groups {
+		for _, issue := range group.Issues {
+			row := sectionRow{Issue: issue, StatusIcon: group.Icon}
+			for _, key := range issueKeys(issue) {
+				rowsByKey[key] = append(rowsByKey[key], row)
+			}
+		}
+		for _, issue := range group.All {
+			for _, key := range issueKeys(issue) {
+				totals[key]++
+			}
+		}
+	}
+
+	sortedKeys := make([]string, 0, len(rowsByKey))
+	for key := range rowsByKey {
+		sortedKeys = append(sortedKeys, key)
+	}
+	sort.Slice(sortedKeys, func(i, j int) bool {
+		a, b := sortedKeys[i], sortedKeys[j]
+		if (a == "") != (b == "") {
+			return b == ""
+		}
+		return a < b
+	})
+
+	sections := make([]listSection, 0, len(sortedKeys))
+	for i, key := range sortedKeys {
+		rows := rowsByKey[key]
+		text := header(names[key], countBadge(len(rows), totals[key]))
+		if i > 0 {
+			text = "\n" + text
+		}
+		sections = append(sections, listSection{Title: names[key], Header: text, Rows: rows, Total: totals[key]})
+	}
+	return sections
+}
+
+// countBadge is a section header's issue count: "12/45" when filters hide
+// some of the section's issues, otherwise just "45"
+func countBadge(shown, total int) string {
+	if shown == total {
+		return fmt.Sprintf("%d", shown)
+	}
+	return fmt.Sprintf("%d/%d", shown, total)
+}
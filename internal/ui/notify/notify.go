@@ -0,0 +1,258 @@
+// Package notify shows status bar notifications one at a time. Messages have
+// a severity level that sets their color, icon, and how long they stay up;
+// a message never hides one of higher severity, so an error isn't replaced by
+// the success message of the next keypress. Recent messages are kept for the
+// notification history overlay.
+package notify
+
+import (
+	"fmt"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/andy/beads-tui/internal/formatting"
+)
+
+// Level is a notification's severity
+type Level int
+
+// Severity levels, lowest first
+const (
+	LevelInfo Level = iota
+	LevelSuccess
+	LevelWarn
+	LevelError
+)
+
+// Levels lists every level, lowest first
+var Levels = []Level{LevelInfo, LevelSuccess, LevelWarn, LevelError}
+
+// String returns the level's name as used in the config file
+func (l Level) String() string {
+	switch l {
+	case LevelSuccess:
+		return "success"
+	case LevelWarn:
+		return "warn"
+	case LevelError:
+		return "error"
+	default:
+		return "info"
+	}
+}
+
+// ParseLevel looks up a level by name ("info", "success", "warn", "error")
+func ParseLevel(name string) (Level, bool) {
+	for _, level := range Levels {
+		if strings.EqualFold(name, level.String()) {
+			return level, true
+		}
+	}
+	return LevelInfo, false
+}
+
+// Icon is shown before the message text
+func (l Level) Icon() string {
+	switch l {
+	case LevelSuccess:
+		return "✓"
+	case LevelWarn:
+		return "⚠"
+	case LevelError:
+		return "✗"
+	default:
+		return "•"
+	}
+}
+
+// Color returns the theme color for the level as a tview color tag value
+func (l Level) Color() string {
+	switch l {
+	case LevelSuccess:
+		return formatting.GetSuccessColor()
+	case LevelWarn:
+		return formatting.GetWarningColor()
+	case LevelError:
+		return formatting.GetErrorColor()
+	default:
+		return formatting.GetInfoColor()
+	}
+}
+
+// DefaultDurations is how long each level stays in the status bar
+var DefaultDurations = map[Level]time.Duration{
+	LevelInfo:    2 * time.Second,
+	LevelSuccess: 2 * time.Second,
+	LevelWarn:    5 * time.Second,
+	LevelError:   8 * time.Second,
+}
+
+const (
+	// historySize is how many notifications Recent keeps
+	historySize = 50
+	// maxQueued bounds the messages waiting behind a higher-severity one
+	maxQueued = 10
+)
+
+// Notification is one message. Text may contain tview color tags.
+type Notification struct {
+	Level Level
+	Text  string
+	Time  time.Time
+}
+
+// Format renders the notification for the status bar
+func (n Notification) Format() string {
+	return fmt.Sprintf("[%s]%s %s[-]", n.Level.Color(), n.Level.Icon(), n.Text)
+}
+
+// Center queues notifications for the status bar. Post and the level
+// helpers must be called on the UI goroutine; expiry timers marshal back
+// onto it with queueUpdate.
+type Center struct {
+	queueUpdate func(func())
+	display     func(text string) // Shows a formatted notification
+	idle        func()            // Restores the normal status bar
+
+	// afterFunc schedules expiry (time.AfterFunc, replaced in tests)
+	afterFunc func(time.Duration, func())
+	now       func() time.Time
+
+	mu         sync.Mutex
+	durations  map[Level]time.Duration
+	current    *Notification
+	generation int // Bumped whenever current changes, so stale timers are ignored
+	queue      []Notification
+	history    []Notification // Oldest first
+}
+
+// New creates a Center that shows notifications with display and calls idle
+// once none is left to show
+func New(queueUpdate func(func()), display func(text string), idle func()) *Center {
+	durations := make(map[Level]time.Duration, len(DefaultDurations))
+	for level, duration := range DefaultDurations {
+		durations[level] = duration
+	}
+	return &Center{
+		queueUpdate: queueUpdate,
+		display:     display,
+		idle:        idle,
+		afterFunc: func(d time.Duration, f func()) {
+			time.AfterFunc(d, f)
+		},
+		now:       time.Now,
+		durations: durations,
+	}
+}
+
+// SetDuration changes how long a level stays in the status bar
+func (c *Center) SetDuration(level Level, duration time.Duration) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	c.durations[level] = duration
+}
+
+// Info shows an informational message
+func (c *Center) Info(text string) { c.Post(LevelInfo, text) }
+
+// Success shows that an action worked
+func (c *Center) Success(text string) { c.Post(LevelSuccess, text) }
+
+// Warn shows a warning
+func (c *Center) Warn(text string) { c.Post(LevelWarn, text) }
+
+// Error shows an error
+func (c *Center) Error(text string) { c.Post(LevelError, text) }
+
+// Post shows a message at the given level. It replaces the message on
+// screen unless that one is more severe, in which case it waits its turn.
+func (c *Center) Post(level Level, text string) {
+	c.mu.Lock()
+	n := Notification{Level: level, Text: text, Time: c.now()}
+	c.history = append(c.history, n)
+	if len(c.history) > historySize {
+		c.history = c.history[len(c.history)-historySize:]
+	}
+
+	if c.current != nil && c.current.Level > level {
+		c.queue = append(c.queue, n)
+		if len(c.queue) > maxQueued {
+			c.queue = c.queue[len(c.queue)-maxQueued:]
+		}
+		c.mu.Unlock()
+		return
+	}
+	c.showLocked(n)
+}
+
+// showLocked makes n the current notification and schedules its expiry.
+// Called with c.mu held; unlocks it before touching the UI.
+func (c *Center) showLocked(n Notification) {
+	c.current = &n
+	c.generation++
+	generation := c.generation
+	duration := c.durations[n.Level]
+	c.mu.Unlock()
+
+	c.display(n.Format())
+	c.afterFunc(duration, func() {
+		c.queueUpdate(func() {
+			c.expire(generation)
+		})
+	})
+}
+
+// expire ends the current notification (if it's still the one the timer was
+// set for) and shows the next queued message that hasn't gone stale
+func (c *Center) expire(generation int) {
+	c.mu.Lock()
+	if generation != c.generation {
+		c.mu.Unlock()
+		return
+	}
+	now := c.now()
+	for len(c.queue) > 0 {
+		next := c.queue[0]
+		c.queue = c.queue[1:]
+		if now.Sub(next.Time) < c.durations[next.Level] {
+			c.showLocked(next)
+			return
+		}
+	}
+	c.current = nil
+	c.generation++
+	c.mu.Unlock()
+	c.idle()
+}
+
+// Redraw shows the current notification again, or the normal status bar if
+// there is none, after something else (a prompt, a key hint) used the bar
+func (c *Center) Redraw() {
+	c.mu.Lock()
+	current := c.current
+	c.mu.Unlock()
+	if current != nil {
+		c.display(current.Format())
+		return
+	}
+	c.idle()
+}
+
+// Showing reports whether a notification is in the status bar
+func (c *Center) Showing() bool {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	return c.current != nil
+}
+
+// Recent returns the last notifications, newest first
+func (c *Center) Recent() []Notification {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	recent := make([]Notification, len(c.history))
+	for i, n := range c.history {
+		recent[len(c.history)-1-i] = n
+	}
+	return recent
+}
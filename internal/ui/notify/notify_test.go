@@ -0,0 +1,128 @@
+package notify
+
+import (
+	"strings"
+	"testing"
+	"time"
+)
+
+// testCenter returns a Center whose timers fire only when the test calls
+// fire, with a clock the test moves
+type testCenter struct {
+	*Center
+	shown  []string
+	idled  int
+	timers []func()
+	clock  time.Time
+}
+
+func newTestCenter() *testCenter {
+	tc := &testCenter{clock: time.Date(2026, 1, 1, 0, 0, 0, 0, time.UTC)}
+	tc.Center = New(func(f func()) { f() }, func(text string) {
+		tc.shown = append(tc.shown, text)
+	}, func() {
+		tc.idled++
+	})
+	tc.afterFunc = func(d time.Duration, f func()) {
+		tc.timers = append(tc.timers, f)
+	}
+	tc.now = func() time.Time { return tc.clock }
+	return tc
+}
+
+// fire runs the most recently scheduled timer
+func (tc *testCenter) fire() {
+	tc.timers[len(tc.timers)-1]()
+}
+
+func (tc *testCenter) last() string {
+	if len(tc.shown) == 0 {
+		return ""
+	}
+	return tc.shown[len(tc.shown)-1]
+}
+
+func TestPostShowsAndExpires(t *testing.T) {
+	tc := newTestCenter()
+	tc.Success("Saved")
+	if !strings.Contains(tc.last(), "✓ Saved") {
+		t.Fatalf("expected the success message, got %q", tc.last())
+	}
+	if !tc.Showing() {
+		t.Error("expected a notification to be showing")
+	}
+
+	tc.fire()
+	if tc.Showing() || tc.idled != 1 {
+		t.Errorf("expected the message to expire to idle, showing=%v idled=%d", tc.Showing(), tc.idled)
+	}
+}
+
+func TestLowerSeverityWaitsBehindError(t *testing.T) {
+	tc := newTestCenter()
+	tc.Error("bd failed")
+	errorTimer := len(tc.timers) - 1
+	tc.Success("Copied")
+	if !strings.Contains(tc.last(), "bd failed") {
+		t.Fatalf("expected the error to stay up, got %q", tc.last())
+	}
+
+	// The queued success is still fresh when the error expires
+	tc.timers[errorTimer]()
+	if !strings.Contains(tc.last(), "Copied") {
+		t.Errorf("expected the queued success next, got %q", tc.last())
+	}
+
+	// A newer message of the same level replaces the current one
+	tc.Success("Copied again")
+	if !strings.Contains(tc.last(), "Copied again") {
+		t.Errorf("expected the newer message, got %q", tc.last())
+	}
+	// The replaced message's timer no longer applies
+	tc.timers[len(tc.timers)-2]()
+	if !tc.Showing() {
+		t.Error("expected a stale timer to be ignored")
+	}
+}
+
+func TestStaleQueuedMessagesAreSkipped(t *testing.T) {
+	tc := newTestCenter()
+	tc.Error("bd failed")
+	tc.Info("Refreshed")
+	tc.clock = tc.clock.Add(time.Minute)
+
+	tc.fire()
+	if tc.Showing() || tc.idled != 1 {
+		t.Errorf("expected the stale info to be skipped, last shown %q", tc.last())
+	}
+}
+
+func TestSetDurationAndRecent(t *testing.T) {
+	tc := newTestCenter()
+	var durations []time.Duration
+	tc.afterFunc = func(d time.Duration, f func()) {
+		durations = append(durations, d)
+	}
+	tc.SetDuration(LevelWarn, 7*time.Second)
+	tc.Warn("careful")
+	tc.Info("note")
+	if len(durations) != 1 || durations[0] != 7*time.Second {
+		t.Errorf("expected the configured warn duration, got %v", durations)
+	}
+
+	recent := tc.Recent()
+	if len(recent) != 2 || recent[0].Text != "note" || recent[1].Level != LevelWarn {
+		t.Errorf("expected newest first, got %+v", recent)
+	}
+}
+
+func TestParseLevel(t *testing.T) {
+	for _, level := range Levels {
+		if got, ok := ParseLevel(strings.ToUpper(level.String())); !ok || got != level {
+			t.Errorf("ParseLevel(%q) = %v, %v", level.String(), got, ok)
+		}
+	}
+	if _, ok := ParseLevel("fatal"); ok {
+		t.Error("expected an unknown level to fail")
+	}
+}
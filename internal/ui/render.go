@@ -9,17 +9,55 @@ import (
 	"github.com/rivo/tview"
 )
 
+// ListOptions are the display settings the issue list is built with
+type ListOptions struct {
+	ShowClosedIssues bool
+	ShowPrefix       bool     // Show the ID prefix ("tui-abc" rather than "abc")
+	Columns          []Column // Visible columns, in order (nil for DefaultColumns)
+	Grouping         Grouping // How list view is sectioned (tree view ignores it)
+}
+
 // PopulateIssueList clears and rebuilds the issue list from state
-// Updates the provided indexToIssue map in place to avoid stale pointer issues
+// Updates the provided indexToIssue map in place to avoid stale pointer issues.
+// Issue rows are formatted lazily by the list, so only rows that are drawn
+// pay for formatting. Returns list view's sections, in order (none in tree
+// view).
 func PopulateIssueList(
-	issueList *tview.List,
+	issueList *VirtualList,
 	appState *state.State,
-	showClosedIssues bool,
-	showPrefix bool,
+	opts ListOptions,
 	indexToIssue map[int]*parser.Issue,
-) {
-	// Clear and rebuild issue list
-	issueList.Clear()
+) []Section {
+	rows, sections := BuildIssueListRows(appState, opts, indexToIssue)
+	issueList.SetRows(rows)
+	return sections
+}
+
+// BuildIssueListRows lays out the issue list rows (section headers and
+// issues, in list or tree view) without a list to show them in, filling
+// indexToIssue with the row index of each issue. Issue rows are formatted
+// on demand (ListRow.Format). The sections say where each of list view's
+// sections starts.
+func BuildIssueListRows(
+	appState *state.State,
+	opts ListOptions,
+	indexToIssue map[int]*parser.Issue,
+) ([]ListRow, []Section) {
+	showPrefix := opts.ShowPrefix
+	columns := opts.Columns
+	if columns == nil {
+		columns = DefaultColumns()
+	}
+	var rows []ListRow
+	var sections []Section
+	addRow := func(text string) {
+		rows = append(rows, ListRow{Text: text})
+	}
+	addIssueRow := func(issue *parser.Issue, statusIcon string) {
+		rows = append(rows, ListRow{Format: func() string {
+			return formatIssueListItem(appState, issue, statusIcon, showPrefix, columns)
+		}})
+	}
 
 	// Clear the map in place (don't create a new one)
 	for k := range indexToIssue {
@@ -31,8 +69,8 @@ func PopulateIssueList(
 	if appState.HasActiveFilters() {
 		warningColor := formatting.GetWarningColor()
 		emphasisColor := formatting.GetEmphasisColor()
-		issueList.AddItem(fmt.Sprintf("[%s::b]⊘ FILTERED[-::-] [%s]%s[-] — press f to modify",
-			warningColor, emphasisColor, appState.GetActiveFilters()), "", 0, nil)
+		addRow(fmt.Sprintf("[%s::b]⊘ FILTERED[-::-] [%s]%s[-] — press f to modify",
+			warningColor, emphasisColor, appState.GetActiveFilters()))
 		currentIndex++
 	}
 
@@ -40,77 +78,27 @@ func PopulateIssueList(
 	if appState.GetViewMode() == state.ViewTree {
 		// Tree view
 		accentColor := formatting.GetAccentColor()
-		issueList.AddItem(fmt.Sprintf("[%s::b]DEPENDENCY TREE[-::-]", accentColor), "", 0, nil)
+		addRow(fmt.Sprintf("[%s::b]DEPENDENCY TREE[-::-]", accentColor))
 		currentIndex++
 
 		treeNodes := appState.GetTreeNodes()
 		for i, node := range treeNodes {
 			isLast := i == len(treeNodes)-1
-			renderTreeNode(issueList, appState, node, "", isLast, showPrefix, &currentIndex, indexToIssue)
+			renderTreeNode(&rows, appState, node, "", isLast, showPrefix, columns, &currentIndex, indexToIssue)
 		}
 	} else {
-		// List view (original behavior)
-		// Add in-progress issues first (most important)
-		inProgressIssues := appState.GetInProgressIssues()
-		if len(inProgressIssues) > 0 {
-			inProgressColor := formatting.GetStatusColor(parser.StatusInProgress)
-			issueList.AddItem(fmt.Sprintf("[%s::b]⬤ IN PROGRESS (%d)[-::-]", inProgressColor, len(inProgressIssues)), "", 0, nil)
-			currentIndex++
-
-			for _, issue := range inProgressIssues {
-				text := formatIssueListItem(issue, "◆", showPrefix)
-				issueList.AddItem(text, "", 0, nil)
-				indexToIssue[currentIndex] = issue
-				currentIndex++
-			}
-		}
-
-		// Add ready issues
-		readyIssues := appState.GetReadyIssues()
-		if len(readyIssues) > 0 {
-			openColor := formatting.GetStatusColor(parser.StatusOpen)
-			issueList.AddItem(fmt.Sprintf("\n[%s::b]⬤ READY (%d)[-::-]", openColor, len(readyIssues)), "", 0, nil)
-			currentIndex++
-
-			for _, issue := range readyIssues {
-				text := formatIssueListItem(issue, "●", showPrefix)
-				issueList.AddItem(text, "", 0, nil)
-				indexToIssue[currentIndex] = issue
-				currentIndex++
-			}
-		}
-
-		// Add blocked issues
-		blockedIssues := appState.GetBlockedIssues()
-		if len(blockedIssues) > 0 {
-			blockedColor := formatting.GetStatusColor(parser.StatusBlocked)
-			issueList.AddItem(fmt.Sprintf("\n[%s::b]⬤ BLOCKED (%d)[-::-]", blockedColor, len(blockedIssues)), "", 0, nil)
+		// List view, in sections by status or assignee
+		for _, section := range listSections(appState, opts.ShowClosedIssues, opts.Grouping) {
+			sections = append(sections, Section{Title: section.Title, Row: currentIndex, Shown: len(section.Rows), Total: section.Total})
+			addRow(section.Header)
 			currentIndex++
 
-			for _, issue := range blockedIssues {
-				text := formatIssueListItem(issue, "○", showPrefix)
-				issueList.AddItem(text, "", 0, nil)
-				indexToIssue[currentIndex] = issue
+			for _, row := range section.Rows {
+				addIssueRow(row.Issue, row.StatusIcon)
+				indexToIssue[currentIndex] = row.Issue
 				currentIndex++
 			}
 		}
-
-		// Add closed issues (only if showClosedIssues is enabled)
-		if showClosedIssues {
-			closedIssues := appState.GetClosedIssues()
-			if len(closedIssues) > 0 {
-				closedColor := formatting.GetStatusColor(parser.StatusClosed)
-				issueList.AddItem(fmt.Sprintf("\n[%s::b]⬤ CLOSED (%d)[-::-]", closedColor, len(closedIssues)), "", 0, nil)
-				currentIndex++
-
-				for _, issue := range closedIssues {
-					text := formatIssueListItem(issue, "✓", showPrefix)
-					issueList.AddItem(text, "", 0, nil)
-					indexToIssue[currentIndex] = issue
-					currentIndex++
-				}
-			}
-		}
 	}
 
 	// Show helpful message when no issues are visible
@@ -118,47 +106,64 @@ func PopulateIssueList(
 		mutedColor := formatting.GetMutedColor()
 		emphasisColor := formatting.GetEmphasisColor()
 		if appState.HasActiveFilters() {
-			issueList.AddItem(fmt.Sprintf("\n  [%s]No issues match current filters[-]", mutedColor), "", 0, nil)
-			issueList.AddItem(fmt.Sprintf("  [%s]Press 'f' to modify filters[-]", emphasisColor), "", 0, nil)
+			addRow(fmt.Sprintf("\n  [%s]No issues match current filters[-]", mutedColor))
+			addRow(fmt.Sprintf("  [%s]Press 'f' to modify filters[-]", emphasisColor))
 		} else {
-			issueList.AddItem(fmt.Sprintf("\n  [%s]No issues found[-]", mutedColor), "", 0, nil)
-			issueList.AddItem(fmt.Sprintf("  [%s]Press 'a' to create an issue[-]", emphasisColor), "", 0, nil)
+			addRow(fmt.Sprintf("\n  [%s]No issues found[-]", mutedColor))
+			addRow(fmt.Sprintf("  [%s]Press 'a' to create an issue[-]", emphasisColor))
 		}
 	}
+
+	return rows, sections
 }
 
 // formatIssueListItem formats a single issue for the list view
-func formatIssueListItem(issue *parser.Issue, statusIcon string, showPrefix bool) string {
-	priorityColor := formatting.GetPriorityColor(issue.Priority)
-	typeIcon := formatting.GetTypeIcon(issue.IssueType)
-	displayID := formatting.FormatIssueID(issue.ID, showPrefix)
-	text := fmt.Sprintf("  [%s]%s[-] %s %s [P%d] %s",
-		priorityColor, statusIcon, typeIcon, displayID, issue.Priority, issue.Title)
+func formatIssueListItem(appState *state.State, issue *parser.Issue, statusIcon string, showPrefix bool, columns []Column) string {
+	return "  " + formatColumns(appState, issue, columns, rowStyle{
+		StatusIcon: statusIcon,
+		IconColor:  formatting.GetPriorityColor(issue.Priority),
+		ShowPrefix: showPrefix,
+	})
+}
 
-	// Add labels if present
-	if len(issue.Labels) > 0 {
-		mutedColor := formatting.GetMutedColor()
-		text += fmt.Sprintf(" [%s]", mutedColor)
-		for i, label := range issue.Labels {
-			if i > 0 {
-				text += " "
-			}
-			text += "#" + label
-		}
-		text += "[-]"
+// watchMarker flags watched issues ahead of the title: "!" when the issue
+// changed in a refresh and hasn't been looked at since, "◉" otherwise
+func watchMarker(appState *state.State, issueID string) string {
+	switch {
+	case appState.HasUnseenChange(issueID):
+		return fmt.Sprintf("[%s::b]![-::-] ", formatting.GetWarningColor())
+	case appState.IsWatched(issueID):
+		return fmt.Sprintf("[%s]◉[-] ", formatting.GetMutedColor())
 	}
+	return ""
+}
 
-	return text
+// cycleMarker flags issues in a dependency cycle ahead of the title (gl lists
+// the cycles)
+func cycleMarker(appState *state.State, issueID string) string {
+	if appState.InCycle(issueID) {
+		return fmt.Sprintf("[%s::b]⟲[-::-] ", formatting.GetErrorColor())
+	}
+	return ""
+}
+
+// branchMarker flags the issue the checked-out git branch is named after
+func branchMarker(appState *state.State, issueID string) string {
+	if appState.BranchIssue() == issueID {
+		return fmt.Sprintf("[%s::b]⎇[-::-] ", formatting.GetAccentColor())
+	}
+	return ""
 }
 
 // renderTreeNode recursively renders a tree node and its children
 func renderTreeNode(
-	issueList *tview.List,
+	rows *[]ListRow,
 	appState *state.State,
 	node *state.TreeNode,
 	prefix string,
 	isLast bool,
 	showPrefix bool,
+	columns []Column,
 	currentIndex *int,
 	indexToIssue map[int]*parser.Issue,
 ) {
@@ -167,17 +172,18 @@ func renderTreeNode(
 	isCollapsed := appState.IsCollapsed(issue.ID)
 
 	// Determine branch characters
+	icons := formatting.Icons()
 	var branch, continuation string
 	if node.Depth == 0 {
 		branch = ""
 		continuation = ""
 	} else {
 		if isLast {
-			branch = "└── "
+			branch = icons.LastBranch
 			continuation = "    "
 		} else {
-			branch = "├── "
-			continuation = "│   "
+			branch = icons.Branch
+			continuation = icons.Continuation
 		}
 	}
 
@@ -188,18 +194,18 @@ func renderTreeNode(
 	var statusColor string
 	switch {
 	case issue.Status == parser.StatusClosed:
-		statusIcon = "✓"
+		statusIcon = icons.Closed
 		statusColor = formatting.GetStatusColor(parser.StatusClosed)
 	case issue.Status == parser.StatusInProgress:
-		statusIcon = "◆"
+		statusIcon = icons.InProgress
 		statusColor = formatting.GetStatusColor(parser.StatusInProgress)
 	case appState.IsEffectivelyBlocked(issue.ID):
 		// Blocked by explicit status OR by dependency
-		statusIcon = "○"
+		statusIcon = icons.Blocked
 		statusColor = formatting.GetStatusColor(parser.StatusBlocked)
 	default:
 		// Ready (open and not blocked)
-		statusIcon = "●"
+		statusIcon = icons.Ready
 		statusColor = formatting.GetStatusColor(parser.StatusOpen)
 	}
 
@@ -207,41 +213,30 @@ func renderTreeNode(
 	collapseIndicator := ""
 	if hasChildren {
 		if isCollapsed {
-			collapseIndicator = "▶ " // Collapsed - can expand
+			collapseIndicator = icons.Collapsed + " " // Collapsed - can expand
 		} else {
-			collapseIndicator = "▼ " // Expanded - can collapse
+			collapseIndicator = icons.Expanded + " " // Expanded - can collapse
 		}
 	} else {
 		collapseIndicator = "  " // Leaf node - no indicator (maintain alignment)
 	}
 
-	// Format issue line
-	priorityColor := formatting.GetPriorityColor(issue.Priority)
-	typeIcon := formatting.GetTypeIcon(issue.IssueType)
-	displayID := formatting.FormatIssueID(issue.ID, showPrefix)
-	text := fmt.Sprintf("%s%s%s[%s]%s[-] %s [%s]%s[-] [P%d] %s",
-		prefix, branch, collapseIndicator, statusColor, statusIcon, typeIcon, priorityColor, displayID, issue.Priority, issue.Title)
-
-	// Add child count for collapsed nodes
-	if hasChildren && isCollapsed {
-		mutedColor := formatting.GetMutedColor()
-		text += fmt.Sprintf(" [%s](%d children)[-]", mutedColor, len(node.Children))
-	}
-
-	// Add labels if present
-	if len(issue.Labels) > 0 {
-		mutedColor := formatting.GetMutedColor()
-		text += fmt.Sprintf(" [%s]", mutedColor)
-		for i, label := range issue.Labels {
-			if i > 0 {
-				text += " "
-			}
-			text += "#" + label
+	// Format issue line when it's first drawn
+	format := func() string {
+		// Add child count for collapsed nodes
+		suffix := ""
+		if hasChildren && isCollapsed {
+			suffix = fmt.Sprintf("[%s](%d children)[-]", formatting.GetMutedColor(), len(node.Children))
 		}
-		text += "[-]"
+		return prefix + branch + collapseIndicator + formatColumns(appState, issue, columns, rowStyle{
+			StatusIcon:  statusIcon,
+			IconColor:   statusColor,
+			IDColor:     formatting.GetPriorityColor(issue.Priority),
+			TitleSuffix: suffix,
+			ShowPrefix:  showPrefix,
+		})
 	}
-
-	issueList.AddItem(text, "", 0, nil)
+	*rows = append(*rows, ListRow{Format: format})
 	indexToIssue[*currentIndex] = issue
 	*currentIndex++
 
@@ -250,14 +245,14 @@ func renderTreeNode(
 		for i, child := range node.Children {
 			isLastChild := i == len(node.Children)-1
 			newPrefix := prefix + continuation
-			renderTreeNode(issueList, appState, child, newPrefix, isLastChild, showPrefix, currentIndex, indexToIssue)
+			renderTreeNode(rows, appState, child, newPrefix, isLastChild, showPrefix, columns, currentIndex, indexToIssue)
 		}
 	}
 }
 
 // UpdatePanelFocus updates the visual indicators for which panel is focused
 func UpdatePanelFocus(
-	issueList *tview.List,
+	issueList *VirtualList,
 	detailPanel *tview.TextView,
 	detailPanelFocused bool,
 ) {
@@ -0,0 +1,292 @@
+package ui
+
+import (
+	"github.com/gdamore/tcell/v2"
+	"github.com/rivo/tview"
+)
+
+// ListRow is one row of a VirtualList. Headers and messages set Text; issue
+// rows set Format, which is called the first time the row is drawn so a
+// list of thousands of issues only formats the rows that are ever on screen.
+type ListRow struct {
+	Text   string
+	Format func() string
+
+	formatted bool // Text holds Format's result
+}
+
+// VirtualList is a single-line-per-row list that works like tview.List for
+// the issue list (same navigation, selection colors, and changed callback)
+// but takes all its rows at once and formats them lazily while drawing.
+type VirtualList struct {
+	*tview.Box
+
+	rows        []ListRow
+	currentItem int
+	itemOffset  int
+
+	mainTextColor           tcell.Color
+	selectedTextColor       tcell.Color
+	selectedBackgroundColor tcell.Color
+
+	changed       func(index int)
+	rightClicked  func(index, x, y int)
+	doubleClicked func(index int)
+}
+
+// NewVirtualList returns an empty list
+func NewVirtualList() *VirtualList {
+	return &VirtualList{
+		Box:                     tview.NewBox(),
+		mainTextColor:           tview.Styles.PrimaryTextColor,
+		selectedTextColor:       tview.Styles.PrimitiveBackgroundColor,
+		selectedBackgroundColor: tview.Styles.PrimaryTextColor,
+	}
+}
+
+// SetMainTextColor sets the text color of unselected rows (color tags in
+// the rows override it)
+func (l *VirtualList) SetMainTextColor(color tcell.Color) *VirtualList {
+	l.mainTextColor = color
+	return l
+}
+
+// SetSelectedTextColor sets the text color of the selected row
+func (l *VirtualList) SetSelectedTextColor(color tcell.Color) *VirtualList {
+	l.selectedTextColor = color
+	return l
+}
+
+// SetSelectedBackgroundColor sets the background color of the selected row
+func (l *VirtualList) SetSelectedBackgroundColor(color tcell.Color) *VirtualList {
+	l.selectedBackgroundColor = color
+	return l
+}
+
+// SetChangedFunc sets the function called when the selected row changes
+func (l *VirtualList) SetChangedFunc(handler func(index int)) *VirtualList {
+	l.changed = handler
+	return l
+}
+
+// SetRightClickedFunc sets the function called after a right click selects
+// a row, with the screen position clicked
+func (l *VirtualList) SetRightClickedFunc(handler func(index, x, y int)) *VirtualList {
+	l.rightClicked = handler
+	return l
+}
+
+// SetDoubleClickedFunc sets the function called when a row is double-clicked
+// (the first click has already selected it)
+func (l *VirtualList) SetDoubleClickedFunc(handler func(index int)) *VirtualList {
+	l.doubleClicked = handler
+	return l
+}
+
+// SetRows replaces every row and selects the first one, like clearing and
+// refilling a tview.List. The scroll offset is kept so restoring the
+// previous selection afterwards doesn't make the list jump.
+func (l *VirtualList) SetRows(rows []ListRow) *VirtualList {
+	l.rows = rows
+	l.currentItem = 0
+	if len(rows) > 0 && l.changed != nil {
+		l.changed(0)
+	}
+	return l
+}
+
+// Clear removes every row
+func (l *VirtualList) Clear() *VirtualList {
+	l.rows = nil
+	l.currentItem = 0
+	return l
+}
+
+// GetItemCount returns the number of rows
+func (l *VirtualList) GetItemCount() int {
+	return len(l.rows)
+}
+
+// GetItemText returns the (formatted) text of a row
+func (l *VirtualList) GetItemText(index int) string {
+	row := &l.rows[index]
+	if row.Format != nil && !row.formatted {
+		row.Text = row.Format()
+		row.formatted = true
+	}
+	return row.Text
+}
+
+// Reformat formats the issue rows again the next time they're drawn, for
+// changes that don't rebuild the list (like a marker being cleared)
+func (l *VirtualList) Reformat() {
+	for i := range l.rows {
+		l.rows[i].formatted = false
+	}
+}
+
+// GetCurrentItem returns the index of the selected row
+func (l *VirtualList) GetCurrentItem() int {
+	return l.currentItem
+}
+
+// SetCurrentItem selects a row, clamping the index into range. Negative
+// indices count from the end. The changed func is called if the selection
+// moved.
+func (l *VirtualList) SetCurrentItem(index int) *VirtualList {
+	if index < 0 {
+		index = len(l.rows) + index
+	}
+	if index >= len(l.rows) {
+		index = len(l.rows) - 1
+	}
+	if index < 0 {
+		index = 0
+	}
+
+	if index != l.currentItem && l.changed != nil {
+		l.changed(index)
+	}
+	l.currentItem = index
+	return l
+}
+
+// ItemPosition returns the screen position of a row's first column, or
+// false if the row is scrolled out of view
+func (l *VirtualList) ItemPosition(index int) (x, y int, visible bool) {
+	rectX, rectY, _, height := l.GetInnerRect()
+	row := index - l.itemOffset
+	if index < 0 || index >= len(l.rows) || row < 0 || row >= height {
+		return 0, 0, false
+	}
+	return rectX, rectY + row, true
+}
+
+// GetOffset returns the number of rows scrolled off the top. The second
+// value is always 0 (there is no horizontal scrolling); it's kept so callers
+// written against tview.List still work.
+func (l *VirtualList) GetOffset() (int, int) {
+	return l.itemOffset, 0
+}
+
+// Draw draws the visible rows
+func (l *VirtualList) Draw(screen tcell.Screen) {
+	l.Box.DrawForSubclass(screen, l)
+
+	x, y, width, height := l.GetInnerRect()
+	if height <= 0 {
+		return
+	}
+	_, totalHeight := screen.Size()
+	if y+height > totalHeight {
+		height = totalHeight - y
+	}
+
+	// Keep the selected row in view
+	if l.currentItem < l.itemOffset {
+		l.itemOffset = l.currentItem
+	} else if l.currentItem-l.itemOffset >= height {
+		l.itemOffset = l.currentItem + 1 - height
+	}
+	if l.itemOffset > len(l.rows)-1 {
+		l.itemOffset = max(len(l.rows)-1, 0)
+	}
+
+	for index := l.itemOffset; index < len(l.rows) && index-l.itemOffset < height; index++ {
+		row := y + index - l.itemOffset
+		if index != l.currentItem {
+			tview.Print(screen, l.GetItemText(index), x, row, width, tview.AlignLeft, l.mainTextColor)
+			continue
+		}
+
+		// Highlight the selected row's text, as tview.List does
+		_, printed := tview.Print(screen, l.GetItemText(index), x, row, width, tview.AlignLeft, l.selectedTextColor)
+		for bx := 0; bx < printed; bx++ {
+			mainc, combc, style, _ := screen.GetContent(x+bx, row)
+			screen.SetContent(x+bx, row, mainc, combc, style.Background(l.selectedBackgroundColor))
+		}
+	}
+}
+
+// InputHandler handles Up/Down (wrapping around), Tab/Backtab, Home/End,
+// and PgUp/PgDn
+func (l *VirtualList) InputHandler() func(event *tcell.EventKey, setFocus func(p tview.Primitive)) {
+	return l.WrapInputHandler(func(event *tcell.EventKey, setFocus func(p tview.Primitive)) {
+		if len(l.rows) == 0 {
+			return
+		}
+		_, _, _, height := l.GetInnerRect()
+
+		index := l.currentItem
+		switch event.Key() {
+		case tcell.KeyDown, tcell.KeyTab:
+			index++
+		case tcell.KeyUp, tcell.KeyBacktab:
+			index--
+		case tcell.KeyHome:
+			index = 0
+		case tcell.KeyEnd:
+			index = len(l.rows) - 1
+		case tcell.KeyPgDn:
+			index = min(index+height, len(l.rows)-1)
+		case tcell.KeyPgUp:
+			index = max(index-height, 0)
+		default:
+			return
+		}
+		if index < 0 {
+			index = len(l.rows) - 1
+		} else if index >= len(l.rows) {
+			index = 0
+		}
+		l.SetCurrentItem(index)
+	})
+}
+
+// MouseHandler selects the clicked row, reports right and double clicks on
+// rows, and scrolls with the wheel
+func (l *VirtualList) MouseHandler() func(action tview.MouseAction, event *tcell.EventMouse, setFocus func(p tview.Primitive)) (consumed bool, capture tview.Primitive) {
+	return l.WrapMouseHandler(func(action tview.MouseAction, event *tcell.EventMouse, setFocus func(p tview.Primitive)) (consumed bool, capture tview.Primitive) {
+		if !l.InRect(event.Position()) {
+			return false, nil
+		}
+
+		_, rectY, _, height := l.GetInnerRect()
+		x, y := event.Position()
+		index := l.itemOffset + y - rectY
+		onRow := y >= rectY && y < rectY+height && index < len(l.rows)
+		switch action {
+		case tview.MouseLeftClick:
+			setFocus(l)
+			if onRow {
+				l.SetCurrentItem(index)
+			}
+			return true, nil
+		case tview.MouseRightClick:
+			setFocus(l)
+			if onRow {
+				l.SetCurrentItem(index)
+				if l.rightClicked != nil {
+					l.rightClicked(index, x, y)
+				}
+			}
+			return true, nil
+		case tview.MouseLeftDoubleClick:
+			if onRow && index == l.currentItem && l.doubleClicked != nil {
+				l.doubleClicked(index)
+			}
+			return true, nil
+		case tview.MouseScrollUp:
+			if l.itemOffset > 0 {
+				l.itemOffset--
+			}
+			return true, nil
+		case tview.MouseScrollDown:
+			if len(l.rows)-l.itemOffset > height {
+				l.itemOffset++
+			}
+			return true, nil
+		}
+		return false, nil
+	})
+}
@@ -1,24 +1,70 @@
 package watcher
 
 import (
+	"errors"
 	"fmt"
 	"log"
+	"os"
+	"path/filepath"
+	"sync"
 	"sync/atomic"
 	"time"
 
 	"github.com/fsnotify/fsnotify"
 )
 
-// Watcher monitors a file for changes and triggers a callback
+// State is how healthy a watcher is
+type State int
+
+const (
+	StateStopped  State = iota // Not watching: not started, stopped, or gave up restarting
+	StateRunning               // Watching for changes
+	StateDegraded              // The fsnotify watcher failed and is being restarted
+)
+
+func (s State) String() string {
+	switch s {
+	case StateRunning:
+		return "running"
+	case StateDegraded:
+		return "degraded"
+	}
+	return "stopped"
+}
+
+// Restart backoff: the first retry waits restartBackoff, each failed one
+// doubles the wait up to maxRestartBackoff, and after maxRestarts failures
+// in a row the watcher gives up
+const (
+	restartBackoff    = time.Second
+	maxRestartBackoff = 30 * time.Second
+	maxRestarts       = 10
+)
+
+// Watcher monitors a file (or the files in a directory) for changes and
+// triggers a callback
 type Watcher struct {
+	mu            sync.Mutex
 	watcher       *fsnotify.Watcher
 	path          string
+	dir           string // The directory fsnotify watches
 	debounceDelay time.Duration
 	onChange      func()
+	onState       func(State)
+	state         State
 	stopCh        chan struct{}
 	errorCount    atomic.Uint64
+	files         map[string]bool // Names watched in the directory (nil: every file)
+
+	backoff     time.Duration
+	maxBackoff  time.Duration
+	maxRestarts int
 }
 
+// sqliteSuffixes name the files SQLite keeps next to a database; changes to
+// them are changes to the database
+var sqliteSuffixes = []string{"-wal", "-shm", "-journal"}
+
 // New creates a new file watcher
 func New(path string, debounceDelay time.Duration, onChange func()) (*Watcher, error) {
 	fsWatcher, err := fsnotify.NewWatcher()
@@ -32,51 +78,164 @@ func New(path string, debounceDelay time.Duration, onChange func()) (*Watcher, e
 		debounceDelay: debounceDelay,
 		onChange:      onChange,
 		stopCh:        make(chan struct{}),
+		backoff:       restartBackoff,
+		maxBackoff:    maxRestartBackoff,
+		maxRestarts:   maxRestarts,
 	}
 
 	return w, nil
 }
 
-// Start begins watching the file (and SQLite WAL file if applicable)
+// Start begins watching the file or directory. A file is watched through its
+// directory, along with the SQLite WAL, shared-memory, and journal files next
+// to it, so writes that only reach the WAL and a database replaced by rename
+// are both seen, and the watch survives the file being recreated.
+//
+// If the fsnotify watcher fails later (an error, or the directory itself
+// going away), it's restarted with backoff; onChange is called once it's
+// back, since changes may have been missed in between.
 func (w *Watcher) Start() error {
-	if err := w.watcher.Add(w.path); err != nil {
+	w.dir = w.path
+	if info, err := os.Stat(w.path); err != nil || !info.IsDir() {
+		w.dir = filepath.Dir(w.path)
+		base := filepath.Base(w.path)
+		w.files = map[string]bool{base: true}
+		for _, suffix := range sqliteSuffixes {
+			w.files[base+suffix] = true
+		}
+	}
+	if err := w.watcher.Add(w.dir); err != nil {
 		return fmt.Errorf("failed to watch file: %w", err)
 	}
 
-	// For SQLite databases, also watch the WAL file where changes are written
-	walPath := w.path + "-wal"
-	// WAL file might not exist yet, which is fine - we'll still catch changes to the main DB file
-	_ = w.watcher.Add(walPath)
-
-	go w.watchLoop()
+	w.setState(StateRunning)
+	go w.run(w.watcher)
 	return nil
 }
 
-// Stop stops watching the file
+// Stop stops watching the file. The state becomes StateStopped without
+// calling the state callback.
 func (w *Watcher) Stop() error {
 	close(w.stopCh)
+	w.mu.Lock()
+	defer w.mu.Unlock()
+	w.state = StateStopped
 	return w.watcher.Close()
 }
 
+// SetOnStateChange sets a callback for when the watcher's state changes. It's
+// called on the watcher's goroutine.
+func (w *Watcher) SetOnStateChange(onState func(State)) {
+	w.mu.Lock()
+	defer w.mu.Unlock()
+	w.onState = onState
+}
+
+// State returns whether the watcher is running, restarting, or stopped
+func (w *Watcher) State() State {
+	w.mu.Lock()
+	defer w.mu.Unlock()
+	return w.state
+}
+
+// setState records a new state and reports it if it changed
+func (w *Watcher) setState(state State) {
+	w.mu.Lock()
+	changed := w.state != state
+	w.state = state
+	onState := w.onState
+	w.mu.Unlock()
+	if changed && onState != nil {
+		onState(state)
+	}
+}
+
 // ErrorCount returns the number of errors encountered by the watcher
 func (w *Watcher) ErrorCount() uint64 {
 	return w.errorCount.Load()
 }
 
-// watchLoop runs the main watch loop with debouncing
-func (w *Watcher) watchLoop() {
+// run watches with fsWatcher until Stop, restarting it whenever it fails
+func (w *Watcher) run(fsWatcher *fsnotify.Watcher) {
+	for fsWatcher != nil {
+		err := w.watchLoop(fsWatcher)
+		if err == nil {
+			return
+		}
+		w.errorCount.Add(1)
+		log.Printf("WATCHER ERROR: path=%s count=%d error=%v (restarting)", w.path, w.errorCount.Load(), err)
+		_ = fsWatcher.Close()
+		w.setState(StateDegraded)
+		fsWatcher = w.restart()
+	}
+}
+
+// restart makes a new fsnotify watcher on the directory, retrying with
+// backoff. It returns nil if the watcher was stopped or gave up.
+func (w *Watcher) restart() *fsnotify.Watcher {
+	delay := w.backoff
+	for attempt := 1; ; attempt++ {
+		select {
+		case <-w.stopCh:
+			return nil
+		case <-time.After(delay):
+		}
+
+		fsWatcher, err := fsnotify.NewWatcher()
+		if err == nil {
+			if err = fsWatcher.Add(w.dir); err != nil {
+				_ = fsWatcher.Close()
+			}
+		}
+		if err == nil {
+			w.mu.Lock()
+			select {
+			case <-w.stopCh:
+				// Stopped while restarting
+				w.mu.Unlock()
+				_ = fsWatcher.Close()
+				return nil
+			default:
+			}
+			w.watcher = fsWatcher
+			w.mu.Unlock()
+			log.Printf("WATCHER: restarted on %s after %d attempt(s)", w.dir, attempt)
+			w.setState(StateRunning)
+			w.onChange()
+			return fsWatcher
+		}
+
+		w.errorCount.Add(1)
+		log.Printf("WATCHER ERROR: restart %d/%d on %s failed: %v", attempt, w.maxRestarts, w.dir, err)
+		if attempt >= w.maxRestarts {
+			w.setState(StateStopped)
+			return nil
+		}
+		delay = min(delay*2, w.maxBackoff)
+	}
+}
+
+// watchLoop handles fsWatcher's events with debouncing. It returns nil once
+// the watcher is stopped, or an error if fsWatcher failed and needs
+// restarting.
+func (w *Watcher) watchLoop(fsWatcher *fsnotify.Watcher) error {
 	var debounceTimer *time.Timer
 
 	for {
 		select {
-		case event, ok := <-w.watcher.Events:
+		case event, ok := <-fsWatcher.Events:
 			if !ok {
-				return
+				return w.closedErr()
+			}
+
+			// The watched directory going away ends its watch
+			if event.Name == w.dir && (event.Has(fsnotify.Remove) || event.Has(fsnotify.Rename)) {
+				return fmt.Errorf("%s was removed", w.dir)
 			}
 
-			// Only respond to write and create events
-			if event.Op&fsnotify.Write == fsnotify.Write || event.Op&fsnotify.Create == fsnotify.Create {
-				// Debounce: reset timer if it's already running
+			if w.relevant(event) {
+				// Debounce: reset timer if it's already running, so a burst
+				// across the database and its WAL is one change
 				if debounceTimer != nil {
 					debounceTimer.Stop()
 				}
@@ -86,19 +245,42 @@ func (w *Watcher) watchLoop() {
 				})
 			}
 
-		case err, ok := <-w.watcher.Errors:
+		case err, ok := <-fsWatcher.Errors:
 			if !ok {
-				return
+				return w.closedErr()
 			}
-			// Log watcher errors for debugging
-			w.errorCount.Add(1)
-			log.Printf("WATCHER ERROR: path=%s count=%d error=%v", w.path, w.errorCount.Load(), err)
+			return err
 
 		case <-w.stopCh:
 			if debounceTimer != nil {
 				debounceTimer.Stop()
 			}
-			return
+			return nil
 		}
 	}
 }
+
+// closedErr is watchLoop's result when fsnotify closes its channels: nil if
+// that's from Stop, otherwise an error so it's restarted
+func (w *Watcher) closedErr() error {
+	select {
+	case <-w.stopCh:
+		return nil
+	default:
+		return errors.New("fsnotify watcher closed")
+	}
+}
+
+// relevant reports whether an event is a change worth reporting. In
+// directory mode that's any file written or created; for a file it's the
+// file or its SQLite companions being written, created, renamed, or removed.
+func (w *Watcher) relevant(event fsnotify.Event) bool {
+	if w.files == nil {
+		return event.Has(fsnotify.Write) || event.Has(fsnotify.Create)
+	}
+	if !w.files[filepath.Base(event.Name)] {
+		return false
+	}
+	return event.Has(fsnotify.Write) || event.Has(fsnotify.Create) ||
+		event.Has(fsnotify.Rename) || event.Has(fsnotify.Remove)
+}
@@ -52,6 +52,35 @@ func TestWatcher(t *testing.T) {
 	}
 }
 
+func TestWatcherDirectory(t *testing.T) {
+	// Watching a directory reports files written or created in it
+	tmpDir := t.TempDir()
+
+	called := make(chan bool, 10)
+	w, err := New(tmpDir, 50*time.Millisecond, func() {
+		called <- true
+	})
+	if err != nil {
+		t.Fatalf("Failed to create watcher: %v", err)
+	}
+	if err := w.Start(); err != nil {
+		t.Fatalf("Failed to start watcher: %v", err)
+	}
+	defer func() { _ = w.Stop() }()
+
+	time.Sleep(100 * time.Millisecond)
+
+	if err := os.WriteFile(filepath.Join(tmpDir, "theme.toml"), []byte("[theme]"), 0644); err != nil {
+		t.Fatalf("Failed to create file: %v", err)
+	}
+
+	select {
+	case <-called:
+	case <-time.After(500 * time.Millisecond):
+		t.Fatal("onChange was not called for a new file in the directory")
+	}
+}
+
 func TestWatcherDebounce(t *testing.T) {
 	tmpDir := t.TempDir()
 	testFile := filepath.Join(tmpDir, "test.txt")
@@ -133,3 +162,210 @@ func TestWatcherStop(t *testing.T) {
 		t.Error("onChange was called after watcher was stopped")
 	}
 }
+
+// startDBWatcher watches a fresh beads.db in a temp dir, reporting changes
+// on the returned channel
+func startDBWatcher(t *testing.T) (string, chan bool) {
+	t.Helper()
+	tmpDir := t.TempDir()
+	dbFile := filepath.Join(tmpDir, "beads.db")
+	if err := os.WriteFile(dbFile, []byte("db"), 0644); err != nil {
+		t.Fatalf("Failed to create db file: %v", err)
+	}
+
+	called := make(chan bool, 10)
+	w, err := New(dbFile, 50*time.Millisecond, func() {
+		called <- true
+	})
+	if err != nil {
+		t.Fatalf("Failed to create watcher: %v", err)
+	}
+	if err := w.Start(); err != nil {
+		t.Fatalf("Failed to start watcher: %v", err)
+	}
+	t.Cleanup(func() { _ = w.Stop() })
+
+	time.Sleep(100 * time.Millisecond)
+	return dbFile, called
+}
+
+func TestWatcherWALCreated(t *testing.T) {
+	// The WAL doesn't exist when watching starts; writing it still counts
+	dbFile, called := startDBWatcher(t)
+
+	if err := os.WriteFile(dbFile+"-wal", []byte("wal"), 0644); err != nil {
+		t.Fatalf("Failed to write WAL file: %v", err)
+	}
+
+	select {
+	case <-called:
+	case <-time.After(500 * time.Millisecond):
+		t.Fatal("onChange was not called for a WAL write")
+	}
+}
+
+func TestWatcherDBReplaced(t *testing.T) {
+	// A database replaced by rename is seen, and so are writes after it
+	dbFile, called := startDBWatcher(t)
+
+	replacement := filepath.Join(filepath.Dir(dbFile), "beads.db.tmp")
+	if err := os.WriteFile(replacement, []byte("new db"), 0644); err != nil {
+		t.Fatalf("Failed to write replacement: %v", err)
+	}
+	if err := os.Rename(replacement, dbFile); err != nil {
+		t.Fatalf("Failed to replace db file: %v", err)
+	}
+
+	select {
+	case <-called:
+	case <-time.After(500 * time.Millisecond):
+		t.Fatal("onChange was not called when the db was replaced")
+	}
+
+	if err := os.WriteFile(dbFile, []byte("written after"), 0644); err != nil {
+		t.Fatalf("Failed to write db file: %v", err)
+	}
+	select {
+	case <-called:
+	case <-time.After(500 * time.Millisecond):
+		t.Fatal("onChange was not called for a write after the db was replaced")
+	}
+}
+
+func TestWatcherIgnoresUnrelatedFiles(t *testing.T) {
+	dbFile, called := startDBWatcher(t)
+
+	if err := os.WriteFile(filepath.Join(filepath.Dir(dbFile), "issues.jsonl"), []byte("{}"), 0644); err != nil {
+		t.Fatalf("Failed to write unrelated file: %v", err)
+	}
+
+	select {
+	case <-called:
+		t.Fatal("onChange was called for a file other than the db")
+	case <-time.After(200 * time.Millisecond):
+	}
+}
+
+func TestWatcherCoalescesRelatedFiles(t *testing.T) {
+	// A write touching the db, WAL, and shared memory is one change
+	dbFile, called := startDBWatcher(t)
+
+	for _, name := range []string{dbFile + "-wal", dbFile + "-shm", dbFile} {
+		if err := os.WriteFile(name, []byte("change"), 0644); err != nil {
+			t.Fatalf("Failed to write %s: %v", name, err)
+		}
+	}
+
+	time.Sleep(200 * time.Millisecond)
+	if got := len(called); got != 1 {
+		t.Errorf("Expected 1 call for related files, got %d", got)
+	}
+}
+
+func TestWatcherRestartsAfterDirectoryRecreated(t *testing.T) {
+	// Removing the watched directory degrades the watcher; once it's back,
+	// the watcher restarts, reports a change, and sees later writes
+	beadsDir := filepath.Join(t.TempDir(), ".beads")
+	if err := os.Mkdir(beadsDir, 0755); err != nil {
+		t.Fatalf("Failed to create dir: %v", err)
+	}
+	dbFile := filepath.Join(beadsDir, "beads.db")
+	if err := os.WriteFile(dbFile, []byte("db"), 0644); err != nil {
+		t.Fatalf("Failed to create db file: %v", err)
+	}
+
+	called := make(chan bool, 10)
+	states := make(chan State, 10)
+	w, err := New(dbFile, 20*time.Millisecond, func() {
+		called <- true
+	})
+	if err != nil {
+		t.Fatalf("Failed to create watcher: %v", err)
+	}
+	w.backoff = 50 * time.Millisecond
+	w.maxBackoff = 50 * time.Millisecond
+	w.SetOnStateChange(func(state State) {
+		states <- state
+	})
+	if err := w.Start(); err != nil {
+		t.Fatalf("Failed to start watcher: %v", err)
+	}
+	defer func() { _ = w.Stop() }()
+
+	if got := <-states; got != StateRunning {
+		t.Fatalf("Expected running after Start, got %s", got)
+	}
+
+	if err := os.RemoveAll(beadsDir); err != nil {
+		t.Fatalf("Failed to remove dir: %v", err)
+	}
+	select {
+	case got := <-states:
+		if got != StateDegraded {
+			t.Fatalf("Expected degraded after the directory was removed, got %s", got)
+		}
+	case <-time.After(time.Second):
+		t.Fatal("State didn't change when the directory was removed")
+	}
+
+	if err := os.Mkdir(beadsDir, 0755); err != nil {
+		t.Fatalf("Failed to recreate dir: %v", err)
+	}
+	select {
+	case got := <-states:
+		if got != StateRunning {
+			t.Fatalf("Expected running after restart, got %s", got)
+		}
+	case <-time.After(time.Second):
+		t.Fatal("Watcher didn't restart once the directory was back")
+	}
+
+	// Drain the change reported for the restart (and any from the removal)
+	time.Sleep(100 * time.Millisecond)
+	for len(called) > 0 {
+		<-called
+	}
+
+	if err := os.WriteFile(dbFile, []byte("new db"), 0644); err != nil {
+		t.Fatalf("Failed to write db file: %v", err)
+	}
+	select {
+	case <-called:
+	case <-time.After(500 * time.Millisecond):
+		t.Fatal("onChange was not called for a write after restart")
+	}
+	if w.ErrorCount() == 0 {
+		t.Error("Expected the failure to be counted")
+	}
+}
+
+func TestWatcherGivesUpRestarting(t *testing.T) {
+	beadsDir := filepath.Join(t.TempDir(), ".beads")
+	if err := os.Mkdir(beadsDir, 0755); err != nil {
+		t.Fatalf("Failed to create dir: %v", err)
+	}
+
+	w, err := New(filepath.Join(beadsDir, "beads.db"), 20*time.Millisecond, func() {})
+	if err != nil {
+		t.Fatalf("Failed to create watcher: %v", err)
+	}
+	w.backoff = 10 * time.Millisecond
+	w.maxBackoff = 10 * time.Millisecond
+	w.maxRestarts = 3
+	if err := w.Start(); err != nil {
+		t.Fatalf("Failed to start watcher: %v", err)
+	}
+	defer func() { _ = w.Stop() }()
+
+	if err := os.RemoveAll(beadsDir); err != nil {
+		t.Fatalf("Failed to remove dir: %v", err)
+	}
+
+	deadline := time.Now().Add(time.Second)
+	for w.State() != StateStopped && time.Now().Before(deadline) {
+		time.Sleep(10 * time.Millisecond)
+	}
+	if got := w.State(); got != StateStopped {
+		t.Errorf("Expected stopped after restarts failed, got %s", got)
+	}
+}